@@ -6,8 +6,13 @@ import (
 	sirenv1beta1 "github.com/odpf/siren/api/proto/odpf/siren/v1beta1"
 	"github.com/odpf/siren/service"
 	"go.uber.org/zap"
+	"google.golang.org/grpc/metadata"
 )
 
+// ActorHeader is the request header naming the user a request is made for,
+// usually set by an authenticating proxy. It is recorded in rule revisions.
+const ActorHeader = "x-siren-actor"
+
 type GRPCServer struct {
 	container *service.Container
 	newrelic  *newrelic.Application
@@ -26,3 +31,14 @@ func NewGRPCServer(container *service.Container, nr *newrelic.Application, logge
 func (s *GRPCServer) Ping(ctx context.Context, in *sirenv1beta1.PingRequest) (*sirenv1beta1.PingResponse, error) {
 	return &sirenv1beta1.PingResponse{Message: "Pong"}, nil
 }
+
+func actorFromContext(ctx context.Context) string {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return ""
+	}
+	if values := md.Get(ActorHeader); len(values) > 0 {
+		return values[0]
+	}
+	return ""
+}
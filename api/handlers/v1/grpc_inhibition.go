@@ -0,0 +1,121 @@
+package v1
+
+import (
+	"context"
+	"errors"
+	sirenv1beta1 "github.com/odpf/siren/api/proto/odpf/siren/v1beta1"
+	"github.com/odpf/siren/domain"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"strings"
+)
+
+func (s *GRPCServer) ListInhibitionRules(_ context.Context, req *sirenv1beta1.ListInhibitionRulesRequest) (*sirenv1beta1.ListInhibitionRulesResponse, error) {
+	inhibitionRules, err := s.container.InhibitionService.ListInhibitionRules(req.GetNamespace())
+	if err != nil {
+		s.logger.Error("handler", zap.Error(err))
+		return nil, status.Errorf(codes.Internal, err.Error())
+	}
+
+	res := &sirenv1beta1.ListInhibitionRulesResponse{
+		InhibitionRules: make([]*sirenv1beta1.InhibitionRule, 0),
+	}
+	for _, inhibitionRule := range inhibitionRules {
+		res.InhibitionRules = append(res.InhibitionRules, getInhibitionRuleFromDomainObject(inhibitionRule))
+	}
+	return res, nil
+}
+
+func (s *GRPCServer) CreateInhibitionRule(_ context.Context, req *sirenv1beta1.CreateInhibitionRuleRequest) (*sirenv1beta1.InhibitionRule, error) {
+	inhibitionRule, err := s.container.InhibitionService.CreateInhibitionRule(&domain.InhibitionRule{
+		Urn:            req.GetUrn(),
+		Namespace:      req.GetNamespace(),
+		SourceMatchers: getMatchersInDomainObject(req.GetSourceMatchers()),
+		TargetMatchers: getMatchersInDomainObject(req.GetTargetMatchers()),
+		Equal:          req.GetEqual(),
+	})
+	if err != nil {
+		var invalidInhibitionRuleErr *domain.InvalidInhibitionRuleError
+		if errors.As(err, &invalidInhibitionRuleErr) {
+			return nil, status.Errorf(codes.InvalidArgument, err.Error())
+		}
+		var invalidMatcherErr *domain.InvalidMatcherError
+		if errors.As(err, &invalidMatcherErr) {
+			return nil, status.Errorf(codes.InvalidArgument, err.Error())
+		}
+		if strings.Contains(err.Error(), `violates unique constraint "inhibition_rule_urn_namespace_id_unique"`) {
+			return nil, status.Errorf(codes.InvalidArgument, "urn and namespace pair already exist")
+		}
+		s.logger.Error("handler", zap.Error(err))
+		return nil, status.Errorf(codes.Internal, err.Error())
+	}
+
+	return getInhibitionRuleFromDomainObject(inhibitionRule), nil
+}
+
+func (s *GRPCServer) GetInhibitionRule(_ context.Context, req *sirenv1beta1.GetInhibitionRuleRequest) (*sirenv1beta1.InhibitionRule, error) {
+	inhibitionRule, err := s.container.InhibitionService.GetInhibitionRule(req.GetId())
+	if err != nil {
+		s.logger.Error("handler", zap.Error(err))
+		return nil, status.Errorf(codes.Internal, err.Error())
+	}
+	if inhibitionRule == nil {
+		return nil, status.Errorf(codes.NotFound, "inhibition rule not found")
+	}
+
+	return getInhibitionRuleFromDomainObject(inhibitionRule), nil
+}
+
+func (s *GRPCServer) UpdateInhibitionRule(_ context.Context, req *sirenv1beta1.UpdateInhibitionRuleRequest) (*sirenv1beta1.InhibitionRule, error) {
+	inhibitionRule, err := s.container.InhibitionService.UpdateInhibitionRule(&domain.InhibitionRule{
+		Id:             req.GetId(),
+		Urn:            req.GetUrn(),
+		Namespace:      req.GetNamespace(),
+		SourceMatchers: getMatchersInDomainObject(req.GetSourceMatchers()),
+		TargetMatchers: getMatchersInDomainObject(req.GetTargetMatchers()),
+		Equal:          req.GetEqual(),
+	})
+	if err != nil {
+		var invalidInhibitionRuleErr *domain.InvalidInhibitionRuleError
+		if errors.As(err, &invalidInhibitionRuleErr) {
+			return nil, status.Errorf(codes.InvalidArgument, err.Error())
+		}
+		var invalidMatcherErr *domain.InvalidMatcherError
+		if errors.As(err, &invalidMatcherErr) {
+			return nil, status.Errorf(codes.InvalidArgument, err.Error())
+		}
+		if strings.Contains(err.Error(), `violates unique constraint "inhibition_rule_urn_namespace_id_unique"`) {
+			return nil, status.Errorf(codes.InvalidArgument, "urn and namespace pair already exist")
+		}
+		s.logger.Error("handler", zap.Error(err))
+		return nil, status.Errorf(codes.Internal, err.Error())
+	}
+
+	return getInhibitionRuleFromDomainObject(inhibitionRule), nil
+}
+
+func (s *GRPCServer) DeleteInhibitionRule(_ context.Context, req *sirenv1beta1.DeleteInhibitionRuleRequest) (*emptypb.Empty, error) {
+	err := s.container.InhibitionService.DeleteInhibitionRule(req.GetId())
+	if err != nil {
+		s.logger.Error("handler", zap.Error(err))
+		return nil, status.Errorf(codes.Internal, err.Error())
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func getInhibitionRuleFromDomainObject(inhibitionRule *domain.InhibitionRule) *sirenv1beta1.InhibitionRule {
+	return &sirenv1beta1.InhibitionRule{
+		Id:             inhibitionRule.Id,
+		Urn:            inhibitionRule.Urn,
+		Namespace:      inhibitionRule.Namespace,
+		SourceMatchers: getMatchersFromDomainObject(inhibitionRule.SourceMatchers),
+		TargetMatchers: getMatchersFromDomainObject(inhibitionRule.TargetMatchers),
+		Equal:          inhibitionRule.Equal,
+		CreatedAt:      timestamppb.New(inhibitionRule.CreatedAt),
+		UpdatedAt:      timestamppb.New(inhibitionRule.UpdatedAt),
+	}
+}
@@ -0,0 +1,299 @@
+package v1
+
+import (
+	"context"
+	"errors"
+	sirenv1beta1 "github.com/odpf/siren/api/proto/odpf/siren/v1beta1"
+	"github.com/odpf/siren/domain"
+	"github.com/odpf/siren/mocks"
+	"github.com/odpf/siren/service"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"go.uber.org/zap/zaptest"
+	"testing"
+	"time"
+)
+
+func TestGRPCServer_ListInhibitionRules(t *testing.T) {
+	t.Run("should return inhibition rules of the namespace", func(t *testing.T) {
+		mockedInhibitionService := &mocks.InhibitionService{}
+		dummyGRPCServer := GRPCServer{
+			container: &service.Container{
+				InhibitionService: mockedInhibitionService,
+			},
+			logger: zaptest.NewLogger(t),
+		}
+		dummyResult := []*domain.InhibitionRule{
+			{
+				Id:             1,
+				Urn:            "cluster-down",
+				Namespace:      1,
+				SourceMatchers: []domain.Matcher{{Name: "alertname", Operator: "=", Value: "ClusterDown"}},
+				TargetMatchers: []domain.Matcher{{Name: "alertname", Operator: "=~", Value: "Pod.*"}},
+				Equal:          []string{"cluster"},
+				CreatedAt:      time.Now(),
+				UpdatedAt:      time.Now(),
+			},
+		}
+
+		mockedInhibitionService.On("ListInhibitionRules", uint64(1)).Return(dummyResult, nil).Once()
+		res, err := dummyGRPCServer.ListInhibitionRules(context.Background(),
+			&sirenv1beta1.ListInhibitionRulesRequest{Namespace: 1})
+		assert.Nil(t, err)
+		assert.Equal(t, 1, len(res.GetInhibitionRules()))
+		assert.Equal(t, "cluster-down", res.GetInhibitionRules()[0].GetUrn())
+		assert.Equal(t, "ClusterDown", res.GetInhibitionRules()[0].GetSourceMatchers()[0].GetValue())
+		assert.Equal(t, []string{"cluster"}, res.GetInhibitionRules()[0].GetEqual())
+	})
+
+	t.Run("should return error code 13 if getting inhibition rules fails", func(t *testing.T) {
+		mockedInhibitionService := &mocks.InhibitionService{}
+		dummyGRPCServer := GRPCServer{
+			container: &service.Container{
+				InhibitionService: mockedInhibitionService,
+			},
+			logger: zaptest.NewLogger(t),
+		}
+		mockedInhibitionService.On("ListInhibitionRules", uint64(0)).
+			Return(nil, errors.New("random error")).Once()
+		res, err := dummyGRPCServer.ListInhibitionRules(context.Background(), &sirenv1beta1.ListInhibitionRulesRequest{})
+		assert.Nil(t, res)
+		assert.EqualError(t, err, "rpc error: code = Internal desc = random error")
+	})
+}
+
+func TestGRPCServer_CreateInhibitionRule(t *testing.T) {
+	payload := &sirenv1beta1.CreateInhibitionRuleRequest{
+		Urn:            "cluster-down",
+		Namespace:      1,
+		SourceMatchers: []*sirenv1beta1.Matcher{{Name: "alertname", Operator: "=", Value: "ClusterDown"}},
+		TargetMatchers: []*sirenv1beta1.Matcher{{Name: "alertname", Operator: "=~", Value: "Pod.*"}},
+		Equal:          []string{"cluster"},
+	}
+	inhibitionRule := &domain.InhibitionRule{
+		Urn:            "cluster-down",
+		Namespace:      1,
+		SourceMatchers: []domain.Matcher{{Name: "alertname", Operator: "=", Value: "ClusterDown"}},
+		TargetMatchers: []domain.Matcher{{Name: "alertname", Operator: "=~", Value: "Pod.*"}},
+		Equal:          []string{"cluster"},
+	}
+
+	t.Run("should create an inhibition rule", func(t *testing.T) {
+		mockedInhibitionService := &mocks.InhibitionService{}
+		dummyGRPCServer := GRPCServer{
+			container: &service.Container{
+				InhibitionService: mockedInhibitionService,
+			},
+			logger: zaptest.NewLogger(t),
+		}
+		created := *inhibitionRule
+		created.Id = 1
+		mockedInhibitionService.On("CreateInhibitionRule", inhibitionRule).Return(&created, nil).Once()
+		res, err := dummyGRPCServer.CreateInhibitionRule(context.Background(), payload)
+		assert.Nil(t, err)
+		assert.Equal(t, uint64(1), res.GetId())
+		assert.Equal(t, "Pod.*", res.GetTargetMatchers()[0].GetValue())
+		mockedInhibitionService.AssertExpectations(t)
+	})
+
+	t.Run("should return error code 3 if the inhibition rule is invalid", func(t *testing.T) {
+		mockedInhibitionService := &mocks.InhibitionService{}
+		dummyGRPCServer := GRPCServer{
+			container: &service.Container{
+				InhibitionService: mockedInhibitionService,
+			},
+			logger: zaptest.NewLogger(t),
+		}
+		mockedInhibitionService.On("CreateInhibitionRule", mock.Anything).
+			Return(nil, &domain.InvalidInhibitionRuleError{Reason: "source matchers cannot be empty"}).Once()
+		res, err := dummyGRPCServer.CreateInhibitionRule(context.Background(), &sirenv1beta1.CreateInhibitionRuleRequest{})
+		assert.Nil(t, res)
+		assert.EqualError(t, err, "rpc error: code = InvalidArgument desc = invalid inhibition rule: source matchers cannot be empty")
+	})
+
+	t.Run("should return error code 3 if a matcher is invalid", func(t *testing.T) {
+		mockedInhibitionService := &mocks.InhibitionService{}
+		dummyGRPCServer := GRPCServer{
+			container: &service.Container{
+				InhibitionService: mockedInhibitionService,
+			},
+			logger: zaptest.NewLogger(t),
+		}
+		invalidMatcherErr := &domain.InvalidMatcherError{
+			Matcher: domain.Matcher{Name: "alertname", Operator: "==", Value: "ClusterDown"},
+			Reason:  "unsupported operator",
+		}
+		mockedInhibitionService.On("CreateInhibitionRule", mock.Anything).Return(nil, invalidMatcherErr).Once()
+		res, err := dummyGRPCServer.CreateInhibitionRule(context.Background(), payload)
+		assert.Nil(t, res)
+		assert.EqualError(t, err, "rpc error: code = InvalidArgument desc = "+invalidMatcherErr.Error())
+	})
+
+	t.Run("should return error code 3 if urn and namespace pair already exist", func(t *testing.T) {
+		mockedInhibitionService := &mocks.InhibitionService{}
+		dummyGRPCServer := GRPCServer{
+			container: &service.Container{
+				InhibitionService: mockedInhibitionService,
+			},
+			logger: zaptest.NewLogger(t),
+		}
+		mockedInhibitionService.On("CreateInhibitionRule", inhibitionRule).
+			Return(nil, errors.New(`violates unique constraint "inhibition_rule_urn_namespace_id_unique"`)).Once()
+		res, err := dummyGRPCServer.CreateInhibitionRule(context.Background(), payload)
+		assert.Nil(t, res)
+		assert.EqualError(t, err, "rpc error: code = InvalidArgument desc = urn and namespace pair already exist")
+	})
+
+	t.Run("should return error code 13 if creating inhibition rule fails", func(t *testing.T) {
+		mockedInhibitionService := &mocks.InhibitionService{}
+		dummyGRPCServer := GRPCServer{
+			container: &service.Container{
+				InhibitionService: mockedInhibitionService,
+			},
+			logger: zaptest.NewLogger(t),
+		}
+		mockedInhibitionService.On("CreateInhibitionRule", inhibitionRule).
+			Return(nil, errors.New("random error")).Once()
+		res, err := dummyGRPCServer.CreateInhibitionRule(context.Background(), payload)
+		assert.Nil(t, res)
+		assert.EqualError(t, err, "rpc error: code = Internal desc = random error")
+	})
+}
+
+func TestGRPCServer_GetInhibitionRule(t *testing.T) {
+	t.Run("should return an inhibition rule", func(t *testing.T) {
+		mockedInhibitionService := &mocks.InhibitionService{}
+		dummyGRPCServer := GRPCServer{
+			container: &service.Container{
+				InhibitionService: mockedInhibitionService,
+			},
+			logger: zaptest.NewLogger(t),
+		}
+		mockedInhibitionService.On("GetInhibitionRule", uint64(1)).
+			Return(&domain.InhibitionRule{Id: 1, Urn: "cluster-down", Namespace: 1}, nil).Once()
+		res, err := dummyGRPCServer.GetInhibitionRule(context.Background(), &sirenv1beta1.GetInhibitionRuleRequest{Id: 1})
+		assert.Nil(t, err)
+		assert.Equal(t, uint64(1), res.GetId())
+		assert.Equal(t, "cluster-down", res.GetUrn())
+	})
+
+	t.Run("should return error code 5 if inhibition rule not found", func(t *testing.T) {
+		mockedInhibitionService := &mocks.InhibitionService{}
+		dummyGRPCServer := GRPCServer{
+			container: &service.Container{
+				InhibitionService: mockedInhibitionService,
+			},
+			logger: zaptest.NewLogger(t),
+		}
+		mockedInhibitionService.On("GetInhibitionRule", uint64(1)).Return(nil, nil).Once()
+		res, err := dummyGRPCServer.GetInhibitionRule(context.Background(), &sirenv1beta1.GetInhibitionRuleRequest{Id: 1})
+		assert.Nil(t, res)
+		assert.EqualError(t, err, "rpc error: code = NotFound desc = inhibition rule not found")
+	})
+
+	t.Run("should return error code 13 if getting inhibition rule fails", func(t *testing.T) {
+		mockedInhibitionService := &mocks.InhibitionService{}
+		dummyGRPCServer := GRPCServer{
+			container: &service.Container{
+				InhibitionService: mockedInhibitionService,
+			},
+			logger: zaptest.NewLogger(t),
+		}
+		mockedInhibitionService.On("GetInhibitionRule", uint64(1)).Return(nil, errors.New("random error")).Once()
+		res, err := dummyGRPCServer.GetInhibitionRule(context.Background(), &sirenv1beta1.GetInhibitionRuleRequest{Id: 1})
+		assert.Nil(t, res)
+		assert.EqualError(t, err, "rpc error: code = Internal desc = random error")
+	})
+}
+
+func TestGRPCServer_UpdateInhibitionRule(t *testing.T) {
+	payload := &sirenv1beta1.UpdateInhibitionRuleRequest{
+		Id:             1,
+		Urn:            "cluster-down",
+		Namespace:      1,
+		SourceMatchers: []*sirenv1beta1.Matcher{{Name: "alertname", Operator: "=", Value: "ClusterDown"}},
+		TargetMatchers: []*sirenv1beta1.Matcher{{Name: "alertname", Operator: "=~", Value: "Pod.*"}},
+	}
+	inhibitionRule := &domain.InhibitionRule{
+		Id:             1,
+		Urn:            "cluster-down",
+		Namespace:      1,
+		SourceMatchers: []domain.Matcher{{Name: "alertname", Operator: "=", Value: "ClusterDown"}},
+		TargetMatchers: []domain.Matcher{{Name: "alertname", Operator: "=~", Value: "Pod.*"}},
+	}
+
+	t.Run("should update an inhibition rule", func(t *testing.T) {
+		mockedInhibitionService := &mocks.InhibitionService{}
+		dummyGRPCServer := GRPCServer{
+			container: &service.Container{
+				InhibitionService: mockedInhibitionService,
+			},
+			logger: zaptest.NewLogger(t),
+		}
+		mockedInhibitionService.On("UpdateInhibitionRule", inhibitionRule).Return(inhibitionRule, nil).Once()
+		res, err := dummyGRPCServer.UpdateInhibitionRule(context.Background(), payload)
+		assert.Nil(t, err)
+		assert.Equal(t, uint64(1), res.GetId())
+		mockedInhibitionService.AssertExpectations(t)
+	})
+
+	t.Run("should return error code 3 if the inhibition rule is invalid", func(t *testing.T) {
+		mockedInhibitionService := &mocks.InhibitionService{}
+		dummyGRPCServer := GRPCServer{
+			container: &service.Container{
+				InhibitionService: mockedInhibitionService,
+			},
+			logger: zaptest.NewLogger(t),
+		}
+		mockedInhibitionService.On("UpdateInhibitionRule", inhibitionRule).
+			Return(nil, &domain.InvalidInhibitionRuleError{Reason: `invalid equal label "1cluster"`}).Once()
+		res, err := dummyGRPCServer.UpdateInhibitionRule(context.Background(), payload)
+		assert.Nil(t, res)
+		assert.EqualError(t, err, `rpc error: code = InvalidArgument desc = invalid inhibition rule: invalid equal label "1cluster"`)
+	})
+
+	t.Run("should return error code 13 if updating inhibition rule fails", func(t *testing.T) {
+		mockedInhibitionService := &mocks.InhibitionService{}
+		dummyGRPCServer := GRPCServer{
+			container: &service.Container{
+				InhibitionService: mockedInhibitionService,
+			},
+			logger: zaptest.NewLogger(t),
+		}
+		mockedInhibitionService.On("UpdateInhibitionRule", inhibitionRule).
+			Return(nil, errors.New("random error")).Once()
+		res, err := dummyGRPCServer.UpdateInhibitionRule(context.Background(), payload)
+		assert.Nil(t, res)
+		assert.EqualError(t, err, "rpc error: code = Internal desc = random error")
+	})
+}
+
+func TestGRPCServer_DeleteInhibitionRule(t *testing.T) {
+	t.Run("should delete an inhibition rule", func(t *testing.T) {
+		mockedInhibitionService := &mocks.InhibitionService{}
+		dummyGRPCServer := GRPCServer{
+			container: &service.Container{
+				InhibitionService: mockedInhibitionService,
+			},
+			logger: zaptest.NewLogger(t),
+		}
+		mockedInhibitionService.On("DeleteInhibitionRule", uint64(1)).Return(nil).Once()
+		res, err := dummyGRPCServer.DeleteInhibitionRule(context.Background(), &sirenv1beta1.DeleteInhibitionRuleRequest{Id: 1})
+		assert.Nil(t, err)
+		assert.NotNil(t, res)
+	})
+
+	t.Run("should return error code 13 if deleting inhibition rule fails", func(t *testing.T) {
+		mockedInhibitionService := &mocks.InhibitionService{}
+		dummyGRPCServer := GRPCServer{
+			container: &service.Container{
+				InhibitionService: mockedInhibitionService,
+			},
+			logger: zaptest.NewLogger(t),
+		}
+		mockedInhibitionService.On("DeleteInhibitionRule", uint64(1)).Return(errors.New("random error")).Once()
+		res, err := dummyGRPCServer.DeleteInhibitionRule(context.Background(), &sirenv1beta1.DeleteInhibitionRuleRequest{Id: 1})
+		assert.Nil(t, res)
+		assert.EqualError(t, err, "rpc error: code = Internal desc = random error")
+	})
+}
@@ -2,6 +2,7 @@ package v1
 
 import (
 	"context"
+	"errors"
 	sirenv1beta1 "github.com/odpf/siren/api/proto/odpf/siren/v1beta1"
 	"github.com/odpf/siren/domain"
 	"go.uber.org/zap"
@@ -31,14 +32,18 @@ func (s *GRPCServer) ListNamespaces(_ context.Context, _ *emptypb.Empty) (*siren
 		}
 
 		item := &sirenv1beta1.Namespace{
-			Id:          namespace.Id,
-			Urn:         namespace.Urn,
-			Name:        namespace.Name,
-			Credentials: credentials,
-			Labels:      namespace.Labels,
-			Provider:    namespace.Provider,
-			CreatedAt:   timestamppb.New(namespace.CreatedAt),
-			UpdatedAt:   timestamppb.New(namespace.UpdatedAt),
+			Id:             namespace.Id,
+			Urn:            namespace.Urn,
+			Name:           namespace.Name,
+			Credentials:    credentials,
+			Labels:         namespace.Labels,
+			Provider:       namespace.Provider,
+			CreatedAt:      timestamppb.New(namespace.CreatedAt),
+			UpdatedAt:      timestamppb.New(namespace.UpdatedAt),
+			GroupBy:        namespace.GroupBy,
+			GroupWait:      namespace.GroupWait,
+			GroupInterval:  namespace.GroupInterval,
+			RepeatInterval: namespace.RepeatInterval,
 		}
 		res.Namespaces = append(res.Namespaces, item)
 	}
@@ -52,8 +57,18 @@ func (s *GRPCServer) CreateNamespace(_ context.Context, req *sirenv1beta1.Create
 		Name:        req.GetName(),
 		Credentials: req.GetCredentials().AsMap(),
 		Labels:      req.GetLabels(),
+		Routing: domain.Routing{
+			GroupBy:        req.GetGroupBy(),
+			GroupWait:      req.GetGroupWait(),
+			GroupInterval:  req.GetGroupInterval(),
+			RepeatInterval: req.GetRepeatInterval(),
+		},
 	})
 	if err != nil {
+		var invalidRoutingErr *domain.InvalidRoutingError
+		if errors.As(err, &invalidRoutingErr) {
+			return nil, status.Errorf(codes.InvalidArgument, err.Error())
+		}
 		if strings.Contains(err.Error(), `violates unique constraint "urn_provider_id_unique"`) {
 			return nil, status.Errorf(codes.InvalidArgument, "urn and provider pair already exist")
 		}
@@ -68,14 +83,18 @@ func (s *GRPCServer) CreateNamespace(_ context.Context, req *sirenv1beta1.Create
 	}
 
 	return &sirenv1beta1.Namespace{
-		Id:          namespace.Id,
-		Provider:    namespace.Provider,
-		Urn:         namespace.Urn,
-		Name:        namespace.Name,
-		Credentials: grpcCredentials,
-		Labels:      namespace.Labels,
-		CreatedAt:   timestamppb.New(namespace.CreatedAt),
-		UpdatedAt:   timestamppb.New(namespace.UpdatedAt),
+		Id:             namespace.Id,
+		Provider:       namespace.Provider,
+		Urn:            namespace.Urn,
+		Name:           namespace.Name,
+		Credentials:    grpcCredentials,
+		Labels:         namespace.Labels,
+		CreatedAt:      timestamppb.New(namespace.CreatedAt),
+		UpdatedAt:      timestamppb.New(namespace.UpdatedAt),
+		GroupBy:        namespace.GroupBy,
+		GroupWait:      namespace.GroupWait,
+		GroupInterval:  namespace.GroupInterval,
+		RepeatInterval: namespace.RepeatInterval,
 	}, nil
 }
 
@@ -96,14 +115,18 @@ func (s *GRPCServer) GetNamespace(_ context.Context, req *sirenv1beta1.GetNamesp
 	}
 
 	return &sirenv1beta1.Namespace{
-		Id:          namespace.Id,
-		Urn:         namespace.Urn,
-		Name:        namespace.Name,
-		Credentials: credentials,
-		Labels:      namespace.Labels,
-		Provider:    namespace.Provider,
-		CreatedAt:   timestamppb.New(namespace.CreatedAt),
-		UpdatedAt:   timestamppb.New(namespace.UpdatedAt),
+		Id:             namespace.Id,
+		Urn:            namespace.Urn,
+		Name:           namespace.Name,
+		Credentials:    credentials,
+		Labels:         namespace.Labels,
+		Provider:       namespace.Provider,
+		CreatedAt:      timestamppb.New(namespace.CreatedAt),
+		UpdatedAt:      timestamppb.New(namespace.UpdatedAt),
+		GroupBy:        namespace.GroupBy,
+		GroupWait:      namespace.GroupWait,
+		GroupInterval:  namespace.GroupInterval,
+		RepeatInterval: namespace.RepeatInterval,
 	}, nil
 }
 
@@ -114,8 +137,18 @@ func (s *GRPCServer) UpdateNamespace(_ context.Context, req *sirenv1beta1.Update
 		Name:        req.GetName(),
 		Credentials: req.GetCredentials().AsMap(),
 		Labels:      req.GetLabels(),
+		Routing: domain.Routing{
+			GroupBy:        req.GetGroupBy(),
+			GroupWait:      req.GetGroupWait(),
+			GroupInterval:  req.GetGroupInterval(),
+			RepeatInterval: req.GetRepeatInterval(),
+		},
 	})
 	if err != nil {
+		var invalidRoutingErr *domain.InvalidRoutingError
+		if errors.As(err, &invalidRoutingErr) {
+			return nil, status.Errorf(codes.InvalidArgument, err.Error())
+		}
 		if strings.Contains(err.Error(), `violates unique constraint "urn_provider_id_unique"`) {
 			return nil, status.Errorf(codes.InvalidArgument, "urn and provider pair already exist")
 		}
@@ -130,14 +163,18 @@ func (s *GRPCServer) UpdateNamespace(_ context.Context, req *sirenv1beta1.Update
 	}
 
 	return &sirenv1beta1.Namespace{
-		Id:          namespace.Id,
-		Urn:         namespace.Urn,
-		Name:        namespace.Name,
-		Provider:    namespace.Provider,
-		Credentials: grpcCredentials,
-		Labels:      namespace.Labels,
-		CreatedAt:   timestamppb.New(namespace.CreatedAt),
-		UpdatedAt:   timestamppb.New(namespace.UpdatedAt),
+		Id:             namespace.Id,
+		Urn:            namespace.Urn,
+		Name:           namespace.Name,
+		Provider:       namespace.Provider,
+		Credentials:    grpcCredentials,
+		Labels:         namespace.Labels,
+		CreatedAt:      timestamppb.New(namespace.CreatedAt),
+		UpdatedAt:      timestamppb.New(namespace.UpdatedAt),
+		GroupBy:        namespace.GroupBy,
+		GroupWait:      namespace.GroupWait,
+		GroupInterval:  namespace.GroupInterval,
+		RepeatInterval: namespace.RepeatInterval,
 	}, nil
 }
 
@@ -162,6 +162,55 @@ func TestGRPCServer_CreateNamespaces(t *testing.T) {
 			"rpc error: code = InvalidArgument desc = urn and provider pair already exist")
 	})
 
+	t.Run("should create a namespace with default routing", func(t *testing.T) {
+		mockedNamespaceService := &mocks.NamespaceService{}
+		dummyGRPCServer := GRPCServer{
+			container: &service.Container{
+				NamespaceService: mockedNamespaceService,
+			},
+			logger: zaptest.NewLogger(t),
+		}
+		payloadWithRouting := &domain.Namespace{
+			Provider:    2,
+			Name:        "foo",
+			Credentials: credentials,
+			Labels:      labels,
+			Routing:     domain.Routing{GroupBy: []string{"service"}, RepeatInterval: "1h"},
+		}
+		mockedNamespaceService.On("CreateNamespace", payloadWithRouting).Return(payloadWithRouting, nil).Once()
+		res, err := dummyGRPCServer.CreateNamespace(context.Background(), &sirenv1beta1.CreateNamespaceRequest{
+			Provider:       2,
+			Name:           "foo",
+			Credentials:    credentialsData,
+			Labels:         labels,
+			GroupBy:        []string{"service"},
+			RepeatInterval: "1h",
+		})
+		assert.Nil(t, err)
+		assert.Equal(t, []string{"service"}, res.GetGroupBy())
+		assert.Equal(t, "1h", res.GetRepeatInterval())
+	})
+
+	t.Run("should return error code 3 if the default routing is invalid", func(t *testing.T) {
+		mockedNamespaceService := &mocks.NamespaceService{}
+		dummyGRPCServer := GRPCServer{
+			container: &service.Container{
+				NamespaceService: mockedNamespaceService,
+			},
+			logger: zaptest.NewLogger(t),
+		}
+		mockedNamespaceService.On("CreateNamespace", mock.Anything).
+			Return(nil, &domain.InvalidRoutingError{Reason: "repeat_interval cannot be zero"}).Once()
+		res, err := dummyGRPCServer.CreateNamespace(context.Background(), &sirenv1beta1.CreateNamespaceRequest{
+			Provider:       2,
+			Name:           "foo",
+			Credentials:    credentialsData,
+			RepeatInterval: "0s",
+		})
+		assert.Nil(t, res)
+		assert.EqualError(t, err, "rpc error: code = InvalidArgument desc = invalid routing: repeat_interval cannot be zero")
+	})
+
 	t.Run("should return error code 13 if NewStruct conversion failed", func(t *testing.T) {
 		mockedNamespaceService := &mocks.NamespaceService{}
 		dummyGRPCServer := GRPCServer{
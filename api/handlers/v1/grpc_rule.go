@@ -2,10 +2,13 @@ package v1
 
 import (
 	"context"
+	"errors"
 	sirenv1beta1 "github.com/odpf/siren/api/proto/odpf/siren/v1beta1"
 	"github.com/odpf/siren/domain"
 	"github.com/odpf/siren/helper"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
@@ -22,34 +25,14 @@ func (s *GRPCServer) ListRules(_ context.Context, req *sirenv1beta1.ListRulesReq
 	}
 
 	res := &sirenv1beta1.ListRulesResponse{Rules: make([]*sirenv1beta1.Rule, 0)}
-	for _, rule := range rules {
-		variables := make([]*sirenv1beta1.Variables, 0)
-		for _, variable := range rule.Variables {
-			variables = append(variables, &sirenv1beta1.Variables{
-				Name:        variable.Name,
-				Value:       variable.Value,
-				Type:        variable.Type,
-				Description: variable.Description,
-			})
-		}
-		res.Rules = append(res.Rules, &sirenv1beta1.Rule{
-			Id:                rule.Id,
-			Name:              rule.Name,
-			Enabled:           rule.Enabled,
-			GroupName:         rule.GroupName,
-			Namespace:         rule.Namespace,
-			Template:          rule.Template,
-			Variables:         variables,
-			ProviderNamespace: rule.ProviderNamespace,
-			CreatedAt:         timestamppb.New(rule.CreatedAt),
-			UpdatedAt:         timestamppb.New(rule.UpdatedAt),
-		})
+	for i := range rules {
+		res.Rules = append(res.Rules, getRuleFromDomainObject(&rules[i]))
 	}
 
 	return res, nil
 }
 
-func (s *GRPCServer) UpdateRule(_ context.Context, req *sirenv1beta1.UpdateRuleRequest) (*sirenv1beta1.UpdateRuleResponse, error) {
+func (s *GRPCServer) UpdateRule(ctx context.Context, req *sirenv1beta1.UpdateRuleRequest) (*sirenv1beta1.UpdateRuleResponse, error) {
 	variables := make([]domain.RuleVariable, 0)
 	for _, variable := range req.Variables {
 		variables = append(variables, domain.RuleVariable{
@@ -65,37 +48,297 @@ func (s *GRPCServer) UpdateRule(_ context.Context, req *sirenv1beta1.UpdateRuleR
 		GroupName:         req.GetGroupName(),
 		Namespace:         req.GetNamespace(),
 		Template:          req.GetTemplate(),
+		TemplateVersion:   uint(req.GetTemplateVersion()),
 		ProviderNamespace: req.GetProviderNamespace(),
 		Variables:         variables,
 	}
 
-	rule, err := s.container.RulesService.Upsert(payload)
+	if req.GetDryRun() {
+		diff, err := s.container.RulesService.Diff(payload)
+		if err != nil {
+			return nil, s.ruleError(err)
+		}
+		return &sirenv1beta1.UpdateRuleResponse{
+			Diff: &sirenv1beta1.RuleGroupDiff{
+				Namespace: diff.Namespace,
+				GroupName: diff.GroupName,
+				Added:     getRuleDiffListFromDomainObject(diff.Added),
+				Changed:   getRuleDiffListFromDomainObject(diff.Changed),
+				Removed:   getRuleDiffListFromDomainObject(diff.Removed),
+			},
+		}, nil
+	}
+
+	rule, err := s.container.RulesService.Upsert(payload, actorFromContext(ctx))
 	if err != nil {
-		return nil, helper.GRPCLogError(s.logger, codes.Internal, err)
+		return nil, s.ruleError(err)
+	}
+
+	return &sirenv1beta1.UpdateRuleResponse{Rule: getRuleFromDomainObject(rule)}, nil
+}
+
+func (s *GRPCServer) ruleError(err error) error {
+	var invalidRuleErr *domain.InvalidRuleError
+	if errors.As(err, &invalidRuleErr) {
+		return helper.GRPCLogError(s.logger, codes.InvalidArgument, err)
+	}
+	var invalidRuleGroupErr *domain.InvalidRuleGroupError
+	if errors.As(err, &invalidRuleGroupErr) {
+		return helper.GRPCLogError(s.logger, codes.InvalidArgument, err)
+	}
+	var invalidVariableErr *domain.InvalidVariableError
+	if errors.As(err, &invalidVariableErr) {
+		return helper.GRPCLogError(s.logger, codes.InvalidArgument, err)
+	}
+	var ruleNotFoundErr *domain.RuleNotFoundError
+	if errors.As(err, &ruleNotFoundErr) {
+		return helper.GRPCLogError(s.logger, codes.NotFound, err)
+	}
+	return helper.GRPCLogError(s.logger, codes.Internal, err)
+}
+
+func getRuleDiffListFromDomainObject(domainRuleDiffs []domain.RuleDiff) []*sirenv1beta1.RuleDiff {
+	res := make([]*sirenv1beta1.RuleDiff, 0)
+	for _, ruleDiff := range domainRuleDiffs {
+		res = append(res, &sirenv1beta1.RuleDiff{
+			Name:    ruleDiff.Name,
+			Current: ruleDiff.Current,
+			Desired: ruleDiff.Desired,
+		})
+	}
+	return res
+}
+
+func (s *GRPCServer) DeleteRule(_ context.Context, req *sirenv1beta1.DeleteRuleRequest) (*emptypb.Empty, error) {
+	err := s.container.RulesService.Delete(req.GetId())
+	if err != nil {
+		return nil, s.ruleError(err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (s *GRPCServer) GetRuleDrift(_ context.Context, _ *emptypb.Empty) (*sirenv1beta1.GetRuleDriftResponse, error) {
+	report, err := s.container.RuleReconciler.Report()
+	if err != nil {
+		return nil, s.ruleError(err)
+	}
+
+	res := &sirenv1beta1.GetRuleDriftResponse{
+		CheckedAt: timestamppb.New(report.CheckedAt),
+		Drifts:    make([]*sirenv1beta1.RuleDrift, 0),
+		Errors:    report.Errors,
 	}
+	for _, drift := range report.Drifts {
+		res.Drifts = append(res.Drifts, &sirenv1beta1.RuleDrift{
+			ProviderNamespace: drift.ProviderNamespace,
+			Diff: &sirenv1beta1.RuleGroupDiff{
+				Namespace: drift.Diff.Namespace,
+				GroupName: drift.Diff.GroupName,
+				Added:     getRuleDiffListFromDomainObject(drift.Diff.Added),
+				Changed:   getRuleDiffListFromDomainObject(drift.Diff.Changed),
+				Removed:   getRuleDiffListFromDomainObject(drift.Diff.Removed),
+			},
+			Repaired: drift.Repaired,
+		})
+	}
+	return res, nil
+}
 
-	responseVariables := make([]*sirenv1beta1.Variables, 0)
-	for _, variable := range rule.Variables {
-		responseVariables = append(responseVariables, &sirenv1beta1.Variables{
+func (s *GRPCServer) ImportRules(_ context.Context, req *sirenv1beta1.ImportRulesRequest) (*sirenv1beta1.ImportRulesResponse, error) {
+	report, err := s.container.RulesService.Import(req.GetProviderNamespace(), req.GetCreateTemplates(), req.GetDryRun())
+	if err != nil {
+		return nil, s.ruleError(err)
+	}
+
+	res := &sirenv1beta1.ImportRulesResponse{
+		Rules:            make([]*sirenv1beta1.Rule, 0),
+		CreatedTemplates: make([]*sirenv1beta1.Template, 0),
+		Unmapped:         make([]*sirenv1beta1.UnmappedRule, 0),
+	}
+	for i := range report.Rules {
+		res.Rules = append(res.Rules, getRuleFromDomainObject(&report.Rules[i]))
+	}
+	for i := range report.CreatedTemplates {
+		res.CreatedTemplates = append(res.CreatedTemplates, getTemplateFromDomainObject(&report.CreatedTemplates[i]))
+	}
+	for _, unmapped := range report.Unmapped {
+		res.Unmapped = append(res.Unmapped, &sirenv1beta1.UnmappedRule{
+			Namespace: unmapped.Namespace,
+			GroupName: unmapped.GroupName,
+			Name:      unmapped.Name,
+			Reason:    unmapped.Reason,
+		})
+	}
+	return res, nil
+}
+
+func (s *GRPCServer) ListRuleRevisions(_ context.Context, req *sirenv1beta1.ListRuleRevisionsRequest) (*sirenv1beta1.ListRuleRevisionsResponse, error) {
+	revisions, err := s.container.RulesService.ListRevisions(req.GetId())
+	if err != nil {
+		return nil, s.ruleError(err)
+	}
+
+	res := &sirenv1beta1.ListRuleRevisionsResponse{Revisions: make([]*sirenv1beta1.RuleRevision, 0)}
+	for _, revision := range revisions {
+		res.Revisions = append(res.Revisions, &sirenv1beta1.RuleRevision{
+			Id:              revision.Id,
+			RuleId:          revision.RuleId,
+			Enabled:         revision.Enabled,
+			Variables:       getRuleVariablesFromDomainObject(revision.Variables),
+			TemplateHash:    revision.TemplateHash,
+			TemplateVersion: uint64(revision.TemplateVersion),
+			Rendered:        revision.Rendered,
+			Actor:           revision.Actor,
+			CreatedAt:       timestamppb.New(revision.CreatedAt),
+		})
+	}
+	return res, nil
+}
+
+func (s *GRPCServer) RollbackRule(ctx context.Context, req *sirenv1beta1.RollbackRuleRequest) (*sirenv1beta1.RollbackRuleResponse, error) {
+	rule, err := s.container.RulesService.Rollback(req.GetId(), req.GetRevisionId(), actorFromContext(ctx))
+	if err != nil {
+		return nil, s.ruleError(err)
+	}
+
+	return &sirenv1beta1.RollbackRuleResponse{Rule: getRuleFromDomainObject(rule)}, nil
+}
+
+func (s *GRPCServer) UpgradeRulesTemplateVersion(ctx context.Context, req *sirenv1beta1.UpgradeRulesTemplateVersionRequest) (*sirenv1beta1.UpgradeRulesTemplateVersionResponse, error) {
+	rules, err := s.container.RulesService.UpgradeTemplateVersion(req.GetTemplate(), uint(req.GetFromVersion()),
+		uint(req.GetToVersion()), actorFromContext(ctx))
+	if err != nil {
+		return nil, s.ruleError(err)
+	}
+
+	res := &sirenv1beta1.UpgradeRulesTemplateVersionResponse{Rules: make([]*sirenv1beta1.Rule, 0)}
+	for i := range rules {
+		res.Rules = append(res.Rules, getRuleFromDomainObject(&rules[i]))
+	}
+	return res, nil
+}
+
+func (s *GRPCServer) GetRuleGroup(_ context.Context, req *sirenv1beta1.GetRuleGroupRequest) (*sirenv1beta1.RuleGroup, error) {
+	group, err := s.container.RulesService.GetGroup(req.GetProviderNamespace(), req.GetNamespace(), req.GetGroupName())
+	if err != nil {
+		return nil, s.ruleError(err)
+	}
+	return getRuleGroupFromDomainObject(group), nil
+}
+
+func (s *GRPCServer) UpdateRuleGroup(_ context.Context, req *sirenv1beta1.UpdateRuleGroupRequest) (*sirenv1beta1.RuleGroup, error) {
+	group, err := s.container.RulesService.UpsertGroup(&domain.RuleGroup{
+		ProviderNamespace: req.GetProviderNamespace(),
+		Namespace:         req.GetNamespace(),
+		GroupName:         req.GetGroupName(),
+		Interval:          req.GetInterval(),
+		Limit:             req.GetLimit(),
+		SourceTenants:     req.GetSourceTenants(),
+	})
+	if err != nil {
+		return nil, s.ruleError(err)
+	}
+	return getRuleGroupFromDomainObject(group), nil
+}
+
+func getRuleGroupFromDomainObject(group *domain.RuleGroup) *sirenv1beta1.RuleGroup {
+	return &sirenv1beta1.RuleGroup{
+		Id:                group.Id,
+		ProviderNamespace: group.ProviderNamespace,
+		Namespace:         group.Namespace,
+		GroupName:         group.GroupName,
+		Interval:          group.Interval,
+		Limit:             group.Limit,
+		SourceTenants:     group.SourceTenants,
+		CreatedAt:         timestamppb.New(group.CreatedAt),
+		UpdatedAt:         timestamppb.New(group.UpdatedAt),
+	}
+}
+
+func getRuleFromDomainObject(rule *domain.Rule) *sirenv1beta1.Rule {
+	return &sirenv1beta1.Rule{
+		Id:                rule.Id,
+		Name:              rule.Name,
+		Enabled:           rule.Enabled,
+		GroupName:         rule.GroupName,
+		Namespace:         rule.Namespace,
+		Template:          rule.Template,
+		TemplateVersion:   uint64(rule.TemplateVersion),
+		Variables:         getRuleVariablesFromDomainObject(rule.Variables),
+		ProviderNamespace: rule.ProviderNamespace,
+		CreatedAt:         timestamppb.New(rule.CreatedAt),
+		UpdatedAt:         timestamppb.New(rule.UpdatedAt),
+	}
+}
+
+func getRuleVariablesFromDomainObject(variables []domain.RuleVariable) []*sirenv1beta1.Variables {
+	res := make([]*sirenv1beta1.Variables, 0)
+	for _, variable := range variables {
+		res = append(res, &sirenv1beta1.Variables{
 			Name:        variable.Name,
-			Type:        variable.Type,
 			Value:       variable.Value,
+			Type:        variable.Type,
 			Description: variable.Description,
 		})
 	}
-	res := &sirenv1beta1.UpdateRuleResponse{
-		Rule: &sirenv1beta1.Rule{
-			Id:                rule.Id,
-			Name:              rule.Name,
-			Enabled:           rule.Enabled,
-			GroupName:         rule.GroupName,
-			Namespace:         rule.Namespace,
-			Template:          rule.Template,
-			Variables:         responseVariables,
-			ProviderNamespace: rule.ProviderNamespace,
-			CreatedAt:         timestamppb.New(rule.CreatedAt),
-			UpdatedAt:         timestamppb.New(rule.UpdatedAt),
-		},
+	return res
+}
+
+func getTemplateFromDomainObject(template *domain.Template) *sirenv1beta1.Template {
+	return &sirenv1beta1.Template{
+		Id:        uint64(template.ID),
+		Name:      template.Name,
+		Body:      template.Body,
+		Tags:      template.Tags,
+		CreatedAt: timestamppb.New(template.CreatedAt),
+		UpdatedAt: timestamppb.New(template.UpdatedAt),
+		Variables: getVariablesFromDomainObject(template.Variables),
+		Version:   uint64(template.Version),
+	}
+}
+
+func getVariablesFromDomainObject(variables []domain.Variable) []*sirenv1beta1.TemplateVariables {
+	res := make([]*sirenv1beta1.TemplateVariables, 0)
+	for _, variable := range variables {
+		res = append(res, &sirenv1beta1.TemplateVariables{
+			Name:          variable.Name,
+			Type:          variable.Type,
+			Default:       variable.Default,
+			Description:   variable.Description,
+			AllowedValues: variable.AllowedValues,
+			Pattern:       variable.Pattern,
+			Min:           variable.Min,
+			Max:           variable.Max,
+			Required:      variable.Required,
+		})
+	}
+	return res
+}
+
+func (s *GRPCServer) ExportRules(_ context.Context, req *sirenv1beta1.ExportRulesRequest) (*sirenv1beta1.ExportRulesResponse, error) {
+	export, err := s.container.RulesService.Export(req.GetProviderNamespace(), req.GetNamespace(), req.GetGroupName())
+	if err != nil {
+		return nil, helper.GRPCLogError(s.logger, codes.Internal, err)
+	}
+	if export == nil {
+		return nil, status.Errorf(codes.NotFound, "provider namespace not found")
+	}
+
+	res := &sirenv1beta1.ExportRulesResponse{
+		Provider:          export.ProviderUrn,
+		ProviderNamespace: export.NamespaceUrn,
+		RuleGroups:        make([]*sirenv1beta1.ExportedRuleGroup, 0),
+	}
+	for i := range export.RuleGroups {
+		ruleGroup := &sirenv1beta1.ExportedRuleGroup{
+			Group: getRuleGroupFromDomainObject(&export.RuleGroups[i].Group),
+			Rules: make([]*sirenv1beta1.Rule, 0),
+		}
+		for j := range export.RuleGroups[i].Rules {
+			ruleGroup.Rules = append(ruleGroup.Rules, getRuleFromDomainObject(&export.RuleGroups[i].Rules[j]))
+		}
+		res.RuleGroups = append(res.RuleGroups, ruleGroup)
 	}
 	return res, nil
 }
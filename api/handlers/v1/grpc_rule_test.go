@@ -11,7 +11,10 @@ import (
 	"github.com/odpf/siren/mocks"
 	"github.com/odpf/siren/service"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 	"go.uber.org/zap/zaptest"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/types/known/emptypb"
 )
 
 func TestGRPCServer_ListRules(t *testing.T) {
@@ -130,16 +133,48 @@ func TestGRPCServer_UpdateRules(t *testing.T) {
 		dummyResult.Name = "foo"
 
 		mockedRuleService.
-			On("Upsert", &dummyPayload).
+			On("Upsert", &dummyPayload, "foo@odpf.io").
 			Return(&dummyResult, nil).Once()
-		res, err := dummyGRPCServer.UpdateRule(context.Background(), dummyReq)
+		ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(ActorHeader, "foo@odpf.io"))
+		res, err := dummyGRPCServer.UpdateRule(ctx, dummyReq)
 		assert.Nil(t, err)
 
 		assert.Equal(t, "foo", res.GetRule().GetName())
 		assert.Equal(t, false, res.GetRule().GetEnabled())
 		assert.Equal(t, "test", res.GetRule().GetNamespace())
 		assert.Equal(t, 1, len(res.GetRule().GetVariables()))
-		mockedRuleService.AssertCalled(t, "Upsert", &dummyPayload)
+		mockedRuleService.AssertCalled(t, "Upsert", &dummyPayload, "foo@odpf.io")
+	})
+
+	t.Run("should pin the rule to the template version of the request", func(t *testing.T) {
+		mockedRuleService := &mocks.RuleService{}
+		dummyGRPCServer := GRPCServer{
+			container: &service.Container{
+				RulesService: mockedRuleService,
+			},
+			logger: zaptest.NewLogger(t),
+		}
+		pinnedPayload := dummyPayload
+		pinnedPayload.TemplateVersion = 2
+		pinnedReq := &sirenv1beta1.UpdateRuleRequest{
+			Enabled:           dummyReq.Enabled,
+			GroupName:         dummyReq.GroupName,
+			Namespace:         dummyReq.Namespace,
+			Template:          dummyReq.Template,
+			TemplateVersion:   2,
+			Variables:         dummyReq.Variables,
+			ProviderNamespace: dummyReq.ProviderNamespace,
+		}
+		dummyResult := pinnedPayload
+		dummyResult.Name = "foo"
+
+		mockedRuleService.
+			On("Upsert", &pinnedPayload, "").
+			Return(&dummyResult, nil).Once()
+		res, err := dummyGRPCServer.UpdateRule(context.Background(), pinnedReq)
+		assert.Nil(t, err)
+		assert.Equal(t, uint64(2), res.GetRule().GetTemplateVersion())
+		mockedRuleService.AssertCalled(t, "Upsert", &pinnedPayload, "")
 	})
 
 	t.Run("should return error code 13 if getting rules failed", func(t *testing.T) {
@@ -152,10 +187,602 @@ func TestGRPCServer_UpdateRules(t *testing.T) {
 			logger: zaptest.NewLogger(t),
 		}
 		mockedRuleService.
-			On("Upsert", &dummyPayload).
+			On("Upsert", &dummyPayload, "").
 			Return(nil, errors.New("random error")).Once()
 		res, err := dummyGRPCServer.UpdateRule(context.Background(), dummyReq)
 		assert.Nil(t, res)
 		assert.EqualError(t, err, "rpc error: code = Internal desc = random error")
 	})
+
+	t.Run("should return error code 3 if rendered rule is invalid", func(t *testing.T) {
+		mockedRuleService := &mocks.RuleService{}
+		dummyGRPCServer := GRPCServer{
+			container: &service.Container{
+				RulesService: mockedRuleService,
+			},
+			logger: zaptest.NewLogger(t),
+		}
+		mockedRuleService.
+			On("Upsert", &dummyPayload, "").
+			Return(nil, &domain.InvalidRuleError{
+				Template:  "foo",
+				Variables: map[string]string{"foo": "bar"},
+				Rule:      "Test",
+				Reason:    "field 'expr' must be set in rule",
+			}).Once()
+		res, err := dummyGRPCServer.UpdateRule(context.Background(), dummyReq)
+		assert.Nil(t, res)
+		assert.EqualError(t, err, "rpc error: code = InvalidArgument desc = invalid rule Test rendered from template foo with variables [foo=bar]: field 'expr' must be set in rule")
+	})
+
+	t.Run("should return error code 3 if a variable of the rule is invalid", func(t *testing.T) {
+		mockedRuleService := &mocks.RuleService{}
+		dummyGRPCServer := GRPCServer{
+			container: &service.Container{
+				RulesService: mockedRuleService,
+			},
+			logger: zaptest.NewLogger(t),
+		}
+		mockedRuleService.
+			On("Upsert", &dummyPayload, "").
+			Return(nil, &domain.InvalidVariableError{Name: "foo", Reason: `"bar" is not an int`}).Once()
+		res, err := dummyGRPCServer.UpdateRule(context.Background(), dummyReq)
+		assert.Nil(t, res)
+		assert.EqualError(t, err, `rpc error: code = InvalidArgument desc = invalid variable foo: "bar" is not an int`)
+	})
+
+	t.Run("should return diff of rule group in dry run mode", func(t *testing.T) {
+		mockedRuleService := &mocks.RuleService{}
+		dummyGRPCServer := GRPCServer{
+			container: &service.Container{
+				RulesService: mockedRuleService,
+			},
+			logger: zaptest.NewLogger(t),
+		}
+		dryRunReq := &sirenv1beta1.UpdateRuleRequest{
+			Enabled:           dummyReq.Enabled,
+			GroupName:         dummyReq.GroupName,
+			Namespace:         dummyReq.Namespace,
+			Template:          dummyReq.Template,
+			Variables:         dummyReq.Variables,
+			ProviderNamespace: dummyReq.ProviderNamespace,
+			DryRun:            true,
+		}
+		dummyDiff := &domain.RuleGroupDiff{
+			Namespace: "test",
+			GroupName: "foo",
+			Added:     []domain.RuleDiff{{Name: "Test", Desired: "alert: Test\n"}},
+			Changed:   []domain.RuleDiff{{Name: "Test2", Current: "alert: Test2\n", Desired: "alert: Test2\nfor: 5m\n"}},
+			Removed:   []domain.RuleDiff{},
+		}
+		mockedRuleService.
+			On("Diff", &dummyPayload).
+			Return(dummyDiff, nil).Once()
+		res, err := dummyGRPCServer.UpdateRule(context.Background(), dryRunReq)
+		assert.Nil(t, err)
+		assert.Nil(t, res.GetRule())
+		assert.Equal(t, "test", res.GetDiff().GetNamespace())
+		assert.Equal(t, "foo", res.GetDiff().GetGroupName())
+		assert.Equal(t, 1, len(res.GetDiff().GetAdded()))
+		assert.Equal(t, "Test", res.GetDiff().GetAdded()[0].GetName())
+		assert.Equal(t, "alert: Test2\nfor: 5m\n", res.GetDiff().GetChanged()[0].GetDesired())
+		assert.Equal(t, 0, len(res.GetDiff().GetRemoved()))
+		mockedRuleService.AssertNotCalled(t, "Upsert", mock.Anything, mock.Anything)
+	})
+
+	t.Run("should return error code 13 if diffing rule group failed", func(t *testing.T) {
+		mockedRuleService := &mocks.RuleService{}
+		dummyGRPCServer := GRPCServer{
+			container: &service.Container{
+				RulesService: mockedRuleService,
+			},
+			logger: zaptest.NewLogger(t),
+		}
+		dryRunReq := &sirenv1beta1.UpdateRuleRequest{
+			Enabled:           dummyReq.Enabled,
+			GroupName:         dummyReq.GroupName,
+			Namespace:         dummyReq.Namespace,
+			Template:          dummyReq.Template,
+			Variables:         dummyReq.Variables,
+			ProviderNamespace: dummyReq.ProviderNamespace,
+			DryRun:            true,
+		}
+		mockedRuleService.
+			On("Diff", &dummyPayload).
+			Return(nil, errors.New("random error")).Once()
+		res, err := dummyGRPCServer.UpdateRule(context.Background(), dryRunReq)
+		assert.Nil(t, res)
+		assert.EqualError(t, err, "rpc error: code = Internal desc = random error")
+	})
+}
+
+func TestGRPCServer_DeleteRule(t *testing.T) {
+	ruleId := uint64(10)
+	dummyReq := &sirenv1beta1.DeleteRuleRequest{
+		Id: uint64(10),
+	}
+
+	t.Run("should delete rule object", func(t *testing.T) {
+		mockedRuleService := &mocks.RuleService{}
+		dummyGRPCServer := GRPCServer{
+			container: &service.Container{
+				RulesService: mockedRuleService,
+			},
+			logger: zaptest.NewLogger(t),
+		}
+		mockedRuleService.On("Delete", ruleId).Return(nil).Once()
+		res, err := dummyGRPCServer.DeleteRule(context.Background(), dummyReq)
+		assert.Nil(t, err)
+		assert.Equal(t, "", res.String())
+		mockedRuleService.AssertExpectations(t)
+	})
+
+	t.Run("should return error code 13 if deleting rule failed", func(t *testing.T) {
+		mockedRuleService := &mocks.RuleService{}
+		dummyGRPCServer := GRPCServer{
+			container: &service.Container{
+				RulesService: mockedRuleService,
+			},
+			logger: zaptest.NewLogger(t),
+		}
+		mockedRuleService.On("Delete", ruleId).Return(errors.New("random error")).Once()
+		res, err := dummyGRPCServer.DeleteRule(context.Background(), dummyReq)
+		assert.Nil(t, res)
+		assert.EqualError(t, err, "rpc error: code = Internal desc = random error")
+	})
+
+	t.Run("should return error code 5 if rule does not exist", func(t *testing.T) {
+		mockedRuleService := &mocks.RuleService{}
+		dummyGRPCServer := GRPCServer{
+			container: &service.Container{
+				RulesService: mockedRuleService,
+			},
+			logger: zaptest.NewLogger(t),
+		}
+		mockedRuleService.On("Delete", ruleId).Return(&domain.RuleNotFoundError{Id: ruleId}).Once()
+		res, err := dummyGRPCServer.DeleteRule(context.Background(), dummyReq)
+		assert.Nil(t, res)
+		assert.EqualError(t, err, "rpc error: code = NotFound desc = rule 10 not found")
+	})
+}
+
+func TestGRPCServer_GetRuleDrift(t *testing.T) {
+	t.Run("should return rule drift report", func(t *testing.T) {
+		mockedRuleReconciler := &mocks.RuleReconciler{}
+		dummyGRPCServer := GRPCServer{
+			container: &service.Container{
+				RuleReconciler: mockedRuleReconciler,
+			},
+			logger: zaptest.NewLogger(t),
+		}
+		dummyReport := &domain.RuleDriftReport{
+			CheckedAt: time.Now(),
+			Drifts: []domain.RuleDrift{{
+				ProviderNamespace: 1,
+				Diff: domain.RuleGroupDiff{
+					Namespace: "foo",
+					GroupName: "bar",
+					Added:     []domain.RuleDiff{},
+					Changed:   []domain.RuleDiff{{Name: "Test", Current: "alert: Test\n", Desired: "alert: Test\nfor: 5m\n"}},
+					Removed:   []domain.RuleDiff{},
+				},
+				Repaired: true,
+			}},
+			Errors: []string{"provider namespace 2: random error"},
+		}
+		mockedRuleReconciler.On("Report").Return(dummyReport, nil).Once()
+		res, err := dummyGRPCServer.GetRuleDrift(context.Background(), &emptypb.Empty{})
+		assert.Nil(t, err)
+		assert.Equal(t, 1, len(res.GetDrifts()))
+		assert.Equal(t, uint64(1), res.GetDrifts()[0].GetProviderNamespace())
+		assert.Equal(t, "bar", res.GetDrifts()[0].GetDiff().GetGroupName())
+		assert.Equal(t, "Test", res.GetDrifts()[0].GetDiff().GetChanged()[0].GetName())
+		assert.Equal(t, true, res.GetDrifts()[0].GetRepaired())
+		assert.Equal(t, []string{"provider namespace 2: random error"}, res.GetErrors())
+		assert.Equal(t, dummyReport.CheckedAt.Unix(), res.GetCheckedAt().AsTime().Unix())
+	})
+
+	t.Run("should return error code 13 if getting rule drift failed", func(t *testing.T) {
+		mockedRuleReconciler := &mocks.RuleReconciler{}
+		dummyGRPCServer := GRPCServer{
+			container: &service.Container{
+				RuleReconciler: mockedRuleReconciler,
+			},
+			logger: zaptest.NewLogger(t),
+		}
+		mockedRuleReconciler.On("Report").Return(nil, errors.New("random error")).Once()
+		res, err := dummyGRPCServer.GetRuleDrift(context.Background(), &emptypb.Empty{})
+		assert.Nil(t, res)
+		assert.EqualError(t, err, "rpc error: code = Internal desc = random error")
+	})
+}
+
+func TestGRPCServer_ImportRules(t *testing.T) {
+	dummyReq := &sirenv1beta1.ImportRulesRequest{
+		ProviderNamespace: 1,
+		CreateTemplates:   true,
+	}
+
+	t.Run("should return imported rules, created templates and unmapped rules", func(t *testing.T) {
+		mockedRuleService := &mocks.RuleService{}
+		dummyGRPCServer := GRPCServer{
+			container: &service.Container{
+				RulesService: mockedRuleService,
+			},
+			logger: zaptest.NewLogger(t),
+		}
+		dummyReport := &domain.RuleImportReport{
+			Rules: []domain.Rule{{
+				Id:        1,
+				Name:      "siren_api_cortex-1_tenant_foo_bar_cpu",
+				Enabled:   true,
+				GroupName: "bar",
+				Namespace: "foo",
+				Template:  "cpu",
+				Variables: []domain.RuleVariable{{Name: "team", Type: "string", Value: "gojek"}},
+			}},
+			CreatedTemplates: []domain.Template{{
+				ID:        5,
+				Name:      "imported_tenant_foo_baz",
+				Tags:      []string{"imported"},
+				Variables: []domain.Variable{{Name: "team", Type: "string", Default: "gojek"}},
+				Version:   1,
+			}},
+			Unmapped: []domain.UnmappedRule{{Namespace: "foo", GroupName: "qux", Name: "Other", Reason: "random reason"}},
+		}
+		mockedRuleService.On("Import", uint64(1), true, false).Return(dummyReport, nil).Once()
+		res, err := dummyGRPCServer.ImportRules(context.Background(), dummyReq)
+		assert.Nil(t, err)
+		assert.Equal(t, 1, len(res.GetRules()))
+		assert.Equal(t, "cpu", res.GetRules()[0].GetTemplate())
+		assert.Equal(t, "gojek", res.GetRules()[0].GetVariables()[0].GetValue())
+		assert.Equal(t, 1, len(res.GetCreatedTemplates()))
+		assert.Equal(t, uint64(5), res.GetCreatedTemplates()[0].GetId())
+		assert.Equal(t, "gojek", res.GetCreatedTemplates()[0].GetVariables()[0].GetDefault())
+		assert.Equal(t, uint64(1), res.GetCreatedTemplates()[0].GetVersion())
+		assert.Equal(t, "Other", res.GetUnmapped()[0].GetName())
+		assert.Equal(t, "random reason", res.GetUnmapped()[0].GetReason())
+	})
+
+	t.Run("should return error code 13 if import failed", func(t *testing.T) {
+		mockedRuleService := &mocks.RuleService{}
+		dummyGRPCServer := GRPCServer{
+			container: &service.Container{
+				RulesService: mockedRuleService,
+			},
+			logger: zaptest.NewLogger(t),
+		}
+		mockedRuleService.On("Import", uint64(1), true, false).Return(nil, errors.New("random error")).Once()
+		res, err := dummyGRPCServer.ImportRules(context.Background(), dummyReq)
+		assert.Nil(t, res)
+		assert.EqualError(t, err, "rpc error: code = Internal desc = random error")
+	})
+}
+
+func TestGRPCServer_ListRuleRevisions(t *testing.T) {
+	t.Run("should return revisions of the rule", func(t *testing.T) {
+		mockedRuleService := &mocks.RuleService{}
+		dummyGRPCServer := GRPCServer{
+			container: &service.Container{
+				RulesService: mockedRuleService,
+			},
+			logger: zaptest.NewLogger(t),
+		}
+		createdAt := time.Now()
+		mockedRuleService.On("ListRevisions", uint64(10)).Return([]domain.RuleRevision{{
+			Id:           1,
+			RuleId:       10,
+			Enabled:      true,
+			Variables:    []domain.RuleVariable{{Name: "for", Type: "string", Value: "10m"}},
+			TemplateHash: "abc",
+			Rendered:     "- alert: Test\n",
+			Actor:        "foo@odpf.io",
+			CreatedAt:    createdAt,
+		}}, nil).Once()
+		res, err := dummyGRPCServer.ListRuleRevisions(context.Background(), &sirenv1beta1.ListRuleRevisionsRequest{Id: 10})
+		assert.Nil(t, err)
+		assert.Equal(t, 1, len(res.GetRevisions()))
+		assert.Equal(t, uint64(1), res.GetRevisions()[0].GetId())
+		assert.Equal(t, uint64(10), res.GetRevisions()[0].GetRuleId())
+		assert.Equal(t, "10m", res.GetRevisions()[0].GetVariables()[0].GetValue())
+		assert.Equal(t, "abc", res.GetRevisions()[0].GetTemplateHash())
+		assert.Equal(t, "- alert: Test\n", res.GetRevisions()[0].GetRendered())
+		assert.Equal(t, "foo@odpf.io", res.GetRevisions()[0].GetActor())
+		assert.Equal(t, createdAt.Unix(), res.GetRevisions()[0].GetCreatedAt().AsTime().Unix())
+	})
+
+	t.Run("should return error code 13 if listing revisions failed", func(t *testing.T) {
+		mockedRuleService := &mocks.RuleService{}
+		dummyGRPCServer := GRPCServer{
+			container: &service.Container{
+				RulesService: mockedRuleService,
+			},
+			logger: zaptest.NewLogger(t),
+		}
+		mockedRuleService.On("ListRevisions", uint64(10)).Return(nil, errors.New("random error")).Once()
+		res, err := dummyGRPCServer.ListRuleRevisions(context.Background(), &sirenv1beta1.ListRuleRevisionsRequest{Id: 10})
+		assert.Nil(t, res)
+		assert.EqualError(t, err, "rpc error: code = Internal desc = random error")
+	})
+}
+
+func TestGRPCServer_RollbackRule(t *testing.T) {
+	t.Run("should rollback rule as the actor of the request", func(t *testing.T) {
+		mockedRuleService := &mocks.RuleService{}
+		dummyGRPCServer := GRPCServer{
+			container: &service.Container{
+				RulesService: mockedRuleService,
+			},
+			logger: zaptest.NewLogger(t),
+		}
+		mockedRuleService.On("Rollback", uint64(10), uint64(1), "foo@odpf.io").Return(&domain.Rule{
+			Id:                10,
+			Name:              "foo",
+			Enabled:           true,
+			Namespace:         "test",
+			Variables:         []domain.RuleVariable{{Name: "for", Type: "string", Value: "10m"}},
+			ProviderNamespace: 1,
+		}, nil).Once()
+		ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(ActorHeader, "foo@odpf.io"))
+		res, err := dummyGRPCServer.RollbackRule(ctx, &sirenv1beta1.RollbackRuleRequest{Id: 10, RevisionId: 1})
+		assert.Nil(t, err)
+		assert.Equal(t, uint64(10), res.GetRule().GetId())
+		assert.Equal(t, "10m", res.GetRule().GetVariables()[0].GetValue())
+	})
+
+	t.Run("should return error code 3 if restored rule is invalid", func(t *testing.T) {
+		mockedRuleService := &mocks.RuleService{}
+		dummyGRPCServer := GRPCServer{
+			container: &service.Container{
+				RulesService: mockedRuleService,
+			},
+			logger: zaptest.NewLogger(t),
+		}
+		mockedRuleService.On("Rollback", uint64(10), uint64(1), "").Return(nil, &domain.InvalidRuleError{
+			Template:  "foo",
+			Variables: map[string]string{"for": "10m"},
+			Rule:      "Test",
+			Reason:    "field 'expr' must be set in rule",
+		}).Once()
+		res, err := dummyGRPCServer.RollbackRule(context.Background(), &sirenv1beta1.RollbackRuleRequest{Id: 10, RevisionId: 1})
+		assert.Nil(t, res)
+		assert.EqualError(t, err, "rpc error: code = InvalidArgument desc = invalid rule Test rendered from template foo with variables [for=10m]: field 'expr' must be set in rule")
+	})
+}
+
+func TestGRPCServer_UpgradeRulesTemplateVersion(t *testing.T) {
+	t.Run("should upgrade rules as the actor of the request", func(t *testing.T) {
+		mockedRuleService := &mocks.RuleService{}
+		dummyGRPCServer := GRPCServer{
+			container: &service.Container{
+				RulesService: mockedRuleService,
+			},
+			logger: zaptest.NewLogger(t),
+		}
+		mockedRuleService.On("UpgradeTemplateVersion", "foo", uint(1), uint(2), "foo@odpf.io").Return([]domain.Rule{{
+			Id:                10,
+			Name:              "foo",
+			Enabled:           true,
+			Namespace:         "test",
+			Template:          "foo",
+			TemplateVersion:   2,
+			Variables:         []domain.RuleVariable{{Name: "for", Type: "string", Value: "10m"}},
+			ProviderNamespace: 1,
+		}}, nil).Once()
+		ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(ActorHeader, "foo@odpf.io"))
+		res, err := dummyGRPCServer.UpgradeRulesTemplateVersion(ctx, &sirenv1beta1.UpgradeRulesTemplateVersionRequest{
+			Template:    "foo",
+			FromVersion: 1,
+			ToVersion:   2,
+		})
+		assert.Nil(t, err)
+		assert.Equal(t, 1, len(res.GetRules()))
+		assert.Equal(t, uint64(2), res.GetRules()[0].GetTemplateVersion())
+		assert.Equal(t, "10m", res.GetRules()[0].GetVariables()[0].GetValue())
+	})
+
+	t.Run("should return error code 13 if upgrading rules failed", func(t *testing.T) {
+		mockedRuleService := &mocks.RuleService{}
+		dummyGRPCServer := GRPCServer{
+			container: &service.Container{
+				RulesService: mockedRuleService,
+			},
+			logger: zaptest.NewLogger(t),
+		}
+		mockedRuleService.On("UpgradeTemplateVersion", "foo", uint(1), uint(3), "").
+			Return(nil, errors.New("version 3 of template foo not found")).Once()
+		res, err := dummyGRPCServer.UpgradeRulesTemplateVersion(context.Background(), &sirenv1beta1.UpgradeRulesTemplateVersionRequest{
+			Template:    "foo",
+			FromVersion: 1,
+			ToVersion:   3,
+		})
+		assert.Nil(t, res)
+		assert.EqualError(t, err, "rpc error: code = Internal desc = version 3 of template foo not found")
+	})
+}
+
+func TestGRPCServer_GetRuleGroup(t *testing.T) {
+	t.Run("should return settings of the rule group", func(t *testing.T) {
+		mockedRuleService := &mocks.RuleService{}
+		dummyGRPCServer := GRPCServer{
+			container: &service.Container{
+				RulesService: mockedRuleService,
+			},
+			logger: zaptest.NewLogger(t),
+		}
+		mockedRuleService.On("GetGroup", uint64(1), "foo", "bar").Return(&domain.RuleGroup{
+			Id:                3,
+			ProviderNamespace: 1,
+			Namespace:         "foo",
+			GroupName:         "bar",
+			Interval:          "1m",
+			Limit:             10,
+			SourceTenants:     []string{"odpf"},
+		}, nil).Once()
+		res, err := dummyGRPCServer.GetRuleGroup(context.Background(), &sirenv1beta1.GetRuleGroupRequest{
+			ProviderNamespace: 1,
+			Namespace:         "foo",
+			GroupName:         "bar",
+		})
+		assert.Nil(t, err)
+		assert.Equal(t, uint64(3), res.GetId())
+		assert.Equal(t, "1m", res.GetInterval())
+		assert.Equal(t, uint32(10), res.GetLimit())
+		assert.Equal(t, []string{"odpf"}, res.GetSourceTenants())
+	})
+
+	t.Run("should return error code 13 if getting rule group failed", func(t *testing.T) {
+		mockedRuleService := &mocks.RuleService{}
+		dummyGRPCServer := GRPCServer{
+			container: &service.Container{
+				RulesService: mockedRuleService,
+			},
+			logger: zaptest.NewLogger(t),
+		}
+		mockedRuleService.On("GetGroup", uint64(1), "foo", "bar").Return(nil, errors.New("random error")).Once()
+		res, err := dummyGRPCServer.GetRuleGroup(context.Background(), &sirenv1beta1.GetRuleGroupRequest{
+			ProviderNamespace: 1,
+			Namespace:         "foo",
+			GroupName:         "bar",
+		})
+		assert.Nil(t, res)
+		assert.EqualError(t, err, "rpc error: code = Internal desc = random error")
+	})
+}
+
+func TestGRPCServer_UpdateRuleGroup(t *testing.T) {
+	dummyReq := &sirenv1beta1.UpdateRuleGroupRequest{
+		ProviderNamespace: 1,
+		Namespace:         "foo",
+		GroupName:         "bar",
+		Interval:          "1m",
+		Limit:             10,
+		SourceTenants:     []string{"odpf"},
+	}
+	dummyPayload := &domain.RuleGroup{
+		ProviderNamespace: 1,
+		Namespace:         "foo",
+		GroupName:         "bar",
+		Interval:          "1m",
+		Limit:             10,
+		SourceTenants:     []string{"odpf"},
+	}
+
+	t.Run("should update settings of the rule group", func(t *testing.T) {
+		mockedRuleService := &mocks.RuleService{}
+		dummyGRPCServer := GRPCServer{
+			container: &service.Container{
+				RulesService: mockedRuleService,
+			},
+			logger: zaptest.NewLogger(t),
+		}
+		upsertedGroup := *dummyPayload
+		upsertedGroup.Id = 3
+		mockedRuleService.On("UpsertGroup", dummyPayload).Return(&upsertedGroup, nil).Once()
+		res, err := dummyGRPCServer.UpdateRuleGroup(context.Background(), dummyReq)
+		assert.Nil(t, err)
+		assert.Equal(t, uint64(3), res.GetId())
+		assert.Equal(t, "1m", res.GetInterval())
+		mockedRuleService.AssertExpectations(t)
+	})
+
+	t.Run("should return error code 3 if settings are invalid", func(t *testing.T) {
+		mockedRuleService := &mocks.RuleService{}
+		dummyGRPCServer := GRPCServer{
+			container: &service.Container{
+				RulesService: mockedRuleService,
+			},
+			logger: zaptest.NewLogger(t),
+		}
+		mockedRuleService.On("UpsertGroup", dummyPayload).Return(nil, &domain.InvalidRuleGroupError{
+			Namespace: "foo",
+			GroupName: "bar",
+			Reason:    "interval must be greater than 0",
+		}).Once()
+		res, err := dummyGRPCServer.UpdateRuleGroup(context.Background(), dummyReq)
+		assert.Nil(t, res)
+		assert.EqualError(t, err, "rpc error: code = InvalidArgument desc = invalid settings of rule group bar in namespace foo: interval must be greater than 0")
+	})
+
+	t.Run("should return error code 13 if updating rule group failed", func(t *testing.T) {
+		mockedRuleService := &mocks.RuleService{}
+		dummyGRPCServer := GRPCServer{
+			container: &service.Container{
+				RulesService: mockedRuleService,
+			},
+			logger: zaptest.NewLogger(t),
+		}
+		mockedRuleService.On("UpsertGroup", dummyPayload).Return(nil, errors.New("random error")).Once()
+		res, err := dummyGRPCServer.UpdateRuleGroup(context.Background(), dummyReq)
+		assert.Nil(t, res)
+		assert.EqualError(t, err, "rpc error: code = Internal desc = random error")
+	})
+}
+
+func TestGRPCServer_ExportRules(t *testing.T) {
+	dummyReq := &sirenv1beta1.ExportRulesRequest{
+		ProviderNamespace: 1,
+		Namespace:         "foo",
+	}
+
+	t.Run("should return the rules grouped by rule group", func(t *testing.T) {
+		mockedRuleService := &mocks.RuleService{}
+		dummyGRPCServer := GRPCServer{
+			container: &service.Container{
+				RulesService: mockedRuleService,
+			},
+			logger: zaptest.NewLogger(t),
+		}
+		dummyExport := &domain.RuleExport{
+			ProviderUrn:  "cortex",
+			NamespaceUrn: "odpf",
+			RuleGroups: []domain.RuleGroupExport{{
+				Group: domain.RuleGroup{ProviderNamespace: 1, Namespace: "foo", GroupName: "bar", Interval: "1m"},
+				Rules: []domain.Rule{{
+					Id: 1, Name: "siren_api_odpf_foo_bar_cpu", Enabled: true, GroupName: "bar", Namespace: "foo",
+					Template: "cpu", TemplateVersion: 2, ProviderNamespace: 1,
+					Variables: []domain.RuleVariable{{Name: "warning", Type: "int", Value: "80"}},
+				}},
+			}},
+		}
+		mockedRuleService.On("Export", uint64(1), "foo", "").Return(dummyExport, nil).Once()
+		res, err := dummyGRPCServer.ExportRules(context.Background(), dummyReq)
+		assert.Nil(t, err)
+		assert.Equal(t, "cortex", res.GetProvider())
+		assert.Equal(t, "odpf", res.GetProviderNamespace())
+		assert.Equal(t, 1, len(res.GetRuleGroups()))
+		assert.Equal(t, "1m", res.GetRuleGroups()[0].GetGroup().GetInterval())
+		assert.Equal(t, 1, len(res.GetRuleGroups()[0].GetRules()))
+		assert.Equal(t, "cpu", res.GetRuleGroups()[0].GetRules()[0].GetTemplate())
+		assert.Equal(t, uint64(2), res.GetRuleGroups()[0].GetRules()[0].GetTemplateVersion())
+		assert.Equal(t, "80", res.GetRuleGroups()[0].GetRules()[0].GetVariables()[0].GetValue())
+	})
+
+	t.Run("should return error code 5 if the provider namespace does not exist", func(t *testing.T) {
+		mockedRuleService := &mocks.RuleService{}
+		dummyGRPCServer := GRPCServer{
+			container: &service.Container{
+				RulesService: mockedRuleService,
+			},
+			logger: zaptest.NewLogger(t),
+		}
+		mockedRuleService.On("Export", uint64(1), "foo", "").Return(nil, nil).Once()
+		res, err := dummyGRPCServer.ExportRules(context.Background(), dummyReq)
+		assert.Nil(t, res)
+		assert.EqualError(t, err, "rpc error: code = NotFound desc = provider namespace not found")
+	})
+
+	t.Run("should return error code 13 if exporting rules failed", func(t *testing.T) {
+		mockedRuleService := &mocks.RuleService{}
+		dummyGRPCServer := GRPCServer{
+			container: &service.Container{
+				RulesService: mockedRuleService,
+			},
+			logger: zaptest.NewLogger(t),
+		}
+		mockedRuleService.On("Export", uint64(1), "foo", "").Return(nil, errors.New("random error")).Once()
+		res, err := dummyGRPCServer.ExportRules(context.Background(), dummyReq)
+		assert.Nil(t, res)
+		assert.EqualError(t, err, "rpc error: code = Internal desc = random error")
+	})
 }
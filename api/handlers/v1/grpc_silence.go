@@ -0,0 +1,99 @@
+package v1
+
+import (
+	"context"
+	"errors"
+	sirenv1beta1 "github.com/odpf/siren/api/proto/odpf/siren/v1beta1"
+	"github.com/odpf/siren/domain"
+	"github.com/odpf/siren/helper"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func (s *GRPCServer) ListSilences(_ context.Context, req *sirenv1beta1.ListSilencesRequest) (*sirenv1beta1.ListSilencesResponse, error) {
+	silences, err := s.container.SilenceService.ListSilences(req.GetNamespace())
+	if err != nil {
+		return nil, s.silenceError(err)
+	}
+
+	res := &sirenv1beta1.ListSilencesResponse{
+		Silences: make([]*sirenv1beta1.Silence, 0),
+	}
+	for _, silence := range silences {
+		res.Silences = append(res.Silences, getSilenceFromDomainObject(silence))
+	}
+	return res, nil
+}
+
+func (s *GRPCServer) CreateSilence(ctx context.Context, req *sirenv1beta1.CreateSilenceRequest) (*sirenv1beta1.Silence, error) {
+	payload := &domain.Silence{
+		Namespace: req.GetNamespace(),
+		Matchers:  getMatchersInDomainObject(req.GetMatchers()),
+		CreatedBy: actorFromContext(ctx),
+		Comment:   req.GetComment(),
+	}
+	if payload.CreatedBy == "" {
+		payload.CreatedBy = req.GetCreatedBy()
+	}
+	if req.GetStartsAt() != nil {
+		payload.StartsAt = req.GetStartsAt().AsTime()
+	}
+	if req.GetEndsAt() != nil {
+		payload.EndsAt = req.GetEndsAt().AsTime()
+	}
+
+	silence, err := s.container.SilenceService.CreateSilence(payload)
+	if err != nil {
+		return nil, s.silenceError(err)
+	}
+
+	return getSilenceFromDomainObject(silence), nil
+}
+
+func (s *GRPCServer) GetSilence(_ context.Context, req *sirenv1beta1.GetSilenceRequest) (*sirenv1beta1.Silence, error) {
+	silence, err := s.container.SilenceService.GetSilence(req.GetNamespace(), req.GetId())
+	if err != nil {
+		return nil, s.silenceError(err)
+	}
+	if silence == nil {
+		return nil, status.Errorf(codes.NotFound, "silence not found")
+	}
+
+	return getSilenceFromDomainObject(silence), nil
+}
+
+func (s *GRPCServer) ExpireSilence(_ context.Context, req *sirenv1beta1.ExpireSilenceRequest) (*emptypb.Empty, error) {
+	err := s.container.SilenceService.ExpireSilence(req.GetNamespace(), req.GetId())
+	if err != nil {
+		return nil, s.silenceError(err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (s *GRPCServer) silenceError(err error) error {
+	var invalidSilenceErr *domain.InvalidSilenceError
+	if errors.As(err, &invalidSilenceErr) {
+		return helper.GRPCLogError(s.logger, codes.InvalidArgument, err)
+	}
+	var invalidMatcherErr *domain.InvalidMatcherError
+	if errors.As(err, &invalidMatcherErr) {
+		return helper.GRPCLogError(s.logger, codes.InvalidArgument, err)
+	}
+	return helper.GRPCLogError(s.logger, codes.Internal, err)
+}
+
+func getSilenceFromDomainObject(silence *domain.Silence) *sirenv1beta1.Silence {
+	return &sirenv1beta1.Silence{
+		Id:        silence.Id,
+		Namespace: silence.Namespace,
+		Matchers:  getMatchersFromDomainObject(silence.Matchers),
+		StartsAt:  timestamppb.New(silence.StartsAt),
+		EndsAt:    timestamppb.New(silence.EndsAt),
+		CreatedBy: silence.CreatedBy,
+		Comment:   silence.Comment,
+		State:     silence.State,
+	}
+}
@@ -0,0 +1,281 @@
+package v1
+
+import (
+	"context"
+	"errors"
+	sirenv1beta1 "github.com/odpf/siren/api/proto/odpf/siren/v1beta1"
+	"github.com/odpf/siren/domain"
+	"github.com/odpf/siren/mocks"
+	"github.com/odpf/siren/service"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"go.uber.org/zap/zaptest"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"testing"
+	"time"
+)
+
+func TestGRPCServer_ListSilences(t *testing.T) {
+	t.Run("should return silences of the namespace", func(t *testing.T) {
+		mockedSilenceService := &mocks.SilenceService{}
+		dummyGRPCServer := GRPCServer{
+			container: &service.Container{
+				SilenceService: mockedSilenceService,
+			},
+			logger: zaptest.NewLogger(t),
+		}
+		dummyResult := []*domain.Silence{
+			{
+				Id:        "a7b5e8b6-3f5e-4d4b-9c1c-1b9b1a4f8d1e",
+				Namespace: 1,
+				Matchers:  []domain.Matcher{{Name: "alertname", Operator: "=", Value: "CPUHigh"}},
+				StartsAt:  time.Now(),
+				EndsAt:    time.Now().Add(time.Hour),
+				CreatedBy: "foo@odpf.io",
+				Comment:   "maintenance",
+				State:     domain.SilenceStateActive,
+			},
+		}
+
+		mockedSilenceService.On("ListSilences", uint64(1)).Return(dummyResult, nil).Once()
+		res, err := dummyGRPCServer.ListSilences(context.Background(), &sirenv1beta1.ListSilencesRequest{Namespace: 1})
+		assert.Nil(t, err)
+		assert.Equal(t, 1, len(res.GetSilences()))
+		assert.Equal(t, "a7b5e8b6-3f5e-4d4b-9c1c-1b9b1a4f8d1e", res.GetSilences()[0].GetId())
+		assert.Equal(t, "CPUHigh", res.GetSilences()[0].GetMatchers()[0].GetValue())
+		assert.Equal(t, "active", res.GetSilences()[0].GetState())
+	})
+
+	t.Run("should return error code 3 if namespace is invalid", func(t *testing.T) {
+		mockedSilenceService := &mocks.SilenceService{}
+		dummyGRPCServer := GRPCServer{
+			container: &service.Container{
+				SilenceService: mockedSilenceService,
+			},
+			logger: zaptest.NewLogger(t),
+		}
+		mockedSilenceService.On("ListSilences", uint64(0)).
+			Return(nil, &domain.InvalidSilenceError{Reason: "namespace is required"}).Once()
+		res, err := dummyGRPCServer.ListSilences(context.Background(), &sirenv1beta1.ListSilencesRequest{})
+		assert.Nil(t, res)
+		assert.EqualError(t, err, "rpc error: code = InvalidArgument desc = invalid silence: namespace is required")
+	})
+
+	t.Run("should return error code 13 if getting silences fails", func(t *testing.T) {
+		mockedSilenceService := &mocks.SilenceService{}
+		dummyGRPCServer := GRPCServer{
+			container: &service.Container{
+				SilenceService: mockedSilenceService,
+			},
+			logger: zaptest.NewLogger(t),
+		}
+		mockedSilenceService.On("ListSilences", uint64(1)).
+			Return(nil, errors.New("random error")).Once()
+		res, err := dummyGRPCServer.ListSilences(context.Background(), &sirenv1beta1.ListSilencesRequest{Namespace: 1})
+		assert.Nil(t, res)
+		assert.EqualError(t, err, "rpc error: code = Internal desc = random error")
+	})
+}
+
+func TestGRPCServer_CreateSilence(t *testing.T) {
+	startsAt := time.Date(2022, 1, 1, 10, 0, 0, 0, time.UTC)
+	endsAt := startsAt.Add(2 * time.Hour)
+	payload := &sirenv1beta1.CreateSilenceRequest{
+		Namespace: 1,
+		Matchers:  []*sirenv1beta1.Matcher{{Name: "alertname", Operator: "=", Value: "CPUHigh"}},
+		StartsAt:  timestamppb.New(startsAt),
+		EndsAt:    timestamppb.New(endsAt),
+		CreatedBy: "bar@odpf.io",
+		Comment:   "maintenance",
+	}
+	silence := &domain.Silence{
+		Namespace: 1,
+		Matchers:  []domain.Matcher{{Name: "alertname", Operator: "=", Value: "CPUHigh"}},
+		StartsAt:  startsAt,
+		EndsAt:    endsAt,
+		CreatedBy: "bar@odpf.io",
+		Comment:   "maintenance",
+	}
+
+	t.Run("should create silence with the creator of the request", func(t *testing.T) {
+		mockedSilenceService := &mocks.SilenceService{}
+		dummyGRPCServer := GRPCServer{
+			container: &service.Container{
+				SilenceService: mockedSilenceService,
+			},
+			logger: zaptest.NewLogger(t),
+		}
+		createdSilence := *silence
+		createdSilence.Id = "a7b5e8b6-3f5e-4d4b-9c1c-1b9b1a4f8d1e"
+		createdSilence.State = domain.SilenceStatePending
+
+		mockedSilenceService.On("CreateSilence", silence).Return(&createdSilence, nil).Once()
+		res, err := dummyGRPCServer.CreateSilence(context.Background(), payload)
+		assert.Nil(t, err)
+		assert.Equal(t, "a7b5e8b6-3f5e-4d4b-9c1c-1b9b1a4f8d1e", res.GetId())
+		assert.Equal(t, "bar@odpf.io", res.GetCreatedBy())
+		assert.Equal(t, endsAt, res.GetEndsAt().AsTime())
+		assert.Equal(t, "pending", res.GetState())
+		mockedSilenceService.AssertExpectations(t)
+	})
+
+	t.Run("should use the actor of the request context as creator", func(t *testing.T) {
+		mockedSilenceService := &mocks.SilenceService{}
+		dummyGRPCServer := GRPCServer{
+			container: &service.Container{
+				SilenceService: mockedSilenceService,
+			},
+			logger: zaptest.NewLogger(t),
+		}
+		ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(ActorHeader, "foo@odpf.io"))
+
+		mockedSilenceService.On("CreateSilence", mock.MatchedBy(func(s *domain.Silence) bool {
+			return s.CreatedBy == "foo@odpf.io"
+		})).Return(silence, nil).Once()
+		_, err := dummyGRPCServer.CreateSilence(ctx, payload)
+		assert.Nil(t, err)
+		mockedSilenceService.AssertExpectations(t)
+	})
+
+	t.Run("should leave times unset if not in the request", func(t *testing.T) {
+		mockedSilenceService := &mocks.SilenceService{}
+		dummyGRPCServer := GRPCServer{
+			container: &service.Container{
+				SilenceService: mockedSilenceService,
+			},
+			logger: zaptest.NewLogger(t),
+		}
+
+		mockedSilenceService.On("CreateSilence", mock.MatchedBy(func(s *domain.Silence) bool {
+			return s.StartsAt.IsZero() && s.EndsAt.IsZero()
+		})).Return(nil, &domain.InvalidSilenceError{Reason: "end time is required"}).Once()
+		res, err := dummyGRPCServer.CreateSilence(context.Background(), &sirenv1beta1.CreateSilenceRequest{
+			Namespace: 1,
+			Matchers:  payload.Matchers,
+			Comment:   "maintenance",
+		})
+		assert.Nil(t, res)
+		assert.EqualError(t, err, "rpc error: code = InvalidArgument desc = invalid silence: end time is required")
+		mockedSilenceService.AssertExpectations(t)
+	})
+
+	t.Run("should return error code 3 if matchers are invalid", func(t *testing.T) {
+		mockedSilenceService := &mocks.SilenceService{}
+		dummyGRPCServer := GRPCServer{
+			container: &service.Container{
+				SilenceService: mockedSilenceService,
+			},
+			logger: zaptest.NewLogger(t),
+		}
+
+		mockedSilenceService.On("CreateSilence", silence).
+			Return(nil, &domain.InvalidMatcherError{
+				Matcher: domain.Matcher{Name: "alertname", Operator: "==", Value: "CPUHigh"},
+				Reason:  "invalid operator",
+			}).Once()
+		res, err := dummyGRPCServer.CreateSilence(context.Background(), payload)
+		assert.Nil(t, res)
+		assert.EqualError(t, err, "rpc error: code = InvalidArgument desc = invalid matcher alertname==\"CPUHigh\": invalid operator")
+	})
+
+	t.Run("should return error code 13 if creating silence fails", func(t *testing.T) {
+		mockedSilenceService := &mocks.SilenceService{}
+		dummyGRPCServer := GRPCServer{
+			container: &service.Container{
+				SilenceService: mockedSilenceService,
+			},
+			logger: zaptest.NewLogger(t),
+		}
+
+		mockedSilenceService.On("CreateSilence", silence).Return(nil, errors.New("random error")).Once()
+		res, err := dummyGRPCServer.CreateSilence(context.Background(), payload)
+		assert.Nil(t, res)
+		assert.EqualError(t, err, "rpc error: code = Internal desc = random error")
+	})
+}
+
+func TestGRPCServer_GetSilence(t *testing.T) {
+	t.Run("should return silence", func(t *testing.T) {
+		mockedSilenceService := &mocks.SilenceService{}
+		dummyGRPCServer := GRPCServer{
+			container: &service.Container{
+				SilenceService: mockedSilenceService,
+			},
+			logger: zaptest.NewLogger(t),
+		}
+		dummyResult := &domain.Silence{
+			Id:        "a7b5e8b6-3f5e-4d4b-9c1c-1b9b1a4f8d1e",
+			Namespace: 1,
+			Matchers:  []domain.Matcher{{Name: "alertname", Operator: "=", Value: "CPUHigh"}},
+			CreatedBy: "foo@odpf.io",
+			Comment:   "maintenance",
+			State:     domain.SilenceStateExpired,
+		}
+
+		mockedSilenceService.On("GetSilence", uint64(1), "a7b5e8b6-3f5e-4d4b-9c1c-1b9b1a4f8d1e").Return(dummyResult, nil).Once()
+		res, err := dummyGRPCServer.GetSilence(context.Background(),
+			&sirenv1beta1.GetSilenceRequest{Id: "a7b5e8b6-3f5e-4d4b-9c1c-1b9b1a4f8d1e", Namespace: 1})
+		assert.Nil(t, err)
+		assert.Equal(t, "maintenance", res.GetComment())
+		assert.Equal(t, "expired", res.GetState())
+	})
+
+	t.Run("should return error code 5 if silence does not exist", func(t *testing.T) {
+		mockedSilenceService := &mocks.SilenceService{}
+		dummyGRPCServer := GRPCServer{
+			container: &service.Container{
+				SilenceService: mockedSilenceService,
+			},
+			logger: zaptest.NewLogger(t),
+		}
+		mockedSilenceService.On("GetSilence", uint64(1), "foo").Return(nil, nil).Once()
+		res, err := dummyGRPCServer.GetSilence(context.Background(), &sirenv1beta1.GetSilenceRequest{Id: "foo", Namespace: 1})
+		assert.Nil(t, res)
+		assert.EqualError(t, err, "rpc error: code = NotFound desc = silence not found")
+	})
+
+	t.Run("should return error code 13 if getting silence fails", func(t *testing.T) {
+		mockedSilenceService := &mocks.SilenceService{}
+		dummyGRPCServer := GRPCServer{
+			container: &service.Container{
+				SilenceService: mockedSilenceService,
+			},
+			logger: zaptest.NewLogger(t),
+		}
+		mockedSilenceService.On("GetSilence", uint64(1), "foo").Return(nil, errors.New("random error")).Once()
+		res, err := dummyGRPCServer.GetSilence(context.Background(), &sirenv1beta1.GetSilenceRequest{Id: "foo", Namespace: 1})
+		assert.Nil(t, res)
+		assert.EqualError(t, err, "rpc error: code = Internal desc = random error")
+	})
+}
+
+func TestGRPCServer_ExpireSilence(t *testing.T) {
+	t.Run("should expire silence", func(t *testing.T) {
+		mockedSilenceService := &mocks.SilenceService{}
+		dummyGRPCServer := GRPCServer{
+			container: &service.Container{
+				SilenceService: mockedSilenceService,
+			},
+			logger: zaptest.NewLogger(t),
+		}
+		mockedSilenceService.On("ExpireSilence", uint64(1), "foo").Return(nil).Once()
+		res, err := dummyGRPCServer.ExpireSilence(context.Background(), &sirenv1beta1.ExpireSilenceRequest{Id: "foo", Namespace: 1})
+		assert.Nil(t, err)
+		assert.NotNil(t, res)
+	})
+
+	t.Run("should return error code 13 if expiring silence fails", func(t *testing.T) {
+		mockedSilenceService := &mocks.SilenceService{}
+		dummyGRPCServer := GRPCServer{
+			container: &service.Container{
+				SilenceService: mockedSilenceService,
+			},
+			logger: zaptest.NewLogger(t),
+		}
+		mockedSilenceService.On("ExpireSilence", uint64(1), "foo").Return(errors.New("random error")).Once()
+		res, err := dummyGRPCServer.ExpireSilence(context.Background(), &sirenv1beta1.ExpireSilenceRequest{Id: "foo", Namespace: 1})
+		assert.Nil(t, res)
+		assert.EqualError(t, err, "rpc error: code = Internal desc = random error")
+	})
+}
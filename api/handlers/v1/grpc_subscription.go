@@ -2,6 +2,7 @@ package v1
 
 import (
 	"context"
+	"errors"
 	sirenv1beta1 "github.com/odpf/siren/api/proto/odpf/siren/v1beta1"
 	"github.com/odpf/siren/domain"
 	"go.uber.org/zap"
@@ -24,13 +25,20 @@ func (s *GRPCServer) ListSubscriptions(_ context.Context, _ *emptypb.Empty) (*si
 	}
 	for _, subscription := range subscriptions {
 		item := &sirenv1beta1.Subscription{
-			Id:        subscription.Id,
-			Urn:       subscription.Urn,
-			Namespace: subscription.Namespace,
-			Match:     subscription.Match,
-			Receivers: getReceiverMetadataListFromDomainObject(subscription.Receivers),
-			CreatedAt: timestamppb.New(subscription.CreatedAt),
-			UpdatedAt: timestamppb.New(subscription.UpdatedAt),
+			Id:             subscription.Id,
+			Urn:            subscription.Urn,
+			Namespace:      subscription.Namespace,
+			Match:          subscription.Match,
+			Matchers:       getMatchersFromDomainObject(subscription.Matchers),
+			Receivers:      getReceiverMetadataListFromDomainObject(subscription.Receivers),
+			CreatedAt:      timestamppb.New(subscription.CreatedAt),
+			UpdatedAt:      timestamppb.New(subscription.UpdatedAt),
+			GroupBy:        subscription.GroupBy,
+			GroupWait:      subscription.GroupWait,
+			GroupInterval:  subscription.GroupInterval,
+			RepeatInterval: subscription.RepeatInterval,
+
+			MuteTimeIntervals: subscription.MuteTimeIntervals,
 		}
 		res.Subscriptions = append(res.Subscriptions, item)
 	}
@@ -43,8 +51,29 @@ func (s *GRPCServer) CreateSubscription(_ context.Context, req *sirenv1beta1.Cre
 		Urn:       req.GetUrn(),
 		Receivers: getReceiverMetadataListInDomainObject(req.GetReceivers()),
 		Match:     req.GetMatch(),
+		Matchers:  getMatchersInDomainObject(req.GetMatchers()),
+
+		MuteTimeIntervals: req.GetMuteTimeIntervals(),
+		Routing: domain.Routing{
+			GroupBy:        req.GetGroupBy(),
+			GroupWait:      req.GetGroupWait(),
+			GroupInterval:  req.GetGroupInterval(),
+			RepeatInterval: req.GetRepeatInterval(),
+		},
 	})
 	if err != nil {
+		var invalidMatcherErr *domain.InvalidMatcherError
+		if errors.As(err, &invalidMatcherErr) {
+			return nil, status.Errorf(codes.InvalidArgument, err.Error())
+		}
+		var invalidRoutingErr *domain.InvalidRoutingError
+		if errors.As(err, &invalidRoutingErr) {
+			return nil, status.Errorf(codes.InvalidArgument, err.Error())
+		}
+		var invalidTimeIntervalErr *domain.InvalidTimeIntervalError
+		if errors.As(err, &invalidTimeIntervalErr) {
+			return nil, status.Errorf(codes.InvalidArgument, err.Error())
+		}
 		s.logger.Error("handler", zap.Error(err))
 		return nil, status.Errorf(codes.Internal, err.Error())
 	}
@@ -55,13 +84,20 @@ func (s *GRPCServer) CreateSubscription(_ context.Context, req *sirenv1beta1.Cre
 		receivers = append(receivers, &item)
 	}
 	return &sirenv1beta1.Subscription{
-		Id:        subscription.Id,
-		Urn:       subscription.Urn,
-		Namespace: subscription.Namespace,
-		Match:     subscription.Match,
-		Receivers: receivers,
-		CreatedAt: timestamppb.New(subscription.CreatedAt),
-		UpdatedAt: timestamppb.New(subscription.UpdatedAt),
+		Id:             subscription.Id,
+		Urn:            subscription.Urn,
+		Namespace:      subscription.Namespace,
+		Match:          subscription.Match,
+		Matchers:       getMatchersFromDomainObject(subscription.Matchers),
+		Receivers:      receivers,
+		CreatedAt:      timestamppb.New(subscription.CreatedAt),
+		UpdatedAt:      timestamppb.New(subscription.UpdatedAt),
+		GroupBy:        subscription.GroupBy,
+		GroupWait:      subscription.GroupWait,
+		GroupInterval:  subscription.GroupInterval,
+		RepeatInterval: subscription.RepeatInterval,
+
+		MuteTimeIntervals: subscription.MuteTimeIntervals,
 	}, nil
 }
 
@@ -80,15 +116,22 @@ func (s *GRPCServer) GetSubscription(_ context.Context, req *sirenv1beta1.GetSub
 		item := getReceiverMetadataFromDomainObject(&receiverMetadataItem)
 		receivers = append(receivers, &item)
 	}
-	
+
 	return &sirenv1beta1.Subscription{
-		Id:        subscription.Id,
-		Urn:       subscription.Urn,
-		Namespace: subscription.Namespace,
-		Match:     subscription.Match,
-		Receivers: receivers,
-		CreatedAt: timestamppb.New(subscription.CreatedAt),
-		UpdatedAt: timestamppb.New(subscription.UpdatedAt),
+		Id:             subscription.Id,
+		Urn:            subscription.Urn,
+		Namespace:      subscription.Namespace,
+		Match:          subscription.Match,
+		Matchers:       getMatchersFromDomainObject(subscription.Matchers),
+		Receivers:      receivers,
+		CreatedAt:      timestamppb.New(subscription.CreatedAt),
+		UpdatedAt:      timestamppb.New(subscription.UpdatedAt),
+		GroupBy:        subscription.GroupBy,
+		GroupWait:      subscription.GroupWait,
+		GroupInterval:  subscription.GroupInterval,
+		RepeatInterval: subscription.RepeatInterval,
+
+		MuteTimeIntervals: subscription.MuteTimeIntervals,
 	}, nil
 }
 
@@ -99,8 +142,29 @@ func (s *GRPCServer) UpdateSubscription(_ context.Context, req *sirenv1beta1.Upd
 		Urn:       req.GetUrn(),
 		Receivers: getReceiverMetadataListInDomainObject(req.GetReceivers()),
 		Match:     req.GetMatch(),
+		Matchers:  getMatchersInDomainObject(req.GetMatchers()),
+
+		MuteTimeIntervals: req.GetMuteTimeIntervals(),
+		Routing: domain.Routing{
+			GroupBy:        req.GetGroupBy(),
+			GroupWait:      req.GetGroupWait(),
+			GroupInterval:  req.GetGroupInterval(),
+			RepeatInterval: req.GetRepeatInterval(),
+		},
 	})
 	if err != nil {
+		var invalidMatcherErr *domain.InvalidMatcherError
+		if errors.As(err, &invalidMatcherErr) {
+			return nil, status.Errorf(codes.InvalidArgument, err.Error())
+		}
+		var invalidRoutingErr *domain.InvalidRoutingError
+		if errors.As(err, &invalidRoutingErr) {
+			return nil, status.Errorf(codes.InvalidArgument, err.Error())
+		}
+		var invalidTimeIntervalErr *domain.InvalidTimeIntervalError
+		if errors.As(err, &invalidTimeIntervalErr) {
+			return nil, status.Errorf(codes.InvalidArgument, err.Error())
+		}
 		if strings.Contains(err.Error(), `violates unique constraint "urn_provider_id_unique"`) {
 			return nil, status.Errorf(codes.InvalidArgument, "urn and provider pair already exist")
 		}
@@ -115,13 +179,20 @@ func (s *GRPCServer) UpdateSubscription(_ context.Context, req *sirenv1beta1.Upd
 	}
 
 	return &sirenv1beta1.Subscription{
-		Id:        subscription.Id,
-		Urn:       subscription.Urn,
-		Namespace: subscription.Namespace,
-		Match:     subscription.Match,
-		Receivers: receivers,
-		CreatedAt: timestamppb.New(subscription.CreatedAt),
-		UpdatedAt: timestamppb.New(subscription.UpdatedAt),
+		Id:             subscription.Id,
+		Urn:            subscription.Urn,
+		Namespace:      subscription.Namespace,
+		Match:          subscription.Match,
+		Matchers:       getMatchersFromDomainObject(subscription.Matchers),
+		Receivers:      receivers,
+		CreatedAt:      timestamppb.New(subscription.CreatedAt),
+		UpdatedAt:      timestamppb.New(subscription.UpdatedAt),
+		GroupBy:        subscription.GroupBy,
+		GroupWait:      subscription.GroupWait,
+		GroupInterval:  subscription.GroupInterval,
+		RepeatInterval: subscription.RepeatInterval,
+
+		MuteTimeIntervals: subscription.MuteTimeIntervals,
 	}, nil
 }
 
@@ -164,3 +235,27 @@ func getReceiverMetadataListFromDomainObject(domainReceivers []domain.ReceiverMe
 	}
 	return receivers
 }
+
+func getMatchersFromDomainObject(domainMatchers []domain.Matcher) []*sirenv1beta1.Matcher {
+	matchers := make([]*sirenv1beta1.Matcher, 0, len(domainMatchers))
+	for _, matcher := range domainMatchers {
+		matchers = append(matchers, &sirenv1beta1.Matcher{
+			Name:     matcher.Name,
+			Operator: matcher.Operator,
+			Value:    matcher.Value,
+		})
+	}
+	return matchers
+}
+
+func getMatchersInDomainObject(matchers []*sirenv1beta1.Matcher) []domain.Matcher {
+	var domainMatchers []domain.Matcher
+	for _, matcher := range matchers {
+		domainMatchers = append(domainMatchers, domain.Matcher{
+			Name:     matcher.GetName(),
+			Operator: matcher.GetOperator(),
+			Value:    matcher.GetValue(),
+		})
+	}
+	return domainMatchers
+}
@@ -191,6 +191,186 @@ func TestGRPCServer_CreateSubscription(t *testing.T) {
 		assert.Nil(t, res)
 		assert.EqualError(t, err, "rpc error: code = Internal desc = random error")
 	})
+
+	t.Run("should create a subscription with matchers", func(t *testing.T) {
+		mockedSubscriptionService := &mocks.SubscriptionService{}
+		dummyGRPCServer := GRPCServer{
+			container: &service.Container{
+				SubscriptionService: mockedSubscriptionService,
+			},
+			logger: zaptest.NewLogger(t),
+		}
+		matchers := []domain.Matcher{{Name: "service", Operator: domain.MatchRegexp, Value: "payments-.*"}}
+		dummyResult := &domain.Subscription{
+			Id:        1,
+			Urn:       "foo",
+			Namespace: 1,
+			Receivers: []domain.ReceiverMetadata{{Id: 1, Configuration: configuration}},
+			Matchers:  matchers,
+		}
+
+		mockedSubscriptionService.On("CreateSubscription", &domain.Subscription{
+			Namespace: 1,
+			Urn:       "foo",
+			Receivers: []domain.ReceiverMetadata{{Id: 1, Configuration: configuration}},
+			Matchers:  matchers,
+		}).Return(dummyResult, nil).Once()
+		res, err := dummyGRPCServer.CreateSubscription(context.Background(), &sirenv1beta1.CreateSubscriptionRequest{
+			Namespace: 1,
+			Urn:       "foo",
+			Receivers: []*sirenv1beta1.ReceiverMetadata{{Id: 1, Configuration: configuration}},
+			Matchers:  []*sirenv1beta1.Matcher{{Name: "service", Operator: "=~", Value: "payments-.*"}},
+		})
+		assert.Nil(t, err)
+		assert.Equal(t, 1, len(res.GetMatchers()))
+		assert.Equal(t, "service", res.GetMatchers()[0].GetName())
+		assert.Equal(t, "=~", res.GetMatchers()[0].GetOperator())
+		assert.Equal(t, "payments-.*", res.GetMatchers()[0].GetValue())
+	})
+
+	t.Run("should return error code 3 if a matcher is invalid", func(t *testing.T) {
+		mockedSubscriptionService := &mocks.SubscriptionService{}
+		dummyGRPCServer := GRPCServer{
+			container: &service.Container{
+				SubscriptionService: mockedSubscriptionService,
+			},
+			logger: zaptest.NewLogger(t),
+		}
+		matcher := domain.Matcher{Name: "service", Operator: domain.MatchRegexp, Value: "payments-(.*"}
+
+		mockedSubscriptionService.On("CreateSubscription", &domain.Subscription{
+			Namespace: 1,
+			Urn:       "foo",
+			Receivers: []domain.ReceiverMetadata{{Id: 1, Configuration: configuration}},
+			Matchers:  []domain.Matcher{matcher},
+		}).Return(nil, &domain.InvalidMatcherError{Matcher: matcher, Reason: "missing closing )"}).Once()
+		res, err := dummyGRPCServer.CreateSubscription(context.Background(), &sirenv1beta1.CreateSubscriptionRequest{
+			Namespace: 1,
+			Urn:       "foo",
+			Receivers: []*sirenv1beta1.ReceiverMetadata{{Id: 1, Configuration: configuration}},
+			Matchers:  []*sirenv1beta1.Matcher{{Name: "service", Operator: "=~", Value: "payments-(.*"}},
+		})
+		assert.Nil(t, res)
+		assert.EqualError(t, err, `rpc error: code = InvalidArgument desc = invalid matcher service=~"payments-(.*": missing closing )`)
+	})
+	t.Run("should create a subscription with routing", func(t *testing.T) {
+		mockedSubscriptionService := &mocks.SubscriptionService{}
+		dummyGRPCServer := GRPCServer{
+			container: &service.Container{
+				SubscriptionService: mockedSubscriptionService,
+			},
+			logger: zaptest.NewLogger(t),
+		}
+		routing := domain.Routing{GroupBy: []string{"service"}, GroupWait: "1m", GroupInterval: "10m", RepeatInterval: "1h"}
+		dummyResult := &domain.Subscription{
+			Id:        1,
+			Urn:       "foo",
+			Namespace: 1,
+			Receivers: []domain.ReceiverMetadata{{Id: 1, Configuration: configuration}},
+			Routing:   routing,
+		}
+
+		mockedSubscriptionService.On("CreateSubscription", &domain.Subscription{
+			Namespace: 1,
+			Urn:       "foo",
+			Receivers: []domain.ReceiverMetadata{{Id: 1, Configuration: configuration}},
+			Routing:   routing,
+		}).Return(dummyResult, nil).Once()
+		res, err := dummyGRPCServer.CreateSubscription(context.Background(), &sirenv1beta1.CreateSubscriptionRequest{
+			Namespace:      1,
+			Urn:            "foo",
+			Receivers:      []*sirenv1beta1.ReceiverMetadata{{Id: 1, Configuration: configuration}},
+			GroupBy:        []string{"service"},
+			GroupWait:      "1m",
+			GroupInterval:  "10m",
+			RepeatInterval: "1h",
+		})
+		assert.Nil(t, err)
+		assert.Equal(t, []string{"service"}, res.GetGroupBy())
+		assert.Equal(t, "1m", res.GetGroupWait())
+		assert.Equal(t, "10m", res.GetGroupInterval())
+		assert.Equal(t, "1h", res.GetRepeatInterval())
+	})
+
+	t.Run("should return error code 3 if the routing is invalid", func(t *testing.T) {
+		mockedSubscriptionService := &mocks.SubscriptionService{}
+		dummyGRPCServer := GRPCServer{
+			container: &service.Container{
+				SubscriptionService: mockedSubscriptionService,
+			},
+			logger: zaptest.NewLogger(t),
+		}
+
+		mockedSubscriptionService.On("CreateSubscription", &domain.Subscription{
+			Namespace: 1,
+			Urn:       "foo",
+			Receivers: []domain.ReceiverMetadata{{Id: 1, Configuration: configuration}},
+			Routing:   domain.Routing{GroupInterval: "0s"},
+		}).Return(nil, &domain.InvalidRoutingError{Reason: "group_interval cannot be zero"}).Once()
+		res, err := dummyGRPCServer.CreateSubscription(context.Background(), &sirenv1beta1.CreateSubscriptionRequest{
+			Namespace:     1,
+			Urn:           "foo",
+			Receivers:     []*sirenv1beta1.ReceiverMetadata{{Id: 1, Configuration: configuration}},
+			GroupInterval: "0s",
+		})
+		assert.Nil(t, res)
+		assert.EqualError(t, err, "rpc error: code = InvalidArgument desc = invalid routing: group_interval cannot be zero")
+	})
+
+	t.Run("should create a subscription with time intervals", func(t *testing.T) {
+		mockedSubscriptionService := &mocks.SubscriptionService{}
+		dummyGRPCServer := GRPCServer{
+			container: &service.Container{
+				SubscriptionService: mockedSubscriptionService,
+			},
+			logger: zaptest.NewLogger(t),
+		}
+		subscription := &domain.Subscription{
+			Namespace:         1,
+			Urn:               "foo",
+			Receivers:         []domain.ReceiverMetadata{{Id: 1, Configuration: configuration}},
+			MuteTimeIntervals: []string{"weekends"},
+		}
+		dummyResult := *subscription
+		dummyResult.Id = 1
+
+		mockedSubscriptionService.On("CreateSubscription", subscription).Return(&dummyResult, nil).Once()
+		res, err := dummyGRPCServer.CreateSubscription(context.Background(), &sirenv1beta1.CreateSubscriptionRequest{
+			Namespace:         1,
+			Urn:               "foo",
+			Receivers:         []*sirenv1beta1.ReceiverMetadata{{Id: 1, Configuration: configuration}},
+			MuteTimeIntervals: []string{"weekends"},
+		})
+		assert.Nil(t, err)
+		assert.Equal(t, []string{"weekends"}, res.GetMuteTimeIntervals())
+	})
+
+	t.Run("should return error code 3 if a time interval is missing from the namespace", func(t *testing.T) {
+		mockedSubscriptionService := &mocks.SubscriptionService{}
+		dummyGRPCServer := GRPCServer{
+			container: &service.Container{
+				SubscriptionService: mockedSubscriptionService,
+			},
+			logger: zaptest.NewLogger(t),
+		}
+		invalidTimeIntervalErr := &domain.InvalidTimeIntervalError{
+			Reason: "subscription foo refers to time interval weekends missing from namespace 1"}
+
+		mockedSubscriptionService.On("CreateSubscription", &domain.Subscription{
+			Namespace:         1,
+			Urn:               "foo",
+			Receivers:         []domain.ReceiverMetadata{{Id: 1, Configuration: configuration}},
+			MuteTimeIntervals: []string{"weekends"},
+		}).Return(nil, invalidTimeIntervalErr).Once()
+		res, err := dummyGRPCServer.CreateSubscription(context.Background(), &sirenv1beta1.CreateSubscriptionRequest{
+			Namespace:         1,
+			Urn:               "foo",
+			Receivers:         []*sirenv1beta1.ReceiverMetadata{{Id: 1, Configuration: configuration}},
+			MuteTimeIntervals: []string{"weekends"},
+		})
+		assert.Nil(t, res)
+		assert.EqualError(t, err, "rpc error: code = InvalidArgument desc = "+invalidTimeIntervalErr.Error())
+	})
 }
 
 func TestGRPCServer_UpdateSubscription(t *testing.T) {
@@ -2,39 +2,44 @@ package v1
 
 import (
 	"context"
+	"errors"
 	sirenv1beta1 "github.com/odpf/siren/api/proto/odpf/siren/v1beta1"
 	"github.com/odpf/siren/domain"
 	"github.com/odpf/siren/helper"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/types/known/emptypb"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 func (s *GRPCServer) ListTemplates(_ context.Context, req *sirenv1beta1.ListTemplatesRequest) (*sirenv1beta1.ListTemplatesResponse, error) {
-	templates, err := s.container.TemplatesService.Index(req.GetTag())
+	tags := req.GetTags()
+	if req.GetTag() != "" {
+		tags = append([]string{req.GetTag()}, tags...)
+	}
+	filter := &domain.TemplateFilter{
+		Tags:         tags,
+		MatchAllTags: req.GetMatchAllTags(),
+		NamePrefix:   req.GetNamePrefix(),
+		NameContains: req.GetNameContains(),
+		SortBy:       req.GetSortBy(),
+		SortOrder:    req.GetSortOrder(),
+		PageSize:     int(req.GetPageSize()),
+		PageToken:    req.GetPageToken(),
+	}
+	if req.GetUpdatedSince() != nil {
+		filter.UpdatedSince = req.GetUpdatedSince().AsTime()
+	}
+	page, err := s.container.TemplatesService.List(filter)
 	if err != nil {
-		return nil, helper.GRPCLogError(s.logger, codes.Internal, err)
+		return nil, s.templateError(err)
 	}
 
-	res := &sirenv1beta1.ListTemplatesResponse{Templates: make([]*sirenv1beta1.Template, 0)}
-	for _, template := range templates {
-		variables := make([]*sirenv1beta1.TemplateVariables, 0)
-		for _, variable := range template.Variables {
-			variables = append(variables, &sirenv1beta1.TemplateVariables{
-				Name:        variable.Name,
-				Type:        variable.Type,
-				Default:     variable.Default,
-				Description: variable.Description,
-			})
-		}
-		res.Templates = append(res.Templates, &sirenv1beta1.Template{
-			Id:        uint64(template.ID),
-			Name:      template.Name,
-			Body:      template.Body,
-			Tags:      template.Tags,
-			CreatedAt: timestamppb.New(template.CreatedAt),
-			UpdatedAt: timestamppb.New(template.UpdatedAt),
-			Variables: variables,
-		})
+	res := &sirenv1beta1.ListTemplatesResponse{
+		Templates:     make([]*sirenv1beta1.Template, 0),
+		NextPageToken: page.NextPageToken,
+	}
+	for i := range page.Templates {
+		res.Templates = append(res.Templates, getTemplateFromDomainObject(&page.Templates[i]))
 	}
 
 	return res, nil
@@ -46,88 +51,186 @@ func (s *GRPCServer) GetTemplateByName(_ context.Context, req *sirenv1beta1.GetT
 		return nil, helper.GRPCLogError(s.logger, codes.Internal, err)
 	}
 
-	variables := make([]*sirenv1beta1.TemplateVariables, 0)
-	for _, variable := range template.Variables {
-		variables = append(variables, &sirenv1beta1.TemplateVariables{
-			Name:        variable.Name,
-			Type:        variable.Type,
-			Default:     variable.Default,
-			Description: variable.Description,
-		})
+	return &sirenv1beta1.TemplateResponse{Template: getTemplateFromDomainObject(template)}, nil
+}
+
+func (s *GRPCServer) ListTemplateVersions(_ context.Context, req *sirenv1beta1.ListTemplateVersionsRequest) (*sirenv1beta1.ListTemplateVersionsResponse, error) {
+	versions, err := s.container.TemplatesService.ListVersions(req.GetName())
+	if err != nil {
+		return nil, helper.GRPCLogError(s.logger, codes.Internal, err)
 	}
-	res := &sirenv1beta1.TemplateResponse{
-		Template: &sirenv1beta1.Template{
-			Id:        uint64(template.ID),
-			Name:      template.Name,
-			Body:      template.Body,
-			Tags:      template.Tags,
-			CreatedAt: timestamppb.New(template.CreatedAt),
-			UpdatedAt: timestamppb.New(template.UpdatedAt),
-			Variables: variables,
-		},
+
+	res := &sirenv1beta1.ListTemplateVersionsResponse{Versions: make([]*sirenv1beta1.Template, 0)}
+	for i := range versions {
+		res.Versions = append(res.Versions, getTemplateFromDomainObject(&versions[i]))
 	}
 	return res, nil
 }
 
 func (s *GRPCServer) UpsertTemplate(_ context.Context, req *sirenv1beta1.UpsertTemplateRequest) (*sirenv1beta1.TemplateResponse, error) {
-	variables := make([]domain.Variable, 0)
-	for _, variable := range req.GetVariables() {
-		variables = append(variables, domain.Variable{
-			Name:        variable.Name,
-			Type:        variable.Type,
-			Default:     variable.Default,
-			Description: variable.Description,
-		})
-	}
 	payload := &domain.Template{
 		ID:        uint(req.GetId()),
 		Name:      req.GetName(),
 		Body:      req.GetBody(),
 		Tags:      req.GetTags(),
-		Variables: variables,
+		Variables: getDomainVariablesFromRequest(req.GetVariables()),
 	}
-	template, err := s.container.TemplatesService.Upsert(payload)
+	template, ruleGroupSyncs, err := s.container.TemplatesService.Upsert(payload, req.GetDryRun())
 	if err != nil {
-		return nil, helper.GRPCLogError(s.logger, codes.Internal, err)
+		return nil, s.templateError(err)
 	}
 
-	templateVariables := make([]*sirenv1beta1.TemplateVariables, 0)
-	for _, variable := range template.Variables {
-		templateVariables = append(templateVariables, &sirenv1beta1.TemplateVariables{
-			Name:        variable.Name,
-			Type:        variable.Type,
-			Default:     variable.Default,
-			Description: variable.Description,
+	res := &sirenv1beta1.TemplateResponse{
+		Template:   getTemplateFromDomainObject(template),
+		RuleGroups: make([]*sirenv1beta1.RuleGroupSync, 0),
+	}
+	for _, ruleGroupSync := range ruleGroupSyncs {
+		item := &sirenv1beta1.RuleGroupSync{
+			ProviderNamespace: ruleGroupSync.ProviderNamespace,
+			Namespace:         ruleGroupSync.Namespace,
+			GroupName:         ruleGroupSync.GroupName,
+			Success:           ruleGroupSync.Success,
+			Error:             ruleGroupSync.Error,
+		}
+		if ruleGroupSync.Diff != nil {
+			item.Diff = &sirenv1beta1.RuleGroupDiff{
+				Namespace: ruleGroupSync.Diff.Namespace,
+				GroupName: ruleGroupSync.Diff.GroupName,
+				Added:     getRuleDiffListFromDomainObject(ruleGroupSync.Diff.Added),
+				Changed:   getRuleDiffListFromDomainObject(ruleGroupSync.Diff.Changed),
+				Removed:   getRuleDiffListFromDomainObject(ruleGroupSync.Diff.Removed),
+			}
+		}
+		res.RuleGroups = append(res.RuleGroups, item)
+	}
+	return res, nil
+}
+
+func getDomainVariablesFromRequest(variables []*sirenv1beta1.TemplateVariables) []domain.Variable {
+	res := make([]domain.Variable, 0)
+	for _, variable := range variables {
+		res = append(res, domain.Variable{
+			Name:          variable.Name,
+			Type:          variable.Type,
+			Default:       variable.Default,
+			Description:   variable.Description,
+			AllowedValues: variable.AllowedValues,
+			Pattern:       variable.Pattern,
+			Min:           variable.Min,
+			Max:           variable.Max,
+			Required:      variable.Required,
 		})
 	}
-	res := &sirenv1beta1.TemplateResponse{
-		Template: &sirenv1beta1.Template{
-			Id:        uint64(template.ID),
-			Name:      template.Name,
-			Body:      template.Body,
-			Tags:      template.Tags,
-			CreatedAt: timestamppb.New(template.CreatedAt),
-			UpdatedAt: timestamppb.New(template.UpdatedAt),
-			Variables: templateVariables,
-		},
+	return res
+}
+
+func (s *GRPCServer) GetTemplateUsage(_ context.Context, req *sirenv1beta1.GetTemplateUsageRequest) (*sirenv1beta1.TemplateUsage, error) {
+	usage, err := s.container.RulesService.TemplateUsage(req.GetName())
+	if err != nil {
+		return nil, helper.GRPCLogError(s.logger, codes.Internal, err)
+	}
+
+	res := &sirenv1beta1.TemplateUsage{
+		Template:   usage.Template,
+		Rules:      make([]*sirenv1beta1.TemplateRuleUsage, 0),
+		Namespaces: usage.Namespaces,
+		Providers:  usage.Providers,
+	}
+	for _, rule := range usage.Rules {
+		res.Rules = append(res.Rules, &sirenv1beta1.TemplateRuleUsage{
+			Id:                rule.Id,
+			Name:              rule.Name,
+			Namespace:         rule.Namespace,
+			GroupName:         rule.GroupName,
+			TemplateVersion:   uint64(rule.TemplateVersion),
+			Enabled:           rule.Enabled,
+			ProviderNamespace: rule.ProviderNamespace,
+			NamespaceUrn:      rule.NamespaceUrn,
+			ProviderUrn:       rule.ProviderUrn,
+		})
 	}
 	return res, nil
 }
 
+// DeleteTemplate refuses to delete a template used by rules unless forced,
+// in which case the rules are deleted first
 func (s *GRPCServer) DeleteTemplate(_ context.Context, req *sirenv1beta1.DeleteTemplateRequest) (*sirenv1beta1.DeleteTemplateResponse, error) {
-	err := s.container.TemplatesService.Delete(req.GetName())
+	rules, err := s.container.TemplatesService.Delete(req.GetName(), req.GetForce())
 	if err != nil {
-		return nil, helper.GRPCLogError(s.logger, codes.Internal, err)
+		return nil, s.templateError(err)
 	}
-	return &sirenv1beta1.DeleteTemplateResponse{}, nil
+
+	res := &sirenv1beta1.DeleteTemplateResponse{RemovedRules: make([]*sirenv1beta1.Rule, 0)}
+	for i := range rules {
+		res.RemovedRules = append(res.RemovedRules, getRuleFromDomainObject(&rules[i]))
+	}
+	return res, nil
 }
 
 func (s *GRPCServer) RenderTemplate(_ context.Context, req *sirenv1beta1.RenderTemplateRequest) (*sirenv1beta1.RenderTemplateResponse, error) {
 	body, err := s.container.TemplatesService.Render(req.GetName(), req.GetVariables())
 	if err != nil {
-		return nil, helper.GRPCLogError(s.logger, codes.Internal, err)
+		return nil, s.templateError(err)
 	}
 	return &sirenv1beta1.RenderTemplateResponse{
 		Body: body,
 	}, nil
 }
+
+func (s *GRPCServer) ListTemplatePartials(_ context.Context, _ *emptypb.Empty) (*sirenv1beta1.ListTemplatePartialsResponse, error) {
+	partials, err := s.container.TemplatesService.ListPartials()
+	if err != nil {
+		return nil, helper.GRPCLogError(s.logger, codes.Internal, err)
+	}
+
+	res := &sirenv1beta1.ListTemplatePartialsResponse{Partials: make([]*sirenv1beta1.TemplatePartial, 0)}
+	for _, partial := range partials {
+		res.Partials = append(res.Partials, &sirenv1beta1.TemplatePartial{
+			Id:        uint64(partial.ID),
+			Name:      partial.Name,
+			Body:      partial.Body,
+			CreatedAt: timestamppb.New(partial.CreatedAt),
+			UpdatedAt: timestamppb.New(partial.UpdatedAt),
+		})
+	}
+	return res, nil
+}
+
+func (s *GRPCServer) UpsertTemplatePartial(_ context.Context, req *sirenv1beta1.UpsertTemplatePartialRequest) (*sirenv1beta1.TemplatePartial, error) {
+	partial, err := s.container.TemplatesService.UpsertPartial(&domain.Partial{
+		Name: req.GetName(),
+		Body: req.GetBody(),
+	})
+	if err != nil {
+		return nil, s.templateError(err)
+	}
+	return &sirenv1beta1.TemplatePartial{
+		Id:        uint64(partial.ID),
+		Name:      partial.Name,
+		Body:      partial.Body,
+		CreatedAt: timestamppb.New(partial.CreatedAt),
+		UpdatedAt: timestamppb.New(partial.UpdatedAt),
+	}, nil
+}
+
+func (s *GRPCServer) DeleteTemplatePartial(_ context.Context, req *sirenv1beta1.DeleteTemplatePartialRequest) (*emptypb.Empty, error) {
+	err := s.container.TemplatesService.DeletePartial(req.GetName())
+	if err != nil {
+		return nil, helper.GRPCLogError(s.logger, codes.Internal, err)
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (s *GRPCServer) templateError(err error) error {
+	var invalidVariableErr *domain.InvalidVariableError
+	var invalidPartialErr *domain.InvalidPartialError
+	var invalidFilterErr *domain.InvalidTemplateFilterError
+	if errors.As(err, &invalidVariableErr) || errors.As(err, &invalidPartialErr) || errors.As(err, &invalidFilterErr) {
+		return helper.GRPCLogError(s.logger, codes.InvalidArgument, err)
+	}
+	var templateInUseErr *domain.TemplateInUseError
+	if errors.As(err, &templateInUseErr) {
+		return helper.GRPCLogError(s.logger, codes.FailedPrecondition, err)
+	}
+	return helper.GRPCLogError(s.logger, codes.Internal, err)
+}
@@ -9,7 +9,10 @@ import (
 	"github.com/odpf/siren/service"
 	"github.com/stretchr/testify/assert"
 	"go.uber.org/zap/zaptest"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
 	"testing"
+	"time"
 )
 
 func TestGRPCServer_ListTemplates(t *testing.T) {
@@ -40,8 +43,8 @@ func TestGRPCServer_ListTemplates(t *testing.T) {
 		}
 
 		mockedTemplatesService.
-			On("Index", "").
-			Return(dummyResult, nil).Once()
+			On("List", &domain.TemplateFilter{}).
+			Return(&domain.TemplatePage{Templates: dummyResult}, nil).Once()
 		res, err := dummyGRPCServer.ListTemplates(context.Background(), dummyReq)
 		assert.Nil(t, err)
 		assert.Equal(t, 1, len(res.GetTemplates()))
@@ -81,8 +84,8 @@ func TestGRPCServer_ListTemplates(t *testing.T) {
 		}
 
 		mockedTemplatesService.
-			On("Index", "foo").
-			Return(dummyResult, nil).Once()
+			On("List", &domain.TemplateFilter{Tags: []string{"foo"}}).
+			Return(&domain.TemplatePage{Templates: dummyResult}, nil).Once()
 		res, err := dummyGRPCServer.ListTemplates(context.Background(), dummyReq)
 		assert.Nil(t, err)
 		assert.Equal(t, 1, len(res.GetTemplates()))
@@ -104,12 +107,73 @@ func TestGRPCServer_ListTemplates(t *testing.T) {
 			Tag: "foo",
 		}
 		mockedTemplatesService.
-			On("Index", "foo").
+			On("List", &domain.TemplateFilter{Tags: []string{"foo"}}).
 			Return(nil, errors.New("random error")).Once()
 		res, err := dummyGRPCServer.ListTemplates(context.Background(), dummyReq)
 		assert.Nil(t, res)
 		assert.EqualError(t, err, "rpc error: code = Internal desc = random error")
 	})
+
+	t.Run("should search, sort and page templates", func(t *testing.T) {
+		mockedTemplatesService := &mocks.TemplatesService{}
+		dummyGRPCServer := GRPCServer{
+			container: &service.Container{
+				TemplatesService: mockedTemplatesService,
+			},
+			logger: zaptest.NewLogger(t),
+		}
+		updatedSince := time.Date(2021, 10, 1, 0, 0, 0, 0, time.UTC)
+		dummyReq := &sirenv1beta1.ListTemplatesRequest{
+			Tags:         []string{"foo", "bar"},
+			MatchAllTags: true,
+			NamePrefix:   "cpu",
+			NameContains: "high",
+			UpdatedSince: timestamppb.New(updatedSince),
+			PageSize:     10,
+			PageToken:    "MTA",
+			SortBy:       "updated_at",
+			SortOrder:    "desc",
+		}
+		dummyFilter := &domain.TemplateFilter{
+			Tags:         []string{"foo", "bar"},
+			MatchAllTags: true,
+			NamePrefix:   "cpu",
+			NameContains: "high",
+			UpdatedSince: updatedSince,
+			SortBy:       "updated_at",
+			SortOrder:    "desc",
+			PageSize:     10,
+			PageToken:    "MTA",
+		}
+		dummyPage := &domain.TemplatePage{
+			Templates:     []domain.Template{{ID: 1, Name: "cpu_high"}},
+			NextPageToken: "MjA",
+		}
+
+		mockedTemplatesService.On("List", dummyFilter).Return(dummyPage, nil).Once()
+		res, err := dummyGRPCServer.ListTemplates(context.Background(), dummyReq)
+		assert.Nil(t, err)
+		assert.Equal(t, 1, len(res.GetTemplates()))
+		assert.Equal(t, "cpu_high", res.GetTemplates()[0].GetName())
+		assert.Equal(t, "MjA", res.GetNextPageToken())
+	})
+
+	t.Run("should return error code 3 if the filter is invalid", func(t *testing.T) {
+		mockedTemplatesService := &mocks.TemplatesService{}
+		dummyGRPCServer := GRPCServer{
+			container: &service.Container{
+				TemplatesService: mockedTemplatesService,
+			},
+			logger: zaptest.NewLogger(t),
+		}
+		dummyReq := &sirenv1beta1.ListTemplatesRequest{PageToken: "foo"}
+		mockedTemplatesService.
+			On("List", &domain.TemplateFilter{PageToken: "foo"}).
+			Return(nil, &domain.InvalidTemplateFilterError{Reason: "malformed page token"}).Once()
+		res, err := dummyGRPCServer.ListTemplates(context.Background(), dummyReq)
+		assert.Nil(t, res)
+		assert.EqualError(t, err, "rpc error: code = InvalidArgument desc = invalid template filter: malformed page token")
+	})
 }
 
 func TestGRPCServer_GetTemplateByName(t *testing.T) {
@@ -171,6 +235,58 @@ func TestGRPCServer_GetTemplateByName(t *testing.T) {
 	})
 }
 
+func TestGRPCServer_ListTemplateVersions(t *testing.T) {
+	t.Run("should return versions of the template", func(t *testing.T) {
+		mockedTemplatesService := &mocks.TemplatesService{}
+		dummyGRPCServer := GRPCServer{
+			container: &service.Container{
+				TemplatesService: mockedTemplatesService,
+			},
+			logger: zaptest.NewLogger(t),
+		}
+		dummyResult := []domain.Template{
+			{
+				ID:        1,
+				Name:      "foo",
+				Body:      "baz",
+				Tags:      []string{"foo"},
+				Variables: []domain.Variable{{Name: "foo", Type: "bar"}},
+				Version:   2,
+			},
+			{
+				ID:        1,
+				Name:      "foo",
+				Body:      "bar",
+				Tags:      []string{"foo"},
+				Variables: []domain.Variable{{Name: "foo", Type: "bar"}},
+				Version:   1,
+			},
+		}
+		mockedTemplatesService.On("ListVersions", "foo").Return(dummyResult, nil).Once()
+		res, err := dummyGRPCServer.ListTemplateVersions(context.Background(), &sirenv1beta1.ListTemplateVersionsRequest{Name: "foo"})
+		assert.Nil(t, err)
+		assert.Equal(t, 2, len(res.GetVersions()))
+		assert.Equal(t, uint64(2), res.GetVersions()[0].GetVersion())
+		assert.Equal(t, "baz", res.GetVersions()[0].GetBody())
+		assert.Equal(t, uint64(1), res.GetVersions()[1].GetVersion())
+		assert.Equal(t, "foo", res.GetVersions()[1].GetVariables()[0].GetName())
+	})
+
+	t.Run("should return error code 13 if listing versions failed", func(t *testing.T) {
+		mockedTemplatesService := &mocks.TemplatesService{}
+		dummyGRPCServer := GRPCServer{
+			container: &service.Container{
+				TemplatesService: mockedTemplatesService,
+			},
+			logger: zaptest.NewLogger(t),
+		}
+		mockedTemplatesService.On("ListVersions", "foo").Return(nil, errors.New("template not found")).Once()
+		res, err := dummyGRPCServer.ListTemplateVersions(context.Background(), &sirenv1beta1.ListTemplateVersionsRequest{Name: "foo"})
+		assert.Nil(t, res)
+		assert.EqualError(t, err, "rpc error: code = Internal desc = template not found")
+	})
+}
+
 func TestGRPCServer_UpsertTemplate(t *testing.T) {
 	dummyReq := &sirenv1beta1.UpsertTemplateRequest{
 		Id:   1,
@@ -211,15 +327,76 @@ func TestGRPCServer_UpsertTemplate(t *testing.T) {
 		}
 
 		mockedTemplatesService.
-			On("Upsert", template).
-			Return(template, nil).Once()
+			On("Upsert", template, false).
+			Return(template, []domain.RuleGroupSync{}, nil).Once()
 		res, err := dummyGRPCServer.UpsertTemplate(context.Background(), dummyReq)
 		assert.Nil(t, err)
 		assert.Equal(t, uint64(1), res.GetTemplate().GetId())
 		assert.Equal(t, "foo", res.GetTemplate().GetName())
 		assert.Equal(t, "bar", res.GetTemplate().GetBody())
 		assert.Equal(t, "foo", res.GetTemplate().GetVariables()[0].GetName())
-		mockedTemplatesService.AssertCalled(t, "Upsert", template)
+		mockedTemplatesService.AssertCalled(t, "Upsert", template, false)
+	})
+
+	t.Run("should return the sync report of the rule groups using the template", func(t *testing.T) {
+		mockedTemplatesService := &mocks.TemplatesService{}
+		dummyGRPCServer := GRPCServer{
+			container: &service.Container{
+				TemplatesService: mockedTemplatesService,
+			},
+			logger: zaptest.NewLogger(t),
+		}
+
+		mockedTemplatesService.
+			On("Upsert", template, false).
+			Return(template, []domain.RuleGroupSync{
+				{ProviderNamespace: 1, Namespace: "foo", GroupName: "bar", Success: true},
+				{ProviderNamespace: 2, Namespace: "foo", GroupName: "baz", Error: "random error"},
+			}, nil).Once()
+		res, err := dummyGRPCServer.UpsertTemplate(context.Background(), dummyReq)
+		assert.Nil(t, err)
+		assert.Equal(t, 2, len(res.GetRuleGroups()))
+		assert.Equal(t, "bar", res.GetRuleGroups()[0].GetGroupName())
+		assert.True(t, res.GetRuleGroups()[0].GetSuccess())
+		assert.Nil(t, res.GetRuleGroups()[0].GetDiff())
+		assert.Equal(t, uint64(2), res.GetRuleGroups()[1].GetProviderNamespace())
+		assert.False(t, res.GetRuleGroups()[1].GetSuccess())
+		assert.Equal(t, "random error", res.GetRuleGroups()[1].GetError())
+	})
+
+	t.Run("should return the diff of the rule groups in dry run", func(t *testing.T) {
+		mockedTemplatesService := &mocks.TemplatesService{}
+		dummyGRPCServer := GRPCServer{
+			container: &service.Container{
+				TemplatesService: mockedTemplatesService,
+			},
+			logger: zaptest.NewLogger(t),
+		}
+		dryRunReq := &sirenv1beta1.UpsertTemplateRequest{
+			Id:        dummyReq.Id,
+			Name:      dummyReq.Name,
+			Body:      dummyReq.Body,
+			Tags:      dummyReq.Tags,
+			Variables: dummyReq.Variables,
+			DryRun:    true,
+		}
+
+		mockedTemplatesService.
+			On("Upsert", template, true).
+			Return(template, []domain.RuleGroupSync{{
+				ProviderNamespace: 1, Namespace: "foo", GroupName: "bar", Success: true,
+				Diff: &domain.RuleGroupDiff{
+					Namespace: "foo",
+					GroupName: "bar",
+					Changed:   []domain.RuleDiff{{Name: "Test", Current: "expr: a\n", Desired: "expr: b\n"}},
+				},
+			}}, nil).Once()
+		res, err := dummyGRPCServer.UpsertTemplate(context.Background(), dryRunReq)
+		assert.Nil(t, err)
+		assert.Equal(t, "foo", res.GetTemplate().GetName())
+		assert.Equal(t, "Test", res.GetRuleGroups()[0].GetDiff().GetChanged()[0].GetName())
+		assert.Equal(t, "expr: b\n", res.GetRuleGroups()[0].GetDiff().GetChanged()[0].GetDesired())
+		mockedTemplatesService.AssertExpectations(t)
 	})
 
 	t.Run("should return error code 13 if upsert template failed", func(t *testing.T) {
@@ -231,12 +408,75 @@ func TestGRPCServer_UpsertTemplate(t *testing.T) {
 			logger: zaptest.NewLogger(t),
 		}
 		mockedTemplatesService.
-			On("Upsert", template).
-			Return(nil, errors.New("random error")).Once()
+			On("Upsert", template, false).
+			Return(nil, nil, errors.New("random error")).Once()
 		res, err := dummyGRPCServer.UpsertTemplate(context.Background(), dummyReq)
 		assert.Nil(t, res)
 		assert.EqualError(t, err, "rpc error: code = Internal desc = random error")
 	})
+
+	t.Run("should return error code 3 if a variable of the template is invalid", func(t *testing.T) {
+		mockedTemplatesService := &mocks.TemplatesService{}
+		dummyGRPCServer := GRPCServer{
+			container: &service.Container{
+				TemplatesService: mockedTemplatesService,
+			},
+			logger: zaptest.NewLogger(t),
+		}
+		mockedTemplatesService.
+			On("Upsert", template, false).
+			Return(nil, nil, &domain.InvalidVariableError{Name: "foo", Reason: `unknown type "bar"`}).Once()
+		res, err := dummyGRPCServer.UpsertTemplate(context.Background(), dummyReq)
+		assert.Nil(t, res)
+		assert.EqualError(t, err, `rpc error: code = InvalidArgument desc = invalid variable foo: unknown type "bar"`)
+	})
+}
+
+func TestGRPCServer_GetTemplateUsage(t *testing.T) {
+	t.Run("should return the rules using the template", func(t *testing.T) {
+		mockedRuleService := &mocks.RuleService{}
+		dummyGRPCServer := GRPCServer{
+			container: &service.Container{
+				RulesService: mockedRuleService,
+			},
+			logger: zaptest.NewLogger(t),
+		}
+		mockedRuleService.
+			On("TemplateUsage", "foo").
+			Return(&domain.TemplateUsage{
+				Template: "foo",
+				Rules: []domain.TemplateRuleUsage{{
+					Id: 1, Name: "siren_api_bar_baz_foo", Namespace: "baz", GroupName: "bar", Enabled: true,
+					ProviderNamespace: 1, NamespaceUrn: "odpf-prod", ProviderUrn: "cortex-prod",
+				}},
+				Namespaces: []string{"odpf-prod"},
+				Providers:  []string{"cortex-prod"},
+			}, nil).Once()
+		res, err := dummyGRPCServer.GetTemplateUsage(context.Background(), &sirenv1beta1.GetTemplateUsageRequest{Name: "foo"})
+		assert.Nil(t, err)
+		assert.Equal(t, "foo", res.GetTemplate())
+		assert.Equal(t, 1, len(res.GetRules()))
+		assert.Equal(t, "siren_api_bar_baz_foo", res.GetRules()[0].GetName())
+		assert.Equal(t, "odpf-prod", res.GetRules()[0].GetNamespaceUrn())
+		assert.Equal(t, []string{"odpf-prod"}, res.GetNamespaces())
+		assert.Equal(t, []string{"cortex-prod"}, res.GetProviders())
+	})
+
+	t.Run("should return error code 13 if getting the usage failed", func(t *testing.T) {
+		mockedRuleService := &mocks.RuleService{}
+		dummyGRPCServer := GRPCServer{
+			container: &service.Container{
+				RulesService: mockedRuleService,
+			},
+			logger: zaptest.NewLogger(t),
+		}
+		mockedRuleService.
+			On("TemplateUsage", "foo").
+			Return(nil, errors.New("random error")).Once()
+		res, err := dummyGRPCServer.GetTemplateUsage(context.Background(), &sirenv1beta1.GetTemplateUsageRequest{Name: "foo"})
+		assert.Nil(t, res)
+		assert.EqualError(t, err, "rpc error: code = Internal desc = random error")
+	})
 }
 
 func TestGRPCServer_DeleteTemplate(t *testing.T) {
@@ -253,12 +493,57 @@ func TestGRPCServer_DeleteTemplate(t *testing.T) {
 		}
 
 		mockedTemplatesService.
-			On("Delete", "foo").
-			Return(nil).Once()
+			On("Delete", "foo", false).
+			Return([]domain.Rule{}, nil).Once()
+		res, err := dummyGRPCServer.DeleteTemplate(context.Background(), dummyReq)
+		assert.Nil(t, err)
+		assert.Equal(t, 0, len(res.GetRemovedRules()))
+		mockedTemplatesService.AssertCalled(t, "Delete", "foo", false)
+	})
+
+	t.Run("should return error code 9 if rules use the template", func(t *testing.T) {
+		mockedTemplatesService := &mocks.TemplatesService{}
+		dummyGRPCServer := GRPCServer{
+			container: &service.Container{
+				TemplatesService: mockedTemplatesService,
+			},
+			logger: zaptest.NewLogger(t),
+		}
+		dummyReq := &sirenv1beta1.DeleteTemplateRequest{
+			Name: "foo",
+		}
+
+		mockedTemplatesService.
+			On("Delete", "foo", false).
+			Return(nil, &domain.TemplateInUseError{Name: "foo", Rules: 2}).Once()
+		res, err := dummyGRPCServer.DeleteTemplate(context.Background(), dummyReq)
+		assert.Nil(t, res)
+		assert.EqualError(t, err, "rpc error: code = FailedPrecondition desc = template foo is used by 2 rules, delete them or force the deletion")
+	})
+
+	t.Run("should return the rules deleted with the template if forced", func(t *testing.T) {
+		mockedTemplatesService := &mocks.TemplatesService{}
+		dummyGRPCServer := GRPCServer{
+			container: &service.Container{
+				TemplatesService: mockedTemplatesService,
+			},
+			logger: zaptest.NewLogger(t),
+		}
+		dummyReq := &sirenv1beta1.DeleteTemplateRequest{
+			Name:  "foo",
+			Force: true,
+		}
+
+		mockedTemplatesService.
+			On("Delete", "foo", true).
+			Return([]domain.Rule{
+				{Id: 1, Name: "siren_api_bar_baz_foo", Namespace: "baz", GroupName: "bar", Template: "foo", ProviderNamespace: 1},
+				{Id: 2, Name: "siren_api_qux_baz_foo", Namespace: "baz", GroupName: "qux", Template: "foo", ProviderNamespace: 1},
+			}, nil).Once()
 		res, err := dummyGRPCServer.DeleteTemplate(context.Background(), dummyReq)
 		assert.Nil(t, err)
-		assert.Equal(t, &sirenv1beta1.DeleteTemplateResponse{}, res)
-		mockedTemplatesService.AssertCalled(t, "Delete", "foo")
+		assert.Equal(t, 2, len(res.GetRemovedRules()))
+		assert.Equal(t, "siren_api_qux_baz_foo", res.GetRemovedRules()[1].GetName())
 	})
 
 	t.Run("should return error code 13 if deleting template failed", func(t *testing.T) {
@@ -273,8 +558,8 @@ func TestGRPCServer_DeleteTemplate(t *testing.T) {
 			Name: "foo",
 		}
 		mockedTemplatesService.
-			On("Delete", "foo").
-			Return(errors.New("random error")).Once()
+			On("Delete", "foo", false).
+			Return(nil, errors.New("random error")).Once()
 		res, err := dummyGRPCServer.DeleteTemplate(context.Background(), dummyReq)
 		assert.Nil(t, res)
 		assert.EqualError(t, err, "rpc error: code = Internal desc = random error")
@@ -322,4 +607,147 @@ func TestGRPCServer_RenderTemplate(t *testing.T) {
 		assert.Empty(t, res)
 		assert.EqualError(t, err, "rpc error: code = Internal desc = random error")
 	})
+
+	t.Run("should return error code 3 if a variable has an invalid value", func(t *testing.T) {
+		mockedTemplatesService := &mocks.TemplatesService{}
+		dummyGRPCServer := GRPCServer{
+			container: &service.Container{
+				TemplatesService: mockedTemplatesService,
+			},
+			logger: zaptest.NewLogger(t),
+		}
+		mockedTemplatesService.
+			On("Render", "foo", dummyReq.GetVariables()).
+			Return("", &domain.InvalidVariableError{Name: "threshold", Reason: `"abc" is not an int`}).Once()
+		res, err := dummyGRPCServer.RenderTemplate(context.Background(), dummyReq)
+		assert.Nil(t, res)
+		assert.EqualError(t, err, `rpc error: code = InvalidArgument desc = invalid variable threshold: "abc" is not an int`)
+	})
+}
+
+func TestGRPCServer_ListTemplatePartials(t *testing.T) {
+	t.Run("should return the partials", func(t *testing.T) {
+		mockedTemplatesService := &mocks.TemplatesService{}
+		dummyGRPCServer := GRPCServer{
+			container: &service.Container{
+				TemplatesService: mockedTemplatesService,
+			},
+			logger: zaptest.NewLogger(t),
+		}
+		mockedTemplatesService.
+			On("ListPartials").
+			Return([]domain.Partial{{ID: 1, Name: "labels", Body: "team: odpf"}}, nil).Once()
+		res, err := dummyGRPCServer.ListTemplatePartials(context.Background(), &emptypb.Empty{})
+		assert.Nil(t, err)
+		assert.Equal(t, 1, len(res.GetPartials()))
+		assert.Equal(t, "labels", res.GetPartials()[0].GetName())
+		assert.Equal(t, "team: odpf", res.GetPartials()[0].GetBody())
+	})
+
+	t.Run("should return error code 13 if listing the partials failed", func(t *testing.T) {
+		mockedTemplatesService := &mocks.TemplatesService{}
+		dummyGRPCServer := GRPCServer{
+			container: &service.Container{
+				TemplatesService: mockedTemplatesService,
+			},
+			logger: zaptest.NewLogger(t),
+		}
+		mockedTemplatesService.
+			On("ListPartials").
+			Return(nil, errors.New("random error")).Once()
+		res, err := dummyGRPCServer.ListTemplatePartials(context.Background(), &emptypb.Empty{})
+		assert.Nil(t, res)
+		assert.EqualError(t, err, "rpc error: code = Internal desc = random error")
+	})
+}
+
+func TestGRPCServer_UpsertTemplatePartial(t *testing.T) {
+	dummyReq := &sirenv1beta1.UpsertTemplatePartialRequest{
+		Name: "labels",
+		Body: "team: odpf",
+	}
+	partial := &domain.Partial{Name: "labels", Body: "team: odpf"}
+
+	t.Run("should upsert the partial", func(t *testing.T) {
+		mockedTemplatesService := &mocks.TemplatesService{}
+		dummyGRPCServer := GRPCServer{
+			container: &service.Container{
+				TemplatesService: mockedTemplatesService,
+			},
+			logger: zaptest.NewLogger(t),
+		}
+		mockedTemplatesService.
+			On("UpsertPartial", partial).
+			Return(&domain.Partial{ID: 1, Name: "labels", Body: "team: odpf"}, nil).Once()
+		res, err := dummyGRPCServer.UpsertTemplatePartial(context.Background(), dummyReq)
+		assert.Nil(t, err)
+		assert.Equal(t, uint64(1), res.GetId())
+		assert.Equal(t, "labels", res.GetName())
+	})
+
+	t.Run("should return error code 3 if the partial includes itself", func(t *testing.T) {
+		mockedTemplatesService := &mocks.TemplatesService{}
+		dummyGRPCServer := GRPCServer{
+			container: &service.Container{
+				TemplatesService: mockedTemplatesService,
+			},
+			logger: zaptest.NewLogger(t),
+		}
+		mockedTemplatesService.
+			On("UpsertPartial", partial).
+			Return(nil, &domain.InvalidPartialError{Name: "labels", Reason: "circular include labels -> team -> labels"}).Once()
+		res, err := dummyGRPCServer.UpsertTemplatePartial(context.Background(), dummyReq)
+		assert.Nil(t, res)
+		assert.EqualError(t, err, "rpc error: code = InvalidArgument desc = invalid partial labels: circular include labels -> team -> labels")
+	})
+
+	t.Run("should return error code 13 if upserting the partial failed", func(t *testing.T) {
+		mockedTemplatesService := &mocks.TemplatesService{}
+		dummyGRPCServer := GRPCServer{
+			container: &service.Container{
+				TemplatesService: mockedTemplatesService,
+			},
+			logger: zaptest.NewLogger(t),
+		}
+		mockedTemplatesService.
+			On("UpsertPartial", partial).
+			Return(nil, errors.New("random error")).Once()
+		res, err := dummyGRPCServer.UpsertTemplatePartial(context.Background(), dummyReq)
+		assert.Nil(t, res)
+		assert.EqualError(t, err, "rpc error: code = Internal desc = random error")
+	})
+}
+
+func TestGRPCServer_DeleteTemplatePartial(t *testing.T) {
+	t.Run("should delete the partial", func(t *testing.T) {
+		mockedTemplatesService := &mocks.TemplatesService{}
+		dummyGRPCServer := GRPCServer{
+			container: &service.Container{
+				TemplatesService: mockedTemplatesService,
+			},
+			logger: zaptest.NewLogger(t),
+		}
+		mockedTemplatesService.
+			On("DeletePartial", "labels").
+			Return(nil).Once()
+		res, err := dummyGRPCServer.DeleteTemplatePartial(context.Background(), &sirenv1beta1.DeleteTemplatePartialRequest{Name: "labels"})
+		assert.Nil(t, err)
+		assert.Equal(t, &emptypb.Empty{}, res)
+	})
+
+	t.Run("should return error code 13 if deleting the partial failed", func(t *testing.T) {
+		mockedTemplatesService := &mocks.TemplatesService{}
+		dummyGRPCServer := GRPCServer{
+			container: &service.Container{
+				TemplatesService: mockedTemplatesService,
+			},
+			logger: zaptest.NewLogger(t),
+		}
+		mockedTemplatesService.
+			On("DeletePartial", "labels").
+			Return(errors.New("random error")).Once()
+		res, err := dummyGRPCServer.DeleteTemplatePartial(context.Background(), &sirenv1beta1.DeleteTemplatePartialRequest{Name: "labels"})
+		assert.Nil(t, res)
+		assert.EqualError(t, err, "rpc error: code = Internal desc = random error")
+	})
 }
@@ -0,0 +1,143 @@
+package v1
+
+import (
+	"context"
+	"errors"
+	sirenv1beta1 "github.com/odpf/siren/api/proto/odpf/siren/v1beta1"
+	"github.com/odpf/siren/domain"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"strings"
+)
+
+func (s *GRPCServer) ListTimeIntervals(_ context.Context, req *sirenv1beta1.ListTimeIntervalsRequest) (*sirenv1beta1.ListTimeIntervalsResponse, error) {
+	timeIntervals, err := s.container.TimeIntervalService.ListTimeIntervals(req.GetNamespace())
+	if err != nil {
+		s.logger.Error("handler", zap.Error(err))
+		return nil, status.Errorf(codes.Internal, err.Error())
+	}
+
+	res := &sirenv1beta1.ListTimeIntervalsResponse{
+		TimeIntervals: make([]*sirenv1beta1.TimeInterval, 0),
+	}
+	for _, timeInterval := range timeIntervals {
+		res.TimeIntervals = append(res.TimeIntervals, getTimeIntervalFromDomainObject(timeInterval))
+	}
+	return res, nil
+}
+
+func (s *GRPCServer) CreateTimeInterval(_ context.Context, req *sirenv1beta1.CreateTimeIntervalRequest) (*sirenv1beta1.TimeInterval, error) {
+	timeInterval, err := s.container.TimeIntervalService.CreateTimeInterval(&domain.TimeInterval{
+		Urn:           req.GetUrn(),
+		Namespace:     req.GetNamespace(),
+		Periods:       getTimePeriodsInDomainObject(req.GetPeriods()),
+		MuteNamespace: req.GetMuteNamespace(),
+	})
+	if err != nil {
+		return nil, s.timeIntervalError(err)
+	}
+
+	return getTimeIntervalFromDomainObject(timeInterval), nil
+}
+
+func (s *GRPCServer) GetTimeInterval(_ context.Context, req *sirenv1beta1.GetTimeIntervalRequest) (*sirenv1beta1.TimeInterval, error) {
+	timeInterval, err := s.container.TimeIntervalService.GetTimeInterval(req.GetId())
+	if err != nil {
+		s.logger.Error("handler", zap.Error(err))
+		return nil, status.Errorf(codes.Internal, err.Error())
+	}
+	if timeInterval == nil {
+		return nil, status.Errorf(codes.NotFound, "time interval not found")
+	}
+
+	return getTimeIntervalFromDomainObject(timeInterval), nil
+}
+
+func (s *GRPCServer) UpdateTimeInterval(_ context.Context, req *sirenv1beta1.UpdateTimeIntervalRequest) (*sirenv1beta1.TimeInterval, error) {
+	timeInterval, err := s.container.TimeIntervalService.UpdateTimeInterval(&domain.TimeInterval{
+		Id:            req.GetId(),
+		Urn:           req.GetUrn(),
+		Namespace:     req.GetNamespace(),
+		Periods:       getTimePeriodsInDomainObject(req.GetPeriods()),
+		MuteNamespace: req.GetMuteNamespace(),
+	})
+	if err != nil {
+		return nil, s.timeIntervalError(err)
+	}
+
+	return getTimeIntervalFromDomainObject(timeInterval), nil
+}
+
+func (s *GRPCServer) DeleteTimeInterval(_ context.Context, req *sirenv1beta1.DeleteTimeIntervalRequest) (*emptypb.Empty, error) {
+	err := s.container.TimeIntervalService.DeleteTimeInterval(req.GetId())
+	if err != nil {
+		return nil, s.timeIntervalError(err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+// timeIntervalError maps invalid time intervals, including the ones still
+// referred to by subscriptions, and duplicate urns to invalid argument errors
+func (s *GRPCServer) timeIntervalError(err error) error {
+	var invalidTimeIntervalErr *domain.InvalidTimeIntervalError
+	if errors.As(err, &invalidTimeIntervalErr) {
+		return status.Errorf(codes.InvalidArgument, err.Error())
+	}
+	if strings.Contains(err.Error(), `violates unique constraint "time_interval_urn_namespace_id_unique"`) {
+		return status.Errorf(codes.InvalidArgument, "urn and namespace pair already exist")
+	}
+	s.logger.Error("handler", zap.Error(err))
+	return status.Errorf(codes.Internal, err.Error())
+}
+
+func getTimeIntervalFromDomainObject(timeInterval *domain.TimeInterval) *sirenv1beta1.TimeInterval {
+	return &sirenv1beta1.TimeInterval{
+		Id:            timeInterval.Id,
+		Urn:           timeInterval.Urn,
+		Namespace:     timeInterval.Namespace,
+		Periods:       getTimePeriodsFromDomainObject(timeInterval.Periods),
+		MuteNamespace: timeInterval.MuteNamespace,
+		CreatedAt:     timestamppb.New(timeInterval.CreatedAt),
+		UpdatedAt:     timestamppb.New(timeInterval.UpdatedAt),
+	}
+}
+
+func getTimePeriodsFromDomainObject(domainPeriods []domain.TimePeriod) []*sirenv1beta1.TimePeriod {
+	periods := make([]*sirenv1beta1.TimePeriod, 0, len(domainPeriods))
+	for _, period := range domainPeriods {
+		times := make([]*sirenv1beta1.TimeRange, 0, len(period.Times))
+		for _, timeRange := range period.Times {
+			times = append(times, &sirenv1beta1.TimeRange{StartTime: timeRange.StartTime, EndTime: timeRange.EndTime})
+		}
+		periods = append(periods, &sirenv1beta1.TimePeriod{
+			Times:       times,
+			Weekdays:    period.Weekdays,
+			DaysOfMonth: period.DaysOfMonth,
+			Months:      period.Months,
+			Years:       period.Years,
+		})
+	}
+	return periods
+}
+
+func getTimePeriodsInDomainObject(periods []*sirenv1beta1.TimePeriod) []domain.TimePeriod {
+	var domainPeriods []domain.TimePeriod
+	for _, period := range periods {
+		var times []domain.TimeRange
+		for _, timeRange := range period.GetTimes() {
+			times = append(times, domain.TimeRange{StartTime: timeRange.GetStartTime(), EndTime: timeRange.GetEndTime()})
+		}
+		domainPeriods = append(domainPeriods, domain.TimePeriod{
+			Times:       times,
+			Weekdays:    period.GetWeekdays(),
+			DaysOfMonth: period.GetDaysOfMonth(),
+			Months:      period.GetMonths(),
+			Years:       period.GetYears(),
+		})
+	}
+	return domainPeriods
+}
@@ -0,0 +1,303 @@
+package v1
+
+import (
+	"context"
+	"errors"
+	sirenv1beta1 "github.com/odpf/siren/api/proto/odpf/siren/v1beta1"
+	"github.com/odpf/siren/domain"
+	"github.com/odpf/siren/mocks"
+	"github.com/odpf/siren/service"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"go.uber.org/zap/zaptest"
+	"testing"
+	"time"
+)
+
+func TestGRPCServer_ListTimeIntervals(t *testing.T) {
+	t.Run("should return time intervals of the namespace", func(t *testing.T) {
+		mockedTimeIntervalService := &mocks.TimeIntervalService{}
+		dummyGRPCServer := GRPCServer{
+			container: &service.Container{
+				TimeIntervalService: mockedTimeIntervalService,
+			},
+			logger: zaptest.NewLogger(t),
+		}
+		dummyResult := []*domain.TimeInterval{
+			{
+				Id:        1,
+				Urn:       "business-hours",
+				Namespace: 1,
+				Periods: []domain.TimePeriod{{
+					Times:    []domain.TimeRange{{StartTime: "09:00", EndTime: "17:00"}},
+					Weekdays: []string{"monday:friday"},
+				}},
+				CreatedAt: time.Now(),
+				UpdatedAt: time.Now(),
+			},
+		}
+
+		mockedTimeIntervalService.On("ListTimeIntervals", uint64(1)).Return(dummyResult, nil).Once()
+		res, err := dummyGRPCServer.ListTimeIntervals(context.Background(),
+			&sirenv1beta1.ListTimeIntervalsRequest{Namespace: 1})
+		assert.Nil(t, err)
+		assert.Equal(t, 1, len(res.GetTimeIntervals()))
+		assert.Equal(t, "business-hours", res.GetTimeIntervals()[0].GetUrn())
+		period := res.GetTimeIntervals()[0].GetPeriods()[0]
+		assert.Equal(t, "17:00", period.GetTimes()[0].GetEndTime())
+		assert.Equal(t, []string{"monday:friday"}, period.GetWeekdays())
+	})
+
+	t.Run("should return error code 13 if getting time intervals fails", func(t *testing.T) {
+		mockedTimeIntervalService := &mocks.TimeIntervalService{}
+		dummyGRPCServer := GRPCServer{
+			container: &service.Container{
+				TimeIntervalService: mockedTimeIntervalService,
+			},
+			logger: zaptest.NewLogger(t),
+		}
+		mockedTimeIntervalService.On("ListTimeIntervals", uint64(0)).
+			Return(nil, errors.New("random error")).Once()
+		res, err := dummyGRPCServer.ListTimeIntervals(context.Background(), &sirenv1beta1.ListTimeIntervalsRequest{})
+		assert.Nil(t, res)
+		assert.EqualError(t, err, "rpc error: code = Internal desc = random error")
+	})
+}
+
+func TestGRPCServer_CreateTimeInterval(t *testing.T) {
+	payload := &sirenv1beta1.CreateTimeIntervalRequest{
+		Urn:       "weekends",
+		Namespace: 1,
+		Periods: []*sirenv1beta1.TimePeriod{{
+			Times:    []*sirenv1beta1.TimeRange{{StartTime: "00:00", EndTime: "24:00"}},
+			Weekdays: []string{"saturday", "sunday"},
+		}},
+		MuteNamespace: true,
+	}
+	timeInterval := &domain.TimeInterval{
+		Urn:       "weekends",
+		Namespace: 1,
+		Periods: []domain.TimePeriod{{
+			Times:    []domain.TimeRange{{StartTime: "00:00", EndTime: "24:00"}},
+			Weekdays: []string{"saturday", "sunday"},
+		}},
+		MuteNamespace: true,
+	}
+
+	t.Run("should create a time interval", func(t *testing.T) {
+		mockedTimeIntervalService := &mocks.TimeIntervalService{}
+		dummyGRPCServer := GRPCServer{
+			container: &service.Container{
+				TimeIntervalService: mockedTimeIntervalService,
+			},
+			logger: zaptest.NewLogger(t),
+		}
+		created := *timeInterval
+		created.Id = 1
+		mockedTimeIntervalService.On("CreateTimeInterval", timeInterval).Return(&created, nil).Once()
+		res, err := dummyGRPCServer.CreateTimeInterval(context.Background(), payload)
+		assert.Nil(t, err)
+		assert.Equal(t, uint64(1), res.GetId())
+		assert.True(t, res.GetMuteNamespace())
+		assert.Equal(t, []string{"saturday", "sunday"}, res.GetPeriods()[0].GetWeekdays())
+		mockedTimeIntervalService.AssertExpectations(t)
+	})
+
+	t.Run("should return error code 3 if the time interval is invalid", func(t *testing.T) {
+		mockedTimeIntervalService := &mocks.TimeIntervalService{}
+		dummyGRPCServer := GRPCServer{
+			container: &service.Container{
+				TimeIntervalService: mockedTimeIntervalService,
+			},
+			logger: zaptest.NewLogger(t),
+		}
+		mockedTimeIntervalService.On("CreateTimeInterval", mock.Anything).
+			Return(nil, &domain.InvalidTimeIntervalError{Reason: "periods cannot be empty"}).Once()
+		res, err := dummyGRPCServer.CreateTimeInterval(context.Background(), &sirenv1beta1.CreateTimeIntervalRequest{})
+		assert.Nil(t, res)
+		assert.EqualError(t, err, "rpc error: code = InvalidArgument desc = invalid time interval: periods cannot be empty")
+	})
+
+	t.Run("should return error code 3 if urn and namespace pair already exist", func(t *testing.T) {
+		mockedTimeIntervalService := &mocks.TimeIntervalService{}
+		dummyGRPCServer := GRPCServer{
+			container: &service.Container{
+				TimeIntervalService: mockedTimeIntervalService,
+			},
+			logger: zaptest.NewLogger(t),
+		}
+		mockedTimeIntervalService.On("CreateTimeInterval", timeInterval).
+			Return(nil, errors.New(`violates unique constraint "time_interval_urn_namespace_id_unique"`)).Once()
+		res, err := dummyGRPCServer.CreateTimeInterval(context.Background(), payload)
+		assert.Nil(t, res)
+		assert.EqualError(t, err, "rpc error: code = InvalidArgument desc = urn and namespace pair already exist")
+	})
+
+	t.Run("should return error code 13 if creating time interval fails", func(t *testing.T) {
+		mockedTimeIntervalService := &mocks.TimeIntervalService{}
+		dummyGRPCServer := GRPCServer{
+			container: &service.Container{
+				TimeIntervalService: mockedTimeIntervalService,
+			},
+			logger: zaptest.NewLogger(t),
+		}
+		mockedTimeIntervalService.On("CreateTimeInterval", timeInterval).
+			Return(nil, errors.New("random error")).Once()
+		res, err := dummyGRPCServer.CreateTimeInterval(context.Background(), payload)
+		assert.Nil(t, res)
+		assert.EqualError(t, err, "rpc error: code = Internal desc = random error")
+	})
+}
+
+func TestGRPCServer_GetTimeInterval(t *testing.T) {
+	t.Run("should return a time interval", func(t *testing.T) {
+		mockedTimeIntervalService := &mocks.TimeIntervalService{}
+		dummyGRPCServer := GRPCServer{
+			container: &service.Container{
+				TimeIntervalService: mockedTimeIntervalService,
+			},
+			logger: zaptest.NewLogger(t),
+		}
+		mockedTimeIntervalService.On("GetTimeInterval", uint64(1)).
+			Return(&domain.TimeInterval{Id: 1, Urn: "weekends", Namespace: 1}, nil).Once()
+		res, err := dummyGRPCServer.GetTimeInterval(context.Background(), &sirenv1beta1.GetTimeIntervalRequest{Id: 1})
+		assert.Nil(t, err)
+		assert.Equal(t, uint64(1), res.GetId())
+		assert.Equal(t, "weekends", res.GetUrn())
+	})
+
+	t.Run("should return error code 5 if time interval not found", func(t *testing.T) {
+		mockedTimeIntervalService := &mocks.TimeIntervalService{}
+		dummyGRPCServer := GRPCServer{
+			container: &service.Container{
+				TimeIntervalService: mockedTimeIntervalService,
+			},
+			logger: zaptest.NewLogger(t),
+		}
+		mockedTimeIntervalService.On("GetTimeInterval", uint64(1)).Return(nil, nil).Once()
+		res, err := dummyGRPCServer.GetTimeInterval(context.Background(), &sirenv1beta1.GetTimeIntervalRequest{Id: 1})
+		assert.Nil(t, res)
+		assert.EqualError(t, err, "rpc error: code = NotFound desc = time interval not found")
+	})
+
+	t.Run("should return error code 13 if getting time interval fails", func(t *testing.T) {
+		mockedTimeIntervalService := &mocks.TimeIntervalService{}
+		dummyGRPCServer := GRPCServer{
+			container: &service.Container{
+				TimeIntervalService: mockedTimeIntervalService,
+			},
+			logger: zaptest.NewLogger(t),
+		}
+		mockedTimeIntervalService.On("GetTimeInterval", uint64(1)).Return(nil, errors.New("random error")).Once()
+		res, err := dummyGRPCServer.GetTimeInterval(context.Background(), &sirenv1beta1.GetTimeIntervalRequest{Id: 1})
+		assert.Nil(t, res)
+		assert.EqualError(t, err, "rpc error: code = Internal desc = random error")
+	})
+}
+
+func TestGRPCServer_UpdateTimeInterval(t *testing.T) {
+	payload := &sirenv1beta1.UpdateTimeIntervalRequest{
+		Id:        1,
+		Urn:       "weekends",
+		Namespace: 1,
+		Periods:   []*sirenv1beta1.TimePeriod{{Weekdays: []string{"saturday", "sunday"}}},
+	}
+	timeInterval := &domain.TimeInterval{
+		Id:        1,
+		Urn:       "weekends",
+		Namespace: 1,
+		Periods:   []domain.TimePeriod{{Weekdays: []string{"saturday", "sunday"}}},
+	}
+
+	t.Run("should update a time interval", func(t *testing.T) {
+		mockedTimeIntervalService := &mocks.TimeIntervalService{}
+		dummyGRPCServer := GRPCServer{
+			container: &service.Container{
+				TimeIntervalService: mockedTimeIntervalService,
+			},
+			logger: zaptest.NewLogger(t),
+		}
+		mockedTimeIntervalService.On("UpdateTimeInterval", timeInterval).Return(timeInterval, nil).Once()
+		res, err := dummyGRPCServer.UpdateTimeInterval(context.Background(), payload)
+		assert.Nil(t, err)
+		assert.Equal(t, uint64(1), res.GetId())
+		mockedTimeIntervalService.AssertExpectations(t)
+	})
+
+	t.Run("should return error code 3 if a subscription of the namespace moved from still refers to it", func(t *testing.T) {
+		mockedTimeIntervalService := &mocks.TimeIntervalService{}
+		dummyGRPCServer := GRPCServer{
+			container: &service.Container{
+				TimeIntervalService: mockedTimeIntervalService,
+			},
+			logger: zaptest.NewLogger(t),
+		}
+		invalidTimeIntervalErr := &domain.InvalidTimeIntervalError{
+			Reason: "subscription foo refers to time interval weekends missing from namespace 2"}
+		mockedTimeIntervalService.On("UpdateTimeInterval", timeInterval).Return(nil, invalidTimeIntervalErr).Once()
+		res, err := dummyGRPCServer.UpdateTimeInterval(context.Background(), payload)
+		assert.Nil(t, res)
+		assert.EqualError(t, err, "rpc error: code = InvalidArgument desc = "+invalidTimeIntervalErr.Error())
+	})
+
+	t.Run("should return error code 13 if updating time interval fails", func(t *testing.T) {
+		mockedTimeIntervalService := &mocks.TimeIntervalService{}
+		dummyGRPCServer := GRPCServer{
+			container: &service.Container{
+				TimeIntervalService: mockedTimeIntervalService,
+			},
+			logger: zaptest.NewLogger(t),
+		}
+		mockedTimeIntervalService.On("UpdateTimeInterval", timeInterval).
+			Return(nil, errors.New("random error")).Once()
+		res, err := dummyGRPCServer.UpdateTimeInterval(context.Background(), payload)
+		assert.Nil(t, res)
+		assert.EqualError(t, err, "rpc error: code = Internal desc = random error")
+	})
+}
+
+func TestGRPCServer_DeleteTimeInterval(t *testing.T) {
+	t.Run("should delete a time interval", func(t *testing.T) {
+		mockedTimeIntervalService := &mocks.TimeIntervalService{}
+		dummyGRPCServer := GRPCServer{
+			container: &service.Container{
+				TimeIntervalService: mockedTimeIntervalService,
+			},
+			logger: zaptest.NewLogger(t),
+		}
+		mockedTimeIntervalService.On("DeleteTimeInterval", uint64(1)).Return(nil).Once()
+		res, err := dummyGRPCServer.DeleteTimeInterval(context.Background(), &sirenv1beta1.DeleteTimeIntervalRequest{Id: 1})
+		assert.Nil(t, err)
+		assert.NotNil(t, res)
+	})
+
+	t.Run("should return error code 3 if a subscription still refers to the time interval", func(t *testing.T) {
+		mockedTimeIntervalService := &mocks.TimeIntervalService{}
+		dummyGRPCServer := GRPCServer{
+			container: &service.Container{
+				TimeIntervalService: mockedTimeIntervalService,
+			},
+			logger: zaptest.NewLogger(t),
+		}
+		invalidTimeIntervalErr := &domain.InvalidTimeIntervalError{
+			Reason: "subscription foo refers to time interval weekends missing from namespace 1"}
+		mockedTimeIntervalService.On("DeleteTimeInterval", uint64(1)).Return(invalidTimeIntervalErr).Once()
+		res, err := dummyGRPCServer.DeleteTimeInterval(context.Background(), &sirenv1beta1.DeleteTimeIntervalRequest{Id: 1})
+		assert.Nil(t, res)
+		assert.EqualError(t, err, "rpc error: code = InvalidArgument desc = "+invalidTimeIntervalErr.Error())
+	})
+
+	t.Run("should return error code 13 if deleting time interval fails", func(t *testing.T) {
+		mockedTimeIntervalService := &mocks.TimeIntervalService{}
+		dummyGRPCServer := GRPCServer{
+			container: &service.Container{
+				TimeIntervalService: mockedTimeIntervalService,
+			},
+			logger: zaptest.NewLogger(t),
+		}
+		mockedTimeIntervalService.On("DeleteTimeInterval", uint64(1)).Return(errors.New("random error")).Once()
+		res, err := dummyGRPCServer.DeleteTimeInterval(context.Background(), &sirenv1beta1.DeleteTimeIntervalRequest{Id: 1})
+		assert.Nil(t, res)
+		assert.EqualError(t, err, "rpc error: code = Internal desc = random error")
+	})
+}
@@ -853,6 +853,14 @@ func (m *Namespace) Validate() error {
 		}
 	}
 
+	// no validation rules for GroupBy
+
+	// no validation rules for GroupWait
+
+	// no validation rules for GroupInterval
+
+	// no validation rules for RepeatInterval
+
 	return nil
 }
 
@@ -1048,6 +1056,14 @@ func (m *CreateNamespaceRequest) Validate() error {
 		}
 	}
 
+	// no validation rules for GroupBy
+
+	// no validation rules for GroupWait
+
+	// no validation rules for GroupInterval
+
+	// no validation rules for RepeatInterval
+
 	return nil
 }
 
@@ -1211,6 +1227,14 @@ func (m *UpdateNamespaceRequest) Validate() error {
 
 	// no validation rules for Labels
 
+	// no validation rules for GroupBy
+
+	// no validation rules for GroupWait
+
+	// no validation rules for GroupInterval
+
+	// no validation rules for RepeatInterval
+
 	return nil
 }
 
@@ -1410,6 +1434,95 @@ var _ interface {
 	ErrorName() string
 } = ReceiverMetadataValidationError{}
 
+// Validate checks the field values on Matcher with the rules defined in the
+// proto definition for this message. If any rules are violated, an error is returned.
+func (m *Matcher) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	if !_Matcher_Name_Pattern.MatchString(m.GetName()) {
+		return MatcherValidationError{
+			field:  "Name",
+			reason: "value does not match regex pattern \"^[a-zA-Z_][a-zA-Z0-9_]*$\"",
+		}
+	}
+
+	if _, ok := _Matcher_Operator_InLookup[m.GetOperator()]; !ok {
+		return MatcherValidationError{
+			field:  "Operator",
+			reason: "value must be in list [= != =~ !~]",
+		}
+	}
+
+	// no validation rules for Value
+
+	return nil
+}
+
+// MatcherValidationError is the validation error returned by Matcher.Validate
+// if the designated constraints aren't met.
+type MatcherValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MatcherValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MatcherValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MatcherValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MatcherValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MatcherValidationError) ErrorName() string { return "MatcherValidationError" }
+
+// Error satisfies the builtin error interface
+func (e MatcherValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMatcher.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MatcherValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MatcherValidationError{}
+
+var _Matcher_Name_Pattern = regexp.MustCompile("^[a-zA-Z_][a-zA-Z0-9_]*$")
+
+var _Matcher_Operator_InLookup = map[string]struct{}{
+	"=":  {},
+	"!=": {},
+	"=~": {},
+	"!~": {},
+}
+
 // Validate checks the field values on Subscription with the rules defined in
 // the proto definition for this message. If any rules are violated, an error
 // is returned.
@@ -1466,6 +1579,31 @@ func (m *Subscription) Validate() error {
 		}
 	}
 
+	for idx, item := range m.GetMatchers() {
+		_, _ = idx, item
+
+		if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return SubscriptionValidationError{
+					field:  fmt.Sprintf("Matchers[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for GroupBy
+
+	// no validation rules for GroupWait
+
+	// no validation rules for GroupInterval
+
+	// no validation rules for RepeatInterval
+
+	// no validation rules for MuteTimeIntervals
+
 	return nil
 }
 
@@ -1641,6 +1779,31 @@ func (m *CreateSubscriptionRequest) Validate() error {
 
 	// no validation rules for Match
 
+	for idx, item := range m.GetMatchers() {
+		_, _ = idx, item
+
+		if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return CreateSubscriptionRequestValidationError{
+					field:  fmt.Sprintf("Matchers[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for GroupBy
+
+	// no validation rules for GroupWait
+
+	// no validation rules for GroupInterval
+
+	// no validation rules for RepeatInterval
+
+	// no validation rules for MuteTimeIntervals
+
 	return nil
 }
 
@@ -1807,6 +1970,31 @@ func (m *UpdateSubscriptionRequest) Validate() error {
 
 	// no validation rules for Match
 
+	for idx, item := range m.GetMatchers() {
+		_, _ = idx, item
+
+		if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return UpdateSubscriptionRequestValidationError{
+					field:  fmt.Sprintf("Matchers[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for GroupBy
+
+	// no validation rules for GroupWait
+
+	// no validation rules for GroupInterval
+
+	// no validation rules for RepeatInterval
+
+	// no validation rules for MuteTimeIntervals
+
 	return nil
 }
 
@@ -1937,49 +2125,60 @@ var _ interface {
 	ErrorName() string
 } = DeleteSubscriptionRequestValidationError{}
 
-// Validate checks the field values on Receiver with the rules defined in the
-// proto definition for this message. If any rules are violated, an error is returned.
-func (m *Receiver) Validate() error {
+// Validate checks the field values on InhibitionRule with the rules defined in
+// the proto definition for this message. If any rules are violated, an error
+// is returned.
+func (m *InhibitionRule) Validate() error {
 	if m == nil {
 		return nil
 	}
 
 	// no validation rules for Id
 
-	// no validation rules for Name
-
-	if _, ok := _Receiver_Type_InLookup[m.GetType()]; !ok {
-		return ReceiverValidationError{
-			field:  "Type",
-			reason: "value must be in list [slack pagerduty http]",
+	if !_InhibitionRule_Urn_Pattern.MatchString(m.GetUrn()) {
+		return InhibitionRuleValidationError{
+			field:  "Urn",
+			reason: "value does not match regex pattern \"^[A-Za-z0-9_-]+$\"",
 		}
 	}
 
-	// no validation rules for Labels
+	// no validation rules for Namespace
 
-	if v, ok := interface{}(m.GetConfigurations()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return ReceiverValidationError{
-				field:  "Configurations",
-				reason: "embedded message failed validation",
-				cause:  err,
+	for idx, item := range m.GetSourceMatchers() {
+		_, _ = idx, item
+
+		if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return InhibitionRuleValidationError{
+					field:  fmt.Sprintf("SourceMatchers[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
 			}
 		}
+
 	}
 
-	if v, ok := interface{}(m.GetData()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return ReceiverValidationError{
-				field:  "Data",
-				reason: "embedded message failed validation",
-				cause:  err,
+	for idx, item := range m.GetTargetMatchers() {
+		_, _ = idx, item
+
+		if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return InhibitionRuleValidationError{
+					field:  fmt.Sprintf("TargetMatchers[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
 			}
 		}
+
 	}
 
+	// no validation rules for Equal
+
 	if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return ReceiverValidationError{
+			return InhibitionRuleValidationError{
 				field:  "CreatedAt",
 				reason: "embedded message failed validation",
 				cause:  err,
@@ -1989,7 +2188,7 @@ func (m *Receiver) Validate() error {
 
 	if v, ok := interface{}(m.GetUpdatedAt()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return ReceiverValidationError{
+			return InhibitionRuleValidationError{
 				field:  "UpdatedAt",
 				reason: "embedded message failed validation",
 				cause:  err,
@@ -2000,9 +2199,9 @@ func (m *Receiver) Validate() error {
 	return nil
 }
 
-// ReceiverValidationError is the validation error returned by
-// Receiver.Validate if the designated constraints aren't met.
-type ReceiverValidationError struct {
+// InhibitionRuleValidationError is the validation error returned by
+// InhibitionRule.Validate if the designated constraints aren't met.
+type InhibitionRuleValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2010,22 +2209,22 @@ type ReceiverValidationError struct {
 }
 
 // Field function returns field value.
-func (e ReceiverValidationError) Field() string { return e.field }
+func (e InhibitionRuleValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ReceiverValidationError) Reason() string { return e.reason }
+func (e InhibitionRuleValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ReceiverValidationError) Cause() error { return e.cause }
+func (e InhibitionRuleValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ReceiverValidationError) Key() bool { return e.key }
+func (e InhibitionRuleValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ReceiverValidationError) ErrorName() string { return "ReceiverValidationError" }
+func (e InhibitionRuleValidationError) ErrorName() string { return "InhibitionRuleValidationError" }
 
 // Error satisfies the builtin error interface
-func (e ReceiverValidationError) Error() string {
+func (e InhibitionRuleValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2037,14 +2236,14 @@ func (e ReceiverValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sReceiver.%s: %s%s",
+		"invalid %sInhibitionRule.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ReceiverValidationError{}
+var _ error = InhibitionRuleValidationError{}
 
 var _ interface {
 	Field() string
@@ -2052,43 +2251,26 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ReceiverValidationError{}
+} = InhibitionRuleValidationError{}
 
-var _Receiver_Type_InLookup = map[string]struct{}{
-	"slack":     {},
-	"pagerduty": {},
-	"http":      {},
-}
+var _InhibitionRule_Urn_Pattern = regexp.MustCompile("^[A-Za-z0-9_-]+$")
 
-// Validate checks the field values on ListReceiversResponse with the rules
-// defined in the proto definition for this message. If any rules are
+// Validate checks the field values on ListInhibitionRulesRequest with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, an error is returned.
-func (m *ListReceiversResponse) Validate() error {
+func (m *ListInhibitionRulesRequest) Validate() error {
 	if m == nil {
 		return nil
 	}
 
-	for idx, item := range m.GetReceivers() {
-		_, _ = idx, item
-
-		if v, ok := interface{}(item).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return ListReceiversResponseValidationError{
-					field:  fmt.Sprintf("Receivers[%v]", idx),
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
-		}
-
-	}
+	// no validation rules for Namespace
 
 	return nil
 }
 
-// ListReceiversResponseValidationError is the validation error returned by
-// ListReceiversResponse.Validate if the designated constraints aren't met.
-type ListReceiversResponseValidationError struct {
+// ListInhibitionRulesRequestValidationError is the validation error returned
+// by ListInhibitionRulesRequest.Validate if the designated constraints aren't met.
+type ListInhibitionRulesRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2096,24 +2278,24 @@ type ListReceiversResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e ListReceiversResponseValidationError) Field() string { return e.field }
+func (e ListInhibitionRulesRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ListReceiversResponseValidationError) Reason() string { return e.reason }
+func (e ListInhibitionRulesRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ListReceiversResponseValidationError) Cause() error { return e.cause }
+func (e ListInhibitionRulesRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ListReceiversResponseValidationError) Key() bool { return e.key }
+func (e ListInhibitionRulesRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ListReceiversResponseValidationError) ErrorName() string {
-	return "ListReceiversResponseValidationError"
+func (e ListInhibitionRulesRequestValidationError) ErrorName() string {
+	return "ListInhibitionRulesRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ListReceiversResponseValidationError) Error() string {
+func (e ListInhibitionRulesRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2125,14 +2307,14 @@ func (e ListReceiversResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sListReceiversResponse.%s: %s%s",
+		"invalid %sListInhibitionRulesRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ListReceiversResponseValidationError{}
+var _ error = ListInhibitionRulesRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -2140,48 +2322,37 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ListReceiversResponseValidationError{}
+} = ListInhibitionRulesRequestValidationError{}
 
-// Validate checks the field values on CreateReceiverRequest with the rules
-// defined in the proto definition for this message. If any rules are
+// Validate checks the field values on ListInhibitionRulesResponse with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, an error is returned.
-func (m *CreateReceiverRequest) Validate() error {
+func (m *ListInhibitionRulesResponse) Validate() error {
 	if m == nil {
 		return nil
 	}
 
-	if !_CreateReceiverRequest_Name_Pattern.MatchString(m.GetName()) {
-		return CreateReceiverRequestValidationError{
-			field:  "Name",
-			reason: "value does not match regex pattern \"^[A-Za-z0-9_.-]+$\"",
-		}
-	}
-
-	if _, ok := _CreateReceiverRequest_Type_InLookup[m.GetType()]; !ok {
-		return CreateReceiverRequestValidationError{
-			field:  "Type",
-			reason: "value must be in list [slack pagerduty http]",
-		}
-	}
-
-	// no validation rules for Labels
+	for idx, item := range m.GetInhibitionRules() {
+		_, _ = idx, item
 
-	if v, ok := interface{}(m.GetConfigurations()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return CreateReceiverRequestValidationError{
-				field:  "Configurations",
-				reason: "embedded message failed validation",
-				cause:  err,
+		if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListInhibitionRulesResponseValidationError{
+					field:  fmt.Sprintf("InhibitionRules[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
 			}
 		}
+
 	}
 
 	return nil
 }
 
-// CreateReceiverRequestValidationError is the validation error returned by
-// CreateReceiverRequest.Validate if the designated constraints aren't met.
-type CreateReceiverRequestValidationError struct {
+// ListInhibitionRulesResponseValidationError is the validation error returned
+// by ListInhibitionRulesResponse.Validate if the designated constraints aren't met.
+type ListInhibitionRulesResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2189,24 +2360,24 @@ type CreateReceiverRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e CreateReceiverRequestValidationError) Field() string { return e.field }
+func (e ListInhibitionRulesResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e CreateReceiverRequestValidationError) Reason() string { return e.reason }
+func (e ListInhibitionRulesResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e CreateReceiverRequestValidationError) Cause() error { return e.cause }
+func (e ListInhibitionRulesResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e CreateReceiverRequestValidationError) Key() bool { return e.key }
+func (e ListInhibitionRulesResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e CreateReceiverRequestValidationError) ErrorName() string {
-	return "CreateReceiverRequestValidationError"
+func (e ListInhibitionRulesResponseValidationError) ErrorName() string {
+	return "ListInhibitionRulesResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e CreateReceiverRequestValidationError) Error() string {
+func (e ListInhibitionRulesResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2218,14 +2389,14 @@ func (e CreateReceiverRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sCreateReceiverRequest.%s: %s%s",
+		"invalid %sListInhibitionRulesResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = CreateReceiverRequestValidationError{}
+var _ error = ListInhibitionRulesResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -2233,32 +2404,63 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = CreateReceiverRequestValidationError{}
-
-var _CreateReceiverRequest_Name_Pattern = regexp.MustCompile("^[A-Za-z0-9_.-]+$")
-
-var _CreateReceiverRequest_Type_InLookup = map[string]struct{}{
-	"slack":     {},
-	"pagerduty": {},
-	"http":      {},
-}
+} = ListInhibitionRulesResponseValidationError{}
 
-// Validate checks the field values on GetReceiverRequest with the rules
-// defined in the proto definition for this message. If any rules are
+// Validate checks the field values on CreateInhibitionRuleRequest with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, an error is returned.
-func (m *GetReceiverRequest) Validate() error {
+func (m *CreateInhibitionRuleRequest) Validate() error {
 	if m == nil {
 		return nil
 	}
 
-	// no validation rules for Id
+	if !_CreateInhibitionRuleRequest_Urn_Pattern.MatchString(m.GetUrn()) {
+		return CreateInhibitionRuleRequestValidationError{
+			field:  "Urn",
+			reason: "value does not match regex pattern \"^[A-Za-z0-9_-]+$\"",
+		}
+	}
+
+	// no validation rules for Namespace
+
+	for idx, item := range m.GetSourceMatchers() {
+		_, _ = idx, item
+
+		if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return CreateInhibitionRuleRequestValidationError{
+					field:  fmt.Sprintf("SourceMatchers[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	for idx, item := range m.GetTargetMatchers() {
+		_, _ = idx, item
+
+		if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return CreateInhibitionRuleRequestValidationError{
+					field:  fmt.Sprintf("TargetMatchers[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for Equal
 
 	return nil
 }
 
-// GetReceiverRequestValidationError is the validation error returned by
-// GetReceiverRequest.Validate if the designated constraints aren't met.
-type GetReceiverRequestValidationError struct {
+// CreateInhibitionRuleRequestValidationError is the validation error returned
+// by CreateInhibitionRuleRequest.Validate if the designated constraints aren't met.
+type CreateInhibitionRuleRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2266,24 +2468,24 @@ type GetReceiverRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetReceiverRequestValidationError) Field() string { return e.field }
+func (e CreateInhibitionRuleRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetReceiverRequestValidationError) Reason() string { return e.reason }
+func (e CreateInhibitionRuleRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetReceiverRequestValidationError) Cause() error { return e.cause }
+func (e CreateInhibitionRuleRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetReceiverRequestValidationError) Key() bool { return e.key }
+func (e CreateInhibitionRuleRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetReceiverRequestValidationError) ErrorName() string {
-	return "GetReceiverRequestValidationError"
+func (e CreateInhibitionRuleRequestValidationError) ErrorName() string {
+	return "CreateInhibitionRuleRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GetReceiverRequestValidationError) Error() string {
+func (e CreateInhibitionRuleRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2295,14 +2497,14 @@ func (e GetReceiverRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetReceiverRequest.%s: %s%s",
+		"invalid %sCreateInhibitionRuleRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetReceiverRequestValidationError{}
+var _ error = CreateInhibitionRuleRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -2310,50 +2512,26 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetReceiverRequestValidationError{}
+} = CreateInhibitionRuleRequestValidationError{}
 
-// Validate checks the field values on UpdateReceiverRequest with the rules
+var _CreateInhibitionRuleRequest_Urn_Pattern = regexp.MustCompile("^[A-Za-z0-9_-]+$")
+
+// Validate checks the field values on GetInhibitionRuleRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, an error is returned.
-func (m *UpdateReceiverRequest) Validate() error {
+func (m *GetInhibitionRuleRequest) Validate() error {
 	if m == nil {
 		return nil
 	}
 
 	// no validation rules for Id
 
-	if !_UpdateReceiverRequest_Name_Pattern.MatchString(m.GetName()) {
-		return UpdateReceiverRequestValidationError{
-			field:  "Name",
-			reason: "value does not match regex pattern \"^[A-Za-z0-9_.-]+$\"",
-		}
-	}
-
-	if _, ok := _UpdateReceiverRequest_Type_InLookup[m.GetType()]; !ok {
-		return UpdateReceiverRequestValidationError{
-			field:  "Type",
-			reason: "value must be in list [slack pagerduty http]",
-		}
-	}
-
-	// no validation rules for Labels
-
-	if v, ok := interface{}(m.GetConfigurations()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return UpdateReceiverRequestValidationError{
-				field:  "Configurations",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
-
 	return nil
 }
 
-// UpdateReceiverRequestValidationError is the validation error returned by
-// UpdateReceiverRequest.Validate if the designated constraints aren't met.
-type UpdateReceiverRequestValidationError struct {
+// GetInhibitionRuleRequestValidationError is the validation error returned by
+// GetInhibitionRuleRequest.Validate if the designated constraints aren't met.
+type GetInhibitionRuleRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2361,24 +2539,24 @@ type UpdateReceiverRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e UpdateReceiverRequestValidationError) Field() string { return e.field }
+func (e GetInhibitionRuleRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e UpdateReceiverRequestValidationError) Reason() string { return e.reason }
+func (e GetInhibitionRuleRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e UpdateReceiverRequestValidationError) Cause() error { return e.cause }
+func (e GetInhibitionRuleRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e UpdateReceiverRequestValidationError) Key() bool { return e.key }
+func (e GetInhibitionRuleRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e UpdateReceiverRequestValidationError) ErrorName() string {
-	return "UpdateReceiverRequestValidationError"
+func (e GetInhibitionRuleRequestValidationError) ErrorName() string {
+	return "GetInhibitionRuleRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e UpdateReceiverRequestValidationError) Error() string {
+func (e GetInhibitionRuleRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2390,14 +2568,14 @@ func (e UpdateReceiverRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sUpdateReceiverRequest.%s: %s%s",
+		"invalid %sGetInhibitionRuleRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = UpdateReceiverRequestValidationError{}
+var _ error = GetInhibitionRuleRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -2405,32 +2583,65 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = UpdateReceiverRequestValidationError{}
-
-var _UpdateReceiverRequest_Name_Pattern = regexp.MustCompile("^[A-Za-z0-9_.-]+$")
-
-var _UpdateReceiverRequest_Type_InLookup = map[string]struct{}{
-	"slack":     {},
-	"pagerduty": {},
-	"http":      {},
-}
+} = GetInhibitionRuleRequestValidationError{}
 
-// Validate checks the field values on DeleteReceiverRequest with the rules
-// defined in the proto definition for this message. If any rules are
+// Validate checks the field values on UpdateInhibitionRuleRequest with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, an error is returned.
-func (m *DeleteReceiverRequest) Validate() error {
+func (m *UpdateInhibitionRuleRequest) Validate() error {
 	if m == nil {
 		return nil
 	}
 
 	// no validation rules for Id
 
+	if !_UpdateInhibitionRuleRequest_Urn_Pattern.MatchString(m.GetUrn()) {
+		return UpdateInhibitionRuleRequestValidationError{
+			field:  "Urn",
+			reason: "value does not match regex pattern \"^[A-Za-z0-9_-]+$\"",
+		}
+	}
+
+	// no validation rules for Namespace
+
+	for idx, item := range m.GetSourceMatchers() {
+		_, _ = idx, item
+
+		if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return UpdateInhibitionRuleRequestValidationError{
+					field:  fmt.Sprintf("SourceMatchers[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	for idx, item := range m.GetTargetMatchers() {
+		_, _ = idx, item
+
+		if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return UpdateInhibitionRuleRequestValidationError{
+					field:  fmt.Sprintf("TargetMatchers[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for Equal
+
 	return nil
 }
 
-// DeleteReceiverRequestValidationError is the validation error returned by
-// DeleteReceiverRequest.Validate if the designated constraints aren't met.
-type DeleteReceiverRequestValidationError struct {
+// UpdateInhibitionRuleRequestValidationError is the validation error returned
+// by UpdateInhibitionRuleRequest.Validate if the designated constraints aren't met.
+type UpdateInhibitionRuleRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2438,24 +2649,24 @@ type DeleteReceiverRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e DeleteReceiverRequestValidationError) Field() string { return e.field }
+func (e UpdateInhibitionRuleRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e DeleteReceiverRequestValidationError) Reason() string { return e.reason }
+func (e UpdateInhibitionRuleRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e DeleteReceiverRequestValidationError) Cause() error { return e.cause }
+func (e UpdateInhibitionRuleRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e DeleteReceiverRequestValidationError) Key() bool { return e.key }
+func (e UpdateInhibitionRuleRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e DeleteReceiverRequestValidationError) ErrorName() string {
-	return "DeleteReceiverRequestValidationError"
+func (e UpdateInhibitionRuleRequestValidationError) ErrorName() string {
+	return "UpdateInhibitionRuleRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e DeleteReceiverRequestValidationError) Error() string {
+func (e UpdateInhibitionRuleRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2467,14 +2678,14 @@ func (e DeleteReceiverRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sDeleteReceiverRequest.%s: %s%s",
+		"invalid %sUpdateInhibitionRuleRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = DeleteReceiverRequestValidationError{}
+var _ error = UpdateInhibitionRuleRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -2482,41 +2693,26 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = DeleteReceiverRequestValidationError{}
+} = UpdateInhibitionRuleRequestValidationError{}
 
-// Validate checks the field values on SendReceiverNotificationRequest with the
+var _UpdateInhibitionRuleRequest_Urn_Pattern = regexp.MustCompile("^[A-Za-z0-9_-]+$")
+
+// Validate checks the field values on DeleteInhibitionRuleRequest with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, an error is returned.
-func (m *SendReceiverNotificationRequest) Validate() error {
+func (m *DeleteInhibitionRuleRequest) Validate() error {
 	if m == nil {
 		return nil
 	}
 
 	// no validation rules for Id
 
-	switch m.Data.(type) {
-
-	case *SendReceiverNotificationRequest_Slack:
-
-		if v, ok := interface{}(m.GetSlack()).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return SendReceiverNotificationRequestValidationError{
-					field:  "Slack",
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
-		}
-
-	}
-
 	return nil
 }
 
-// SendReceiverNotificationRequestValidationError is the validation error
-// returned by SendReceiverNotificationRequest.Validate if the designated
-// constraints aren't met.
-type SendReceiverNotificationRequestValidationError struct {
+// DeleteInhibitionRuleRequestValidationError is the validation error returned
+// by DeleteInhibitionRuleRequest.Validate if the designated constraints aren't met.
+type DeleteInhibitionRuleRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2524,24 +2720,24 @@ type SendReceiverNotificationRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e SendReceiverNotificationRequestValidationError) Field() string { return e.field }
+func (e DeleteInhibitionRuleRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e SendReceiverNotificationRequestValidationError) Reason() string { return e.reason }
+func (e DeleteInhibitionRuleRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e SendReceiverNotificationRequestValidationError) Cause() error { return e.cause }
+func (e DeleteInhibitionRuleRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e SendReceiverNotificationRequestValidationError) Key() bool { return e.key }
+func (e DeleteInhibitionRuleRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e SendReceiverNotificationRequestValidationError) ErrorName() string {
-	return "SendReceiverNotificationRequestValidationError"
+func (e DeleteInhibitionRuleRequestValidationError) ErrorName() string {
+	return "DeleteInhibitionRuleRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e SendReceiverNotificationRequestValidationError) Error() string {
+func (e DeleteInhibitionRuleRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2553,14 +2749,14 @@ func (e SendReceiverNotificationRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sSendReceiverNotificationRequest.%s: %s%s",
+		"invalid %sDeleteInhibitionRuleRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = SendReceiverNotificationRequestValidationError{}
+var _ error = DeleteInhibitionRuleRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -2568,25 +2764,25 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = SendReceiverNotificationRequestValidationError{}
+} = DeleteInhibitionRuleRequestValidationError{}
 
-// Validate checks the field values on SendReceiverNotificationResponse with
-// the rules defined in the proto definition for this message. If any rules
-// are violated, an error is returned.
-func (m *SendReceiverNotificationResponse) Validate() error {
+// Validate checks the field values on TimeRange with the rules defined in the
+// proto definition for this message. If any rules are violated, an error is returned.
+func (m *TimeRange) Validate() error {
 	if m == nil {
 		return nil
 	}
 
-	// no validation rules for Ok
+	// no validation rules for StartTime
+
+	// no validation rules for EndTime
 
 	return nil
 }
 
-// SendReceiverNotificationResponseValidationError is the validation error
-// returned by SendReceiverNotificationResponse.Validate if the designated
-// constraints aren't met.
-type SendReceiverNotificationResponseValidationError struct {
+// TimeRangeValidationError is the validation error returned by
+// TimeRange.Validate if the designated constraints aren't met.
+type TimeRangeValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2594,24 +2790,22 @@ type SendReceiverNotificationResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e SendReceiverNotificationResponseValidationError) Field() string { return e.field }
+func (e TimeRangeValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e SendReceiverNotificationResponseValidationError) Reason() string { return e.reason }
+func (e TimeRangeValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e SendReceiverNotificationResponseValidationError) Cause() error { return e.cause }
+func (e TimeRangeValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e SendReceiverNotificationResponseValidationError) Key() bool { return e.key }
+func (e TimeRangeValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e SendReceiverNotificationResponseValidationError) ErrorName() string {
-	return "SendReceiverNotificationResponseValidationError"
-}
+func (e TimeRangeValidationError) ErrorName() string { return "TimeRangeValidationError" }
 
 // Error satisfies the builtin error interface
-func (e SendReceiverNotificationResponseValidationError) Error() string {
+func (e TimeRangeValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2623,14 +2817,14 @@ func (e SendReceiverNotificationResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sSendReceiverNotificationResponse.%s: %s%s",
+		"invalid %sTimeRange.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = SendReceiverNotificationResponseValidationError{}
+var _ error = TimeRangeValidationError{}
 
 var _ interface {
 	Field() string
@@ -2638,42 +2832,44 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = SendReceiverNotificationResponseValidationError{}
+} = TimeRangeValidationError{}
 
-// Validate checks the field values on ListAlertsRequest with the rules defined
-// in the proto definition for this message. If any rules are violated, an
-// error is returned.
-func (m *ListAlertsRequest) Validate() error {
+// Validate checks the field values on TimePeriod with the rules defined in the
+// proto definition for this message. If any rules are violated, an error is returned.
+func (m *TimePeriod) Validate() error {
 	if m == nil {
 		return nil
 	}
 
-	if _, ok := _ListAlertsRequest_ProviderName_InLookup[m.GetProviderName()]; !ok {
-		return ListAlertsRequestValidationError{
-			field:  "ProviderName",
-			reason: "value must be in list [cortex]",
+	for idx, item := range m.GetTimes() {
+		_, _ = idx, item
+
+		if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return TimePeriodValidationError{
+					field:  fmt.Sprintf("Times[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
 		}
+
 	}
 
-	// no validation rules for ProviderId
+	// no validation rules for Weekdays
 
-	if !_ListAlertsRequest_ResourceName_Pattern.MatchString(m.GetResourceName()) {
-		return ListAlertsRequestValidationError{
-			field:  "ResourceName",
-			reason: "value does not match regex pattern \"^[A-Za-z0-9_-]+$\"",
-		}
-	}
+	// no validation rules for DaysOfMonth
 
-	// no validation rules for StartTime
+	// no validation rules for Months
 
-	// no validation rules for EndTime
+	// no validation rules for Years
 
 	return nil
 }
 
-// ListAlertsRequestValidationError is the validation error returned by
-// ListAlertsRequest.Validate if the designated constraints aren't met.
-type ListAlertsRequestValidationError struct {
+// TimePeriodValidationError is the validation error returned by
+// TimePeriod.Validate if the designated constraints aren't met.
+type TimePeriodValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2681,24 +2877,22 @@ type ListAlertsRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e ListAlertsRequestValidationError) Field() string { return e.field }
+func (e TimePeriodValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ListAlertsRequestValidationError) Reason() string { return e.reason }
+func (e TimePeriodValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ListAlertsRequestValidationError) Cause() error { return e.cause }
+func (e TimePeriodValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ListAlertsRequestValidationError) Key() bool { return e.key }
+func (e TimePeriodValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ListAlertsRequestValidationError) ErrorName() string {
-	return "ListAlertsRequestValidationError"
-}
+func (e TimePeriodValidationError) ErrorName() string { return "TimePeriodValidationError" }
 
 // Error satisfies the builtin error interface
-func (e ListAlertsRequestValidationError) Error() string {
+func (e TimePeriodValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2710,14 +2904,14 @@ func (e ListAlertsRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sListAlertsRequest.%s: %s%s",
+		"invalid %sTimePeriod.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ListAlertsRequestValidationError{}
+var _ error = TimePeriodValidationError{}
 
 var _ interface {
 	Field() string
@@ -2725,28 +2919,34 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ListAlertsRequestValidationError{}
-
-var _ListAlertsRequest_ProviderName_InLookup = map[string]struct{}{
-	"cortex": {},
-}
-
-var _ListAlertsRequest_ResourceName_Pattern = regexp.MustCompile("^[A-Za-z0-9_-]+$")
+} = TimePeriodValidationError{}
 
-// Validate checks the field values on Alerts with the rules defined in the
-// proto definition for this message. If any rules are violated, an error is returned.
-func (m *Alerts) Validate() error {
+// Validate checks the field values on TimeInterval with the rules defined in
+// the proto definition for this message. If any rules are violated, an error
+// is returned.
+func (m *TimeInterval) Validate() error {
 	if m == nil {
 		return nil
 	}
 
-	for idx, item := range m.GetAlerts() {
+	// no validation rules for Id
+
+	if !_TimeInterval_Urn_Pattern.MatchString(m.GetUrn()) {
+		return TimeIntervalValidationError{
+			field:  "Urn",
+			reason: "value does not match regex pattern \"^[A-Za-z0-9_-]+$\"",
+		}
+	}
+
+	// no validation rules for Namespace
+
+	for idx, item := range m.GetPeriods() {
 		_, _ = idx, item
 
 		if v, ok := interface{}(item).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				return AlertsValidationError{
-					field:  fmt.Sprintf("Alerts[%v]", idx),
+				return TimeIntervalValidationError{
+					field:  fmt.Sprintf("Periods[%v]", idx),
 					reason: "embedded message failed validation",
 					cause:  err,
 				}
@@ -2755,12 +2955,34 @@ func (m *Alerts) Validate() error {
 
 	}
 
+	// no validation rules for MuteNamespace
+
+	if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return TimeIntervalValidationError{
+				field:  "CreatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if v, ok := interface{}(m.GetUpdatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return TimeIntervalValidationError{
+				field:  "UpdatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
 	return nil
 }
 
-// AlertsValidationError is the validation error returned by Alerts.Validate if
-// the designated constraints aren't met.
-type AlertsValidationError struct {
+// TimeIntervalValidationError is the validation error returned by
+// TimeInterval.Validate if the designated constraints aren't met.
+type TimeIntervalValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2768,22 +2990,22 @@ type AlertsValidationError struct {
 }
 
 // Field function returns field value.
-func (e AlertsValidationError) Field() string { return e.field }
+func (e TimeIntervalValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AlertsValidationError) Reason() string { return e.reason }
+func (e TimeIntervalValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AlertsValidationError) Cause() error { return e.cause }
+func (e TimeIntervalValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AlertsValidationError) Key() bool { return e.key }
+func (e TimeIntervalValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AlertsValidationError) ErrorName() string { return "AlertsValidationError" }
+func (e TimeIntervalValidationError) ErrorName() string { return "TimeIntervalValidationError" }
 
 // Error satisfies the builtin error interface
-func (e AlertsValidationError) Error() string {
+func (e TimeIntervalValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2795,14 +3017,14 @@ func (e AlertsValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAlerts.%s: %s%s",
+		"invalid %sTimeInterval.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AlertsValidationError{}
+var _ error = TimeIntervalValidationError{}
 
 var _ interface {
 	Field() string
@@ -2810,45 +3032,26 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AlertsValidationError{}
+} = TimeIntervalValidationError{}
 
-// Validate checks the field values on Alert with the rules defined in the
-// proto definition for this message. If any rules are violated, an error is returned.
-func (m *Alert) Validate() error {
+var _TimeInterval_Urn_Pattern = regexp.MustCompile("^[A-Za-z0-9_-]+$")
+
+// Validate checks the field values on ListTimeIntervalsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *ListTimeIntervalsRequest) Validate() error {
 	if m == nil {
 		return nil
 	}
 
-	// no validation rules for Id
-
-	// no validation rules for ProviderId
-
-	// no validation rules for ResourceName
-
-	// no validation rules for MetricName
-
-	// no validation rules for MetricValue
-
-	// no validation rules for Severity
-
-	// no validation rules for Rule
-
-	if v, ok := interface{}(m.GetTriggeredAt()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return AlertValidationError{
-				field:  "TriggeredAt",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
+	// no validation rules for Namespace
 
 	return nil
 }
 
-// AlertValidationError is the validation error returned by Alert.Validate if
-// the designated constraints aren't met.
-type AlertValidationError struct {
+// ListTimeIntervalsRequestValidationError is the validation error returned by
+// ListTimeIntervalsRequest.Validate if the designated constraints aren't met.
+type ListTimeIntervalsRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2856,22 +3059,24 @@ type AlertValidationError struct {
 }
 
 // Field function returns field value.
-func (e AlertValidationError) Field() string { return e.field }
+func (e ListTimeIntervalsRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AlertValidationError) Reason() string { return e.reason }
+func (e ListTimeIntervalsRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AlertValidationError) Cause() error { return e.cause }
+func (e ListTimeIntervalsRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AlertValidationError) Key() bool { return e.key }
+func (e ListTimeIntervalsRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AlertValidationError) ErrorName() string { return "AlertValidationError" }
+func (e ListTimeIntervalsRequestValidationError) ErrorName() string {
+	return "ListTimeIntervalsRequestValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e AlertValidationError) Error() string {
+func (e ListTimeIntervalsRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2883,14 +3088,14 @@ func (e AlertValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAlert.%s: %s%s",
+		"invalid %sListTimeIntervalsRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AlertValidationError{}
+var _ error = ListTimeIntervalsRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -2898,25 +3103,23 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AlertValidationError{}
+} = ListTimeIntervalsRequestValidationError{}
 
-// Validate checks the field values on CreateCortexAlertsRequest with the rules
+// Validate checks the field values on ListTimeIntervalsResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, an error is returned.
-func (m *CreateCortexAlertsRequest) Validate() error {
+func (m *ListTimeIntervalsResponse) Validate() error {
 	if m == nil {
 		return nil
 	}
 
-	// no validation rules for ProviderId
-
-	for idx, item := range m.GetAlerts() {
+	for idx, item := range m.GetTimeIntervals() {
 		_, _ = idx, item
 
 		if v, ok := interface{}(item).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				return CreateCortexAlertsRequestValidationError{
-					field:  fmt.Sprintf("Alerts[%v]", idx),
+				return ListTimeIntervalsResponseValidationError{
+					field:  fmt.Sprintf("TimeIntervals[%v]", idx),
 					reason: "embedded message failed validation",
 					cause:  err,
 				}
@@ -2928,9 +3131,9 @@ func (m *CreateCortexAlertsRequest) Validate() error {
 	return nil
 }
 
-// CreateCortexAlertsRequestValidationError is the validation error returned by
-// CreateCortexAlertsRequest.Validate if the designated constraints aren't met.
-type CreateCortexAlertsRequestValidationError struct {
+// ListTimeIntervalsResponseValidationError is the validation error returned by
+// ListTimeIntervalsResponse.Validate if the designated constraints aren't met.
+type ListTimeIntervalsResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2938,24 +3141,24 @@ type CreateCortexAlertsRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e CreateCortexAlertsRequestValidationError) Field() string { return e.field }
+func (e ListTimeIntervalsResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e CreateCortexAlertsRequestValidationError) Reason() string { return e.reason }
+func (e ListTimeIntervalsResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e CreateCortexAlertsRequestValidationError) Cause() error { return e.cause }
+func (e ListTimeIntervalsResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e CreateCortexAlertsRequestValidationError) Key() bool { return e.key }
+func (e ListTimeIntervalsResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e CreateCortexAlertsRequestValidationError) ErrorName() string {
-	return "CreateCortexAlertsRequestValidationError"
+func (e ListTimeIntervalsResponseValidationError) ErrorName() string {
+	return "ListTimeIntervalsResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e CreateCortexAlertsRequestValidationError) Error() string {
+func (e ListTimeIntervalsResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2967,14 +3170,14 @@ func (e CreateCortexAlertsRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sCreateCortexAlertsRequest.%s: %s%s",
+		"invalid %sListTimeIntervalsResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = CreateCortexAlertsRequestValidationError{}
+var _ error = ListTimeIntervalsResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -2982,54 +3185,48 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = CreateCortexAlertsRequestValidationError{}
+} = ListTimeIntervalsResponseValidationError{}
 
-// Validate checks the field values on CortexAlert with the rules defined in
-// the proto definition for this message. If any rules are violated, an error
-// is returned.
-func (m *CortexAlert) Validate() error {
+// Validate checks the field values on CreateTimeIntervalRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *CreateTimeIntervalRequest) Validate() error {
 	if m == nil {
 		return nil
 	}
 
-	if v, ok := interface{}(m.GetAnnotations()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return CortexAlertValidationError{
-				field:  "Annotations",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
+	if !_CreateTimeIntervalRequest_Urn_Pattern.MatchString(m.GetUrn()) {
+		return CreateTimeIntervalRequestValidationError{
+			field:  "Urn",
+			reason: "value does not match regex pattern \"^[A-Za-z0-9_-]+$\"",
 		}
 	}
 
-	if v, ok := interface{}(m.GetLabels()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return CortexAlertValidationError{
-				field:  "Labels",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
+	// no validation rules for Namespace
 
-	// no validation rules for Status
+	for idx, item := range m.GetPeriods() {
+		_, _ = idx, item
 
-	if v, ok := interface{}(m.GetStartsAt()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return CortexAlertValidationError{
-				field:  "StartsAt",
-				reason: "embedded message failed validation",
-				cause:  err,
+		if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return CreateTimeIntervalRequestValidationError{
+					field:  fmt.Sprintf("Periods[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
 			}
 		}
+
 	}
 
+	// no validation rules for MuteNamespace
+
 	return nil
 }
 
-// CortexAlertValidationError is the validation error returned by
-// CortexAlert.Validate if the designated constraints aren't met.
-type CortexAlertValidationError struct {
+// CreateTimeIntervalRequestValidationError is the validation error returned by
+// CreateTimeIntervalRequest.Validate if the designated constraints aren't met.
+type CreateTimeIntervalRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -3037,22 +3234,24 @@ type CortexAlertValidationError struct {
 }
 
 // Field function returns field value.
-func (e CortexAlertValidationError) Field() string { return e.field }
+func (e CreateTimeIntervalRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e CortexAlertValidationError) Reason() string { return e.reason }
+func (e CreateTimeIntervalRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e CortexAlertValidationError) Cause() error { return e.cause }
+func (e CreateTimeIntervalRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e CortexAlertValidationError) Key() bool { return e.key }
+func (e CreateTimeIntervalRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e CortexAlertValidationError) ErrorName() string { return "CortexAlertValidationError" }
+func (e CreateTimeIntervalRequestValidationError) ErrorName() string {
+	return "CreateTimeIntervalRequestValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e CortexAlertValidationError) Error() string {
+func (e CreateTimeIntervalRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -3064,14 +3263,14 @@ func (e CortexAlertValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sCortexAlert.%s: %s%s",
+		"invalid %sCreateTimeIntervalRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = CortexAlertValidationError{}
+var _ error = CreateTimeIntervalRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -3079,30 +3278,26 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = CortexAlertValidationError{}
+} = CreateTimeIntervalRequestValidationError{}
 
-// Validate checks the field values on Annotations with the rules defined in
-// the proto definition for this message. If any rules are violated, an error
-// is returned.
-func (m *Annotations) Validate() error {
+var _CreateTimeIntervalRequest_Urn_Pattern = regexp.MustCompile("^[A-Za-z0-9_-]+$")
+
+// Validate checks the field values on GetTimeIntervalRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *GetTimeIntervalRequest) Validate() error {
 	if m == nil {
 		return nil
 	}
 
-	// no validation rules for MetricName
-
-	// no validation rules for MetricValue
-
-	// no validation rules for Resource
-
-	// no validation rules for Template
+	// no validation rules for Id
 
 	return nil
 }
 
-// AnnotationsValidationError is the validation error returned by
-// Annotations.Validate if the designated constraints aren't met.
-type AnnotationsValidationError struct {
+// GetTimeIntervalRequestValidationError is the validation error returned by
+// GetTimeIntervalRequest.Validate if the designated constraints aren't met.
+type GetTimeIntervalRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -3110,22 +3305,24 @@ type AnnotationsValidationError struct {
 }
 
 // Field function returns field value.
-func (e AnnotationsValidationError) Field() string { return e.field }
+func (e GetTimeIntervalRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AnnotationsValidationError) Reason() string { return e.reason }
+func (e GetTimeIntervalRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AnnotationsValidationError) Cause() error { return e.cause }
+func (e GetTimeIntervalRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AnnotationsValidationError) Key() bool { return e.key }
+func (e GetTimeIntervalRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AnnotationsValidationError) ErrorName() string { return "AnnotationsValidationError" }
+func (e GetTimeIntervalRequestValidationError) ErrorName() string {
+	return "GetTimeIntervalRequestValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e AnnotationsValidationError) Error() string {
+func (e GetTimeIntervalRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -3137,14 +3334,14 @@ func (e AnnotationsValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAnnotations.%s: %s%s",
+		"invalid %sGetTimeIntervalRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AnnotationsValidationError{}
+var _ error = GetTimeIntervalRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -3152,23 +3349,50 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AnnotationsValidationError{}
+} = GetTimeIntervalRequestValidationError{}
 
-// Validate checks the field values on Labels with the rules defined in the
-// proto definition for this message. If any rules are violated, an error is returned.
-func (m *Labels) Validate() error {
+// Validate checks the field values on UpdateTimeIntervalRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *UpdateTimeIntervalRequest) Validate() error {
 	if m == nil {
 		return nil
 	}
 
-	// no validation rules for Severity
+	// no validation rules for Id
+
+	if !_UpdateTimeIntervalRequest_Urn_Pattern.MatchString(m.GetUrn()) {
+		return UpdateTimeIntervalRequestValidationError{
+			field:  "Urn",
+			reason: "value does not match regex pattern \"^[A-Za-z0-9_-]+$\"",
+		}
+	}
+
+	// no validation rules for Namespace
+
+	for idx, item := range m.GetPeriods() {
+		_, _ = idx, item
+
+		if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return UpdateTimeIntervalRequestValidationError{
+					field:  fmt.Sprintf("Periods[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for MuteNamespace
 
 	return nil
 }
 
-// LabelsValidationError is the validation error returned by Labels.Validate if
-// the designated constraints aren't met.
-type LabelsValidationError struct {
+// UpdateTimeIntervalRequestValidationError is the validation error returned by
+// UpdateTimeIntervalRequest.Validate if the designated constraints aren't met.
+type UpdateTimeIntervalRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -3176,22 +3400,24 @@ type LabelsValidationError struct {
 }
 
 // Field function returns field value.
-func (e LabelsValidationError) Field() string { return e.field }
+func (e UpdateTimeIntervalRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e LabelsValidationError) Reason() string { return e.reason }
+func (e UpdateTimeIntervalRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e LabelsValidationError) Cause() error { return e.cause }
+func (e UpdateTimeIntervalRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e LabelsValidationError) Key() bool { return e.key }
+func (e UpdateTimeIntervalRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e LabelsValidationError) ErrorName() string { return "LabelsValidationError" }
+func (e UpdateTimeIntervalRequestValidationError) ErrorName() string {
+	return "UpdateTimeIntervalRequestValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e LabelsValidationError) Error() string {
+func (e UpdateTimeIntervalRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -3203,14 +3429,14 @@ func (e LabelsValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sLabels.%s: %s%s",
+		"invalid %sUpdateTimeIntervalRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = LabelsValidationError{}
+var _ error = UpdateTimeIntervalRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -3218,75 +3444,26 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = LabelsValidationError{}
+} = UpdateTimeIntervalRequestValidationError{}
 
-// Validate checks the field values on Rule with the rules defined in the proto
-// definition for this message. If any rules are violated, an error is returned.
-func (m *Rule) Validate() error {
+var _UpdateTimeIntervalRequest_Urn_Pattern = regexp.MustCompile("^[A-Za-z0-9_-]+$")
+
+// Validate checks the field values on DeleteTimeIntervalRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *DeleteTimeIntervalRequest) Validate() error {
 	if m == nil {
 		return nil
 	}
 
 	// no validation rules for Id
 
-	// no validation rules for Name
-
-	// no validation rules for Enabled
-
-	// no validation rules for GroupName
-
-	// no validation rules for Namespace
-
-	// no validation rules for Template
-
-	for idx, item := range m.GetVariables() {
-		_, _ = idx, item
-
-		if v, ok := interface{}(item).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return RuleValidationError{
-					field:  fmt.Sprintf("Variables[%v]", idx),
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
-		}
-
-	}
-
-	if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return RuleValidationError{
-				field:  "CreatedAt",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
-
-	if v, ok := interface{}(m.GetUpdatedAt()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return RuleValidationError{
-				field:  "UpdatedAt",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
-
-	if m.GetProviderNamespace() < 0 {
-		return RuleValidationError{
-			field:  "ProviderNamespace",
-			reason: "value must be greater than or equal to 0",
-		}
-	}
-
 	return nil
 }
 
-// RuleValidationError is the validation error returned by Rule.Validate if the
-// designated constraints aren't met.
-type RuleValidationError struct {
+// DeleteTimeIntervalRequestValidationError is the validation error returned by
+// DeleteTimeIntervalRequest.Validate if the designated constraints aren't met.
+type DeleteTimeIntervalRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -3294,22 +3471,24 @@ type RuleValidationError struct {
 }
 
 // Field function returns field value.
-func (e RuleValidationError) Field() string { return e.field }
+func (e DeleteTimeIntervalRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e RuleValidationError) Reason() string { return e.reason }
+func (e DeleteTimeIntervalRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e RuleValidationError) Cause() error { return e.cause }
+func (e DeleteTimeIntervalRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e RuleValidationError) Key() bool { return e.key }
+func (e DeleteTimeIntervalRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e RuleValidationError) ErrorName() string { return "RuleValidationError" }
+func (e DeleteTimeIntervalRequestValidationError) ErrorName() string {
+	return "DeleteTimeIntervalRequestValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e RuleValidationError) Error() string {
+func (e DeleteTimeIntervalRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -3321,14 +3500,14 @@ func (e RuleValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sRule.%s: %s%s",
+		"invalid %sDeleteTimeIntervalRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = RuleValidationError{}
+var _ error = DeleteTimeIntervalRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -3336,34 +3515,66 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = RuleValidationError{}
+} = DeleteTimeIntervalRequestValidationError{}
 
-// Validate checks the field values on Variables with the rules defined in the
+// Validate checks the field values on Silence with the rules defined in the
 // proto definition for this message. If any rules are violated, an error is returned.
-func (m *Variables) Validate() error {
+func (m *Silence) Validate() error {
 	if m == nil {
 		return nil
 	}
 
-	if !_Variables_Name_Pattern.MatchString(m.GetName()) {
-		return VariablesValidationError{
-			field:  "Name",
-			reason: "value does not match regex pattern \"^[A-Za-z0-9_-]+$\"",
+	// no validation rules for Id
+
+	// no validation rules for Namespace
+
+	for idx, item := range m.GetMatchers() {
+		_, _ = idx, item
+
+		if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return SilenceValidationError{
+					field:  fmt.Sprintf("Matchers[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
 		}
+
 	}
 
-	// no validation rules for Value
+	if v, ok := interface{}(m.GetStartsAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SilenceValidationError{
+				field:  "StartsAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
 
-	// no validation rules for Type
+	if v, ok := interface{}(m.GetEndsAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SilenceValidationError{
+				field:  "EndsAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
 
-	// no validation rules for Description
+	// no validation rules for CreatedBy
+
+	// no validation rules for Comment
+
+	// no validation rules for State
 
 	return nil
 }
 
-// VariablesValidationError is the validation error returned by
-// Variables.Validate if the designated constraints aren't met.
-type VariablesValidationError struct {
+// SilenceValidationError is the validation error returned by Silence.Validate
+// if the designated constraints aren't met.
+type SilenceValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -3371,22 +3582,22 @@ type VariablesValidationError struct {
 }
 
 // Field function returns field value.
-func (e VariablesValidationError) Field() string { return e.field }
+func (e SilenceValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e VariablesValidationError) Reason() string { return e.reason }
+func (e SilenceValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e VariablesValidationError) Cause() error { return e.cause }
+func (e SilenceValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e VariablesValidationError) Key() bool { return e.key }
+func (e SilenceValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e VariablesValidationError) ErrorName() string { return "VariablesValidationError" }
+func (e SilenceValidationError) ErrorName() string { return "SilenceValidationError" }
 
 // Error satisfies the builtin error interface
-func (e VariablesValidationError) Error() string {
+func (e SilenceValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -3398,14 +3609,14 @@ func (e VariablesValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sVariables.%s: %s%s",
+		"invalid %sSilence.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = VariablesValidationError{}
+var _ error = SilenceValidationError{}
 
 var _ interface {
 	Field() string
@@ -3413,34 +3624,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = VariablesValidationError{}
-
-var _Variables_Name_Pattern = regexp.MustCompile("^[A-Za-z0-9_-]+$")
+} = SilenceValidationError{}
 
-// Validate checks the field values on ListRulesRequest with the rules defined
-// in the proto definition for this message. If any rules are violated, an
-// error is returned.
-func (m *ListRulesRequest) Validate() error {
+// Validate checks the field values on ListSilencesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *ListSilencesRequest) Validate() error {
 	if m == nil {
 		return nil
 	}
 
-	// no validation rules for Name
-
 	// no validation rules for Namespace
 
-	// no validation rules for GroupName
-
-	// no validation rules for Template
-
-	// no validation rules for ProviderNamespace
-
 	return nil
 }
 
-// ListRulesRequestValidationError is the validation error returned by
-// ListRulesRequest.Validate if the designated constraints aren't met.
-type ListRulesRequestValidationError struct {
+// ListSilencesRequestValidationError is the validation error returned by
+// ListSilencesRequest.Validate if the designated constraints aren't met.
+type ListSilencesRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -3448,22 +3649,24 @@ type ListRulesRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e ListRulesRequestValidationError) Field() string { return e.field }
+func (e ListSilencesRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ListRulesRequestValidationError) Reason() string { return e.reason }
+func (e ListSilencesRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ListRulesRequestValidationError) Cause() error { return e.cause }
+func (e ListSilencesRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ListRulesRequestValidationError) Key() bool { return e.key }
+func (e ListSilencesRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ListRulesRequestValidationError) ErrorName() string { return "ListRulesRequestValidationError" }
+func (e ListSilencesRequestValidationError) ErrorName() string {
+	return "ListSilencesRequestValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e ListRulesRequestValidationError) Error() string {
+func (e ListSilencesRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -3475,14 +3678,14 @@ func (e ListRulesRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sListRulesRequest.%s: %s%s",
+		"invalid %sListSilencesRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ListRulesRequestValidationError{}
+var _ error = ListSilencesRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -3490,23 +3693,23 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ListRulesRequestValidationError{}
+} = ListSilencesRequestValidationError{}
 
-// Validate checks the field values on ListRulesResponse with the rules defined
-// in the proto definition for this message. If any rules are violated, an
-// error is returned.
-func (m *ListRulesResponse) Validate() error {
+// Validate checks the field values on ListSilencesResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *ListSilencesResponse) Validate() error {
 	if m == nil {
 		return nil
 	}
 
-	for idx, item := range m.GetRules() {
+	for idx, item := range m.GetSilences() {
 		_, _ = idx, item
 
 		if v, ok := interface{}(item).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				return ListRulesResponseValidationError{
-					field:  fmt.Sprintf("Rules[%v]", idx),
+				return ListSilencesResponseValidationError{
+					field:  fmt.Sprintf("Silences[%v]", idx),
 					reason: "embedded message failed validation",
 					cause:  err,
 				}
@@ -3518,9 +3721,9 @@ func (m *ListRulesResponse) Validate() error {
 	return nil
 }
 
-// ListRulesResponseValidationError is the validation error returned by
-// ListRulesResponse.Validate if the designated constraints aren't met.
-type ListRulesResponseValidationError struct {
+// ListSilencesResponseValidationError is the validation error returned by
+// ListSilencesResponse.Validate if the designated constraints aren't met.
+type ListSilencesResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -3528,24 +3731,24 @@ type ListRulesResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e ListRulesResponseValidationError) Field() string { return e.field }
+func (e ListSilencesResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ListRulesResponseValidationError) Reason() string { return e.reason }
+func (e ListSilencesResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ListRulesResponseValidationError) Cause() error { return e.cause }
+func (e ListSilencesResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ListRulesResponseValidationError) Key() bool { return e.key }
+func (e ListSilencesResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ListRulesResponseValidationError) ErrorName() string {
-	return "ListRulesResponseValidationError"
+func (e ListSilencesResponseValidationError) ErrorName() string {
+	return "ListSilencesResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ListRulesResponseValidationError) Error() string {
+func (e ListSilencesResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -3557,14 +3760,14 @@ func (e ListRulesResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sListRulesResponse.%s: %s%s",
+		"invalid %sListSilencesResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ListRulesResponseValidationError{}
+var _ error = ListSilencesResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -3572,32 +3775,63 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ListRulesResponseValidationError{}
+} = ListSilencesResponseValidationError{}
 
-// Validate checks the field values on UpdateRuleResponse with the rules
+// Validate checks the field values on CreateSilenceRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, an error is returned.
-func (m *UpdateRuleResponse) Validate() error {
+func (m *CreateSilenceRequest) Validate() error {
 	if m == nil {
 		return nil
 	}
 
-	if v, ok := interface{}(m.GetRule()).(interface{ Validate() error }); ok {
+	// no validation rules for Namespace
+
+	for idx, item := range m.GetMatchers() {
+		_, _ = idx, item
+
+		if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return CreateSilenceRequestValidationError{
+					field:  fmt.Sprintf("Matchers[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if v, ok := interface{}(m.GetStartsAt()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return UpdateRuleResponseValidationError{
-				field:  "Rule",
+			return CreateSilenceRequestValidationError{
+				field:  "StartsAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if v, ok := interface{}(m.GetEndsAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateSilenceRequestValidationError{
+				field:  "EndsAt",
 				reason: "embedded message failed validation",
 				cause:  err,
 			}
 		}
 	}
 
+	// no validation rules for CreatedBy
+
+	// no validation rules for Comment
+
 	return nil
 }
 
-// UpdateRuleResponseValidationError is the validation error returned by
-// UpdateRuleResponse.Validate if the designated constraints aren't met.
-type UpdateRuleResponseValidationError struct {
+// CreateSilenceRequestValidationError is the validation error returned by
+// CreateSilenceRequest.Validate if the designated constraints aren't met.
+type CreateSilenceRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -3605,24 +3839,24 @@ type UpdateRuleResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e UpdateRuleResponseValidationError) Field() string { return e.field }
+func (e CreateSilenceRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e UpdateRuleResponseValidationError) Reason() string { return e.reason }
+func (e CreateSilenceRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e UpdateRuleResponseValidationError) Cause() error { return e.cause }
+func (e CreateSilenceRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e UpdateRuleResponseValidationError) Key() bool { return e.key }
+func (e CreateSilenceRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e UpdateRuleResponseValidationError) ErrorName() string {
-	return "UpdateRuleResponseValidationError"
+func (e CreateSilenceRequestValidationError) ErrorName() string {
+	return "CreateSilenceRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e UpdateRuleResponseValidationError) Error() string {
+func (e CreateSilenceRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -3634,14 +3868,14 @@ func (e UpdateRuleResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sUpdateRuleResponse.%s: %s%s",
+		"invalid %sCreateSilenceRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = UpdateRuleResponseValidationError{}
+var _ error = CreateSilenceRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -3649,62 +3883,26 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = UpdateRuleResponseValidationError{}
+} = CreateSilenceRequestValidationError{}
 
-// Validate checks the field values on UpdateRuleRequest with the rules defined
+// Validate checks the field values on GetSilenceRequest with the rules defined
 // in the proto definition for this message. If any rules are violated, an
 // error is returned.
-func (m *UpdateRuleRequest) Validate() error {
+func (m *GetSilenceRequest) Validate() error {
 	if m == nil {
 		return nil
 	}
 
-	// no validation rules for Enabled
-
-	if !_UpdateRuleRequest_GroupName_Pattern.MatchString(m.GetGroupName()) {
-		return UpdateRuleRequestValidationError{
-			field:  "GroupName",
-			reason: "value does not match regex pattern \"^[A-Za-z0-9_-]+$\"",
-		}
-	}
-
-	if !_UpdateRuleRequest_Namespace_Pattern.MatchString(m.GetNamespace()) {
-		return UpdateRuleRequestValidationError{
-			field:  "Namespace",
-			reason: "value does not match regex pattern \"^[A-Za-z0-9_-]+$\"",
-		}
-	}
-
-	if !_UpdateRuleRequest_Template_Pattern.MatchString(m.GetTemplate()) {
-		return UpdateRuleRequestValidationError{
-			field:  "Template",
-			reason: "value does not match regex pattern \"^[A-Za-z0-9_-]+$\"",
-		}
-	}
-
-	for idx, item := range m.GetVariables() {
-		_, _ = idx, item
-
-		if v, ok := interface{}(item).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return UpdateRuleRequestValidationError{
-					field:  fmt.Sprintf("Variables[%v]", idx),
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
-		}
-
-	}
+	// no validation rules for Id
 
-	// no validation rules for ProviderNamespace
+	// no validation rules for Namespace
 
 	return nil
 }
 
-// UpdateRuleRequestValidationError is the validation error returned by
-// UpdateRuleRequest.Validate if the designated constraints aren't met.
-type UpdateRuleRequestValidationError struct {
+// GetSilenceRequestValidationError is the validation error returned by
+// GetSilenceRequest.Validate if the designated constraints aren't met.
+type GetSilenceRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -3712,16 +3910,2108 @@ type UpdateRuleRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e UpdateRuleRequestValidationError) Field() string { return e.field }
+func (e GetSilenceRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e UpdateRuleRequestValidationError) Reason() string { return e.reason }
+func (e GetSilenceRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e UpdateRuleRequestValidationError) Cause() error { return e.cause }
+func (e GetSilenceRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e UpdateRuleRequestValidationError) Key() bool { return e.key }
+func (e GetSilenceRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetSilenceRequestValidationError) ErrorName() string {
+	return "GetSilenceRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetSilenceRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetSilenceRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetSilenceRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetSilenceRequestValidationError{}
+
+// Validate checks the field values on ExpireSilenceRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *ExpireSilenceRequest) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	// no validation rules for Id
+
+	// no validation rules for Namespace
+
+	return nil
+}
+
+// ExpireSilenceRequestValidationError is the validation error returned by
+// ExpireSilenceRequest.Validate if the designated constraints aren't met.
+type ExpireSilenceRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ExpireSilenceRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ExpireSilenceRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ExpireSilenceRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ExpireSilenceRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ExpireSilenceRequestValidationError) ErrorName() string {
+	return "ExpireSilenceRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ExpireSilenceRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sExpireSilenceRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ExpireSilenceRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ExpireSilenceRequestValidationError{}
+
+// Validate checks the field values on Receiver with the rules defined in the
+// proto definition for this message. If any rules are violated, an error is returned.
+func (m *Receiver) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	// no validation rules for Id
+
+	// no validation rules for Name
+
+	if _, ok := _Receiver_Type_InLookup[m.GetType()]; !ok {
+		return ReceiverValidationError{
+			field:  "Type",
+			reason: "value must be in list [slack pagerduty http]",
+		}
+	}
+
+	// no validation rules for Labels
+
+	if v, ok := interface{}(m.GetConfigurations()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ReceiverValidationError{
+				field:  "Configurations",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if v, ok := interface{}(m.GetData()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ReceiverValidationError{
+				field:  "Data",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ReceiverValidationError{
+				field:  "CreatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if v, ok := interface{}(m.GetUpdatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ReceiverValidationError{
+				field:  "UpdatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	return nil
+}
+
+// ReceiverValidationError is the validation error returned by
+// Receiver.Validate if the designated constraints aren't met.
+type ReceiverValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ReceiverValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ReceiverValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ReceiverValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ReceiverValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ReceiverValidationError) ErrorName() string { return "ReceiverValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ReceiverValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sReceiver.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ReceiverValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ReceiverValidationError{}
+
+var _Receiver_Type_InLookup = map[string]struct{}{
+	"slack":     {},
+	"pagerduty": {},
+	"http":      {},
+}
+
+// Validate checks the field values on ListReceiversResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *ListReceiversResponse) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	for idx, item := range m.GetReceivers() {
+		_, _ = idx, item
+
+		if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListReceiversResponseValidationError{
+					field:  fmt.Sprintf("Receivers[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// ListReceiversResponseValidationError is the validation error returned by
+// ListReceiversResponse.Validate if the designated constraints aren't met.
+type ListReceiversResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListReceiversResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListReceiversResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListReceiversResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListReceiversResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListReceiversResponseValidationError) ErrorName() string {
+	return "ListReceiversResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListReceiversResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListReceiversResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListReceiversResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListReceiversResponseValidationError{}
+
+// Validate checks the field values on CreateReceiverRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *CreateReceiverRequest) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	if !_CreateReceiverRequest_Name_Pattern.MatchString(m.GetName()) {
+		return CreateReceiverRequestValidationError{
+			field:  "Name",
+			reason: "value does not match regex pattern \"^[A-Za-z0-9_.-]+$\"",
+		}
+	}
+
+	if _, ok := _CreateReceiverRequest_Type_InLookup[m.GetType()]; !ok {
+		return CreateReceiverRequestValidationError{
+			field:  "Type",
+			reason: "value must be in list [slack pagerduty http]",
+		}
+	}
+
+	// no validation rules for Labels
+
+	if v, ok := interface{}(m.GetConfigurations()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateReceiverRequestValidationError{
+				field:  "Configurations",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	return nil
+}
+
+// CreateReceiverRequestValidationError is the validation error returned by
+// CreateReceiverRequest.Validate if the designated constraints aren't met.
+type CreateReceiverRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateReceiverRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateReceiverRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateReceiverRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateReceiverRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateReceiverRequestValidationError) ErrorName() string {
+	return "CreateReceiverRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateReceiverRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateReceiverRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateReceiverRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateReceiverRequestValidationError{}
+
+var _CreateReceiverRequest_Name_Pattern = regexp.MustCompile("^[A-Za-z0-9_.-]+$")
+
+var _CreateReceiverRequest_Type_InLookup = map[string]struct{}{
+	"slack":     {},
+	"pagerduty": {},
+	"http":      {},
+}
+
+// Validate checks the field values on GetReceiverRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *GetReceiverRequest) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	// no validation rules for Id
+
+	return nil
+}
+
+// GetReceiverRequestValidationError is the validation error returned by
+// GetReceiverRequest.Validate if the designated constraints aren't met.
+type GetReceiverRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetReceiverRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetReceiverRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetReceiverRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetReceiverRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetReceiverRequestValidationError) ErrorName() string {
+	return "GetReceiverRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetReceiverRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetReceiverRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetReceiverRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetReceiverRequestValidationError{}
+
+// Validate checks the field values on UpdateReceiverRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *UpdateReceiverRequest) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	// no validation rules for Id
+
+	if !_UpdateReceiverRequest_Name_Pattern.MatchString(m.GetName()) {
+		return UpdateReceiverRequestValidationError{
+			field:  "Name",
+			reason: "value does not match regex pattern \"^[A-Za-z0-9_.-]+$\"",
+		}
+	}
+
+	if _, ok := _UpdateReceiverRequest_Type_InLookup[m.GetType()]; !ok {
+		return UpdateReceiverRequestValidationError{
+			field:  "Type",
+			reason: "value must be in list [slack pagerduty http]",
+		}
+	}
+
+	// no validation rules for Labels
+
+	if v, ok := interface{}(m.GetConfigurations()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpdateReceiverRequestValidationError{
+				field:  "Configurations",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	return nil
+}
+
+// UpdateReceiverRequestValidationError is the validation error returned by
+// UpdateReceiverRequest.Validate if the designated constraints aren't met.
+type UpdateReceiverRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateReceiverRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateReceiverRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateReceiverRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateReceiverRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateReceiverRequestValidationError) ErrorName() string {
+	return "UpdateReceiverRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateReceiverRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateReceiverRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateReceiverRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateReceiverRequestValidationError{}
+
+var _UpdateReceiverRequest_Name_Pattern = regexp.MustCompile("^[A-Za-z0-9_.-]+$")
+
+var _UpdateReceiverRequest_Type_InLookup = map[string]struct{}{
+	"slack":     {},
+	"pagerduty": {},
+	"http":      {},
+}
+
+// Validate checks the field values on DeleteReceiverRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *DeleteReceiverRequest) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	// no validation rules for Id
+
+	return nil
+}
+
+// DeleteReceiverRequestValidationError is the validation error returned by
+// DeleteReceiverRequest.Validate if the designated constraints aren't met.
+type DeleteReceiverRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteReceiverRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteReceiverRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteReceiverRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteReceiverRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteReceiverRequestValidationError) ErrorName() string {
+	return "DeleteReceiverRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteReceiverRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteReceiverRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteReceiverRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteReceiverRequestValidationError{}
+
+// Validate checks the field values on SendReceiverNotificationRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *SendReceiverNotificationRequest) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	// no validation rules for Id
+
+	switch m.Data.(type) {
+
+	case *SendReceiverNotificationRequest_Slack:
+
+		if v, ok := interface{}(m.GetSlack()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return SendReceiverNotificationRequestValidationError{
+					field:  "Slack",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// SendReceiverNotificationRequestValidationError is the validation error
+// returned by SendReceiverNotificationRequest.Validate if the designated
+// constraints aren't met.
+type SendReceiverNotificationRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SendReceiverNotificationRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SendReceiverNotificationRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SendReceiverNotificationRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SendReceiverNotificationRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SendReceiverNotificationRequestValidationError) ErrorName() string {
+	return "SendReceiverNotificationRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SendReceiverNotificationRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSendReceiverNotificationRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SendReceiverNotificationRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SendReceiverNotificationRequestValidationError{}
+
+// Validate checks the field values on SendReceiverNotificationResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, an error is returned.
+func (m *SendReceiverNotificationResponse) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	// no validation rules for Ok
+
+	return nil
+}
+
+// SendReceiverNotificationResponseValidationError is the validation error
+// returned by SendReceiverNotificationResponse.Validate if the designated
+// constraints aren't met.
+type SendReceiverNotificationResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SendReceiverNotificationResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SendReceiverNotificationResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SendReceiverNotificationResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SendReceiverNotificationResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SendReceiverNotificationResponseValidationError) ErrorName() string {
+	return "SendReceiverNotificationResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SendReceiverNotificationResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSendReceiverNotificationResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SendReceiverNotificationResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SendReceiverNotificationResponseValidationError{}
+
+// Validate checks the field values on ListAlertsRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, an
+// error is returned.
+func (m *ListAlertsRequest) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	if _, ok := _ListAlertsRequest_ProviderName_InLookup[m.GetProviderName()]; !ok {
+		return ListAlertsRequestValidationError{
+			field:  "ProviderName",
+			reason: "value must be in list [cortex]",
+		}
+	}
+
+	// no validation rules for ProviderId
+
+	if !_ListAlertsRequest_ResourceName_Pattern.MatchString(m.GetResourceName()) {
+		return ListAlertsRequestValidationError{
+			field:  "ResourceName",
+			reason: "value does not match regex pattern \"^[A-Za-z0-9_-]+$\"",
+		}
+	}
+
+	// no validation rules for StartTime
+
+	// no validation rules for EndTime
+
+	return nil
+}
+
+// ListAlertsRequestValidationError is the validation error returned by
+// ListAlertsRequest.Validate if the designated constraints aren't met.
+type ListAlertsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListAlertsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListAlertsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListAlertsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListAlertsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListAlertsRequestValidationError) ErrorName() string {
+	return "ListAlertsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListAlertsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListAlertsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListAlertsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListAlertsRequestValidationError{}
+
+var _ListAlertsRequest_ProviderName_InLookup = map[string]struct{}{
+	"cortex": {},
+}
+
+var _ListAlertsRequest_ResourceName_Pattern = regexp.MustCompile("^[A-Za-z0-9_-]+$")
+
+// Validate checks the field values on Alerts with the rules defined in the
+// proto definition for this message. If any rules are violated, an error is returned.
+func (m *Alerts) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	for idx, item := range m.GetAlerts() {
+		_, _ = idx, item
+
+		if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return AlertsValidationError{
+					field:  fmt.Sprintf("Alerts[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// AlertsValidationError is the validation error returned by Alerts.Validate if
+// the designated constraints aren't met.
+type AlertsValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AlertsValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AlertsValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AlertsValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AlertsValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AlertsValidationError) ErrorName() string { return "AlertsValidationError" }
+
+// Error satisfies the builtin error interface
+func (e AlertsValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAlerts.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AlertsValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AlertsValidationError{}
+
+// Validate checks the field values on Alert with the rules defined in the
+// proto definition for this message. If any rules are violated, an error is returned.
+func (m *Alert) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	// no validation rules for Id
+
+	// no validation rules for ProviderId
+
+	// no validation rules for ResourceName
+
+	// no validation rules for MetricName
+
+	// no validation rules for MetricValue
+
+	// no validation rules for Severity
+
+	// no validation rules for Rule
+
+	if v, ok := interface{}(m.GetTriggeredAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AlertValidationError{
+				field:  "TriggeredAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	return nil
+}
+
+// AlertValidationError is the validation error returned by Alert.Validate if
+// the designated constraints aren't met.
+type AlertValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AlertValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AlertValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AlertValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AlertValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AlertValidationError) ErrorName() string { return "AlertValidationError" }
+
+// Error satisfies the builtin error interface
+func (e AlertValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAlert.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AlertValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AlertValidationError{}
+
+// Validate checks the field values on CreateCortexAlertsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *CreateCortexAlertsRequest) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	// no validation rules for ProviderId
+
+	for idx, item := range m.GetAlerts() {
+		_, _ = idx, item
+
+		if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return CreateCortexAlertsRequestValidationError{
+					field:  fmt.Sprintf("Alerts[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// CreateCortexAlertsRequestValidationError is the validation error returned by
+// CreateCortexAlertsRequest.Validate if the designated constraints aren't met.
+type CreateCortexAlertsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateCortexAlertsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateCortexAlertsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateCortexAlertsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateCortexAlertsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateCortexAlertsRequestValidationError) ErrorName() string {
+	return "CreateCortexAlertsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateCortexAlertsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateCortexAlertsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateCortexAlertsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateCortexAlertsRequestValidationError{}
+
+// Validate checks the field values on CortexAlert with the rules defined in
+// the proto definition for this message. If any rules are violated, an error
+// is returned.
+func (m *CortexAlert) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	if v, ok := interface{}(m.GetAnnotations()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CortexAlertValidationError{
+				field:  "Annotations",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if v, ok := interface{}(m.GetLabels()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CortexAlertValidationError{
+				field:  "Labels",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Status
+
+	if v, ok := interface{}(m.GetStartsAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CortexAlertValidationError{
+				field:  "StartsAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	return nil
+}
+
+// CortexAlertValidationError is the validation error returned by
+// CortexAlert.Validate if the designated constraints aren't met.
+type CortexAlertValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CortexAlertValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CortexAlertValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CortexAlertValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CortexAlertValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CortexAlertValidationError) ErrorName() string { return "CortexAlertValidationError" }
+
+// Error satisfies the builtin error interface
+func (e CortexAlertValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCortexAlert.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CortexAlertValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CortexAlertValidationError{}
+
+// Validate checks the field values on Annotations with the rules defined in
+// the proto definition for this message. If any rules are violated, an error
+// is returned.
+func (m *Annotations) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	// no validation rules for MetricName
+
+	// no validation rules for MetricValue
+
+	// no validation rules for Resource
+
+	// no validation rules for Template
+
+	return nil
+}
+
+// AnnotationsValidationError is the validation error returned by
+// Annotations.Validate if the designated constraints aren't met.
+type AnnotationsValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AnnotationsValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AnnotationsValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AnnotationsValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AnnotationsValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AnnotationsValidationError) ErrorName() string { return "AnnotationsValidationError" }
+
+// Error satisfies the builtin error interface
+func (e AnnotationsValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAnnotations.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AnnotationsValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AnnotationsValidationError{}
+
+// Validate checks the field values on Labels with the rules defined in the
+// proto definition for this message. If any rules are violated, an error is returned.
+func (m *Labels) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	// no validation rules for Severity
+
+	return nil
+}
+
+// LabelsValidationError is the validation error returned by Labels.Validate if
+// the designated constraints aren't met.
+type LabelsValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e LabelsValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e LabelsValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e LabelsValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e LabelsValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e LabelsValidationError) ErrorName() string { return "LabelsValidationError" }
+
+// Error satisfies the builtin error interface
+func (e LabelsValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sLabels.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = LabelsValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = LabelsValidationError{}
+
+// Validate checks the field values on Rule with the rules defined in the proto
+// definition for this message. If any rules are violated, an error is returned.
+func (m *Rule) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	// no validation rules for Id
+
+	// no validation rules for Name
+
+	// no validation rules for Enabled
+
+	// no validation rules for GroupName
+
+	// no validation rules for Namespace
+
+	// no validation rules for Template
+
+	for idx, item := range m.GetVariables() {
+		_, _ = idx, item
+
+		if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return RuleValidationError{
+					field:  fmt.Sprintf("Variables[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return RuleValidationError{
+				field:  "CreatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if v, ok := interface{}(m.GetUpdatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return RuleValidationError{
+				field:  "UpdatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if m.GetProviderNamespace() < 0 {
+		return RuleValidationError{
+			field:  "ProviderNamespace",
+			reason: "value must be greater than or equal to 0",
+		}
+	}
+
+	// no validation rules for TemplateVersion
+
+	return nil
+}
+
+// RuleValidationError is the validation error returned by Rule.Validate if the
+// designated constraints aren't met.
+type RuleValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RuleValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RuleValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RuleValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RuleValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RuleValidationError) ErrorName() string { return "RuleValidationError" }
+
+// Error satisfies the builtin error interface
+func (e RuleValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRule.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RuleValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RuleValidationError{}
+
+// Validate checks the field values on Variables with the rules defined in the
+// proto definition for this message. If any rules are violated, an error is returned.
+func (m *Variables) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	if !_Variables_Name_Pattern.MatchString(m.GetName()) {
+		return VariablesValidationError{
+			field:  "Name",
+			reason: "value does not match regex pattern \"^[A-Za-z0-9_-]+$\"",
+		}
+	}
+
+	// no validation rules for Value
+
+	// no validation rules for Type
+
+	// no validation rules for Description
+
+	return nil
+}
+
+// VariablesValidationError is the validation error returned by
+// Variables.Validate if the designated constraints aren't met.
+type VariablesValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e VariablesValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e VariablesValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e VariablesValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e VariablesValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e VariablesValidationError) ErrorName() string { return "VariablesValidationError" }
+
+// Error satisfies the builtin error interface
+func (e VariablesValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sVariables.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = VariablesValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = VariablesValidationError{}
+
+var _Variables_Name_Pattern = regexp.MustCompile("^[A-Za-z0-9_-]+$")
+
+// Validate checks the field values on ListRulesRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, an
+// error is returned.
+func (m *ListRulesRequest) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	// no validation rules for Name
+
+	// no validation rules for Namespace
+
+	// no validation rules for GroupName
+
+	// no validation rules for Template
+
+	// no validation rules for ProviderNamespace
+
+	return nil
+}
+
+// ListRulesRequestValidationError is the validation error returned by
+// ListRulesRequest.Validate if the designated constraints aren't met.
+type ListRulesRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListRulesRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListRulesRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListRulesRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListRulesRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListRulesRequestValidationError) ErrorName() string { return "ListRulesRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ListRulesRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListRulesRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListRulesRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListRulesRequestValidationError{}
+
+// Validate checks the field values on ListRulesResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, an
+// error is returned.
+func (m *ListRulesResponse) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	for idx, item := range m.GetRules() {
+		_, _ = idx, item
+
+		if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListRulesResponseValidationError{
+					field:  fmt.Sprintf("Rules[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// ListRulesResponseValidationError is the validation error returned by
+// ListRulesResponse.Validate if the designated constraints aren't met.
+type ListRulesResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListRulesResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListRulesResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListRulesResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListRulesResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListRulesResponseValidationError) ErrorName() string {
+	return "ListRulesResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListRulesResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListRulesResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListRulesResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListRulesResponseValidationError{}
+
+// Validate checks the field values on RuleDiff with the rules defined in the
+// proto definition for this message. If any rules are violated, an error is returned.
+func (m *RuleDiff) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	// no validation rules for Name
+
+	// no validation rules for Current
+
+	// no validation rules for Desired
+
+	return nil
+}
+
+// RuleDiffValidationError is the validation error returned by
+// RuleDiff.Validate if the designated constraints aren't met.
+type RuleDiffValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RuleDiffValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RuleDiffValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RuleDiffValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RuleDiffValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RuleDiffValidationError) ErrorName() string { return "RuleDiffValidationError" }
+
+// Error satisfies the builtin error interface
+func (e RuleDiffValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRuleDiff.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RuleDiffValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RuleDiffValidationError{}
+
+// Validate checks the field values on RuleGroupDiff with the rules defined in
+// the proto definition for this message. If any rules are violated, an error
+// is returned.
+func (m *RuleGroupDiff) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	// no validation rules for Namespace
+
+	// no validation rules for GroupName
+
+	for idx, item := range m.GetAdded() {
+		_, _ = idx, item
+
+		if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return RuleGroupDiffValidationError{
+					field:  fmt.Sprintf("Added[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	for idx, item := range m.GetChanged() {
+		_, _ = idx, item
+
+		if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return RuleGroupDiffValidationError{
+					field:  fmt.Sprintf("Changed[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	for idx, item := range m.GetRemoved() {
+		_, _ = idx, item
+
+		if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return RuleGroupDiffValidationError{
+					field:  fmt.Sprintf("Removed[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// RuleGroupDiffValidationError is the validation error returned by
+// RuleGroupDiff.Validate if the designated constraints aren't met.
+type RuleGroupDiffValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RuleGroupDiffValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RuleGroupDiffValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RuleGroupDiffValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RuleGroupDiffValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RuleGroupDiffValidationError) ErrorName() string { return "RuleGroupDiffValidationError" }
+
+// Error satisfies the builtin error interface
+func (e RuleGroupDiffValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRuleGroupDiff.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RuleGroupDiffValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RuleGroupDiffValidationError{}
+
+// Validate checks the field values on UpdateRuleResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *UpdateRuleResponse) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	if v, ok := interface{}(m.GetRule()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpdateRuleResponseValidationError{
+				field:  "Rule",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if v, ok := interface{}(m.GetDiff()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpdateRuleResponseValidationError{
+				field:  "Diff",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	return nil
+}
+
+// UpdateRuleResponseValidationError is the validation error returned by
+// UpdateRuleResponse.Validate if the designated constraints aren't met.
+type UpdateRuleResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateRuleResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateRuleResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateRuleResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateRuleResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateRuleResponseValidationError) ErrorName() string {
+	return "UpdateRuleResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateRuleResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateRuleResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateRuleResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateRuleResponseValidationError{}
+
+// Validate checks the field values on UpdateRuleRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, an
+// error is returned.
+func (m *UpdateRuleRequest) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	// no validation rules for Enabled
+
+	if !_UpdateRuleRequest_GroupName_Pattern.MatchString(m.GetGroupName()) {
+		return UpdateRuleRequestValidationError{
+			field:  "GroupName",
+			reason: "value does not match regex pattern \"^[A-Za-z0-9_-]+$\"",
+		}
+	}
+
+	if !_UpdateRuleRequest_Namespace_Pattern.MatchString(m.GetNamespace()) {
+		return UpdateRuleRequestValidationError{
+			field:  "Namespace",
+			reason: "value does not match regex pattern \"^[A-Za-z0-9_-]+$\"",
+		}
+	}
+
+	if !_UpdateRuleRequest_Template_Pattern.MatchString(m.GetTemplate()) {
+		return UpdateRuleRequestValidationError{
+			field:  "Template",
+			reason: "value does not match regex pattern \"^[A-Za-z0-9_-]+$\"",
+		}
+	}
+
+	for idx, item := range m.GetVariables() {
+		_, _ = idx, item
+
+		if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return UpdateRuleRequestValidationError{
+					field:  fmt.Sprintf("Variables[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for ProviderNamespace
+
+	// no validation rules for DryRun
+
+	// no validation rules for TemplateVersion
+
+	return nil
+}
+
+// UpdateRuleRequestValidationError is the validation error returned by
+// UpdateRuleRequest.Validate if the designated constraints aren't met.
+type UpdateRuleRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateRuleRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateRuleRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateRuleRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateRuleRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
 func (e UpdateRuleRequestValidationError) ErrorName() string {
@@ -3729,7 +6019,2550 @@ func (e UpdateRuleRequestValidationError) ErrorName() string {
 }
 
 // Error satisfies the builtin error interface
-func (e UpdateRuleRequestValidationError) Error() string {
+func (e UpdateRuleRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateRuleRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateRuleRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateRuleRequestValidationError{}
+
+var _UpdateRuleRequest_GroupName_Pattern = regexp.MustCompile("^[A-Za-z0-9_-]+$")
+
+var _UpdateRuleRequest_Namespace_Pattern = regexp.MustCompile("^[A-Za-z0-9_-]+$")
+
+var _UpdateRuleRequest_Template_Pattern = regexp.MustCompile("^[A-Za-z0-9_-]+$")
+
+// Validate checks the field values on DeleteRuleRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, an
+// error is returned.
+func (m *DeleteRuleRequest) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	// no validation rules for Id
+
+	return nil
+}
+
+// DeleteRuleRequestValidationError is the validation error returned by
+// DeleteRuleRequest.Validate if the designated constraints aren't met.
+type DeleteRuleRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteRuleRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteRuleRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteRuleRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteRuleRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteRuleRequestValidationError) ErrorName() string {
+	return "DeleteRuleRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteRuleRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteRuleRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteRuleRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteRuleRequestValidationError{}
+
+// Validate checks the field values on RuleDrift with the rules defined in the
+// proto definition for this message. If any rules are violated, an error is returned.
+func (m *RuleDrift) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	// no validation rules for ProviderNamespace
+
+	if v, ok := interface{}(m.GetDiff()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return RuleDriftValidationError{
+				field:  "Diff",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Repaired
+
+	return nil
+}
+
+// RuleDriftValidationError is the validation error returned by
+// RuleDrift.Validate if the designated constraints aren't met.
+type RuleDriftValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RuleDriftValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RuleDriftValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RuleDriftValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RuleDriftValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RuleDriftValidationError) ErrorName() string { return "RuleDriftValidationError" }
+
+// Error satisfies the builtin error interface
+func (e RuleDriftValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRuleDrift.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RuleDriftValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RuleDriftValidationError{}
+
+// Validate checks the field values on GetRuleDriftResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *GetRuleDriftResponse) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	if v, ok := interface{}(m.GetCheckedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetRuleDriftResponseValidationError{
+				field:  "CheckedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	for idx, item := range m.GetDrifts() {
+		_, _ = idx, item
+
+		if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetRuleDriftResponseValidationError{
+					field:  fmt.Sprintf("Drifts[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for Errors
+
+	return nil
+}
+
+// GetRuleDriftResponseValidationError is the validation error returned by
+// GetRuleDriftResponse.Validate if the designated constraints aren't met.
+type GetRuleDriftResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetRuleDriftResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetRuleDriftResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetRuleDriftResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetRuleDriftResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetRuleDriftResponseValidationError) ErrorName() string {
+	return "GetRuleDriftResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetRuleDriftResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetRuleDriftResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetRuleDriftResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetRuleDriftResponseValidationError{}
+
+// Validate checks the field values on ImportRulesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *ImportRulesRequest) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	// no validation rules for ProviderNamespace
+
+	// no validation rules for CreateTemplates
+
+	// no validation rules for DryRun
+
+	return nil
+}
+
+// ImportRulesRequestValidationError is the validation error returned by
+// ImportRulesRequest.Validate if the designated constraints aren't met.
+type ImportRulesRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ImportRulesRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ImportRulesRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ImportRulesRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ImportRulesRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ImportRulesRequestValidationError) ErrorName() string {
+	return "ImportRulesRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ImportRulesRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sImportRulesRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ImportRulesRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ImportRulesRequestValidationError{}
+
+// Validate checks the field values on UnmappedRule with the rules defined in
+// the proto definition for this message. If any rules are violated, an error
+// is returned.
+func (m *UnmappedRule) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	// no validation rules for Namespace
+
+	// no validation rules for GroupName
+
+	// no validation rules for Name
+
+	// no validation rules for Reason
+
+	return nil
+}
+
+// UnmappedRuleValidationError is the validation error returned by
+// UnmappedRule.Validate if the designated constraints aren't met.
+type UnmappedRuleValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UnmappedRuleValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UnmappedRuleValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UnmappedRuleValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UnmappedRuleValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UnmappedRuleValidationError) ErrorName() string { return "UnmappedRuleValidationError" }
+
+// Error satisfies the builtin error interface
+func (e UnmappedRuleValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUnmappedRule.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UnmappedRuleValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UnmappedRuleValidationError{}
+
+// Validate checks the field values on ImportRulesResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *ImportRulesResponse) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	for idx, item := range m.GetRules() {
+		_, _ = idx, item
+
+		if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ImportRulesResponseValidationError{
+					field:  fmt.Sprintf("Rules[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	for idx, item := range m.GetCreatedTemplates() {
+		_, _ = idx, item
+
+		if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ImportRulesResponseValidationError{
+					field:  fmt.Sprintf("CreatedTemplates[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	for idx, item := range m.GetUnmapped() {
+		_, _ = idx, item
+
+		if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ImportRulesResponseValidationError{
+					field:  fmt.Sprintf("Unmapped[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// ImportRulesResponseValidationError is the validation error returned by
+// ImportRulesResponse.Validate if the designated constraints aren't met.
+type ImportRulesResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ImportRulesResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ImportRulesResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ImportRulesResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ImportRulesResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ImportRulesResponseValidationError) ErrorName() string {
+	return "ImportRulesResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ImportRulesResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sImportRulesResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ImportRulesResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ImportRulesResponseValidationError{}
+
+// Validate checks the field values on RuleRevision with the rules defined in
+// the proto definition for this message. If any rules are violated, an error
+// is returned.
+func (m *RuleRevision) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	// no validation rules for Id
+
+	// no validation rules for RuleId
+
+	// no validation rules for Enabled
+
+	for idx, item := range m.GetVariables() {
+		_, _ = idx, item
+
+		if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return RuleRevisionValidationError{
+					field:  fmt.Sprintf("Variables[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for TemplateHash
+
+	// no validation rules for Rendered
+
+	// no validation rules for Actor
+
+	if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return RuleRevisionValidationError{
+				field:  "CreatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for TemplateVersion
+
+	return nil
+}
+
+// RuleRevisionValidationError is the validation error returned by
+// RuleRevision.Validate if the designated constraints aren't met.
+type RuleRevisionValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RuleRevisionValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RuleRevisionValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RuleRevisionValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RuleRevisionValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RuleRevisionValidationError) ErrorName() string { return "RuleRevisionValidationError" }
+
+// Error satisfies the builtin error interface
+func (e RuleRevisionValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRuleRevision.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RuleRevisionValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RuleRevisionValidationError{}
+
+// Validate checks the field values on ListRuleRevisionsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *ListRuleRevisionsRequest) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	// no validation rules for Id
+
+	return nil
+}
+
+// ListRuleRevisionsRequestValidationError is the validation error returned by
+// ListRuleRevisionsRequest.Validate if the designated constraints aren't met.
+type ListRuleRevisionsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListRuleRevisionsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListRuleRevisionsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListRuleRevisionsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListRuleRevisionsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListRuleRevisionsRequestValidationError) ErrorName() string {
+	return "ListRuleRevisionsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListRuleRevisionsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListRuleRevisionsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListRuleRevisionsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListRuleRevisionsRequestValidationError{}
+
+// Validate checks the field values on ListRuleRevisionsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *ListRuleRevisionsResponse) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	for idx, item := range m.GetRevisions() {
+		_, _ = idx, item
+
+		if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListRuleRevisionsResponseValidationError{
+					field:  fmt.Sprintf("Revisions[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// ListRuleRevisionsResponseValidationError is the validation error returned by
+// ListRuleRevisionsResponse.Validate if the designated constraints aren't met.
+type ListRuleRevisionsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListRuleRevisionsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListRuleRevisionsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListRuleRevisionsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListRuleRevisionsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListRuleRevisionsResponseValidationError) ErrorName() string {
+	return "ListRuleRevisionsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListRuleRevisionsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListRuleRevisionsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListRuleRevisionsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListRuleRevisionsResponseValidationError{}
+
+// Validate checks the field values on RollbackRuleRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *RollbackRuleRequest) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	// no validation rules for Id
+
+	// no validation rules for RevisionId
+
+	return nil
+}
+
+// RollbackRuleRequestValidationError is the validation error returned by
+// RollbackRuleRequest.Validate if the designated constraints aren't met.
+type RollbackRuleRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RollbackRuleRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RollbackRuleRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RollbackRuleRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RollbackRuleRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RollbackRuleRequestValidationError) ErrorName() string {
+	return "RollbackRuleRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RollbackRuleRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRollbackRuleRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RollbackRuleRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RollbackRuleRequestValidationError{}
+
+// Validate checks the field values on RollbackRuleResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *RollbackRuleResponse) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	if v, ok := interface{}(m.GetRule()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return RollbackRuleResponseValidationError{
+				field:  "Rule",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	return nil
+}
+
+// RollbackRuleResponseValidationError is the validation error returned by
+// RollbackRuleResponse.Validate if the designated constraints aren't met.
+type RollbackRuleResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RollbackRuleResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RollbackRuleResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RollbackRuleResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RollbackRuleResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RollbackRuleResponseValidationError) ErrorName() string {
+	return "RollbackRuleResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RollbackRuleResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRollbackRuleResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RollbackRuleResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RollbackRuleResponseValidationError{}
+
+// Validate checks the field values on UpgradeRulesTemplateVersionRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, an error is returned.
+func (m *UpgradeRulesTemplateVersionRequest) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	if !_UpgradeRulesTemplateVersionRequest_Template_Pattern.MatchString(m.GetTemplate()) {
+		return UpgradeRulesTemplateVersionRequestValidationError{
+			field:  "Template",
+			reason: "value does not match regex pattern \"^[A-Za-z0-9_-]+$\"",
+		}
+	}
+
+	// no validation rules for FromVersion
+
+	// no validation rules for ToVersion
+
+	return nil
+}
+
+// UpgradeRulesTemplateVersionRequestValidationError is the validation error
+// returned by UpgradeRulesTemplateVersionRequest.Validate if the designated
+// constraints aren't met.
+type UpgradeRulesTemplateVersionRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpgradeRulesTemplateVersionRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpgradeRulesTemplateVersionRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpgradeRulesTemplateVersionRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpgradeRulesTemplateVersionRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpgradeRulesTemplateVersionRequestValidationError) ErrorName() string {
+	return "UpgradeRulesTemplateVersionRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpgradeRulesTemplateVersionRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpgradeRulesTemplateVersionRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpgradeRulesTemplateVersionRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpgradeRulesTemplateVersionRequestValidationError{}
+
+var _UpgradeRulesTemplateVersionRequest_Template_Pattern = regexp.MustCompile("^[A-Za-z0-9_-]+$")
+
+// Validate checks the field values on UpgradeRulesTemplateVersionResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, an error is returned.
+func (m *UpgradeRulesTemplateVersionResponse) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	for idx, item := range m.GetRules() {
+		_, _ = idx, item
+
+		if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return UpgradeRulesTemplateVersionResponseValidationError{
+					field:  fmt.Sprintf("Rules[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// UpgradeRulesTemplateVersionResponseValidationError is the validation error
+// returned by UpgradeRulesTemplateVersionResponse.Validate if the designated
+// constraints aren't met.
+type UpgradeRulesTemplateVersionResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpgradeRulesTemplateVersionResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpgradeRulesTemplateVersionResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpgradeRulesTemplateVersionResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpgradeRulesTemplateVersionResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpgradeRulesTemplateVersionResponseValidationError) ErrorName() string {
+	return "UpgradeRulesTemplateVersionResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpgradeRulesTemplateVersionResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpgradeRulesTemplateVersionResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpgradeRulesTemplateVersionResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpgradeRulesTemplateVersionResponseValidationError{}
+
+// Validate checks the field values on RuleGroup with the rules defined in the
+// proto definition for this message. If any rules are violated, an error is returned.
+func (m *RuleGroup) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	// no validation rules for Id
+
+	// no validation rules for ProviderNamespace
+
+	// no validation rules for Namespace
+
+	// no validation rules for GroupName
+
+	// no validation rules for Interval
+
+	// no validation rules for Limit
+
+	// no validation rules for SourceTenants
+
+	if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return RuleGroupValidationError{
+				field:  "CreatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if v, ok := interface{}(m.GetUpdatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return RuleGroupValidationError{
+				field:  "UpdatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	return nil
+}
+
+// RuleGroupValidationError is the validation error returned by
+// RuleGroup.Validate if the designated constraints aren't met.
+type RuleGroupValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RuleGroupValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RuleGroupValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RuleGroupValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RuleGroupValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RuleGroupValidationError) ErrorName() string { return "RuleGroupValidationError" }
+
+// Error satisfies the builtin error interface
+func (e RuleGroupValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRuleGroup.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RuleGroupValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RuleGroupValidationError{}
+
+// Validate checks the field values on GetRuleGroupRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *GetRuleGroupRequest) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	// no validation rules for ProviderNamespace
+
+	// no validation rules for Namespace
+
+	// no validation rules for GroupName
+
+	return nil
+}
+
+// GetRuleGroupRequestValidationError is the validation error returned by
+// GetRuleGroupRequest.Validate if the designated constraints aren't met.
+type GetRuleGroupRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetRuleGroupRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetRuleGroupRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetRuleGroupRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetRuleGroupRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetRuleGroupRequestValidationError) ErrorName() string {
+	return "GetRuleGroupRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetRuleGroupRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetRuleGroupRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetRuleGroupRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetRuleGroupRequestValidationError{}
+
+// Validate checks the field values on UpdateRuleGroupRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *UpdateRuleGroupRequest) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	// no validation rules for ProviderNamespace
+
+	if !_UpdateRuleGroupRequest_Namespace_Pattern.MatchString(m.GetNamespace()) {
+		return UpdateRuleGroupRequestValidationError{
+			field:  "Namespace",
+			reason: "value does not match regex pattern \"^[A-Za-z0-9_-]+$\"",
+		}
+	}
+
+	if !_UpdateRuleGroupRequest_GroupName_Pattern.MatchString(m.GetGroupName()) {
+		return UpdateRuleGroupRequestValidationError{
+			field:  "GroupName",
+			reason: "value does not match regex pattern \"^[A-Za-z0-9_-]+$\"",
+		}
+	}
+
+	// no validation rules for Interval
+
+	// no validation rules for Limit
+
+	// no validation rules for SourceTenants
+
+	return nil
+}
+
+// UpdateRuleGroupRequestValidationError is the validation error returned by
+// UpdateRuleGroupRequest.Validate if the designated constraints aren't met.
+type UpdateRuleGroupRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateRuleGroupRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateRuleGroupRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateRuleGroupRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateRuleGroupRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateRuleGroupRequestValidationError) ErrorName() string {
+	return "UpdateRuleGroupRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateRuleGroupRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateRuleGroupRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateRuleGroupRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateRuleGroupRequestValidationError{}
+
+var _UpdateRuleGroupRequest_Namespace_Pattern = regexp.MustCompile("^[A-Za-z0-9_-]+$")
+
+var _UpdateRuleGroupRequest_GroupName_Pattern = regexp.MustCompile("^[A-Za-z0-9_-]+$")
+
+// Validate checks the field values on ExportRulesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *ExportRulesRequest) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	// no validation rules for ProviderNamespace
+
+	// no validation rules for Namespace
+
+	// no validation rules for GroupName
+
+	return nil
+}
+
+// ExportRulesRequestValidationError is the validation error returned by
+// ExportRulesRequest.Validate if the designated constraints aren't met.
+type ExportRulesRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ExportRulesRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ExportRulesRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ExportRulesRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ExportRulesRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ExportRulesRequestValidationError) ErrorName() string {
+	return "ExportRulesRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ExportRulesRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sExportRulesRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ExportRulesRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ExportRulesRequestValidationError{}
+
+// Validate checks the field values on ExportedRuleGroup with the rules defined
+// in the proto definition for this message. If any rules are violated, an
+// error is returned.
+func (m *ExportedRuleGroup) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	if v, ok := interface{}(m.GetGroup()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ExportedRuleGroupValidationError{
+				field:  "Group",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	for idx, item := range m.GetRules() {
+		_, _ = idx, item
+
+		if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ExportedRuleGroupValidationError{
+					field:  fmt.Sprintf("Rules[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// ExportedRuleGroupValidationError is the validation error returned by
+// ExportedRuleGroup.Validate if the designated constraints aren't met.
+type ExportedRuleGroupValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ExportedRuleGroupValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ExportedRuleGroupValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ExportedRuleGroupValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ExportedRuleGroupValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ExportedRuleGroupValidationError) ErrorName() string {
+	return "ExportedRuleGroupValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ExportedRuleGroupValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sExportedRuleGroup.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ExportedRuleGroupValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ExportedRuleGroupValidationError{}
+
+// Validate checks the field values on ExportRulesResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *ExportRulesResponse) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	// no validation rules for Provider
+
+	// no validation rules for ProviderNamespace
+
+	for idx, item := range m.GetRuleGroups() {
+		_, _ = idx, item
+
+		if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ExportRulesResponseValidationError{
+					field:  fmt.Sprintf("RuleGroups[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// ExportRulesResponseValidationError is the validation error returned by
+// ExportRulesResponse.Validate if the designated constraints aren't met.
+type ExportRulesResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ExportRulesResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ExportRulesResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ExportRulesResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ExportRulesResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ExportRulesResponseValidationError) ErrorName() string {
+	return "ExportRulesResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ExportRulesResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sExportRulesResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ExportRulesResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ExportRulesResponseValidationError{}
+
+// Validate checks the field values on ListTemplatesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *ListTemplatesRequest) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	// no validation rules for Tag
+
+	// no validation rules for Tags
+
+	// no validation rules for MatchAllTags
+
+	// no validation rules for NamePrefix
+
+	// no validation rules for NameContains
+
+	if v, ok := interface{}(m.GetUpdatedSince()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ListTemplatesRequestValidationError{
+				field:  "UpdatedSince",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for PageSize
+
+	// no validation rules for PageToken
+
+	if _, ok := _ListTemplatesRequest_SortBy_InLookup[m.GetSortBy()]; !ok {
+		return ListTemplatesRequestValidationError{
+			field:  "SortBy",
+			reason: "value must be in list [ name created_at updated_at]",
+		}
+	}
+
+	if _, ok := _ListTemplatesRequest_SortOrder_InLookup[m.GetSortOrder()]; !ok {
+		return ListTemplatesRequestValidationError{
+			field:  "SortOrder",
+			reason: "value must be in list [ asc desc]",
+		}
+	}
+
+	return nil
+}
+
+// ListTemplatesRequestValidationError is the validation error returned by
+// ListTemplatesRequest.Validate if the designated constraints aren't met.
+type ListTemplatesRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListTemplatesRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListTemplatesRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListTemplatesRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListTemplatesRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListTemplatesRequestValidationError) ErrorName() string {
+	return "ListTemplatesRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListTemplatesRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListTemplatesRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListTemplatesRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListTemplatesRequestValidationError{}
+
+var _ListTemplatesRequest_SortBy_InLookup = map[string]struct{}{
+	"":           {},
+	"name":       {},
+	"created_at": {},
+	"updated_at": {},
+}
+
+var _ListTemplatesRequest_SortOrder_InLookup = map[string]struct{}{
+	"":     {},
+	"asc":  {},
+	"desc": {},
+}
+
+// Validate checks the field values on TemplateVariables with the rules defined
+// in the proto definition for this message. If any rules are violated, an
+// error is returned.
+func (m *TemplateVariables) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	if !_TemplateVariables_Name_Pattern.MatchString(m.GetName()) {
+		return TemplateVariablesValidationError{
+			field:  "Name",
+			reason: "value does not match regex pattern \"^[A-Za-z0-9_-]+$\"",
+		}
+	}
+
+	if !_TemplateVariables_Type_Pattern.MatchString(m.GetType()) {
+		return TemplateVariablesValidationError{
+			field:  "Type",
+			reason: "value does not match regex pattern \"^[A-Za-z0-9_-]+$\"",
+		}
+	}
+
+	// no validation rules for Default
+
+	// no validation rules for Description
+
+	// no validation rules for AllowedValues
+
+	// no validation rules for Pattern
+
+	// no validation rules for Min
+
+	// no validation rules for Max
+
+	// no validation rules for Required
+
+	return nil
+}
+
+// TemplateVariablesValidationError is the validation error returned by
+// TemplateVariables.Validate if the designated constraints aren't met.
+type TemplateVariablesValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e TemplateVariablesValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TemplateVariablesValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e TemplateVariablesValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TemplateVariablesValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TemplateVariablesValidationError) ErrorName() string {
+	return "TemplateVariablesValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e TemplateVariablesValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTemplateVariables.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = TemplateVariablesValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TemplateVariablesValidationError{}
+
+var _TemplateVariables_Name_Pattern = regexp.MustCompile("^[A-Za-z0-9_-]+$")
+
+var _TemplateVariables_Type_Pattern = regexp.MustCompile("^[A-Za-z0-9_-]+$")
+
+// Validate checks the field values on Template with the rules defined in the
+// proto definition for this message. If any rules are violated, an error is returned.
+func (m *Template) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	// no validation rules for Id
+
+	if !_Template_Name_Pattern.MatchString(m.GetName()) {
+		return TemplateValidationError{
+			field:  "Name",
+			reason: "value does not match regex pattern \"^[A-Za-z0-9_-]+$\"",
+		}
+	}
+
+	if !_Template_Body_Pattern.MatchString(m.GetBody()) {
+		return TemplateValidationError{
+			field:  "Body",
+			reason: "value does not match regex pattern \"^[A-Za-z0-9_-]+$\"",
+		}
+	}
+
+	if len(m.GetTags()) < 1 {
+		return TemplateValidationError{
+			field:  "Tags",
+			reason: "value must contain at least 1 item(s)",
+		}
+	}
+
+	if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return TemplateValidationError{
+				field:  "CreatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if v, ok := interface{}(m.GetUpdatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return TemplateValidationError{
+				field:  "UpdatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(m.GetVariables()) < 1 {
+		return TemplateValidationError{
+			field:  "Variables",
+			reason: "value must contain at least 1 item(s)",
+		}
+	}
+
+	for idx, item := range m.GetVariables() {
+		_, _ = idx, item
+
+		if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return TemplateValidationError{
+					field:  fmt.Sprintf("Variables[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for Version
+
+	return nil
+}
+
+// TemplateValidationError is the validation error returned by
+// Template.Validate if the designated constraints aren't met.
+type TemplateValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e TemplateValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TemplateValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e TemplateValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TemplateValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TemplateValidationError) ErrorName() string { return "TemplateValidationError" }
+
+// Error satisfies the builtin error interface
+func (e TemplateValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTemplate.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = TemplateValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TemplateValidationError{}
+
+var _Template_Name_Pattern = regexp.MustCompile("^[A-Za-z0-9_-]+$")
+
+var _Template_Body_Pattern = regexp.MustCompile("^[A-Za-z0-9_-]+$")
+
+// Validate checks the field values on TemplateResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, an
+// error is returned.
+func (m *TemplateResponse) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	if v, ok := interface{}(m.GetTemplate()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return TemplateResponseValidationError{
+				field:  "Template",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	for idx, item := range m.GetRuleGroups() {
+		_, _ = idx, item
+
+		if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return TemplateResponseValidationError{
+					field:  fmt.Sprintf("RuleGroups[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// TemplateResponseValidationError is the validation error returned by
+// TemplateResponse.Validate if the designated constraints aren't met.
+type TemplateResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e TemplateResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TemplateResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e TemplateResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TemplateResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TemplateResponseValidationError) ErrorName() string { return "TemplateResponseValidationError" }
+
+// Error satisfies the builtin error interface
+func (e TemplateResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTemplateResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = TemplateResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TemplateResponseValidationError{}
+
+// Validate checks the field values on RuleGroupSync with the rules defined in
+// the proto definition for this message. If any rules are violated, an error
+// is returned.
+func (m *RuleGroupSync) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	// no validation rules for ProviderNamespace
+
+	// no validation rules for Namespace
+
+	// no validation rules for GroupName
+
+	// no validation rules for Success
+
+	// no validation rules for Error
+
+	if v, ok := interface{}(m.GetDiff()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return RuleGroupSyncValidationError{
+				field:  "Diff",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	return nil
+}
+
+// RuleGroupSyncValidationError is the validation error returned by
+// RuleGroupSync.Validate if the designated constraints aren't met.
+type RuleGroupSyncValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RuleGroupSyncValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RuleGroupSyncValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RuleGroupSyncValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RuleGroupSyncValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RuleGroupSyncValidationError) ErrorName() string { return "RuleGroupSyncValidationError" }
+
+// Error satisfies the builtin error interface
+func (e RuleGroupSyncValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRuleGroupSync.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RuleGroupSyncValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RuleGroupSyncValidationError{}
+
+// Validate checks the field values on UpsertTemplateRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *UpsertTemplateRequest) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	// no validation rules for Id
+
+	if !_UpsertTemplateRequest_Name_Pattern.MatchString(m.GetName()) {
+		return UpsertTemplateRequestValidationError{
+			field:  "Name",
+			reason: "value does not match regex pattern \"^[A-Za-z0-9_-]+$\"",
+		}
+	}
+
+	// no validation rules for Body
+
+	if len(m.GetTags()) < 1 {
+		return UpsertTemplateRequestValidationError{
+			field:  "Tags",
+			reason: "value must contain at least 1 item(s)",
+		}
+	}
+
+	if len(m.GetVariables()) < 1 {
+		return UpsertTemplateRequestValidationError{
+			field:  "Variables",
+			reason: "value must contain at least 1 item(s)",
+		}
+	}
+
+	for idx, item := range m.GetVariables() {
+		_, _ = idx, item
+
+		if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return UpsertTemplateRequestValidationError{
+					field:  fmt.Sprintf("Variables[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for DryRun
+
+	return nil
+}
+
+// UpsertTemplateRequestValidationError is the validation error returned by
+// UpsertTemplateRequest.Validate if the designated constraints aren't met.
+type UpsertTemplateRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpsertTemplateRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpsertTemplateRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpsertTemplateRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpsertTemplateRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpsertTemplateRequestValidationError) ErrorName() string {
+	return "UpsertTemplateRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpsertTemplateRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpsertTemplateRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpsertTemplateRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpsertTemplateRequestValidationError{}
+
+var _UpsertTemplateRequest_Name_Pattern = regexp.MustCompile("^[A-Za-z0-9_-]+$")
+
+// Validate checks the field values on ListTemplatesResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *ListTemplatesResponse) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	for idx, item := range m.GetTemplates() {
+		_, _ = idx, item
+
+		if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListTemplatesResponseValidationError{
+					field:  fmt.Sprintf("Templates[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for NextPageToken
+
+	return nil
+}
+
+// ListTemplatesResponseValidationError is the validation error returned by
+// ListTemplatesResponse.Validate if the designated constraints aren't met.
+type ListTemplatesResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListTemplatesResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListTemplatesResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListTemplatesResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListTemplatesResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListTemplatesResponseValidationError) ErrorName() string {
+	return "ListTemplatesResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListTemplatesResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListTemplatesResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListTemplatesResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListTemplatesResponseValidationError{}
+
+// Validate checks the field values on GetTemplateByNameRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *GetTemplateByNameRequest) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	if !_GetTemplateByNameRequest_Name_Pattern.MatchString(m.GetName()) {
+		return GetTemplateByNameRequestValidationError{
+			field:  "Name",
+			reason: "value does not match regex pattern \"^[A-Za-z0-9_-]+$\"",
+		}
+	}
+
+	return nil
+}
+
+// GetTemplateByNameRequestValidationError is the validation error returned by
+// GetTemplateByNameRequest.Validate if the designated constraints aren't met.
+type GetTemplateByNameRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetTemplateByNameRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetTemplateByNameRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetTemplateByNameRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetTemplateByNameRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetTemplateByNameRequestValidationError) ErrorName() string {
+	return "GetTemplateByNameRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetTemplateByNameRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetTemplateByNameRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetTemplateByNameRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetTemplateByNameRequestValidationError{}
+
+var _GetTemplateByNameRequest_Name_Pattern = regexp.MustCompile("^[A-Za-z0-9_-]+$")
+
+// Validate checks the field values on ListTemplateVersionsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *ListTemplateVersionsRequest) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	if !_ListTemplateVersionsRequest_Name_Pattern.MatchString(m.GetName()) {
+		return ListTemplateVersionsRequestValidationError{
+			field:  "Name",
+			reason: "value does not match regex pattern \"^[A-Za-z0-9_-]+$\"",
+		}
+	}
+
+	return nil
+}
+
+// ListTemplateVersionsRequestValidationError is the validation error returned
+// by ListTemplateVersionsRequest.Validate if the designated constraints aren't met.
+type ListTemplateVersionsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListTemplateVersionsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListTemplateVersionsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListTemplateVersionsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListTemplateVersionsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListTemplateVersionsRequestValidationError) ErrorName() string {
+	return "ListTemplateVersionsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListTemplateVersionsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListTemplateVersionsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListTemplateVersionsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListTemplateVersionsRequestValidationError{}
+
+var _ListTemplateVersionsRequest_Name_Pattern = regexp.MustCompile("^[A-Za-z0-9_-]+$")
+
+// Validate checks the field values on ListTemplateVersionsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *ListTemplateVersionsResponse) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	for idx, item := range m.GetVersions() {
+		_, _ = idx, item
+
+		if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListTemplateVersionsResponseValidationError{
+					field:  fmt.Sprintf("Versions[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// ListTemplateVersionsResponseValidationError is the validation error returned
+// by ListTemplateVersionsResponse.Validate if the designated constraints aren't met.
+type ListTemplateVersionsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListTemplateVersionsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListTemplateVersionsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListTemplateVersionsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListTemplateVersionsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListTemplateVersionsResponseValidationError) ErrorName() string {
+	return "ListTemplateVersionsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListTemplateVersionsResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -3741,14 +8574,14 @@ func (e UpdateRuleRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sUpdateRuleRequest.%s: %s%s",
+		"invalid %sListTemplateVersionsResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = UpdateRuleRequestValidationError{}
+var _ error = ListTemplateVersionsResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -3756,30 +8589,26 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = UpdateRuleRequestValidationError{}
-
-var _UpdateRuleRequest_GroupName_Pattern = regexp.MustCompile("^[A-Za-z0-9_-]+$")
-
-var _UpdateRuleRequest_Namespace_Pattern = regexp.MustCompile("^[A-Za-z0-9_-]+$")
-
-var _UpdateRuleRequest_Template_Pattern = regexp.MustCompile("^[A-Za-z0-9_-]+$")
+} = ListTemplateVersionsResponseValidationError{}
 
-// Validate checks the field values on ListTemplatesRequest with the rules
+// Validate checks the field values on DeleteTemplateRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, an error is returned.
-func (m *ListTemplatesRequest) Validate() error {
+func (m *DeleteTemplateRequest) Validate() error {
 	if m == nil {
 		return nil
 	}
 
-	// no validation rules for Tag
+	// no validation rules for Name
+
+	// no validation rules for Force
 
 	return nil
 }
 
-// ListTemplatesRequestValidationError is the validation error returned by
-// ListTemplatesRequest.Validate if the designated constraints aren't met.
-type ListTemplatesRequestValidationError struct {
+// DeleteTemplateRequestValidationError is the validation error returned by
+// DeleteTemplateRequest.Validate if the designated constraints aren't met.
+type DeleteTemplateRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -3787,24 +8616,24 @@ type ListTemplatesRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e ListTemplatesRequestValidationError) Field() string { return e.field }
+func (e DeleteTemplateRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ListTemplatesRequestValidationError) Reason() string { return e.reason }
+func (e DeleteTemplateRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ListTemplatesRequestValidationError) Cause() error { return e.cause }
+func (e DeleteTemplateRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ListTemplatesRequestValidationError) Key() bool { return e.key }
+func (e DeleteTemplateRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ListTemplatesRequestValidationError) ErrorName() string {
-	return "ListTemplatesRequestValidationError"
+func (e DeleteTemplateRequestValidationError) ErrorName() string {
+	return "DeleteTemplateRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ListTemplatesRequestValidationError) Error() string {
+func (e DeleteTemplateRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -3816,14 +8645,14 @@ func (e ListTemplatesRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sListTemplatesRequest.%s: %s%s",
+		"invalid %sDeleteTemplateRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ListTemplatesRequestValidationError{}
+var _ error = DeleteTemplateRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -3831,40 +8660,37 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ListTemplatesRequestValidationError{}
+} = DeleteTemplateRequestValidationError{}
 
-// Validate checks the field values on TemplateVariables with the rules defined
-// in the proto definition for this message. If any rules are violated, an
-// error is returned.
-func (m *TemplateVariables) Validate() error {
+// Validate checks the field values on DeleteTemplateResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *DeleteTemplateResponse) Validate() error {
 	if m == nil {
 		return nil
 	}
 
-	if !_TemplateVariables_Name_Pattern.MatchString(m.GetName()) {
-		return TemplateVariablesValidationError{
-			field:  "Name",
-			reason: "value does not match regex pattern \"^[A-Za-z0-9_-]+$\"",
-		}
-	}
+	for idx, item := range m.GetRemovedRules() {
+		_, _ = idx, item
 
-	if !_TemplateVariables_Type_Pattern.MatchString(m.GetType()) {
-		return TemplateVariablesValidationError{
-			field:  "Type",
-			reason: "value does not match regex pattern \"^[A-Za-z0-9_-]+$\"",
+		if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return DeleteTemplateResponseValidationError{
+					field:  fmt.Sprintf("RemovedRules[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
 		}
-	}
-
-	// no validation rules for Default
 
-	// no validation rules for Description
+	}
 
 	return nil
 }
 
-// TemplateVariablesValidationError is the validation error returned by
-// TemplateVariables.Validate if the designated constraints aren't met.
-type TemplateVariablesValidationError struct {
+// DeleteTemplateResponseValidationError is the validation error returned by
+// DeleteTemplateResponse.Validate if the designated constraints aren't met.
+type DeleteTemplateResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -3872,24 +8698,24 @@ type TemplateVariablesValidationError struct {
 }
 
 // Field function returns field value.
-func (e TemplateVariablesValidationError) Field() string { return e.field }
+func (e DeleteTemplateResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e TemplateVariablesValidationError) Reason() string { return e.reason }
+func (e DeleteTemplateResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e TemplateVariablesValidationError) Cause() error { return e.cause }
+func (e DeleteTemplateResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e TemplateVariablesValidationError) Key() bool { return e.key }
+func (e DeleteTemplateResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e TemplateVariablesValidationError) ErrorName() string {
-	return "TemplateVariablesValidationError"
+func (e DeleteTemplateResponseValidationError) ErrorName() string {
+	return "DeleteTemplateResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e TemplateVariablesValidationError) Error() string {
+func (e DeleteTemplateResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -3901,14 +8727,14 @@ func (e TemplateVariablesValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sTemplateVariables.%s: %s%s",
+		"invalid %sDeleteTemplateResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = TemplateVariablesValidationError{}
+var _ error = DeleteTemplateResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -3916,90 +8742,29 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = TemplateVariablesValidationError{}
-
-var _TemplateVariables_Name_Pattern = regexp.MustCompile("^[A-Za-z0-9_-]+$")
-
-var _TemplateVariables_Type_Pattern = regexp.MustCompile("^[A-Za-z0-9_-]+$")
+} = DeleteTemplateResponseValidationError{}
 
-// Validate checks the field values on Template with the rules defined in the
-// proto definition for this message. If any rules are violated, an error is returned.
-func (m *Template) Validate() error {
+// Validate checks the field values on GetTemplateUsageRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *GetTemplateUsageRequest) Validate() error {
 	if m == nil {
 		return nil
 	}
 
-	// no validation rules for Id
-
-	if !_Template_Name_Pattern.MatchString(m.GetName()) {
-		return TemplateValidationError{
+	if !_GetTemplateUsageRequest_Name_Pattern.MatchString(m.GetName()) {
+		return GetTemplateUsageRequestValidationError{
 			field:  "Name",
 			reason: "value does not match regex pattern \"^[A-Za-z0-9_-]+$\"",
 		}
 	}
 
-	if !_Template_Body_Pattern.MatchString(m.GetBody()) {
-		return TemplateValidationError{
-			field:  "Body",
-			reason: "value does not match regex pattern \"^[A-Za-z0-9_-]+$\"",
-		}
-	}
-
-	if len(m.GetTags()) < 1 {
-		return TemplateValidationError{
-			field:  "Tags",
-			reason: "value must contain at least 1 item(s)",
-		}
-	}
-
-	if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return TemplateValidationError{
-				field:  "CreatedAt",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
-
-	if v, ok := interface{}(m.GetUpdatedAt()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return TemplateValidationError{
-				field:  "UpdatedAt",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
-
-	if len(m.GetVariables()) < 1 {
-		return TemplateValidationError{
-			field:  "Variables",
-			reason: "value must contain at least 1 item(s)",
-		}
-	}
-
-	for idx, item := range m.GetVariables() {
-		_, _ = idx, item
-
-		if v, ok := interface{}(item).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return TemplateValidationError{
-					field:  fmt.Sprintf("Variables[%v]", idx),
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
-		}
-
-	}
-
 	return nil
 }
 
-// TemplateValidationError is the validation error returned by
-// Template.Validate if the designated constraints aren't met.
-type TemplateValidationError struct {
+// GetTemplateUsageRequestValidationError is the validation error returned by
+// GetTemplateUsageRequest.Validate if the designated constraints aren't met.
+type GetTemplateUsageRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -4007,22 +8772,24 @@ type TemplateValidationError struct {
 }
 
 // Field function returns field value.
-func (e TemplateValidationError) Field() string { return e.field }
+func (e GetTemplateUsageRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e TemplateValidationError) Reason() string { return e.reason }
+func (e GetTemplateUsageRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e TemplateValidationError) Cause() error { return e.cause }
+func (e GetTemplateUsageRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e TemplateValidationError) Key() bool { return e.key }
+func (e GetTemplateUsageRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e TemplateValidationError) ErrorName() string { return "TemplateValidationError" }
+func (e GetTemplateUsageRequestValidationError) ErrorName() string {
+	return "GetTemplateUsageRequestValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e TemplateValidationError) Error() string {
+func (e GetTemplateUsageRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -4034,14 +8801,14 @@ func (e TemplateValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sTemplate.%s: %s%s",
+		"invalid %sGetTemplateUsageRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = TemplateValidationError{}
+var _ error = GetTemplateUsageRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -4049,36 +8816,42 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = TemplateValidationError{}
-
-var _Template_Name_Pattern = regexp.MustCompile("^[A-Za-z0-9_-]+$")
+} = GetTemplateUsageRequestValidationError{}
 
-var _Template_Body_Pattern = regexp.MustCompile("^[A-Za-z0-9_-]+$")
+var _GetTemplateUsageRequest_Name_Pattern = regexp.MustCompile("^[A-Za-z0-9_-]+$")
 
-// Validate checks the field values on TemplateResponse with the rules defined
+// Validate checks the field values on TemplateRuleUsage with the rules defined
 // in the proto definition for this message. If any rules are violated, an
 // error is returned.
-func (m *TemplateResponse) Validate() error {
+func (m *TemplateRuleUsage) Validate() error {
 	if m == nil {
 		return nil
 	}
 
-	if v, ok := interface{}(m.GetTemplate()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return TemplateResponseValidationError{
-				field:  "Template",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
+	// no validation rules for Id
+
+	// no validation rules for Name
+
+	// no validation rules for Namespace
+
+	// no validation rules for GroupName
+
+	// no validation rules for TemplateVersion
+
+	// no validation rules for Enabled
+
+	// no validation rules for ProviderNamespace
+
+	// no validation rules for NamespaceUrn
+
+	// no validation rules for ProviderUrn
 
 	return nil
 }
 
-// TemplateResponseValidationError is the validation error returned by
-// TemplateResponse.Validate if the designated constraints aren't met.
-type TemplateResponseValidationError struct {
+// TemplateRuleUsageValidationError is the validation error returned by
+// TemplateRuleUsage.Validate if the designated constraints aren't met.
+type TemplateRuleUsageValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -4086,22 +8859,24 @@ type TemplateResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e TemplateResponseValidationError) Field() string { return e.field }
+func (e TemplateRuleUsageValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e TemplateResponseValidationError) Reason() string { return e.reason }
+func (e TemplateRuleUsageValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e TemplateResponseValidationError) Cause() error { return e.cause }
+func (e TemplateRuleUsageValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e TemplateResponseValidationError) Key() bool { return e.key }
+func (e TemplateRuleUsageValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e TemplateResponseValidationError) ErrorName() string { return "TemplateResponseValidationError" }
+func (e TemplateRuleUsageValidationError) ErrorName() string {
+	return "TemplateRuleUsageValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e TemplateResponseValidationError) Error() string {
+func (e TemplateRuleUsageValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -4113,63 +8888,40 @@ func (e TemplateResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sTemplateResponse.%s: %s%s",
+		"invalid %sTemplateRuleUsage.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = TemplateResponseValidationError{}
-
-var _ interface {
-	Field() string
-	Reason() string
-	Key() bool
-	Cause() error
-	ErrorName() string
-} = TemplateResponseValidationError{}
-
-// Validate checks the field values on UpsertTemplateRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, an error is returned.
-func (m *UpsertTemplateRequest) Validate() error {
-	if m == nil {
-		return nil
-	}
-
-	// no validation rules for Id
-
-	if !_UpsertTemplateRequest_Name_Pattern.MatchString(m.GetName()) {
-		return UpsertTemplateRequestValidationError{
-			field:  "Name",
-			reason: "value does not match regex pattern \"^[A-Za-z0-9_-]+$\"",
-		}
-	}
+var _ error = TemplateRuleUsageValidationError{}
 
-	// no validation rules for Body
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TemplateRuleUsageValidationError{}
 
-	if len(m.GetTags()) < 1 {
-		return UpsertTemplateRequestValidationError{
-			field:  "Tags",
-			reason: "value must contain at least 1 item(s)",
-		}
+// Validate checks the field values on TemplateUsage with the rules defined in
+// the proto definition for this message. If any rules are violated, an error
+// is returned.
+func (m *TemplateUsage) Validate() error {
+	if m == nil {
+		return nil
 	}
 
-	if len(m.GetVariables()) < 1 {
-		return UpsertTemplateRequestValidationError{
-			field:  "Variables",
-			reason: "value must contain at least 1 item(s)",
-		}
-	}
+	// no validation rules for Template
 
-	for idx, item := range m.GetVariables() {
+	for idx, item := range m.GetRules() {
 		_, _ = idx, item
 
 		if v, ok := interface{}(item).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				return UpsertTemplateRequestValidationError{
-					field:  fmt.Sprintf("Variables[%v]", idx),
+				return TemplateUsageValidationError{
+					field:  fmt.Sprintf("Rules[%v]", idx),
 					reason: "embedded message failed validation",
 					cause:  err,
 				}
@@ -4178,12 +8930,16 @@ func (m *UpsertTemplateRequest) Validate() error {
 
 	}
 
+	// no validation rules for Namespaces
+
+	// no validation rules for Providers
+
 	return nil
 }
 
-// UpsertTemplateRequestValidationError is the validation error returned by
-// UpsertTemplateRequest.Validate if the designated constraints aren't met.
-type UpsertTemplateRequestValidationError struct {
+// TemplateUsageValidationError is the validation error returned by
+// TemplateUsage.Validate if the designated constraints aren't met.
+type TemplateUsageValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -4191,24 +8947,22 @@ type UpsertTemplateRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e UpsertTemplateRequestValidationError) Field() string { return e.field }
+func (e TemplateUsageValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e UpsertTemplateRequestValidationError) Reason() string { return e.reason }
+func (e TemplateUsageValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e UpsertTemplateRequestValidationError) Cause() error { return e.cause }
+func (e TemplateUsageValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e UpsertTemplateRequestValidationError) Key() bool { return e.key }
+func (e TemplateUsageValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e UpsertTemplateRequestValidationError) ErrorName() string {
-	return "UpsertTemplateRequestValidationError"
-}
+func (e TemplateUsageValidationError) ErrorName() string { return "TemplateUsageValidationError" }
 
 // Error satisfies the builtin error interface
-func (e UpsertTemplateRequestValidationError) Error() string {
+func (e TemplateUsageValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -4220,14 +8974,14 @@ func (e UpsertTemplateRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sUpsertTemplateRequest.%s: %s%s",
+		"invalid %sTemplateUsage.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = UpsertTemplateRequestValidationError{}
+var _ error = TemplateUsageValidationError{}
 
 var _ interface {
 	Field() string
@@ -4235,39 +8989,31 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = UpsertTemplateRequestValidationError{}
-
-var _UpsertTemplateRequest_Name_Pattern = regexp.MustCompile("^[A-Za-z0-9_-]+$")
+} = TemplateUsageValidationError{}
 
-// Validate checks the field values on ListTemplatesResponse with the rules
+// Validate checks the field values on RenderTemplateRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, an error is returned.
-func (m *ListTemplatesResponse) Validate() error {
+func (m *RenderTemplateRequest) Validate() error {
 	if m == nil {
 		return nil
 	}
 
-	for idx, item := range m.GetTemplates() {
-		_, _ = idx, item
-
-		if v, ok := interface{}(item).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return ListTemplatesResponseValidationError{
-					field:  fmt.Sprintf("Templates[%v]", idx),
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
+	if !_RenderTemplateRequest_Name_Pattern.MatchString(m.GetName()) {
+		return RenderTemplateRequestValidationError{
+			field:  "Name",
+			reason: "value does not match regex pattern \"^[A-Za-z0-9_-]+$\"",
 		}
-
 	}
 
+	// no validation rules for Variables
+
 	return nil
 }
 
-// ListTemplatesResponseValidationError is the validation error returned by
-// ListTemplatesResponse.Validate if the designated constraints aren't met.
-type ListTemplatesResponseValidationError struct {
+// RenderTemplateRequestValidationError is the validation error returned by
+// RenderTemplateRequest.Validate if the designated constraints aren't met.
+type RenderTemplateRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -4275,24 +9021,24 @@ type ListTemplatesResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e ListTemplatesResponseValidationError) Field() string { return e.field }
+func (e RenderTemplateRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ListTemplatesResponseValidationError) Reason() string { return e.reason }
+func (e RenderTemplateRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ListTemplatesResponseValidationError) Cause() error { return e.cause }
+func (e RenderTemplateRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ListTemplatesResponseValidationError) Key() bool { return e.key }
+func (e RenderTemplateRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ListTemplatesResponseValidationError) ErrorName() string {
-	return "ListTemplatesResponseValidationError"
+func (e RenderTemplateRequestValidationError) ErrorName() string {
+	return "RenderTemplateRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ListTemplatesResponseValidationError) Error() string {
+func (e RenderTemplateRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -4304,14 +9050,14 @@ func (e ListTemplatesResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sListTemplatesResponse.%s: %s%s",
+		"invalid %sRenderTemplateRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ListTemplatesResponseValidationError{}
+var _ error = RenderTemplateRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -4319,29 +9065,26 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ListTemplatesResponseValidationError{}
+} = RenderTemplateRequestValidationError{}
 
-// Validate checks the field values on GetTemplateByNameRequest with the rules
+var _RenderTemplateRequest_Name_Pattern = regexp.MustCompile("^[A-Za-z0-9_-]+$")
+
+// Validate checks the field values on RenderTemplateResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, an error is returned.
-func (m *GetTemplateByNameRequest) Validate() error {
+func (m *RenderTemplateResponse) Validate() error {
 	if m == nil {
 		return nil
 	}
 
-	if !_GetTemplateByNameRequest_Name_Pattern.MatchString(m.GetName()) {
-		return GetTemplateByNameRequestValidationError{
-			field:  "Name",
-			reason: "value does not match regex pattern \"^[A-Za-z0-9_-]+$\"",
-		}
-	}
+	// no validation rules for Body
 
 	return nil
 }
 
-// GetTemplateByNameRequestValidationError is the validation error returned by
-// GetTemplateByNameRequest.Validate if the designated constraints aren't met.
-type GetTemplateByNameRequestValidationError struct {
+// RenderTemplateResponseValidationError is the validation error returned by
+// RenderTemplateResponse.Validate if the designated constraints aren't met.
+type RenderTemplateResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -4349,24 +9092,24 @@ type GetTemplateByNameRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetTemplateByNameRequestValidationError) Field() string { return e.field }
+func (e RenderTemplateResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetTemplateByNameRequestValidationError) Reason() string { return e.reason }
+func (e RenderTemplateResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetTemplateByNameRequestValidationError) Cause() error { return e.cause }
+func (e RenderTemplateResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetTemplateByNameRequestValidationError) Key() bool { return e.key }
+func (e RenderTemplateResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetTemplateByNameRequestValidationError) ErrorName() string {
-	return "GetTemplateByNameRequestValidationError"
+func (e RenderTemplateResponseValidationError) ErrorName() string {
+	return "RenderTemplateResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GetTemplateByNameRequestValidationError) Error() string {
+func (e RenderTemplateResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -4378,14 +9121,14 @@ func (e GetTemplateByNameRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetTemplateByNameRequest.%s: %s%s",
+		"invalid %sRenderTemplateResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetTemplateByNameRequestValidationError{}
+var _ error = RenderTemplateResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -4393,26 +9136,53 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetTemplateByNameRequestValidationError{}
-
-var _GetTemplateByNameRequest_Name_Pattern = regexp.MustCompile("^[A-Za-z0-9_-]+$")
+} = RenderTemplateResponseValidationError{}
 
-// Validate checks the field values on DeleteTemplateRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, an error is returned.
-func (m *DeleteTemplateRequest) Validate() error {
+// Validate checks the field values on TemplatePartial with the rules defined
+// in the proto definition for this message. If any rules are violated, an
+// error is returned.
+func (m *TemplatePartial) Validate() error {
 	if m == nil {
 		return nil
 	}
 
-	// no validation rules for Name
+	// no validation rules for Id
+
+	if !_TemplatePartial_Name_Pattern.MatchString(m.GetName()) {
+		return TemplatePartialValidationError{
+			field:  "Name",
+			reason: "value does not match regex pattern \"^[A-Za-z0-9_-]+$\"",
+		}
+	}
+
+	// no validation rules for Body
+
+	if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return TemplatePartialValidationError{
+				field:  "CreatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if v, ok := interface{}(m.GetUpdatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return TemplatePartialValidationError{
+				field:  "UpdatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
 
 	return nil
 }
 
-// DeleteTemplateRequestValidationError is the validation error returned by
-// DeleteTemplateRequest.Validate if the designated constraints aren't met.
-type DeleteTemplateRequestValidationError struct {
+// TemplatePartialValidationError is the validation error returned by
+// TemplatePartial.Validate if the designated constraints aren't met.
+type TemplatePartialValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -4420,24 +9190,22 @@ type DeleteTemplateRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e DeleteTemplateRequestValidationError) Field() string { return e.field }
+func (e TemplatePartialValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e DeleteTemplateRequestValidationError) Reason() string { return e.reason }
+func (e TemplatePartialValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e DeleteTemplateRequestValidationError) Cause() error { return e.cause }
+func (e TemplatePartialValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e DeleteTemplateRequestValidationError) Key() bool { return e.key }
+func (e TemplatePartialValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e DeleteTemplateRequestValidationError) ErrorName() string {
-	return "DeleteTemplateRequestValidationError"
-}
+func (e TemplatePartialValidationError) ErrorName() string { return "TemplatePartialValidationError" }
 
 // Error satisfies the builtin error interface
-func (e DeleteTemplateRequestValidationError) Error() string {
+func (e TemplatePartialValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -4449,14 +9217,14 @@ func (e DeleteTemplateRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sDeleteTemplateRequest.%s: %s%s",
+		"invalid %sTemplatePartial.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = DeleteTemplateRequestValidationError{}
+var _ error = TemplatePartialValidationError{}
 
 var _ interface {
 	Field() string
@@ -4464,22 +9232,39 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = DeleteTemplateRequestValidationError{}
+} = TemplatePartialValidationError{}
 
-// Validate checks the field values on DeleteTemplateResponse with the rules
-// defined in the proto definition for this message. If any rules are
+var _TemplatePartial_Name_Pattern = regexp.MustCompile("^[A-Za-z0-9_-]+$")
+
+// Validate checks the field values on ListTemplatePartialsResponse with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, an error is returned.
-func (m *DeleteTemplateResponse) Validate() error {
+func (m *ListTemplatePartialsResponse) Validate() error {
 	if m == nil {
 		return nil
 	}
 
+	for idx, item := range m.GetPartials() {
+		_, _ = idx, item
+
+		if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListTemplatePartialsResponseValidationError{
+					field:  fmt.Sprintf("Partials[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
 	return nil
 }
 
-// DeleteTemplateResponseValidationError is the validation error returned by
-// DeleteTemplateResponse.Validate if the designated constraints aren't met.
-type DeleteTemplateResponseValidationError struct {
+// ListTemplatePartialsResponseValidationError is the validation error returned
+// by ListTemplatePartialsResponse.Validate if the designated constraints aren't met.
+type ListTemplatePartialsResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -4487,24 +9272,24 @@ type DeleteTemplateResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e DeleteTemplateResponseValidationError) Field() string { return e.field }
+func (e ListTemplatePartialsResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e DeleteTemplateResponseValidationError) Reason() string { return e.reason }
+func (e ListTemplatePartialsResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e DeleteTemplateResponseValidationError) Cause() error { return e.cause }
+func (e ListTemplatePartialsResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e DeleteTemplateResponseValidationError) Key() bool { return e.key }
+func (e ListTemplatePartialsResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e DeleteTemplateResponseValidationError) ErrorName() string {
-	return "DeleteTemplateResponseValidationError"
+func (e ListTemplatePartialsResponseValidationError) ErrorName() string {
+	return "ListTemplatePartialsResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e DeleteTemplateResponseValidationError) Error() string {
+func (e ListTemplatePartialsResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -4516,14 +9301,14 @@ func (e DeleteTemplateResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sDeleteTemplateResponse.%s: %s%s",
+		"invalid %sListTemplatePartialsResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = DeleteTemplateResponseValidationError{}
+var _ error = ListTemplatePartialsResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -4531,31 +9316,29 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = DeleteTemplateResponseValidationError{}
+} = ListTemplatePartialsResponseValidationError{}
 
-// Validate checks the field values on RenderTemplateRequest with the rules
-// defined in the proto definition for this message. If any rules are
+// Validate checks the field values on UpsertTemplatePartialRequest with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, an error is returned.
-func (m *RenderTemplateRequest) Validate() error {
+func (m *UpsertTemplatePartialRequest) Validate() error {
 	if m == nil {
 		return nil
 	}
 
-	if !_RenderTemplateRequest_Name_Pattern.MatchString(m.GetName()) {
-		return RenderTemplateRequestValidationError{
+	if !_UpsertTemplatePartialRequest_Name_Pattern.MatchString(m.GetName()) {
+		return UpsertTemplatePartialRequestValidationError{
 			field:  "Name",
 			reason: "value does not match regex pattern \"^[A-Za-z0-9_-]+$\"",
 		}
 	}
 
-	// no validation rules for Variables
-
 	return nil
 }
 
-// RenderTemplateRequestValidationError is the validation error returned by
-// RenderTemplateRequest.Validate if the designated constraints aren't met.
-type RenderTemplateRequestValidationError struct {
+// UpsertTemplatePartialRequestValidationError is the validation error returned
+// by UpsertTemplatePartialRequest.Validate if the designated constraints aren't met.
+type UpsertTemplatePartialRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -4563,24 +9346,24 @@ type RenderTemplateRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e RenderTemplateRequestValidationError) Field() string { return e.field }
+func (e UpsertTemplatePartialRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e RenderTemplateRequestValidationError) Reason() string { return e.reason }
+func (e UpsertTemplatePartialRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e RenderTemplateRequestValidationError) Cause() error { return e.cause }
+func (e UpsertTemplatePartialRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e RenderTemplateRequestValidationError) Key() bool { return e.key }
+func (e UpsertTemplatePartialRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e RenderTemplateRequestValidationError) ErrorName() string {
-	return "RenderTemplateRequestValidationError"
+func (e UpsertTemplatePartialRequestValidationError) ErrorName() string {
+	return "UpsertTemplatePartialRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e RenderTemplateRequestValidationError) Error() string {
+func (e UpsertTemplatePartialRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -4592,14 +9375,14 @@ func (e RenderTemplateRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sRenderTemplateRequest.%s: %s%s",
+		"invalid %sUpsertTemplatePartialRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = RenderTemplateRequestValidationError{}
+var _ error = UpsertTemplatePartialRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -4607,26 +9390,31 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = RenderTemplateRequestValidationError{}
+} = UpsertTemplatePartialRequestValidationError{}
 
-var _RenderTemplateRequest_Name_Pattern = regexp.MustCompile("^[A-Za-z0-9_-]+$")
+var _UpsertTemplatePartialRequest_Name_Pattern = regexp.MustCompile("^[A-Za-z0-9_-]+$")
 
-// Validate checks the field values on RenderTemplateResponse with the rules
-// defined in the proto definition for this message. If any rules are
+// Validate checks the field values on DeleteTemplatePartialRequest with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, an error is returned.
-func (m *RenderTemplateResponse) Validate() error {
+func (m *DeleteTemplatePartialRequest) Validate() error {
 	if m == nil {
 		return nil
 	}
 
-	// no validation rules for Body
+	if !_DeleteTemplatePartialRequest_Name_Pattern.MatchString(m.GetName()) {
+		return DeleteTemplatePartialRequestValidationError{
+			field:  "Name",
+			reason: "value does not match regex pattern \"^[A-Za-z0-9_-]+$\"",
+		}
+	}
 
 	return nil
 }
 
-// RenderTemplateResponseValidationError is the validation error returned by
-// RenderTemplateResponse.Validate if the designated constraints aren't met.
-type RenderTemplateResponseValidationError struct {
+// DeleteTemplatePartialRequestValidationError is the validation error returned
+// by DeleteTemplatePartialRequest.Validate if the designated constraints aren't met.
+type DeleteTemplatePartialRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -4634,24 +9422,24 @@ type RenderTemplateResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e RenderTemplateResponseValidationError) Field() string { return e.field }
+func (e DeleteTemplatePartialRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e RenderTemplateResponseValidationError) Reason() string { return e.reason }
+func (e DeleteTemplatePartialRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e RenderTemplateResponseValidationError) Cause() error { return e.cause }
+func (e DeleteTemplatePartialRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e RenderTemplateResponseValidationError) Key() bool { return e.key }
+func (e DeleteTemplatePartialRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e RenderTemplateResponseValidationError) ErrorName() string {
-	return "RenderTemplateResponseValidationError"
+func (e DeleteTemplatePartialRequestValidationError) ErrorName() string {
+	return "DeleteTemplatePartialRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e RenderTemplateResponseValidationError) Error() string {
+func (e DeleteTemplatePartialRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -4663,14 +9451,14 @@ func (e RenderTemplateResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sRenderTemplateResponse.%s: %s%s",
+		"invalid %sDeleteTemplatePartialRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = RenderTemplateResponseValidationError{}
+var _ error = DeleteTemplatePartialRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -4678,7 +9466,9 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = RenderTemplateResponseValidationError{}
+} = DeleteTemplatePartialRequestValidationError{}
+
+var _DeleteTemplatePartialRequest_Name_Pattern = regexp.MustCompile("^[A-Za-z0-9_-]+$")
 
 // Validate checks the field values on
 // SendReceiverNotificationRequest_SlackPayload with the rules defined in the
@@ -36,6 +36,20 @@ type SirenServiceClient interface {
 	GetSubscription(ctx context.Context, in *GetSubscriptionRequest, opts ...grpc.CallOption) (*Subscription, error)
 	UpdateSubscription(ctx context.Context, in *UpdateSubscriptionRequest, opts ...grpc.CallOption) (*Subscription, error)
 	DeleteSubscription(ctx context.Context, in *DeleteSubscriptionRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	ListInhibitionRules(ctx context.Context, in *ListInhibitionRulesRequest, opts ...grpc.CallOption) (*ListInhibitionRulesResponse, error)
+	CreateInhibitionRule(ctx context.Context, in *CreateInhibitionRuleRequest, opts ...grpc.CallOption) (*InhibitionRule, error)
+	GetInhibitionRule(ctx context.Context, in *GetInhibitionRuleRequest, opts ...grpc.CallOption) (*InhibitionRule, error)
+	UpdateInhibitionRule(ctx context.Context, in *UpdateInhibitionRuleRequest, opts ...grpc.CallOption) (*InhibitionRule, error)
+	DeleteInhibitionRule(ctx context.Context, in *DeleteInhibitionRuleRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	ListTimeIntervals(ctx context.Context, in *ListTimeIntervalsRequest, opts ...grpc.CallOption) (*ListTimeIntervalsResponse, error)
+	CreateTimeInterval(ctx context.Context, in *CreateTimeIntervalRequest, opts ...grpc.CallOption) (*TimeInterval, error)
+	GetTimeInterval(ctx context.Context, in *GetTimeIntervalRequest, opts ...grpc.CallOption) (*TimeInterval, error)
+	UpdateTimeInterval(ctx context.Context, in *UpdateTimeIntervalRequest, opts ...grpc.CallOption) (*TimeInterval, error)
+	DeleteTimeInterval(ctx context.Context, in *DeleteTimeIntervalRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	ListSilences(ctx context.Context, in *ListSilencesRequest, opts ...grpc.CallOption) (*ListSilencesResponse, error)
+	CreateSilence(ctx context.Context, in *CreateSilenceRequest, opts ...grpc.CallOption) (*Silence, error)
+	GetSilence(ctx context.Context, in *GetSilenceRequest, opts ...grpc.CallOption) (*Silence, error)
+	ExpireSilence(ctx context.Context, in *ExpireSilenceRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	ListReceivers(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ListReceiversResponse, error)
 	CreateReceiver(ctx context.Context, in *CreateReceiverRequest, opts ...grpc.CallOption) (*Receiver, error)
 	GetReceiver(ctx context.Context, in *GetReceiverRequest, opts ...grpc.CallOption) (*Receiver, error)
@@ -45,11 +59,25 @@ type SirenServiceClient interface {
 	CreateCortexAlerts(ctx context.Context, in *CreateCortexAlertsRequest, opts ...grpc.CallOption) (*Alerts, error)
 	ListRules(ctx context.Context, in *ListRulesRequest, opts ...grpc.CallOption) (*ListRulesResponse, error)
 	UpdateRule(ctx context.Context, in *UpdateRuleRequest, opts ...grpc.CallOption) (*UpdateRuleResponse, error)
+	DeleteRule(ctx context.Context, in *DeleteRuleRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	GetRuleDrift(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*GetRuleDriftResponse, error)
+	ImportRules(ctx context.Context, in *ImportRulesRequest, opts ...grpc.CallOption) (*ImportRulesResponse, error)
+	ListRuleRevisions(ctx context.Context, in *ListRuleRevisionsRequest, opts ...grpc.CallOption) (*ListRuleRevisionsResponse, error)
+	RollbackRule(ctx context.Context, in *RollbackRuleRequest, opts ...grpc.CallOption) (*RollbackRuleResponse, error)
+	UpgradeRulesTemplateVersion(ctx context.Context, in *UpgradeRulesTemplateVersionRequest, opts ...grpc.CallOption) (*UpgradeRulesTemplateVersionResponse, error)
+	GetRuleGroup(ctx context.Context, in *GetRuleGroupRequest, opts ...grpc.CallOption) (*RuleGroup, error)
+	UpdateRuleGroup(ctx context.Context, in *UpdateRuleGroupRequest, opts ...grpc.CallOption) (*RuleGroup, error)
+	ExportRules(ctx context.Context, in *ExportRulesRequest, opts ...grpc.CallOption) (*ExportRulesResponse, error)
 	ListTemplates(ctx context.Context, in *ListTemplatesRequest, opts ...grpc.CallOption) (*ListTemplatesResponse, error)
 	GetTemplateByName(ctx context.Context, in *GetTemplateByNameRequest, opts ...grpc.CallOption) (*TemplateResponse, error)
 	UpsertTemplate(ctx context.Context, in *UpsertTemplateRequest, opts ...grpc.CallOption) (*TemplateResponse, error)
+	ListTemplateVersions(ctx context.Context, in *ListTemplateVersionsRequest, opts ...grpc.CallOption) (*ListTemplateVersionsResponse, error)
+	GetTemplateUsage(ctx context.Context, in *GetTemplateUsageRequest, opts ...grpc.CallOption) (*TemplateUsage, error)
 	DeleteTemplate(ctx context.Context, in *DeleteTemplateRequest, opts ...grpc.CallOption) (*DeleteTemplateResponse, error)
 	RenderTemplate(ctx context.Context, in *RenderTemplateRequest, opts ...grpc.CallOption) (*RenderTemplateResponse, error)
+	ListTemplatePartials(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ListTemplatePartialsResponse, error)
+	UpsertTemplatePartial(ctx context.Context, in *UpsertTemplatePartialRequest, opts ...grpc.CallOption) (*TemplatePartial, error)
+	DeleteTemplatePartial(ctx context.Context, in *DeleteTemplatePartialRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 }
 
 type sirenServiceClient struct {
@@ -213,6 +241,132 @@ func (c *sirenServiceClient) DeleteSubscription(ctx context.Context, in *DeleteS
 	return out, nil
 }
 
+func (c *sirenServiceClient) ListInhibitionRules(ctx context.Context, in *ListInhibitionRulesRequest, opts ...grpc.CallOption) (*ListInhibitionRulesResponse, error) {
+	out := new(ListInhibitionRulesResponse)
+	err := c.cc.Invoke(ctx, "/odpf.siren.v1beta1.SirenService/ListInhibitionRules", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sirenServiceClient) CreateInhibitionRule(ctx context.Context, in *CreateInhibitionRuleRequest, opts ...grpc.CallOption) (*InhibitionRule, error) {
+	out := new(InhibitionRule)
+	err := c.cc.Invoke(ctx, "/odpf.siren.v1beta1.SirenService/CreateInhibitionRule", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sirenServiceClient) GetInhibitionRule(ctx context.Context, in *GetInhibitionRuleRequest, opts ...grpc.CallOption) (*InhibitionRule, error) {
+	out := new(InhibitionRule)
+	err := c.cc.Invoke(ctx, "/odpf.siren.v1beta1.SirenService/GetInhibitionRule", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sirenServiceClient) UpdateInhibitionRule(ctx context.Context, in *UpdateInhibitionRuleRequest, opts ...grpc.CallOption) (*InhibitionRule, error) {
+	out := new(InhibitionRule)
+	err := c.cc.Invoke(ctx, "/odpf.siren.v1beta1.SirenService/UpdateInhibitionRule", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sirenServiceClient) DeleteInhibitionRule(ctx context.Context, in *DeleteInhibitionRuleRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/odpf.siren.v1beta1.SirenService/DeleteInhibitionRule", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sirenServiceClient) ListTimeIntervals(ctx context.Context, in *ListTimeIntervalsRequest, opts ...grpc.CallOption) (*ListTimeIntervalsResponse, error) {
+	out := new(ListTimeIntervalsResponse)
+	err := c.cc.Invoke(ctx, "/odpf.siren.v1beta1.SirenService/ListTimeIntervals", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sirenServiceClient) CreateTimeInterval(ctx context.Context, in *CreateTimeIntervalRequest, opts ...grpc.CallOption) (*TimeInterval, error) {
+	out := new(TimeInterval)
+	err := c.cc.Invoke(ctx, "/odpf.siren.v1beta1.SirenService/CreateTimeInterval", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sirenServiceClient) GetTimeInterval(ctx context.Context, in *GetTimeIntervalRequest, opts ...grpc.CallOption) (*TimeInterval, error) {
+	out := new(TimeInterval)
+	err := c.cc.Invoke(ctx, "/odpf.siren.v1beta1.SirenService/GetTimeInterval", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sirenServiceClient) UpdateTimeInterval(ctx context.Context, in *UpdateTimeIntervalRequest, opts ...grpc.CallOption) (*TimeInterval, error) {
+	out := new(TimeInterval)
+	err := c.cc.Invoke(ctx, "/odpf.siren.v1beta1.SirenService/UpdateTimeInterval", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sirenServiceClient) DeleteTimeInterval(ctx context.Context, in *DeleteTimeIntervalRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/odpf.siren.v1beta1.SirenService/DeleteTimeInterval", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sirenServiceClient) ListSilences(ctx context.Context, in *ListSilencesRequest, opts ...grpc.CallOption) (*ListSilencesResponse, error) {
+	out := new(ListSilencesResponse)
+	err := c.cc.Invoke(ctx, "/odpf.siren.v1beta1.SirenService/ListSilences", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sirenServiceClient) CreateSilence(ctx context.Context, in *CreateSilenceRequest, opts ...grpc.CallOption) (*Silence, error) {
+	out := new(Silence)
+	err := c.cc.Invoke(ctx, "/odpf.siren.v1beta1.SirenService/CreateSilence", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sirenServiceClient) GetSilence(ctx context.Context, in *GetSilenceRequest, opts ...grpc.CallOption) (*Silence, error) {
+	out := new(Silence)
+	err := c.cc.Invoke(ctx, "/odpf.siren.v1beta1.SirenService/GetSilence", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sirenServiceClient) ExpireSilence(ctx context.Context, in *ExpireSilenceRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/odpf.siren.v1beta1.SirenService/ExpireSilence", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *sirenServiceClient) ListReceivers(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ListReceiversResponse, error) {
 	out := new(ListReceiversResponse)
 	err := c.cc.Invoke(ctx, "/odpf.siren.v1beta1.SirenService/ListReceivers", in, out, opts...)
@@ -294,6 +448,87 @@ func (c *sirenServiceClient) UpdateRule(ctx context.Context, in *UpdateRuleReque
 	return out, nil
 }
 
+func (c *sirenServiceClient) DeleteRule(ctx context.Context, in *DeleteRuleRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/odpf.siren.v1beta1.SirenService/DeleteRule", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sirenServiceClient) GetRuleDrift(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*GetRuleDriftResponse, error) {
+	out := new(GetRuleDriftResponse)
+	err := c.cc.Invoke(ctx, "/odpf.siren.v1beta1.SirenService/GetRuleDrift", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sirenServiceClient) ImportRules(ctx context.Context, in *ImportRulesRequest, opts ...grpc.CallOption) (*ImportRulesResponse, error) {
+	out := new(ImportRulesResponse)
+	err := c.cc.Invoke(ctx, "/odpf.siren.v1beta1.SirenService/ImportRules", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sirenServiceClient) ListRuleRevisions(ctx context.Context, in *ListRuleRevisionsRequest, opts ...grpc.CallOption) (*ListRuleRevisionsResponse, error) {
+	out := new(ListRuleRevisionsResponse)
+	err := c.cc.Invoke(ctx, "/odpf.siren.v1beta1.SirenService/ListRuleRevisions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sirenServiceClient) RollbackRule(ctx context.Context, in *RollbackRuleRequest, opts ...grpc.CallOption) (*RollbackRuleResponse, error) {
+	out := new(RollbackRuleResponse)
+	err := c.cc.Invoke(ctx, "/odpf.siren.v1beta1.SirenService/RollbackRule", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sirenServiceClient) UpgradeRulesTemplateVersion(ctx context.Context, in *UpgradeRulesTemplateVersionRequest, opts ...grpc.CallOption) (*UpgradeRulesTemplateVersionResponse, error) {
+	out := new(UpgradeRulesTemplateVersionResponse)
+	err := c.cc.Invoke(ctx, "/odpf.siren.v1beta1.SirenService/UpgradeRulesTemplateVersion", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sirenServiceClient) GetRuleGroup(ctx context.Context, in *GetRuleGroupRequest, opts ...grpc.CallOption) (*RuleGroup, error) {
+	out := new(RuleGroup)
+	err := c.cc.Invoke(ctx, "/odpf.siren.v1beta1.SirenService/GetRuleGroup", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sirenServiceClient) UpdateRuleGroup(ctx context.Context, in *UpdateRuleGroupRequest, opts ...grpc.CallOption) (*RuleGroup, error) {
+	out := new(RuleGroup)
+	err := c.cc.Invoke(ctx, "/odpf.siren.v1beta1.SirenService/UpdateRuleGroup", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sirenServiceClient) ExportRules(ctx context.Context, in *ExportRulesRequest, opts ...grpc.CallOption) (*ExportRulesResponse, error) {
+	out := new(ExportRulesResponse)
+	err := c.cc.Invoke(ctx, "/odpf.siren.v1beta1.SirenService/ExportRules", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *sirenServiceClient) ListTemplates(ctx context.Context, in *ListTemplatesRequest, opts ...grpc.CallOption) (*ListTemplatesResponse, error) {
 	out := new(ListTemplatesResponse)
 	err := c.cc.Invoke(ctx, "/odpf.siren.v1beta1.SirenService/ListTemplates", in, out, opts...)
@@ -321,6 +556,24 @@ func (c *sirenServiceClient) UpsertTemplate(ctx context.Context, in *UpsertTempl
 	return out, nil
 }
 
+func (c *sirenServiceClient) ListTemplateVersions(ctx context.Context, in *ListTemplateVersionsRequest, opts ...grpc.CallOption) (*ListTemplateVersionsResponse, error) {
+	out := new(ListTemplateVersionsResponse)
+	err := c.cc.Invoke(ctx, "/odpf.siren.v1beta1.SirenService/ListTemplateVersions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sirenServiceClient) GetTemplateUsage(ctx context.Context, in *GetTemplateUsageRequest, opts ...grpc.CallOption) (*TemplateUsage, error) {
+	out := new(TemplateUsage)
+	err := c.cc.Invoke(ctx, "/odpf.siren.v1beta1.SirenService/GetTemplateUsage", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *sirenServiceClient) DeleteTemplate(ctx context.Context, in *DeleteTemplateRequest, opts ...grpc.CallOption) (*DeleteTemplateResponse, error) {
 	out := new(DeleteTemplateResponse)
 	err := c.cc.Invoke(ctx, "/odpf.siren.v1beta1.SirenService/DeleteTemplate", in, out, opts...)
@@ -339,6 +592,33 @@ func (c *sirenServiceClient) RenderTemplate(ctx context.Context, in *RenderTempl
 	return out, nil
 }
 
+func (c *sirenServiceClient) ListTemplatePartials(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ListTemplatePartialsResponse, error) {
+	out := new(ListTemplatePartialsResponse)
+	err := c.cc.Invoke(ctx, "/odpf.siren.v1beta1.SirenService/ListTemplatePartials", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sirenServiceClient) UpsertTemplatePartial(ctx context.Context, in *UpsertTemplatePartialRequest, opts ...grpc.CallOption) (*TemplatePartial, error) {
+	out := new(TemplatePartial)
+	err := c.cc.Invoke(ctx, "/odpf.siren.v1beta1.SirenService/UpsertTemplatePartial", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sirenServiceClient) DeleteTemplatePartial(ctx context.Context, in *DeleteTemplatePartialRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/odpf.siren.v1beta1.SirenService/DeleteTemplatePartial", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // SirenServiceServer is the server API for SirenService service.
 // All implementations must embed UnimplementedSirenServiceServer
 // for forward compatibility
@@ -360,6 +640,20 @@ type SirenServiceServer interface {
 	GetSubscription(context.Context, *GetSubscriptionRequest) (*Subscription, error)
 	UpdateSubscription(context.Context, *UpdateSubscriptionRequest) (*Subscription, error)
 	DeleteSubscription(context.Context, *DeleteSubscriptionRequest) (*emptypb.Empty, error)
+	ListInhibitionRules(context.Context, *ListInhibitionRulesRequest) (*ListInhibitionRulesResponse, error)
+	CreateInhibitionRule(context.Context, *CreateInhibitionRuleRequest) (*InhibitionRule, error)
+	GetInhibitionRule(context.Context, *GetInhibitionRuleRequest) (*InhibitionRule, error)
+	UpdateInhibitionRule(context.Context, *UpdateInhibitionRuleRequest) (*InhibitionRule, error)
+	DeleteInhibitionRule(context.Context, *DeleteInhibitionRuleRequest) (*emptypb.Empty, error)
+	ListTimeIntervals(context.Context, *ListTimeIntervalsRequest) (*ListTimeIntervalsResponse, error)
+	CreateTimeInterval(context.Context, *CreateTimeIntervalRequest) (*TimeInterval, error)
+	GetTimeInterval(context.Context, *GetTimeIntervalRequest) (*TimeInterval, error)
+	UpdateTimeInterval(context.Context, *UpdateTimeIntervalRequest) (*TimeInterval, error)
+	DeleteTimeInterval(context.Context, *DeleteTimeIntervalRequest) (*emptypb.Empty, error)
+	ListSilences(context.Context, *ListSilencesRequest) (*ListSilencesResponse, error)
+	CreateSilence(context.Context, *CreateSilenceRequest) (*Silence, error)
+	GetSilence(context.Context, *GetSilenceRequest) (*Silence, error)
+	ExpireSilence(context.Context, *ExpireSilenceRequest) (*emptypb.Empty, error)
 	ListReceivers(context.Context, *emptypb.Empty) (*ListReceiversResponse, error)
 	CreateReceiver(context.Context, *CreateReceiverRequest) (*Receiver, error)
 	GetReceiver(context.Context, *GetReceiverRequest) (*Receiver, error)
@@ -369,11 +663,25 @@ type SirenServiceServer interface {
 	CreateCortexAlerts(context.Context, *CreateCortexAlertsRequest) (*Alerts, error)
 	ListRules(context.Context, *ListRulesRequest) (*ListRulesResponse, error)
 	UpdateRule(context.Context, *UpdateRuleRequest) (*UpdateRuleResponse, error)
+	DeleteRule(context.Context, *DeleteRuleRequest) (*emptypb.Empty, error)
+	GetRuleDrift(context.Context, *emptypb.Empty) (*GetRuleDriftResponse, error)
+	ImportRules(context.Context, *ImportRulesRequest) (*ImportRulesResponse, error)
+	ListRuleRevisions(context.Context, *ListRuleRevisionsRequest) (*ListRuleRevisionsResponse, error)
+	RollbackRule(context.Context, *RollbackRuleRequest) (*RollbackRuleResponse, error)
+	UpgradeRulesTemplateVersion(context.Context, *UpgradeRulesTemplateVersionRequest) (*UpgradeRulesTemplateVersionResponse, error)
+	GetRuleGroup(context.Context, *GetRuleGroupRequest) (*RuleGroup, error)
+	UpdateRuleGroup(context.Context, *UpdateRuleGroupRequest) (*RuleGroup, error)
+	ExportRules(context.Context, *ExportRulesRequest) (*ExportRulesResponse, error)
 	ListTemplates(context.Context, *ListTemplatesRequest) (*ListTemplatesResponse, error)
 	GetTemplateByName(context.Context, *GetTemplateByNameRequest) (*TemplateResponse, error)
 	UpsertTemplate(context.Context, *UpsertTemplateRequest) (*TemplateResponse, error)
+	ListTemplateVersions(context.Context, *ListTemplateVersionsRequest) (*ListTemplateVersionsResponse, error)
+	GetTemplateUsage(context.Context, *GetTemplateUsageRequest) (*TemplateUsage, error)
 	DeleteTemplate(context.Context, *DeleteTemplateRequest) (*DeleteTemplateResponse, error)
 	RenderTemplate(context.Context, *RenderTemplateRequest) (*RenderTemplateResponse, error)
+	ListTemplatePartials(context.Context, *emptypb.Empty) (*ListTemplatePartialsResponse, error)
+	UpsertTemplatePartial(context.Context, *UpsertTemplatePartialRequest) (*TemplatePartial, error)
+	DeleteTemplatePartial(context.Context, *DeleteTemplatePartialRequest) (*emptypb.Empty, error)
 	mustEmbedUnimplementedSirenServiceServer()
 }
 
@@ -432,6 +740,48 @@ func (UnimplementedSirenServiceServer) UpdateSubscription(context.Context, *Upda
 func (UnimplementedSirenServiceServer) DeleteSubscription(context.Context, *DeleteSubscriptionRequest) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteSubscription not implemented")
 }
+func (UnimplementedSirenServiceServer) ListInhibitionRules(context.Context, *ListInhibitionRulesRequest) (*ListInhibitionRulesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListInhibitionRules not implemented")
+}
+func (UnimplementedSirenServiceServer) CreateInhibitionRule(context.Context, *CreateInhibitionRuleRequest) (*InhibitionRule, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateInhibitionRule not implemented")
+}
+func (UnimplementedSirenServiceServer) GetInhibitionRule(context.Context, *GetInhibitionRuleRequest) (*InhibitionRule, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetInhibitionRule not implemented")
+}
+func (UnimplementedSirenServiceServer) UpdateInhibitionRule(context.Context, *UpdateInhibitionRuleRequest) (*InhibitionRule, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateInhibitionRule not implemented")
+}
+func (UnimplementedSirenServiceServer) DeleteInhibitionRule(context.Context, *DeleteInhibitionRuleRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteInhibitionRule not implemented")
+}
+func (UnimplementedSirenServiceServer) ListTimeIntervals(context.Context, *ListTimeIntervalsRequest) (*ListTimeIntervalsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTimeIntervals not implemented")
+}
+func (UnimplementedSirenServiceServer) CreateTimeInterval(context.Context, *CreateTimeIntervalRequest) (*TimeInterval, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateTimeInterval not implemented")
+}
+func (UnimplementedSirenServiceServer) GetTimeInterval(context.Context, *GetTimeIntervalRequest) (*TimeInterval, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTimeInterval not implemented")
+}
+func (UnimplementedSirenServiceServer) UpdateTimeInterval(context.Context, *UpdateTimeIntervalRequest) (*TimeInterval, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateTimeInterval not implemented")
+}
+func (UnimplementedSirenServiceServer) DeleteTimeInterval(context.Context, *DeleteTimeIntervalRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteTimeInterval not implemented")
+}
+func (UnimplementedSirenServiceServer) ListSilences(context.Context, *ListSilencesRequest) (*ListSilencesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSilences not implemented")
+}
+func (UnimplementedSirenServiceServer) CreateSilence(context.Context, *CreateSilenceRequest) (*Silence, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateSilence not implemented")
+}
+func (UnimplementedSirenServiceServer) GetSilence(context.Context, *GetSilenceRequest) (*Silence, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSilence not implemented")
+}
+func (UnimplementedSirenServiceServer) ExpireSilence(context.Context, *ExpireSilenceRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExpireSilence not implemented")
+}
 func (UnimplementedSirenServiceServer) ListReceivers(context.Context, *emptypb.Empty) (*ListReceiversResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListReceivers not implemented")
 }
@@ -459,6 +809,33 @@ func (UnimplementedSirenServiceServer) ListRules(context.Context, *ListRulesRequ
 func (UnimplementedSirenServiceServer) UpdateRule(context.Context, *UpdateRuleRequest) (*UpdateRuleResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateRule not implemented")
 }
+func (UnimplementedSirenServiceServer) DeleteRule(context.Context, *DeleteRuleRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteRule not implemented")
+}
+func (UnimplementedSirenServiceServer) GetRuleDrift(context.Context, *emptypb.Empty) (*GetRuleDriftResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRuleDrift not implemented")
+}
+func (UnimplementedSirenServiceServer) ImportRules(context.Context, *ImportRulesRequest) (*ImportRulesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ImportRules not implemented")
+}
+func (UnimplementedSirenServiceServer) ListRuleRevisions(context.Context, *ListRuleRevisionsRequest) (*ListRuleRevisionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListRuleRevisions not implemented")
+}
+func (UnimplementedSirenServiceServer) RollbackRule(context.Context, *RollbackRuleRequest) (*RollbackRuleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RollbackRule not implemented")
+}
+func (UnimplementedSirenServiceServer) UpgradeRulesTemplateVersion(context.Context, *UpgradeRulesTemplateVersionRequest) (*UpgradeRulesTemplateVersionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpgradeRulesTemplateVersion not implemented")
+}
+func (UnimplementedSirenServiceServer) GetRuleGroup(context.Context, *GetRuleGroupRequest) (*RuleGroup, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRuleGroup not implemented")
+}
+func (UnimplementedSirenServiceServer) UpdateRuleGroup(context.Context, *UpdateRuleGroupRequest) (*RuleGroup, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateRuleGroup not implemented")
+}
+func (UnimplementedSirenServiceServer) ExportRules(context.Context, *ExportRulesRequest) (*ExportRulesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExportRules not implemented")
+}
 func (UnimplementedSirenServiceServer) ListTemplates(context.Context, *ListTemplatesRequest) (*ListTemplatesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListTemplates not implemented")
 }
@@ -468,12 +845,27 @@ func (UnimplementedSirenServiceServer) GetTemplateByName(context.Context, *GetTe
 func (UnimplementedSirenServiceServer) UpsertTemplate(context.Context, *UpsertTemplateRequest) (*TemplateResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpsertTemplate not implemented")
 }
+func (UnimplementedSirenServiceServer) ListTemplateVersions(context.Context, *ListTemplateVersionsRequest) (*ListTemplateVersionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTemplateVersions not implemented")
+}
+func (UnimplementedSirenServiceServer) GetTemplateUsage(context.Context, *GetTemplateUsageRequest) (*TemplateUsage, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTemplateUsage not implemented")
+}
 func (UnimplementedSirenServiceServer) DeleteTemplate(context.Context, *DeleteTemplateRequest) (*DeleteTemplateResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteTemplate not implemented")
 }
 func (UnimplementedSirenServiceServer) RenderTemplate(context.Context, *RenderTemplateRequest) (*RenderTemplateResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RenderTemplate not implemented")
 }
+func (UnimplementedSirenServiceServer) ListTemplatePartials(context.Context, *emptypb.Empty) (*ListTemplatePartialsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTemplatePartials not implemented")
+}
+func (UnimplementedSirenServiceServer) UpsertTemplatePartial(context.Context, *UpsertTemplatePartialRequest) (*TemplatePartial, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpsertTemplatePartial not implemented")
+}
+func (UnimplementedSirenServiceServer) DeleteTemplatePartial(context.Context, *DeleteTemplatePartialRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteTemplatePartial not implemented")
+}
 func (UnimplementedSirenServiceServer) mustEmbedUnimplementedSirenServiceServer() {}
 
 // UnsafeSirenServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -793,200 +1185,614 @@ func _SirenService_DeleteSubscription_Handler(srv interface{}, ctx context.Conte
 	return interceptor(ctx, in, info, handler)
 }
 
-func _SirenService_ListReceivers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(emptypb.Empty)
+func _SirenService_ListInhibitionRules_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListInhibitionRulesRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SirenServiceServer).ListReceivers(ctx, in)
+		return srv.(SirenServiceServer).ListInhibitionRules(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/odpf.siren.v1beta1.SirenService/ListReceivers",
+		FullMethod: "/odpf.siren.v1beta1.SirenService/ListInhibitionRules",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SirenServiceServer).ListReceivers(ctx, req.(*emptypb.Empty))
+		return srv.(SirenServiceServer).ListInhibitionRules(ctx, req.(*ListInhibitionRulesRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _SirenService_CreateReceiver_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateReceiverRequest)
+func _SirenService_CreateInhibitionRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateInhibitionRuleRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SirenServiceServer).CreateReceiver(ctx, in)
+		return srv.(SirenServiceServer).CreateInhibitionRule(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/odpf.siren.v1beta1.SirenService/CreateReceiver",
+		FullMethod: "/odpf.siren.v1beta1.SirenService/CreateInhibitionRule",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SirenServiceServer).CreateReceiver(ctx, req.(*CreateReceiverRequest))
+		return srv.(SirenServiceServer).CreateInhibitionRule(ctx, req.(*CreateInhibitionRuleRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _SirenService_GetReceiver_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetReceiverRequest)
+func _SirenService_GetInhibitionRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetInhibitionRuleRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SirenServiceServer).GetReceiver(ctx, in)
+		return srv.(SirenServiceServer).GetInhibitionRule(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/odpf.siren.v1beta1.SirenService/GetReceiver",
+		FullMethod: "/odpf.siren.v1beta1.SirenService/GetInhibitionRule",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SirenServiceServer).GetReceiver(ctx, req.(*GetReceiverRequest))
+		return srv.(SirenServiceServer).GetInhibitionRule(ctx, req.(*GetInhibitionRuleRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _SirenService_UpdateReceiver_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateReceiverRequest)
+func _SirenService_UpdateInhibitionRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateInhibitionRuleRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SirenServiceServer).UpdateReceiver(ctx, in)
+		return srv.(SirenServiceServer).UpdateInhibitionRule(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/odpf.siren.v1beta1.SirenService/UpdateReceiver",
+		FullMethod: "/odpf.siren.v1beta1.SirenService/UpdateInhibitionRule",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SirenServiceServer).UpdateReceiver(ctx, req.(*UpdateReceiverRequest))
+		return srv.(SirenServiceServer).UpdateInhibitionRule(ctx, req.(*UpdateInhibitionRuleRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _SirenService_DeleteReceiver_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteReceiverRequest)
+func _SirenService_DeleteInhibitionRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteInhibitionRuleRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SirenServiceServer).DeleteReceiver(ctx, in)
+		return srv.(SirenServiceServer).DeleteInhibitionRule(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/odpf.siren.v1beta1.SirenService/DeleteReceiver",
+		FullMethod: "/odpf.siren.v1beta1.SirenService/DeleteInhibitionRule",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SirenServiceServer).DeleteReceiver(ctx, req.(*DeleteReceiverRequest))
+		return srv.(SirenServiceServer).DeleteInhibitionRule(ctx, req.(*DeleteInhibitionRuleRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _SirenService_ListAlerts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListAlertsRequest)
+func _SirenService_ListTimeIntervals_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTimeIntervalsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SirenServiceServer).ListAlerts(ctx, in)
+		return srv.(SirenServiceServer).ListTimeIntervals(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/odpf.siren.v1beta1.SirenService/ListAlerts",
+		FullMethod: "/odpf.siren.v1beta1.SirenService/ListTimeIntervals",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SirenServiceServer).ListAlerts(ctx, req.(*ListAlertsRequest))
+		return srv.(SirenServiceServer).ListTimeIntervals(ctx, req.(*ListTimeIntervalsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _SirenService_CreateCortexAlerts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateCortexAlertsRequest)
+func _SirenService_CreateTimeInterval_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateTimeIntervalRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SirenServiceServer).CreateCortexAlerts(ctx, in)
+		return srv.(SirenServiceServer).CreateTimeInterval(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/odpf.siren.v1beta1.SirenService/CreateCortexAlerts",
+		FullMethod: "/odpf.siren.v1beta1.SirenService/CreateTimeInterval",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SirenServiceServer).CreateCortexAlerts(ctx, req.(*CreateCortexAlertsRequest))
+		return srv.(SirenServiceServer).CreateTimeInterval(ctx, req.(*CreateTimeIntervalRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _SirenService_ListRules_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListRulesRequest)
+func _SirenService_GetTimeInterval_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTimeIntervalRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SirenServiceServer).ListRules(ctx, in)
+		return srv.(SirenServiceServer).GetTimeInterval(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/odpf.siren.v1beta1.SirenService/ListRules",
+		FullMethod: "/odpf.siren.v1beta1.SirenService/GetTimeInterval",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SirenServiceServer).ListRules(ctx, req.(*ListRulesRequest))
+		return srv.(SirenServiceServer).GetTimeInterval(ctx, req.(*GetTimeIntervalRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _SirenService_UpdateRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateRuleRequest)
+func _SirenService_UpdateTimeInterval_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateTimeIntervalRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SirenServiceServer).UpdateRule(ctx, in)
+		return srv.(SirenServiceServer).UpdateTimeInterval(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/odpf.siren.v1beta1.SirenService/UpdateRule",
+		FullMethod: "/odpf.siren.v1beta1.SirenService/UpdateTimeInterval",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SirenServiceServer).UpdateRule(ctx, req.(*UpdateRuleRequest))
+		return srv.(SirenServiceServer).UpdateTimeInterval(ctx, req.(*UpdateTimeIntervalRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _SirenService_ListTemplates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListTemplatesRequest)
+func _SirenService_DeleteTimeInterval_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteTimeIntervalRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SirenServiceServer).ListTemplates(ctx, in)
+		return srv.(SirenServiceServer).DeleteTimeInterval(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/odpf.siren.v1beta1.SirenService/ListTemplates",
+		FullMethod: "/odpf.siren.v1beta1.SirenService/DeleteTimeInterval",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SirenServiceServer).ListTemplates(ctx, req.(*ListTemplatesRequest))
+		return srv.(SirenServiceServer).DeleteTimeInterval(ctx, req.(*DeleteTimeIntervalRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _SirenService_GetTemplateByName_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetTemplateByNameRequest)
+func _SirenService_ListSilences_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSilencesRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SirenServiceServer).GetTemplateByName(ctx, in)
+		return srv.(SirenServiceServer).ListSilences(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/odpf.siren.v1beta1.SirenService/GetTemplateByName",
+		FullMethod: "/odpf.siren.v1beta1.SirenService/ListSilences",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SirenServiceServer).GetTemplateByName(ctx, req.(*GetTemplateByNameRequest))
+		return srv.(SirenServiceServer).ListSilences(ctx, req.(*ListSilencesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SirenService_CreateSilence_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateSilenceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SirenServiceServer).CreateSilence(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/odpf.siren.v1beta1.SirenService/CreateSilence",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SirenServiceServer).CreateSilence(ctx, req.(*CreateSilenceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SirenService_GetSilence_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSilenceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SirenServiceServer).GetSilence(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/odpf.siren.v1beta1.SirenService/GetSilence",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SirenServiceServer).GetSilence(ctx, req.(*GetSilenceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SirenService_ExpireSilence_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExpireSilenceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SirenServiceServer).ExpireSilence(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/odpf.siren.v1beta1.SirenService/ExpireSilence",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SirenServiceServer).ExpireSilence(ctx, req.(*ExpireSilenceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SirenService_ListReceivers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SirenServiceServer).ListReceivers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/odpf.siren.v1beta1.SirenService/ListReceivers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SirenServiceServer).ListReceivers(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SirenService_CreateReceiver_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateReceiverRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SirenServiceServer).CreateReceiver(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/odpf.siren.v1beta1.SirenService/CreateReceiver",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SirenServiceServer).CreateReceiver(ctx, req.(*CreateReceiverRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SirenService_GetReceiver_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetReceiverRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SirenServiceServer).GetReceiver(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/odpf.siren.v1beta1.SirenService/GetReceiver",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SirenServiceServer).GetReceiver(ctx, req.(*GetReceiverRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SirenService_UpdateReceiver_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateReceiverRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SirenServiceServer).UpdateReceiver(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/odpf.siren.v1beta1.SirenService/UpdateReceiver",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SirenServiceServer).UpdateReceiver(ctx, req.(*UpdateReceiverRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SirenService_DeleteReceiver_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteReceiverRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SirenServiceServer).DeleteReceiver(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/odpf.siren.v1beta1.SirenService/DeleteReceiver",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SirenServiceServer).DeleteReceiver(ctx, req.(*DeleteReceiverRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SirenService_ListAlerts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAlertsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SirenServiceServer).ListAlerts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/odpf.siren.v1beta1.SirenService/ListAlerts",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SirenServiceServer).ListAlerts(ctx, req.(*ListAlertsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SirenService_CreateCortexAlerts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateCortexAlertsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SirenServiceServer).CreateCortexAlerts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/odpf.siren.v1beta1.SirenService/CreateCortexAlerts",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SirenServiceServer).CreateCortexAlerts(ctx, req.(*CreateCortexAlertsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SirenService_ListRules_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListRulesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SirenServiceServer).ListRules(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/odpf.siren.v1beta1.SirenService/ListRules",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SirenServiceServer).ListRules(ctx, req.(*ListRulesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SirenService_UpdateRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateRuleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SirenServiceServer).UpdateRule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/odpf.siren.v1beta1.SirenService/UpdateRule",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SirenServiceServer).UpdateRule(ctx, req.(*UpdateRuleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SirenService_DeleteRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteRuleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SirenServiceServer).DeleteRule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/odpf.siren.v1beta1.SirenService/DeleteRule",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SirenServiceServer).DeleteRule(ctx, req.(*DeleteRuleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SirenService_GetRuleDrift_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SirenServiceServer).GetRuleDrift(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/odpf.siren.v1beta1.SirenService/GetRuleDrift",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SirenServiceServer).GetRuleDrift(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SirenService_ImportRules_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportRulesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SirenServiceServer).ImportRules(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/odpf.siren.v1beta1.SirenService/ImportRules",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SirenServiceServer).ImportRules(ctx, req.(*ImportRulesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SirenService_ListRuleRevisions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListRuleRevisionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SirenServiceServer).ListRuleRevisions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/odpf.siren.v1beta1.SirenService/ListRuleRevisions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SirenServiceServer).ListRuleRevisions(ctx, req.(*ListRuleRevisionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SirenService_RollbackRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RollbackRuleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SirenServiceServer).RollbackRule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/odpf.siren.v1beta1.SirenService/RollbackRule",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SirenServiceServer).RollbackRule(ctx, req.(*RollbackRuleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SirenService_UpgradeRulesTemplateVersion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpgradeRulesTemplateVersionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SirenServiceServer).UpgradeRulesTemplateVersion(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/odpf.siren.v1beta1.SirenService/UpgradeRulesTemplateVersion",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SirenServiceServer).UpgradeRulesTemplateVersion(ctx, req.(*UpgradeRulesTemplateVersionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SirenService_GetRuleGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRuleGroupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SirenServiceServer).GetRuleGroup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/odpf.siren.v1beta1.SirenService/GetRuleGroup",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SirenServiceServer).GetRuleGroup(ctx, req.(*GetRuleGroupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SirenService_UpdateRuleGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateRuleGroupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SirenServiceServer).UpdateRuleGroup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/odpf.siren.v1beta1.SirenService/UpdateRuleGroup",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SirenServiceServer).UpdateRuleGroup(ctx, req.(*UpdateRuleGroupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SirenService_ExportRules_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportRulesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SirenServiceServer).ExportRules(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/odpf.siren.v1beta1.SirenService/ExportRules",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SirenServiceServer).ExportRules(ctx, req.(*ExportRulesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SirenService_ListTemplates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTemplatesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SirenServiceServer).ListTemplates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/odpf.siren.v1beta1.SirenService/ListTemplates",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SirenServiceServer).ListTemplates(ctx, req.(*ListTemplatesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SirenService_GetTemplateByName_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTemplateByNameRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SirenServiceServer).GetTemplateByName(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/odpf.siren.v1beta1.SirenService/GetTemplateByName",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SirenServiceServer).GetTemplateByName(ctx, req.(*GetTemplateByNameRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -1009,6 +1815,42 @@ func _SirenService_UpsertTemplate_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _SirenService_ListTemplateVersions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTemplateVersionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SirenServiceServer).ListTemplateVersions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/odpf.siren.v1beta1.SirenService/ListTemplateVersions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SirenServiceServer).ListTemplateVersions(ctx, req.(*ListTemplateVersionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SirenService_GetTemplateUsage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTemplateUsageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SirenServiceServer).GetTemplateUsage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/odpf.siren.v1beta1.SirenService/GetTemplateUsage",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SirenServiceServer).GetTemplateUsage(ctx, req.(*GetTemplateUsageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _SirenService_DeleteTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(DeleteTemplateRequest)
 	if err := dec(in); err != nil {
@@ -1045,6 +1887,60 @@ func _SirenService_RenderTemplate_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _SirenService_ListTemplatePartials_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SirenServiceServer).ListTemplatePartials(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/odpf.siren.v1beta1.SirenService/ListTemplatePartials",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SirenServiceServer).ListTemplatePartials(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SirenService_UpsertTemplatePartial_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpsertTemplatePartialRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SirenServiceServer).UpsertTemplatePartial(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/odpf.siren.v1beta1.SirenService/UpsertTemplatePartial",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SirenServiceServer).UpsertTemplatePartial(ctx, req.(*UpsertTemplatePartialRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SirenService_DeleteTemplatePartial_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteTemplatePartialRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SirenServiceServer).DeleteTemplatePartial(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/odpf.siren.v1beta1.SirenService/DeleteTemplatePartial",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SirenServiceServer).DeleteTemplatePartial(ctx, req.(*DeleteTemplatePartialRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // SirenService_ServiceDesc is the grpc.ServiceDesc for SirenService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -1120,6 +2016,62 @@ var SirenService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteSubscription",
 			Handler:    _SirenService_DeleteSubscription_Handler,
 		},
+		{
+			MethodName: "ListInhibitionRules",
+			Handler:    _SirenService_ListInhibitionRules_Handler,
+		},
+		{
+			MethodName: "CreateInhibitionRule",
+			Handler:    _SirenService_CreateInhibitionRule_Handler,
+		},
+		{
+			MethodName: "GetInhibitionRule",
+			Handler:    _SirenService_GetInhibitionRule_Handler,
+		},
+		{
+			MethodName: "UpdateInhibitionRule",
+			Handler:    _SirenService_UpdateInhibitionRule_Handler,
+		},
+		{
+			MethodName: "DeleteInhibitionRule",
+			Handler:    _SirenService_DeleteInhibitionRule_Handler,
+		},
+		{
+			MethodName: "ListTimeIntervals",
+			Handler:    _SirenService_ListTimeIntervals_Handler,
+		},
+		{
+			MethodName: "CreateTimeInterval",
+			Handler:    _SirenService_CreateTimeInterval_Handler,
+		},
+		{
+			MethodName: "GetTimeInterval",
+			Handler:    _SirenService_GetTimeInterval_Handler,
+		},
+		{
+			MethodName: "UpdateTimeInterval",
+			Handler:    _SirenService_UpdateTimeInterval_Handler,
+		},
+		{
+			MethodName: "DeleteTimeInterval",
+			Handler:    _SirenService_DeleteTimeInterval_Handler,
+		},
+		{
+			MethodName: "ListSilences",
+			Handler:    _SirenService_ListSilences_Handler,
+		},
+		{
+			MethodName: "CreateSilence",
+			Handler:    _SirenService_CreateSilence_Handler,
+		},
+		{
+			MethodName: "GetSilence",
+			Handler:    _SirenService_GetSilence_Handler,
+		},
+		{
+			MethodName: "ExpireSilence",
+			Handler:    _SirenService_ExpireSilence_Handler,
+		},
 		{
 			MethodName: "ListReceivers",
 			Handler:    _SirenService_ListReceivers_Handler,
@@ -1156,6 +2108,42 @@ var SirenService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateRule",
 			Handler:    _SirenService_UpdateRule_Handler,
 		},
+		{
+			MethodName: "DeleteRule",
+			Handler:    _SirenService_DeleteRule_Handler,
+		},
+		{
+			MethodName: "GetRuleDrift",
+			Handler:    _SirenService_GetRuleDrift_Handler,
+		},
+		{
+			MethodName: "ImportRules",
+			Handler:    _SirenService_ImportRules_Handler,
+		},
+		{
+			MethodName: "ListRuleRevisions",
+			Handler:    _SirenService_ListRuleRevisions_Handler,
+		},
+		{
+			MethodName: "RollbackRule",
+			Handler:    _SirenService_RollbackRule_Handler,
+		},
+		{
+			MethodName: "UpgradeRulesTemplateVersion",
+			Handler:    _SirenService_UpgradeRulesTemplateVersion_Handler,
+		},
+		{
+			MethodName: "GetRuleGroup",
+			Handler:    _SirenService_GetRuleGroup_Handler,
+		},
+		{
+			MethodName: "UpdateRuleGroup",
+			Handler:    _SirenService_UpdateRuleGroup_Handler,
+		},
+		{
+			MethodName: "ExportRules",
+			Handler:    _SirenService_ExportRules_Handler,
+		},
 		{
 			MethodName: "ListTemplates",
 			Handler:    _SirenService_ListTemplates_Handler,
@@ -1168,6 +2156,14 @@ var SirenService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UpsertTemplate",
 			Handler:    _SirenService_UpsertTemplate_Handler,
 		},
+		{
+			MethodName: "ListTemplateVersions",
+			Handler:    _SirenService_ListTemplateVersions_Handler,
+		},
+		{
+			MethodName: "GetTemplateUsage",
+			Handler:    _SirenService_GetTemplateUsage_Handler,
+		},
 		{
 			MethodName: "DeleteTemplate",
 			Handler:    _SirenService_DeleteTemplate_Handler,
@@ -1176,6 +2172,18 @@ var SirenService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "RenderTemplate",
 			Handler:    _SirenService_RenderTemplate_Handler,
 		},
+		{
+			MethodName: "ListTemplatePartials",
+			Handler:    _SirenService_ListTemplatePartials_Handler,
+		},
+		{
+			MethodName: "UpsertTemplatePartial",
+			Handler:    _SirenService_UpsertTemplatePartial_Handler,
+		},
+		{
+			MethodName: "DeleteTemplatePartial",
+			Handler:    _SirenService_DeleteTemplatePartial_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "odpf/siren/v1beta1/siren.proto",
@@ -21,6 +21,7 @@ import (
 	sirenv1beta1 "github.com/odpf/siren/api/proto/odpf/siren/v1beta1"
 	"github.com/odpf/siren/logger"
 	"github.com/odpf/siren/metric"
+	"github.com/odpf/siren/pkg/rules"
 	"golang.org/x/net/http2"
 	"google.golang.org/grpc"
 
@@ -53,11 +54,15 @@ func RunServer(c *domain.Config) error {
 	}
 
 	httpClient := &http.Client{}
-	services, err := service.Init(store, c, httpClient)
+	adapters := service.NewProviderAdapters(c, httpClient)
+	services, err := service.Init(store, c, httpClient, adapters)
 	if err != nil {
 		return err
 	}
 
+	ruleReconciler := rules.NewReconciler(store, adapters, c.RuleReconciler, nr, logger)
+	services.RuleReconciler = ruleReconciler
+
 	loggerOpts := []grpc_zap.Option{grpc_zap.WithLevels(grpc_zap.DefaultCodeToLevel)}
 
 	// init grpc server
@@ -86,6 +91,12 @@ func RunServer(c *domain.Config) error {
 
 	gwmux := runtime.NewServeMux(
 		runtime.WithErrorHandler(runtime.DefaultHTTPErrorHandler),
+		runtime.WithIncomingHeaderMatcher(func(key string) (string, bool) {
+			if strings.EqualFold(key, v1.ActorHeader) {
+				return v1.ActorHeader, true
+			}
+			return runtime.DefaultHeaderMatcher(key)
+		}),
 		runtime.WithMarshalerOption(runtime.MIMEWildcard, &runtime.HTTPBodyMarshaler{
 			Marshaler: &runtime.JSONPb{
 				MarshalOptions: protojson.MarshalOptions{
@@ -110,6 +121,10 @@ func RunServer(c *domain.Config) error {
 		return err
 	}
 
+	if c.RuleReconciler.Enabled {
+		go ruleReconciler.Run(runtimeCtx)
+	}
+
 	baseMux := http.NewServeMux()
 	baseMux.HandleFunc("/siren.swagger.json", func(w http.ResponseWriter, r *http.Request) {
 		http.FileServer(http.FS(swaggerFile)).ServeHTTP(w, r)
@@ -149,7 +164,7 @@ func RunMigrations(c *domain.Config) error {
 		return nil
 	}
 	httpClient := &http.Client{}
-	services, err := service.Init(store, c, httpClient)
+	services, err := service.Init(store, c, httpClient, service.NewProviderAdapters(c, httpClient))
 	if err != nil {
 		return err
 	}
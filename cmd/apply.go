@@ -0,0 +1,1029 @@
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/MakeNowJust/heredoc"
+	sirenv1beta1 "github.com/odpf/siren/api/proto/odpf/siren/v1beta1"
+	"github.com/odpf/siren/domain"
+	"github.com/spf13/cobra"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+const (
+	kindProvider     = "provider"
+	kindNamespace    = "namespace"
+	kindReceiver     = "receiver"
+	kindPartial      = "partial"
+	kindTemplate     = "template"
+	kindRule         = "rule"
+	kindSubscription = "subscription"
+
+	actionCreate = "create"
+	actionUpdate = "update"
+	actionDelete = "delete"
+)
+
+type providerSpec struct {
+	Urn         string                 `yaml:"urn"`
+	Name        string                 `yaml:"name"`
+	Host        string                 `yaml:"host"`
+	Type        string                 `yaml:"type"`
+	Credentials map[string]interface{} `yaml:"credentials"`
+	Labels      map[string]string      `yaml:"labels"`
+}
+
+type namespaceSpec struct {
+	Urn         string                 `yaml:"urn"`
+	Name        string                 `yaml:"name"`
+	Provider    string                 `yaml:"provider"`
+	Credentials map[string]interface{} `yaml:"credentials"`
+	Labels      map[string]string      `yaml:"labels"`
+
+	domain.Routing `yaml:",inline"`
+}
+
+type receiverSpec struct {
+	Name           string                 `yaml:"name"`
+	Type           string                 `yaml:"type"`
+	Labels         map[string]string      `yaml:"labels"`
+	Configurations map[string]interface{} `yaml:"configurations"`
+}
+
+type subscriptionReceiverSpec struct {
+	Name          string            `yaml:"name"`
+	Configuration map[string]string `yaml:"configuration"`
+}
+
+type matcherSpec struct {
+	Name     string `yaml:"name"`
+	Operator string `yaml:"operator"`
+	Value    string `yaml:"value"`
+}
+
+type subscriptionSpec struct {
+	Urn       string                     `yaml:"urn"`
+	Namespace string                     `yaml:"namespace"`
+	Provider  string                     `yaml:"provider"`
+	Receivers []subscriptionReceiverSpec `yaml:"receivers"`
+	Match     map[string]string          `yaml:"match"`
+	Matchers  []matcherSpec              `yaml:"matchers"`
+
+	MuteTimeIntervals []string `yaml:"muteTimeIntervals"`
+	domain.Routing    `yaml:",inline"`
+}
+
+// resources holds the resources read from the files given to apply
+type resources struct {
+	providers     []providerSpec
+	namespaces    []namespaceSpec
+	receivers     []receiverSpec
+	partials      []partial
+	templates     []template
+	rules         []ruleYaml
+	subscriptions []subscriptionSpec
+	kinds         map[string]bool
+}
+
+// change is a change of the plan of apply
+type change struct {
+	action string
+	kind   string
+	name   string
+	apply  func(context.Context) error
+}
+
+// planner compares resources with the server and plans the changes to make.
+// The ids of providers, namespaces and receivers are looked up when a change
+// is applied, so that changes can refer to resources created before them.
+type planner struct {
+	client       sirenv1beta1.SirenServiceClient
+	prune        bool
+	providerIDs  map[string]uint64
+	providerUrns map[uint64]string
+	namespaceIDs map[string]uint64
+	receiverIDs  map[string]uint64
+}
+
+func applyCmd(c *configuration) *cobra.Command {
+	var path string
+	var dryRun bool
+	var prune bool
+	cmd := &cobra.Command{
+		Use:   "apply",
+		Short: "Apply a directory of resource files",
+		Long: heredoc.Doc(`
+			Apply the providers, namespaces, receivers, template partials,
+			templates, rules and subscriptions of a directory of YAML files.
+
+			Every YAML document has a kind. Template, partial and rule files of
+			siren template upload and siren rule upload can be used as they are.
+			Resources refer to each other by urn, and receivers by name.
+
+			The changes to make are printed as a plan and applied in dependency
+			order. With --prune, the resources of the kinds found in the files
+			that are missing from them are deleted, and rules are only pruned in
+			the provider namespaces of the rule files.
+		`),
+		Example: heredoc.Doc(`
+			$ siren apply -f resources/ --dry-run
+			$ siren apply -f resources/
+			$ siren apply -f resources/ --prune
+		`),
+		Annotations: map[string]string{
+			"group:core": "true",
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			res, err := readResources(path)
+			if err != nil {
+				return err
+			}
+
+			ctx := context.Background()
+			client, cancel, err := createClient(ctx, c.Host)
+			if err != nil {
+				return err
+			}
+			defer cancel()
+
+			p := &planner{
+				client:       client,
+				prune:        prune,
+				providerIDs:  make(map[string]uint64),
+				providerUrns: make(map[uint64]string),
+				namespaceIDs: make(map[string]uint64),
+				receiverIDs:  make(map[string]uint64),
+			}
+			changes, err := p.plan(ctx, res)
+			if err != nil {
+				return err
+			}
+			printPlan(changes)
+			if dryRun {
+				return nil
+			}
+
+			for _, ch := range changes {
+				if err := ch.apply(ctx); err != nil {
+					return fmt.Errorf("failed to %s %s %s: %w", ch.action, ch.kind, ch.name, err)
+				}
+				fmt.Printf("%sd %s %s\n", ch.action, ch.kind, ch.name)
+			}
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVarP(&path, "file", "f", "", "path to a directory or file of resources")
+	cmd.MarkFlagRequired("file")
+	cmd.Flags().BoolVar(&dryRun, "dry-run", false, "Print the plan without applying it")
+	cmd.Flags().BoolVar(&prune, "prune", false, "Delete resources of the kinds in the files that are missing from them")
+
+	return cmd
+}
+
+func readResources(path string) (*resources, error) {
+	res := &resources{kinds: make(map[string]bool)}
+	err := filepath.Walk(path, func(filePath string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		ext := filepath.Ext(filePath)
+		if info.IsDir() || (ext != ".yaml" && ext != ".yml") {
+			return nil
+		}
+		content, err := ioutil.ReadFile(filePath)
+		if err != nil {
+			return err
+		}
+		decoder := yaml.NewDecoder(bytes.NewReader(content))
+		for {
+			var node yaml.Node
+			err := decoder.Decode(&node)
+			if errors.Is(err, io.EOF) {
+				return nil
+			}
+			if err != nil {
+				return fmt.Errorf("%s: %w", filePath, err)
+			}
+			if len(node.Content) == 0 {
+				continue
+			}
+			if err := res.add(&node); err != nil {
+				return fmt.Errorf("%s: %w", filePath, err)
+			}
+		}
+	})
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+func (r *resources) add(node *yaml.Node) error {
+	var header struct {
+		Kind string `yaml:"kind"`
+		Type string `yaml:"type"`
+	}
+	if err := node.Decode(&header); err != nil {
+		return err
+	}
+	kind := strings.ToLower(header.Kind)
+	if kind == "" {
+		switch strings.ToLower(header.Type) {
+		case kindTemplate, kindPartial, kindRule:
+			kind = strings.ToLower(header.Type)
+		default:
+			return errors.New("document has no kind")
+		}
+	}
+
+	var err error
+	switch kind {
+	case kindProvider:
+		var spec providerSpec
+		err = node.Decode(&spec)
+		r.providers = append(r.providers, spec)
+	case kindNamespace:
+		var spec namespaceSpec
+		err = node.Decode(&spec)
+		r.namespaces = append(r.namespaces, spec)
+	case kindReceiver:
+		var spec receiverSpec
+		err = node.Decode(&spec)
+		r.receivers = append(r.receivers, spec)
+	case kindPartial:
+		var spec partial
+		err = node.Decode(&spec)
+		r.partials = append(r.partials, spec)
+	case kindTemplate:
+		var spec template
+		err = node.Decode(&spec)
+		r.templates = append(r.templates, spec)
+	case kindRule:
+		var spec ruleYaml
+		err = node.Decode(&spec)
+		r.rules = append(r.rules, spec)
+	case kindSubscription:
+		var spec subscriptionSpec
+		err = node.Decode(&spec)
+		r.subscriptions = append(r.subscriptions, spec)
+	default:
+		return fmt.Errorf("unknown kind %q", header.Kind)
+	}
+	if err != nil {
+		return err
+	}
+	r.kinds[kind] = true
+	return nil
+}
+
+// plan returns the creations and updates in dependency order, followed by
+// the deletions in reverse dependency order
+func (p *planner) plan(ctx context.Context, res *resources) ([]change, error) {
+	steps := []func(context.Context, *resources) ([]change, []change, error){
+		p.planProviders,
+		p.planNamespaces,
+		p.planReceivers,
+		p.planPartials,
+		p.planTemplates,
+		p.planRules,
+		p.planSubscriptions,
+	}
+	var changes []change
+	var deletions [][]change
+	for _, step := range steps {
+		stepChanges, stepDeletions, err := step(ctx, res)
+		if err != nil {
+			return nil, err
+		}
+		changes = append(changes, stepChanges...)
+		deletions = append(deletions, stepDeletions)
+	}
+	for i := len(deletions) - 1; i >= 0; i-- {
+		changes = append(changes, deletions[i]...)
+	}
+	return changes, nil
+}
+
+func (p *planner) planProviders(ctx context.Context, res *resources) ([]change, []change, error) {
+	current, err := p.client.ListProviders(ctx, &sirenv1beta1.ListProvidersRequest{})
+	if err != nil {
+		return nil, nil, err
+	}
+	existing := make(map[string]*sirenv1beta1.Provider)
+	for _, provider := range current.GetProviders() {
+		existing[provider.GetUrn()] = provider
+		p.providerIDs[provider.GetUrn()] = provider.GetId()
+		p.providerUrns[provider.GetId()] = provider.GetUrn()
+	}
+
+	var changes, deletions []change
+	seen := make(map[string]bool)
+	for _, spec := range res.providers {
+		spec := spec
+		if seen[spec.Urn] {
+			return nil, nil, fmt.Errorf("provider %s is defined more than once", spec.Urn)
+		}
+		seen[spec.Urn] = true
+		credentials, err := structpb.NewStruct(spec.Credentials)
+		if err != nil {
+			return nil, nil, fmt.Errorf("provider %s: %w", spec.Urn, err)
+		}
+
+		provider, ok := existing[spec.Urn]
+		if !ok {
+			p.providerIDs[spec.Urn] = 0
+			changes = append(changes, change{actionCreate, kindProvider, spec.Urn, func(ctx context.Context) error {
+				created, err := p.client.CreateProvider(ctx, &sirenv1beta1.CreateProviderRequest{
+					Host:        spec.Host,
+					Urn:         spec.Urn,
+					Name:        spec.Name,
+					Type:        spec.Type,
+					Credentials: credentials,
+					Labels:      spec.Labels,
+				})
+				if err != nil {
+					return err
+				}
+				p.providerIDs[spec.Urn] = created.GetId()
+				return nil
+			}})
+			continue
+		}
+		if provider.GetHost() == spec.Host && provider.GetName() == spec.Name && provider.GetType() == spec.Type &&
+			sameLabels(provider.GetLabels(), spec.Labels) && sameStruct(provider.GetCredentials(), credentials) {
+			continue
+		}
+		changes = append(changes, change{actionUpdate, kindProvider, spec.Urn, func(ctx context.Context) error {
+			_, err := p.client.UpdateProvider(ctx, &sirenv1beta1.UpdateProviderRequest{
+				Id:          provider.GetId(),
+				Host:        spec.Host,
+				Name:        spec.Name,
+				Type:        spec.Type,
+				Credentials: credentials,
+				Labels:      spec.Labels,
+			})
+			return err
+		}})
+	}
+
+	if p.prune && res.kinds[kindProvider] {
+		for _, provider := range current.GetProviders() {
+			id := provider.GetId()
+			if seen[provider.GetUrn()] {
+				continue
+			}
+			deletions = append(deletions, change{actionDelete, kindProvider, provider.GetUrn(), func(ctx context.Context) error {
+				_, err := p.client.DeleteProvider(ctx, &sirenv1beta1.DeleteProviderRequest{Id: id})
+				return err
+			}})
+		}
+	}
+	return changes, deletions, nil
+}
+
+func (p *planner) planNamespaces(ctx context.Context, res *resources) ([]change, []change, error) {
+	current, err := p.client.ListNamespaces(ctx, &emptypb.Empty{})
+	if err != nil {
+		return nil, nil, err
+	}
+	existing := make(map[string]*sirenv1beta1.Namespace)
+	for _, namespace := range current.GetNamespaces() {
+		key := namespaceKey(p.providerUrns[namespace.GetProvider()], namespace.GetUrn())
+		existing[key] = namespace
+		p.namespaceIDs[key] = namespace.GetId()
+	}
+
+	var changes, deletions []change
+	seen := make(map[string]bool)
+	for _, spec := range res.namespaces {
+		spec := spec
+		key := namespaceKey(spec.Provider, spec.Urn)
+		if seen[key] {
+			return nil, nil, fmt.Errorf("namespace %s is defined more than once", key)
+		}
+		seen[key] = true
+		if _, ok := p.providerIDs[spec.Provider]; !ok {
+			return nil, nil, fmt.Errorf("namespace %s: provider %s not found", spec.Urn, spec.Provider)
+		}
+		credentials, err := structpb.NewStruct(spec.Credentials)
+		if err != nil {
+			return nil, nil, fmt.Errorf("namespace %s: %w", key, err)
+		}
+
+		namespace, ok := existing[key]
+		if !ok {
+			p.namespaceIDs[key] = 0
+			changes = append(changes, change{actionCreate, kindNamespace, key, func(ctx context.Context) error {
+				created, err := p.client.CreateNamespace(ctx, &sirenv1beta1.CreateNamespaceRequest{
+					Name:           spec.Name,
+					Urn:            spec.Urn,
+					Provider:       p.providerIDs[spec.Provider],
+					Credentials:    credentials,
+					Labels:         spec.Labels,
+					GroupBy:        spec.GroupBy,
+					GroupWait:      spec.GroupWait,
+					GroupInterval:  spec.GroupInterval,
+					RepeatInterval: spec.RepeatInterval,
+				})
+				if err != nil {
+					return err
+				}
+				p.namespaceIDs[key] = created.GetId()
+				return nil
+			}})
+			continue
+		}
+		if namespace.GetName() == spec.Name && sameLabels(namespace.GetLabels(), spec.Labels) &&
+			sameStruct(namespace.GetCredentials(), credentials) && sameRouting(namespace, spec.Routing) {
+			continue
+		}
+		changes = append(changes, change{actionUpdate, kindNamespace, key, func(ctx context.Context) error {
+			_, err := p.client.UpdateNamespace(ctx, &sirenv1beta1.UpdateNamespaceRequest{
+				Id:             namespace.GetId(),
+				Name:           spec.Name,
+				Provider:       namespace.GetProvider(),
+				Credentials:    credentials,
+				Labels:         spec.Labels,
+				GroupBy:        spec.GroupBy,
+				GroupWait:      spec.GroupWait,
+				GroupInterval:  spec.GroupInterval,
+				RepeatInterval: spec.RepeatInterval,
+			})
+			return err
+		}})
+	}
+
+	if p.prune && res.kinds[kindNamespace] {
+		for _, namespace := range current.GetNamespaces() {
+			id := namespace.GetId()
+			key := namespaceKey(p.providerUrns[namespace.GetProvider()], namespace.GetUrn())
+			if seen[key] {
+				continue
+			}
+			deletions = append(deletions, change{actionDelete, kindNamespace, key, func(ctx context.Context) error {
+				_, err := p.client.DeleteNamespace(ctx, &sirenv1beta1.DeleteNamespaceRequest{Id: id})
+				return err
+			}})
+		}
+	}
+	return changes, deletions, nil
+}
+
+func (p *planner) planReceivers(ctx context.Context, res *resources) ([]change, []change, error) {
+	current, err := p.client.ListReceivers(ctx, &emptypb.Empty{})
+	if err != nil {
+		return nil, nil, err
+	}
+	existing := make(map[string]*sirenv1beta1.Receiver)
+	for _, receiver := range current.GetReceivers() {
+		if _, ok := existing[receiver.GetName()]; ok {
+			continue
+		}
+		existing[receiver.GetName()] = receiver
+		p.receiverIDs[receiver.GetName()] = receiver.GetId()
+	}
+
+	var changes, deletions []change
+	seen := make(map[string]bool)
+	for _, spec := range res.receivers {
+		spec := spec
+		if seen[spec.Name] {
+			return nil, nil, fmt.Errorf("receiver %s is defined more than once", spec.Name)
+		}
+		seen[spec.Name] = true
+		configurations, err := structpb.NewStruct(spec.Configurations)
+		if err != nil {
+			return nil, nil, fmt.Errorf("receiver %s: %w", spec.Name, err)
+		}
+
+		receiver, ok := existing[spec.Name]
+		if !ok {
+			p.receiverIDs[spec.Name] = 0
+			changes = append(changes, change{actionCreate, kindReceiver, spec.Name, func(ctx context.Context) error {
+				created, err := p.client.CreateReceiver(ctx, &sirenv1beta1.CreateReceiverRequest{
+					Name:           spec.Name,
+					Type:           spec.Type,
+					Labels:         spec.Labels,
+					Configurations: configurations,
+				})
+				if err != nil {
+					return err
+				}
+				p.receiverIDs[spec.Name] = created.GetId()
+				return nil
+			}})
+			continue
+		}
+		// slack receivers store the token the auth code was exchanged for
+		// instead of their configurations, which can then not be compared
+		if receiver.GetType() == spec.Type && sameLabels(receiver.GetLabels(), spec.Labels) &&
+			(spec.Type == "slack" || sameStruct(receiver.GetConfigurations(), configurations)) {
+			continue
+		}
+		changes = append(changes, change{actionUpdate, kindReceiver, spec.Name, func(ctx context.Context) error {
+			_, err := p.client.UpdateReceiver(ctx, &sirenv1beta1.UpdateReceiverRequest{
+				Id:             receiver.GetId(),
+				Name:           spec.Name,
+				Type:           spec.Type,
+				Labels:         spec.Labels,
+				Configurations: configurations,
+			})
+			return err
+		}})
+	}
+
+	if p.prune && res.kinds[kindReceiver] {
+		for _, receiver := range current.GetReceivers() {
+			id := receiver.GetId()
+			if seen[receiver.GetName()] {
+				continue
+			}
+			deletions = append(deletions, change{actionDelete, kindReceiver, receiver.GetName(), func(ctx context.Context) error {
+				_, err := p.client.DeleteReceiver(ctx, &sirenv1beta1.DeleteReceiverRequest{Id: id})
+				return err
+			}})
+		}
+	}
+	return changes, deletions, nil
+}
+
+func (p *planner) planPartials(ctx context.Context, res *resources) ([]change, []change, error) {
+	current, err := p.client.ListTemplatePartials(ctx, &emptypb.Empty{})
+	if err != nil {
+		return nil, nil, err
+	}
+	existing := make(map[string]*sirenv1beta1.TemplatePartial)
+	for _, partial := range current.GetPartials() {
+		existing[partial.GetName()] = partial
+	}
+
+	var changes, deletions []change
+	seen := make(map[string]bool)
+	for _, spec := range res.partials {
+		spec := spec
+		if seen[spec.Name] {
+			return nil, nil, fmt.Errorf("partial %s is defined more than once", spec.Name)
+		}
+		seen[spec.Name] = true
+
+		action := actionCreate
+		if partial, ok := existing[spec.Name]; ok {
+			if partial.GetBody() == spec.Body {
+				continue
+			}
+			action = actionUpdate
+		}
+		changes = append(changes, change{action, kindPartial, spec.Name, func(ctx context.Context) error {
+			_, err := p.client.UpsertTemplatePartial(ctx, &sirenv1beta1.UpsertTemplatePartialRequest{
+				Name: spec.Name,
+				Body: spec.Body,
+			})
+			return err
+		}})
+	}
+
+	if p.prune && res.kinds[kindPartial] {
+		for _, partial := range current.GetPartials() {
+			name := partial.GetName()
+			if seen[name] {
+				continue
+			}
+			deletions = append(deletions, change{actionDelete, kindPartial, name, func(ctx context.Context) error {
+				_, err := p.client.DeleteTemplatePartial(ctx, &sirenv1beta1.DeleteTemplatePartialRequest{Name: name})
+				return err
+			}})
+		}
+	}
+	return changes, deletions, nil
+}
+
+func (p *planner) planTemplates(ctx context.Context, res *resources) ([]change, []change, error) {
+	current, err := p.client.ListTemplates(ctx, &sirenv1beta1.ListTemplatesRequest{})
+	if err != nil {
+		return nil, nil, err
+	}
+	existing := make(map[string]*sirenv1beta1.Template)
+	for _, t := range current.GetTemplates() {
+		existing[t.GetName()] = t
+	}
+
+	var changes, deletions []change
+	seen := make(map[string]bool)
+	for _, spec := range res.templates {
+		spec := spec
+		if seen[spec.Name] {
+			return nil, nil, fmt.Errorf("template %s is defined more than once", spec.Name)
+		}
+		seen[spec.Name] = true
+
+		action := actionCreate
+		if t, ok := existing[spec.Name]; ok {
+			same, err := sameTemplate(t, spec)
+			if err != nil {
+				return nil, nil, fmt.Errorf("template %s: %w", spec.Name, err)
+			}
+			if same {
+				continue
+			}
+			action = actionUpdate
+		}
+		changes = append(changes, change{action, kindTemplate, spec.Name, func(ctx context.Context) error {
+			upserted, err := upsertTemplate(p.client, spec, false)
+			if err != nil {
+				return err
+			}
+			printRuleGroupSyncs(upserted.RuleGroups, false)
+			return nil
+		}})
+	}
+
+	if p.prune && res.kinds[kindTemplate] {
+		for _, t := range current.GetTemplates() {
+			name := t.GetName()
+			if seen[name] {
+				continue
+			}
+			deletions = append(deletions, change{actionDelete, kindTemplate, name, func(ctx context.Context) error {
+				_, err := p.client.DeleteTemplate(ctx, &sirenv1beta1.DeleteTemplateRequest{Name: name})
+				return err
+			}})
+		}
+	}
+	return changes, deletions, nil
+}
+
+func (p *planner) planRules(ctx context.Context, res *resources) ([]change, []change, error) {
+	var changes, deletions []change
+	existing := make(map[string][]*sirenv1beta1.Rule)
+	var namespaceKeys []string
+	seen := make(map[string]bool)
+	for _, doc := range res.rules {
+		if doc.Provider == "" || doc.ProviderNamespace == "" {
+			return nil, nil, fmt.Errorf("rules of namespace %s need a provider and a provider namespace", doc.Namespace)
+		}
+		nsKey := namespaceKey(doc.Provider, doc.ProviderNamespace)
+		nsID, ok := p.namespaceIDs[nsKey]
+		if !ok {
+			return nil, nil, fmt.Errorf("rules of namespace %s: provider namespace %s not found", doc.Namespace, nsKey)
+		}
+		if _, ok := existing[nsKey]; !ok {
+			existing[nsKey] = nil
+			namespaceKeys = append(namespaceKeys, nsKey)
+			if nsID != 0 {
+				current, err := p.client.ListRules(ctx, &sirenv1beta1.ListRulesRequest{ProviderNamespace: nsID})
+				if err != nil {
+					return nil, nil, err
+				}
+				existing[nsKey] = current.GetRules()
+			}
+		}
+
+		groupNames := make([]string, 0, len(doc.Rules))
+		for groupName := range doc.Rules {
+			groupNames = append(groupNames, groupName)
+		}
+		sort.Strings(groupNames)
+		for _, groupName := range groupNames {
+			namespace, groupName, spec := doc.Namespace, groupName, doc.Rules[groupName]
+			key := ruleKey(nsKey, namespace, groupName, spec.Template)
+			if seen[key] {
+				return nil, nil, fmt.Errorf("rule %s is defined more than once", key)
+			}
+			seen[key] = true
+
+			action := actionCreate
+			for _, r := range existing[nsKey] {
+				if ruleKey(nsKey, r.GetNamespace(), r.GetGroupName(), r.GetTemplate()) != key {
+					continue
+				}
+				same, err := p.sameRule(ctx, nsID, r, spec)
+				if err != nil {
+					return nil, nil, err
+				}
+				action = actionUpdate
+				if same {
+					action = ""
+				}
+				break
+			}
+			if action == "" {
+				continue
+			}
+			changes = append(changes, change{action, kindRule, key, func(ctx context.Context) error {
+				return p.upsertRule(ctx, p.namespaceIDs[nsKey], namespace, groupName, spec)
+			}})
+		}
+	}
+
+	if p.prune && res.kinds[kindRule] {
+		for _, nsKey := range namespaceKeys {
+			for _, r := range existing[nsKey] {
+				id := r.GetId()
+				key := ruleKey(nsKey, r.GetNamespace(), r.GetGroupName(), r.GetTemplate())
+				if seen[key] {
+					continue
+				}
+				deletions = append(deletions, change{actionDelete, kindRule, key, func(ctx context.Context) error {
+					_, err := p.client.DeleteRule(ctx, &sirenv1beta1.DeleteRuleRequest{Id: id})
+					return err
+				}})
+			}
+		}
+	}
+	return changes, deletions, nil
+}
+
+// sameRule tells whether a rule and the settings of its group already are as
+// specified. Variables left out of the spec are not compared, as the server
+// stores them with their defaults.
+func (p *planner) sameRule(ctx context.Context, providerNamespace uint64, current *sirenv1beta1.Rule, spec rule) (bool, error) {
+	if current.GetTemplateVersion() != spec.TemplateVersion || current.GetEnabled() != spec.Enabled {
+		return false, nil
+	}
+	values := make(map[string]string)
+	for _, variable := range current.GetVariables() {
+		values[variable.GetName()] = variable.GetValue()
+	}
+	for _, variable := range spec.Variables {
+		if value, ok := values[variable.Name]; !ok || value != variable.Value {
+			return false, nil
+		}
+	}
+	if spec.Interval == "" && spec.Limit == 0 && len(spec.SourceTenants) == 0 {
+		return true, nil
+	}
+	group, err := p.client.GetRuleGroup(ctx, &sirenv1beta1.GetRuleGroupRequest{
+		ProviderNamespace: providerNamespace,
+		Namespace:         current.GetNamespace(),
+		GroupName:         current.GetGroupName(),
+	})
+	if err != nil {
+		return false, err
+	}
+	return (spec.Interval == "" || group.GetInterval() == spec.Interval) &&
+		(spec.Limit == 0 || group.GetLimit() == spec.Limit) &&
+		(len(spec.SourceTenants) == 0 || reflect.DeepEqual(group.GetSourceTenants(), spec.SourceTenants)), nil
+}
+
+func (p *planner) upsertRule(ctx context.Context, providerNamespace uint64, namespace, groupName string, spec rule) error {
+	if spec.Interval != "" || spec.Limit != 0 || len(spec.SourceTenants) != 0 {
+		_, err := p.client.UpdateRuleGroup(ctx, &sirenv1beta1.UpdateRuleGroupRequest{
+			ProviderNamespace: providerNamespace,
+			Namespace:         namespace,
+			GroupName:         groupName,
+			Interval:          spec.Interval,
+			Limit:             spec.Limit,
+			SourceTenants:     spec.SourceTenants,
+		})
+		if err != nil {
+			return err
+		}
+	}
+	ruleVariables := make([]*sirenv1beta1.Variables, 0, len(spec.Variables))
+	for _, variable := range spec.Variables {
+		ruleVariables = append(ruleVariables, &sirenv1beta1.Variables{Name: variable.Name, Value: variable.Value})
+	}
+	_, err := p.client.UpdateRule(ctx, &sirenv1beta1.UpdateRuleRequest{
+		GroupName:         groupName,
+		Namespace:         namespace,
+		Template:          spec.Template,
+		TemplateVersion:   spec.TemplateVersion,
+		Variables:         ruleVariables,
+		ProviderNamespace: providerNamespace,
+		Enabled:           spec.Enabled,
+	})
+	return err
+}
+
+func (p *planner) planSubscriptions(ctx context.Context, res *resources) ([]change, []change, error) {
+	current, err := p.client.ListSubscriptions(ctx, &emptypb.Empty{})
+	if err != nil {
+		return nil, nil, err
+	}
+	existing := make(map[string]*sirenv1beta1.Subscription)
+	for _, subscription := range current.GetSubscriptions() {
+		existing[subscription.GetUrn()] = subscription
+	}
+
+	var changes, deletions []change
+	seen := make(map[string]bool)
+	for _, spec := range res.subscriptions {
+		spec := spec
+		if seen[spec.Urn] {
+			return nil, nil, fmt.Errorf("subscription %s is defined more than once", spec.Urn)
+		}
+		seen[spec.Urn] = true
+		nsKey := namespaceKey(spec.Provider, spec.Namespace)
+		if _, ok := p.namespaceIDs[nsKey]; !ok {
+			return nil, nil, fmt.Errorf("subscription %s: namespace %s not found", spec.Urn, nsKey)
+		}
+		for _, receiver := range spec.Receivers {
+			if _, ok := p.receiverIDs[receiver.Name]; !ok {
+				return nil, nil, fmt.Errorf("subscription %s: receiver %s not found", spec.Urn, receiver.Name)
+			}
+		}
+
+		subscription, ok := existing[spec.Urn]
+		if !ok {
+			changes = append(changes, change{actionCreate, kindSubscription, spec.Urn, func(ctx context.Context) error {
+				_, err := p.client.CreateSubscription(ctx, &sirenv1beta1.CreateSubscriptionRequest{
+					Urn:            spec.Urn,
+					Namespace:      p.namespaceIDs[nsKey],
+					Receivers:      p.subscriptionReceivers(spec),
+					Match:          spec.Match,
+					Matchers:       subscriptionMatchers(spec),
+					GroupBy:        spec.GroupBy,
+					GroupWait:      spec.GroupWait,
+					GroupInterval:  spec.GroupInterval,
+					RepeatInterval: spec.RepeatInterval,
+
+					MuteTimeIntervals: spec.MuteTimeIntervals,
+				})
+				return err
+			}})
+			continue
+		}
+		if subscription.GetNamespace() == p.namespaceIDs[nsKey] && sameLabels(subscription.GetMatch(), spec.Match) &&
+			sameMatchers(subscription.GetMatchers(), subscriptionMatchers(spec)) && sameRouting(subscription, spec.Routing) &&
+			sameReceivers(subscription.GetReceivers(), p.subscriptionReceivers(spec)) &&
+			sameStrings(subscription.GetMuteTimeIntervals(), spec.MuteTimeIntervals) {
+			continue
+		}
+		changes = append(changes, change{actionUpdate, kindSubscription, spec.Urn, func(ctx context.Context) error {
+			_, err := p.client.UpdateSubscription(ctx, &sirenv1beta1.UpdateSubscriptionRequest{
+				Id:             subscription.GetId(),
+				Urn:            spec.Urn,
+				Namespace:      p.namespaceIDs[nsKey],
+				Receivers:      p.subscriptionReceivers(spec),
+				Match:          spec.Match,
+				Matchers:       subscriptionMatchers(spec),
+				GroupBy:        spec.GroupBy,
+				GroupWait:      spec.GroupWait,
+				GroupInterval:  spec.GroupInterval,
+				RepeatInterval: spec.RepeatInterval,
+
+				MuteTimeIntervals: spec.MuteTimeIntervals,
+			})
+			return err
+		}})
+	}
+
+	if p.prune && res.kinds[kindSubscription] {
+		for _, subscription := range current.GetSubscriptions() {
+			id := subscription.GetId()
+			if seen[subscription.GetUrn()] {
+				continue
+			}
+			deletions = append(deletions, change{actionDelete, kindSubscription, subscription.GetUrn(), func(ctx context.Context) error {
+				_, err := p.client.DeleteSubscription(ctx, &sirenv1beta1.DeleteSubscriptionRequest{Id: id})
+				return err
+			}})
+		}
+	}
+	return changes, deletions, nil
+}
+
+func (p *planner) subscriptionReceivers(spec subscriptionSpec) []*sirenv1beta1.ReceiverMetadata {
+	receivers := make([]*sirenv1beta1.ReceiverMetadata, 0, len(spec.Receivers))
+	for _, receiver := range spec.Receivers {
+		receivers = append(receivers, &sirenv1beta1.ReceiverMetadata{
+			Id:            p.receiverIDs[receiver.Name],
+			Configuration: receiver.Configuration,
+		})
+	}
+	return receivers
+}
+
+func subscriptionMatchers(spec subscriptionSpec) []*sirenv1beta1.Matcher {
+	matchers := make([]*sirenv1beta1.Matcher, 0, len(spec.Matchers))
+	for _, matcher := range spec.Matchers {
+		matchers = append(matchers, &sirenv1beta1.Matcher{
+			Name:     matcher.Name,
+			Operator: matcher.Operator,
+			Value:    matcher.Value,
+		})
+	}
+	return matchers
+}
+
+func namespaceKey(providerUrn, urn string) string {
+	return providerUrn + "/" + urn
+}
+
+func ruleKey(namespaceKey, namespace, groupName, template string) string {
+	return fmt.Sprintf("%s/%s/%s/%s", namespaceKey, namespace, groupName, template)
+}
+
+func sameLabels(current, desired map[string]string) bool {
+	return (len(current) == 0 && len(desired) == 0) || reflect.DeepEqual(current, desired)
+}
+
+func sameStrings(current, desired []string) bool {
+	return (len(current) == 0 && len(desired) == 0) || reflect.DeepEqual(current, desired)
+}
+
+func sameStruct(current, desired *structpb.Struct) bool {
+	return reflect.DeepEqual(current.AsMap(), desired.AsMap())
+}
+
+func sameReceivers(current, desired []*sirenv1beta1.ReceiverMetadata) bool {
+	if len(current) != len(desired) {
+		return false
+	}
+	for i := range current {
+		if current[i].GetId() != desired[i].GetId() || !sameLabels(current[i].GetConfiguration(), desired[i].GetConfiguration()) {
+			return false
+		}
+	}
+	return true
+}
+
+func sameMatchers(current, desired []*sirenv1beta1.Matcher) bool {
+	if len(current) != len(desired) {
+		return false
+	}
+	for i := range current {
+		if !proto.Equal(current[i], desired[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// routed is implemented by the namespaces and subscriptions carrying a routing
+type routed interface {
+	GetGroupBy() []string
+	GetGroupWait() string
+	GetGroupInterval() string
+	GetRepeatInterval() string
+}
+
+func sameRouting(current routed, desired domain.Routing) bool {
+	return (len(current.GetGroupBy()) == 0 && len(desired.GroupBy) == 0 || reflect.DeepEqual(current.GetGroupBy(), desired.GroupBy)) &&
+		current.GetGroupWait() == desired.GroupWait &&
+		current.GetGroupInterval() == desired.GroupInterval &&
+		current.GetRepeatInterval() == desired.RepeatInterval
+}
+
+// sameTemplate compares a template with a template file, with both bodies
+// formatted the way template upload formats them
+func sameTemplate(current *sirenv1beta1.Template, spec template) (bool, error) {
+	var currentBody []templatedRule
+	if err := yaml.Unmarshal([]byte(current.GetBody()), &currentBody); err != nil {
+		return false, nil
+	}
+	currentYaml, err := yaml.Marshal(currentBody)
+	if err != nil {
+		return false, err
+	}
+	desiredYaml, err := yaml.Marshal(spec.Body)
+	if err != nil {
+		return false, err
+	}
+	if string(currentYaml) != string(desiredYaml) {
+		return false, nil
+	}
+	if !(len(current.GetTags()) == 0 && len(spec.Tags) == 0) && !reflect.DeepEqual(current.GetTags(), spec.Tags) {
+		return false, nil
+	}
+	variables := templateVariables(spec.Variables)
+	if len(current.GetVariables()) != len(variables) {
+		return false, nil
+	}
+	for i := range variables {
+		if !proto.Equal(current.GetVariables()[i], variables[i]) {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
+func printPlan(changes []change) {
+	if len(changes) == 0 {
+		fmt.Println("No changes, the resources are up to date")
+		return
+	}
+	symbols := map[string]string{actionCreate: "+", actionUpdate: "~", actionDelete: "-"}
+	counts := make(map[string]int)
+	for _, ch := range changes {
+		fmt.Printf("%s %s %s\n", symbols[ch.action], ch.kind, ch.name)
+		counts[ch.action]++
+	}
+	fmt.Printf("\nPlan: %d to create, %d to update, %d to delete\n\n",
+		counts[actionCreate], counts[actionUpdate], counts[actionDelete])
+}
@@ -0,0 +1,315 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/odpf/salt/printer"
+	sirenv1beta1 "github.com/odpf/siren/api/proto/odpf/siren/v1beta1"
+	"github.com/odpf/siren/domain"
+	"github.com/spf13/cobra"
+)
+
+func inhibitionRulesCmd(c *configuration) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "inhibition",
+		Aliases: []string{"inhibitions"},
+		Short:   "Manage inhibition rules",
+		Long: heredoc.Doc(`
+			Work with inhibition rules.
+
+			Inhibition rules mute the alerts of a namespace matching the target
+			matchers while an alert matching the source matchers is firing.
+		`),
+		Annotations: map[string]string{
+			"group:core": "true",
+		},
+	}
+
+	cmd.AddCommand(listInhibitionRulesCmd(c))
+	cmd.AddCommand(createInhibitionRuleCmd(c))
+	cmd.AddCommand(getInhibitionRuleCmd(c))
+	cmd.AddCommand(updateInhibitionRuleCmd(c))
+	cmd.AddCommand(deleteInhibitionRuleCmd(c))
+	return cmd
+}
+
+func listInhibitionRulesCmd(c *configuration) *cobra.Command {
+	var namespace uint64
+	cmd := &cobra.Command{
+		Use:   "list",
+		Short: "List inhibition rules",
+		Long: heredoc.Doc(`
+			List all inhibition rules, or only the ones of a namespace.
+		`),
+		Example: heredoc.Doc(`
+			$ siren inhibition list --namespace 1
+		`),
+		Annotations: map[string]string{
+			"group:core": "true",
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := context.Background()
+			client, cancel, err := createClient(ctx, c.Host)
+			if err != nil {
+				return err
+			}
+			defer cancel()
+
+			res, err := client.ListInhibitionRules(ctx, &sirenv1beta1.ListInhibitionRulesRequest{
+				Namespace: namespace,
+			})
+			if err != nil {
+				return err
+			}
+
+			inhibitionRules := res.InhibitionRules
+			report := [][]string{}
+
+			fmt.Printf(" \nShowing %d of %d inhibition rules\n \n", len(inhibitionRules), len(inhibitionRules))
+			report = append(report, []string{"ID", "URN", "NAMESPACE", "EQUAL"})
+
+			for _, p := range inhibitionRules {
+				report = append(report, []string{
+					fmt.Sprintf("%v", p.GetId()),
+					p.GetUrn(),
+					fmt.Sprintf("%v", p.GetNamespace()),
+					strings.Join(p.GetEqual(), ","),
+				})
+			}
+			printer.Table(os.Stdout, report)
+
+			fmt.Println("\nFor details on an inhibition rule, try: siren inhibition view <id>")
+			return nil
+		},
+	}
+
+	cmd.Flags().Uint64Var(&namespace, "namespace", 0, "namespace id")
+
+	return cmd
+}
+
+func createInhibitionRuleCmd(c *configuration) *cobra.Command {
+	var filePath string
+	cmd := &cobra.Command{
+		Use:   "create",
+		Short: "Create a new inhibition rule",
+		Long: heredoc.Doc(`
+			Create a new inhibition rule.
+		`),
+		Annotations: map[string]string{
+			"group:core": "true",
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			var inhibitionRuleConfig domain.InhibitionRule
+			if err := parseFile(filePath, &inhibitionRuleConfig); err != nil {
+				return err
+			}
+
+			ctx := context.Background()
+			client, cancel, err := createClient(ctx, c.Host)
+			if err != nil {
+				return err
+			}
+			defer cancel()
+
+			res, err := client.CreateInhibitionRule(ctx, &sirenv1beta1.CreateInhibitionRuleRequest{
+				Urn:            inhibitionRuleConfig.Urn,
+				Namespace:      inhibitionRuleConfig.Namespace,
+				SourceMatchers: getMatchers(inhibitionRuleConfig.SourceMatchers),
+				TargetMatchers: getMatchers(inhibitionRuleConfig.TargetMatchers),
+				Equal:          inhibitionRuleConfig.Equal,
+			})
+			if err != nil {
+				return err
+			}
+
+			fmt.Printf("inhibition rule created with id: %v\n", res.GetId())
+
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVarP(&filePath, "file", "f", "", "path to the inhibition rule config")
+	cmd.MarkFlagRequired("file")
+
+	return cmd
+}
+
+func getInhibitionRuleCmd(c *configuration) *cobra.Command {
+	var format string
+	cmd := &cobra.Command{
+		Use:   "view",
+		Short: "View an inhibition rule details",
+		Long: heredoc.Doc(`
+			View an inhibition rule.
+
+			Display the Id, namespace, matchers and equal labels of an inhibition rule.
+		`),
+		Example: heredoc.Doc(`
+			$ siren inhibition view 1
+		`),
+		Annotations: map[string]string{
+			"group:core": "true",
+		},
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := context.Background()
+			client, cancel, err := createClient(ctx, c.Host)
+			if err != nil {
+				return err
+			}
+			defer cancel()
+
+			id, err := strconv.ParseUint(args[0], 10, 32)
+			if err != nil {
+				return fmt.Errorf("invalid inhibition rule id: %v", err)
+			}
+
+			res, err := client.GetInhibitionRule(ctx, &sirenv1beta1.GetInhibitionRuleRequest{
+				Id: uint64(id),
+			})
+			if err != nil {
+				return err
+			}
+
+			inhibitionRule := &domain.InhibitionRule{
+				Id:             res.GetId(),
+				Urn:            res.GetUrn(),
+				Namespace:      res.GetNamespace(),
+				SourceMatchers: getDomainMatchers(res.GetSourceMatchers()),
+				TargetMatchers: getDomainMatchers(res.GetTargetMatchers()),
+				Equal:          res.GetEqual(),
+				CreatedAt:      res.CreatedAt.AsTime(),
+				UpdatedAt:      res.UpdatedAt.AsTime(),
+			}
+
+			if err := printer.Text(inhibitionRule, format); err != nil {
+				return fmt.Errorf("failed to format inhibition rule: %v", err)
+			}
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVar(&format, "format", "yaml", "Print output with the selected format")
+
+	return cmd
+}
+
+func updateInhibitionRuleCmd(c *configuration) *cobra.Command {
+	var id uint64
+	var filePath string
+	cmd := &cobra.Command{
+		Use:   "edit",
+		Short: "Edit an inhibition rule",
+		Long: heredoc.Doc(`
+			Edit an existing inhibition rule.
+		`),
+		Annotations: map[string]string{
+			"group:core": "true",
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			var inhibitionRuleConfig domain.InhibitionRule
+			if err := parseFile(filePath, &inhibitionRuleConfig); err != nil {
+				return err
+			}
+
+			ctx := context.Background()
+			client, cancel, err := createClient(ctx, c.Host)
+			if err != nil {
+				return err
+			}
+			defer cancel()
+
+			_, err = client.UpdateInhibitionRule(ctx, &sirenv1beta1.UpdateInhibitionRuleRequest{
+				Id:             id,
+				Urn:            inhibitionRuleConfig.Urn,
+				Namespace:      inhibitionRuleConfig.Namespace,
+				SourceMatchers: getMatchers(inhibitionRuleConfig.SourceMatchers),
+				TargetMatchers: getMatchers(inhibitionRuleConfig.TargetMatchers),
+				Equal:          inhibitionRuleConfig.Equal,
+			})
+			if err != nil {
+				return err
+			}
+
+			fmt.Println("Successfully updated inhibition rule")
+
+			return nil
+		},
+	}
+
+	cmd.Flags().Uint64Var(&id, "id", 0, "inhibition rule id")
+	cmd.MarkFlagRequired("id")
+	cmd.Flags().StringVarP(&filePath, "file", "f", "", "Path to the inhibition rule config")
+	cmd.MarkFlagRequired("file")
+
+	return cmd
+}
+
+func deleteInhibitionRuleCmd(c *configuration) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "delete",
+		Short: "Delete an inhibition rule",
+		Example: heredoc.Doc(`
+			$ siren inhibition delete 1
+		`),
+		Annotations: map[string]string{
+			"group:core": "true",
+		},
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := context.Background()
+			client, cancel, err := createClient(ctx, c.Host)
+			if err != nil {
+				return err
+			}
+			defer cancel()
+
+			id, err := strconv.ParseUint(args[0], 10, 32)
+			if err != nil {
+				return fmt.Errorf("invalid inhibition rule id: %v", err)
+			}
+
+			_, err = client.DeleteInhibitionRule(ctx, &sirenv1beta1.DeleteInhibitionRuleRequest{
+				Id: uint64(id),
+			})
+			if err != nil {
+				return err
+			}
+
+			fmt.Println("Successfully deleted inhibition rule")
+			return nil
+		},
+	}
+
+	return cmd
+}
+
+func getMatchers(domainMatchers []domain.Matcher) []*sirenv1beta1.Matcher {
+	matchers := make([]*sirenv1beta1.Matcher, 0, len(domainMatchers))
+	for _, matcher := range domainMatchers {
+		matchers = append(matchers, &sirenv1beta1.Matcher{
+			Name:     matcher.Name,
+			Operator: matcher.Operator,
+			Value:    matcher.Value,
+		})
+	}
+	return matchers
+}
+
+func getDomainMatchers(matchers []*sirenv1beta1.Matcher) []domain.Matcher {
+	domainMatchers := make([]domain.Matcher, 0, len(matchers))
+	for _, matcher := range matchers {
+		domainMatchers = append(domainMatchers, domain.Matcher{
+			Name:     matcher.GetName(),
+			Operator: matcher.GetOperator(),
+			Value:    matcher.GetValue(),
+		})
+	}
+	return domainMatchers
+}
@@ -112,11 +112,15 @@ func createNamespaceCmd(c *configuration) *cobra.Command {
 			defer cancel()
 
 			res, err := client.CreateNamespace(ctx, &sirenv1beta1.CreateNamespaceRequest{
-				Provider:    namespaceConfig.Provider,
-				Urn:         namespaceConfig.Urn,
-				Name:        namespaceConfig.Name,
-				Credentials: grpcCredentials,
-				Labels:      namespaceConfig.Labels,
+				Provider:       namespaceConfig.Provider,
+				Urn:            namespaceConfig.Urn,
+				Name:           namespaceConfig.Name,
+				Credentials:    grpcCredentials,
+				Labels:         namespaceConfig.Labels,
+				GroupBy:        namespaceConfig.GroupBy,
+				GroupWait:      namespaceConfig.GroupWait,
+				GroupInterval:  namespaceConfig.GroupInterval,
+				RepeatInterval: namespaceConfig.RepeatInterval,
 			})
 
 			if err != nil {
@@ -180,6 +184,12 @@ func getNamespaceCmd(c *configuration) *cobra.Command {
 				Labels:      res.GetLabels(),
 				CreatedAt:   res.CreatedAt.AsTime(),
 				UpdatedAt:   res.UpdatedAt.AsTime(),
+				Routing: domain.Routing{
+					GroupBy:        res.GetGroupBy(),
+					GroupWait:      res.GetGroupWait(),
+					GroupInterval:  res.GetGroupInterval(),
+					RepeatInterval: res.GetRepeatInterval(),
+				},
 			}
 
 			if err := printer.Text(namespace, format); err != nil {
@@ -225,11 +235,15 @@ func updateNamespaceCmd(c *configuration) *cobra.Command {
 			defer cancel()
 
 			_, err = client.UpdateNamespace(ctx, &sirenv1beta1.UpdateNamespaceRequest{
-				Id:          id,
-				Provider:    namespaceConfig.Provider,
-				Name:        namespaceConfig.Name,
-				Credentials: grpcCredentials,
-				Labels:      namespaceConfig.Labels,
+				Id:             id,
+				Provider:       namespaceConfig.Provider,
+				Name:           namespaceConfig.Name,
+				Credentials:    grpcCredentials,
+				Labels:         namespaceConfig.Labels,
+				GroupBy:        namespaceConfig.GroupBy,
+				GroupWait:      namespaceConfig.GroupWait,
+				GroupInterval:  namespaceConfig.GroupInterval,
+				RepeatInterval: namespaceConfig.RepeatInterval,
 			})
 			if err != nil {
 				return err
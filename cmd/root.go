@@ -43,9 +43,13 @@ func Execute() {
 	rootCmd.AddCommand(providersCmd(cliConfig))
 	rootCmd.AddCommand(namespacesCmd(cliConfig))
 	rootCmd.AddCommand(receiversCmd(cliConfig))
+	rootCmd.AddCommand(inhibitionRulesCmd(cliConfig))
+	rootCmd.AddCommand(timeIntervalsCmd(cliConfig))
+	rootCmd.AddCommand(silencesCmd(cliConfig))
 	rootCmd.AddCommand(templatesCmd(cliConfig))
 	rootCmd.AddCommand(rulesCmd(cliConfig))
 	rootCmd.AddCommand(alertsCmd(cliConfig))
+	rootCmd.AddCommand(applyCmd(cliConfig))
 
 	if err := rootCmd.Execute(); err != nil {
 		fmt.Fprintln(os.Stderr, err)
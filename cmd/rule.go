@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"io/ioutil"
 	"os"
+	"path/filepath"
 	"strconv"
 	"strings"
 
@@ -17,6 +18,9 @@ import (
 	"github.com/odpf/salt/printer"
 	sirenv1beta1 "github.com/odpf/siren/api/proto/odpf/siren/v1beta1"
 	"github.com/odpf/siren/domain"
+	"github.com/odpf/siren/pkg/ruletest"
+	"github.com/odpf/siren/pkg/templates"
+	"github.com/prometheus/common/model"
 	"github.com/spf13/cobra"
 )
 
@@ -25,14 +29,18 @@ type variables struct {
 	Value string `yaml:"value"`
 }
 type rule struct {
-	Template  string      `yaml:"template"`
-	Enabled   bool        `yaml:"enabled"`
-	Variables []variables `yaml:"variables"`
+	Template        string      `yaml:"template"`
+	TemplateVersion uint64      `yaml:"templateVersion,omitempty"`
+	Enabled         bool        `yaml:"enabled"`
+	Variables       []variables `yaml:"variables"`
+	Interval        string      `yaml:"interval,omitempty"`
+	Limit           uint32      `yaml:"limit,omitempty"`
+	SourceTenants   []string    `yaml:"sourceTenants,omitempty"`
 }
 
 type ruleYaml struct {
 	ApiVersion        string          `yaml:"apiVersion"`
-	Entity            string          `yaml:"entity"`
+	Entity            string          `yaml:"entity,omitempty"`
 	Type              string          `yaml:"type"`
 	Namespace         string          `yaml:"namespace"`
 	Provider          string          `yaml:"provider"`
@@ -40,6 +48,14 @@ type ruleYaml struct {
 	Rules             map[string]rule `yaml:"rules"`
 }
 
+type ruleTestYaml struct {
+	Template           string               `yaml:"template"`
+	TemplateFile       string               `yaml:"templateFile"`
+	Variables          []variables          `yaml:"variables"`
+	EvaluationInterval model.Duration       `yaml:"evaluationInterval"`
+	Tests              []ruletest.TestGroup `yaml:"tests"`
+}
+
 func rulesCmd(c *configuration) *cobra.Command {
 	cmd := &cobra.Command{
 		Use:     "rule",
@@ -57,7 +73,14 @@ func rulesCmd(c *configuration) *cobra.Command {
 
 	cmd.AddCommand(listRulesCmd(c))
 	cmd.AddCommand(updateRuleCmd(c))
+	cmd.AddCommand(deleteRuleCmd(c))
+	cmd.AddCommand(importRuleCmd(c))
+	cmd.AddCommand(listRuleRevisionsCmd(c))
+	cmd.AddCommand(rollbackRuleCmd(c))
+	cmd.AddCommand(upgradeRuleCmd(c))
 	cmd.AddCommand(uploadRuleCmd(c))
+	cmd.AddCommand(downloadRuleCmd(c))
+	cmd.AddCommand(testRuleCmd(c))
 
 	return cmd
 }
@@ -166,6 +189,7 @@ func updateRuleCmd(c *configuration) *cobra.Command {
 				GroupName:         ruleConfig.GroupName,
 				Namespace:         ruleConfig.Namespace,
 				Template:          ruleConfig.Template,
+				TemplateVersion:   uint64(ruleConfig.TemplateVersion),
 				Variables:         variables,
 				ProviderNamespace: ruleConfig.ProviderNamespace,
 			})
@@ -187,11 +211,324 @@ func updateRuleCmd(c *configuration) *cobra.Command {
 	return cmd
 }
 
+func deleteRuleCmd(c *configuration) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "delete",
+		Short: "Delete a rule",
+		Long: heredoc.Doc(`
+			Delete a rule.
+
+			The rule is removed from siren and the rule group it belongs to
+			is re-synced with the provider.
+		`),
+		Example: heredoc.Doc(`
+			$ siren rule delete 1
+		`),
+		Annotations: map[string]string{
+			"group:core": "true",
+		},
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := context.Background()
+			client, cancel, err := createClient(ctx, c.Host)
+			if err != nil {
+				return err
+			}
+			defer cancel()
+
+			id, err := strconv.ParseUint(args[0], 10, 32)
+			if err != nil {
+				return fmt.Errorf("invalid rule id: %v", err)
+			}
+
+			_, err = client.DeleteRule(ctx, &sirenv1beta1.DeleteRuleRequest{
+				Id: uint64(id),
+			})
+			if err != nil {
+				return err
+			}
+
+			fmt.Println("Successfully deleted rule")
+			return nil
+		},
+	}
+
+	return cmd
+}
+
+func listRuleRevisionsCmd(c *configuration) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "revisions",
+		Short: "List revisions of a rule",
+		Long: heredoc.Doc(`
+			List the revisions of a rule, latest first.
+
+			A revision is stored every time the rule is created or updated.
+		`),
+		Example: heredoc.Doc(`
+			$ siren rule revisions 1
+		`),
+		Annotations: map[string]string{
+			"group:core": "true",
+		},
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := context.Background()
+			client, cancel, err := createClient(ctx, c.Host)
+			if err != nil {
+				return err
+			}
+			defer cancel()
+
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid rule id: %v", err)
+			}
+
+			res, err := client.ListRuleRevisions(ctx, &sirenv1beta1.ListRuleRevisionsRequest{
+				Id: id,
+			})
+			if err != nil {
+				return err
+			}
+
+			report := [][]string{}
+			report = append(report, []string{"REVISION", "CREATED_AT", "ACTOR", "ENABLED", "TEMPLATE_VERSION", "VARIABLES"})
+			for _, revision := range res.GetRevisions() {
+				variables := make([]string, 0, len(revision.GetVariables()))
+				for _, variable := range revision.GetVariables() {
+					variables = append(variables, fmt.Sprintf("%s=%s", variable.GetName(), variable.GetValue()))
+				}
+				report = append(report, []string{
+					fmt.Sprintf("%v", revision.GetId()),
+					revision.GetCreatedAt().AsTime().Format("2006-01-02T15:04:05Z07:00"),
+					revision.GetActor(),
+					strconv.FormatBool(revision.GetEnabled()),
+					formatTemplateVersion(revision.GetTemplateVersion()),
+					strings.Join(variables, ", "),
+				})
+			}
+			printer.Table(os.Stdout, report)
+
+			fmt.Println("\nTo restore a revision, try: siren rule rollback <id> --revision <revision>")
+			return nil
+		},
+	}
+
+	return cmd
+}
+
+func rollbackRuleCmd(c *configuration) *cobra.Command {
+	var revision uint64
+	cmd := &cobra.Command{
+		Use:   "rollback",
+		Short: "Restore a revision of a rule",
+		Long: heredoc.Doc(`
+			Restore the variables, enabled flag and template version of a revision of a rule.
+
+			The rule group of the rule is pushed to the provider again, with the
+			rule rendered from the restored template version, or from the current
+			body of its template if the revision followed the latest version.
+		`),
+		Example: heredoc.Doc(`
+			$ siren rule rollback 1 --revision 4
+		`),
+		Annotations: map[string]string{
+			"group:core": "true",
+		},
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := context.Background()
+			client, cancel, err := createClient(ctx, c.Host)
+			if err != nil {
+				return err
+			}
+			defer cancel()
+
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid rule id: %v", err)
+			}
+
+			res, err := client.RollbackRule(ctx, &sirenv1beta1.RollbackRuleRequest{
+				Id:         id,
+				RevisionId: revision,
+			})
+			if err != nil {
+				return err
+			}
+
+			fmt.Printf("Successfully restored revision %d of rule %d\n", revision, res.GetRule().GetId())
+			return nil
+		},
+	}
+
+	cmd.Flags().Uint64Var(&revision, "revision", 0, "revision id to restore")
+	cmd.MarkFlagRequired("revision")
+
+	return cmd
+}
+
+func upgradeRuleCmd(c *configuration) *cobra.Command {
+	var template string
+	var from uint64
+	var to uint64
+	cmd := &cobra.Command{
+		Use:   "upgrade",
+		Short: "Move rules pinned to a template version to another version",
+		Long: heredoc.Doc(`
+			Pin every rule of a template that is pinned to a version to another version.
+
+			The rule groups of the rules are pushed to the provider again. Version 0
+			stands for the latest version of the template, so --from 0 pins the rules
+			following the latest version and --to 0 makes them follow it again.
+		`),
+		Example: heredoc.Doc(`
+			$ siren rule upgrade --template cpu-usage --from 1 --to 2
+			$ siren rule upgrade --template cpu-usage --from 2 --to 0
+		`),
+		Annotations: map[string]string{
+			"group:core": "true",
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := context.Background()
+			client, cancel, err := createClient(ctx, c.Host)
+			if err != nil {
+				return err
+			}
+			defer cancel()
+
+			res, err := client.UpgradeRulesTemplateVersion(ctx, &sirenv1beta1.UpgradeRulesTemplateVersionRequest{
+				Template:    template,
+				FromVersion: from,
+				ToVersion:   to,
+			})
+			if err != nil {
+				return err
+			}
+
+			fmt.Printf(" \nUpgraded %d rules to template version %s\n \n", len(res.GetRules()), formatTemplateVersion(to))
+			report := [][]string{{"ID", "NAMESPACE", "GROUP_NAME", "TEMPLATE"}}
+			for _, r := range res.GetRules() {
+				report = append(report, []string{
+					fmt.Sprintf("%v", r.GetId()),
+					r.GetNamespace(),
+					r.GetGroupName(),
+					r.GetTemplate(),
+				})
+			}
+			printer.Table(os.Stdout, report)
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVar(&template, "template", "", "name of the template")
+	cmd.MarkFlagRequired("template")
+	cmd.Flags().Uint64Var(&from, "from", 0, "template version the rules are pinned to")
+	cmd.Flags().Uint64Var(&to, "to", 0, "template version to pin the rules to")
+
+	return cmd
+}
+
+func formatTemplateVersion(version uint64) string {
+	if version == 0 {
+		return "latest"
+	}
+	return strconv.FormatUint(version, 10)
+}
+
+func importRuleCmd(c *configuration) *cobra.Command {
+	var providerNamespace uint64
+	var createTemplates bool
+	var dryRun bool
+	cmd := &cobra.Command{
+		Use:   "import",
+		Short: "Import rule groups from a provider",
+		Long: heredoc.Doc(`
+			Import the rule groups of a provider namespace into siren.
+
+			Every provider rule is matched against the existing templates and a rule
+			is created with the variables extracted from it. Rules matching no template
+			are put in an ad-hoc template per rule group if --create-templates is set,
+			otherwise they are reported as unmapped. Rule groups that already have
+			siren rules are skipped.
+		`),
+		Example: heredoc.Doc(`
+			$ siren rule import --provider-namespace 1 --dry-run
+			$ siren rule import --provider-namespace 1 --create-templates
+		`),
+		Annotations: map[string]string{
+			"group:core": "true",
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := context.Background()
+			client, cancel, err := createClient(ctx, c.Host)
+			if err != nil {
+				return err
+			}
+			defer cancel()
+
+			res, err := client.ImportRules(ctx, &sirenv1beta1.ImportRulesRequest{
+				ProviderNamespace: providerNamespace,
+				CreateTemplates:   createTemplates,
+				DryRun:            dryRun,
+			})
+			if err != nil {
+				return err
+			}
+
+			if dryRun {
+				fmt.Println("Dry run, nothing was imported")
+			}
+			fmt.Printf(" \nImported %d rules\n \n", len(res.Rules))
+			report := [][]string{{"ID", "NAMESPACE", "GROUP_NAME", "TEMPLATE"}}
+			for _, r := range res.Rules {
+				report = append(report, []string{
+					fmt.Sprintf("%v", r.GetId()),
+					r.GetNamespace(),
+					r.GetGroupName(),
+					r.GetTemplate(),
+				})
+			}
+			printer.Table(os.Stdout, report)
+
+			if len(res.CreatedTemplates) != 0 {
+				fmt.Printf(" \nCreated %d templates\n \n", len(res.CreatedTemplates))
+				for _, t := range res.CreatedTemplates {
+					fmt.Println(t.GetName())
+				}
+			}
+
+			if len(res.Unmapped) != 0 {
+				fmt.Printf(" \nCould not import %d rules\n \n", len(res.Unmapped))
+				report = [][]string{{"NAMESPACE", "GROUP_NAME", "NAME", "REASON"}}
+				for _, u := range res.Unmapped {
+					report = append(report, []string{u.GetNamespace(), u.GetGroupName(), u.GetName(), u.GetReason()})
+				}
+				printer.Table(os.Stdout, report)
+			}
+			return nil
+		},
+	}
+
+	cmd.Flags().Uint64Var(&providerNamespace, "provider-namespace", 0, "provider namespace id")
+	cmd.MarkFlagRequired("provider-namespace")
+	cmd.Flags().BoolVar(&createTemplates, "create-templates", false, "Create ad-hoc templates for rules matching no template")
+	cmd.Flags().BoolVar(&dryRun, "dry-run", false, "Show what would be imported without importing it")
+
+	return cmd
+}
+
 func uploadRuleCmd(c *configuration) *cobra.Command {
 	var fileReader = ioutil.ReadFile
-	return &cobra.Command{
+	var dryRun bool
+	cmd := &cobra.Command{
 		Use:   "upload",
 		Short: "Upload Rules YAML file",
+		Example: heredoc.Doc(`
+			$ siren rule upload cpu_rule.yaml
+			$ siren rule upload cpu_rule.yaml --dry-run
+		`),
 		Annotations: map[string]string{
 			"group:core": "true",
 		},
@@ -219,7 +556,7 @@ func uploadRuleCmd(c *configuration) *cobra.Command {
 			}
 
 			if strings.ToLower(yamlObject.Type) == "rule" {
-				result, err := uploadRule(client, yamlFile)
+				result, err := uploadRule(client, yamlFile, dryRun)
 				if err != nil {
 					return err
 				}
@@ -230,9 +567,13 @@ func uploadRuleCmd(c *configuration) *cobra.Command {
 			return nil
 		},
 	}
+
+	cmd.Flags().BoolVar(&dryRun, "dry-run", false, "Show changes to the provider rule groups without applying them")
+
+	return cmd
 }
 
-func uploadRule(client sirenv1beta1.SirenServiceClient, yamlFile []byte) ([]*sirenv1beta1.Rule, error) {
+func uploadRule(client sirenv1beta1.SirenServiceClient, yamlFile []byte, dryRun bool) ([]*sirenv1beta1.Rule, error) {
 	var yamlBody ruleYaml
 	err := yaml.Unmarshal(yamlFile, &yamlBody)
 	if err != nil {
@@ -290,16 +631,42 @@ func uploadRule(client sirenv1beta1.SirenServiceClient, yamlFile []byte) ([]*sir
 			return nil, fmt.Errorf("no namespace found with urn: %s under provider %s", yamlBody.ProviderNamespace, provider.Name)
 		}
 
+		if !dryRun && (v.Interval != "" || v.Limit != 0 || len(v.SourceTenants) != 0) {
+			_, err := client.UpdateRuleGroup(context.Background(), &sirenv1beta1.UpdateRuleGroupRequest{
+				ProviderNamespace: providerNamespace.Id,
+				Namespace:         yamlBody.Namespace,
+				GroupName:         groupName,
+				Interval:          v.Interval,
+				Limit:             v.Limit,
+				SourceTenants:     v.SourceTenants,
+			})
+			if err != nil {
+				fmt.Println(fmt.Sprintf("rule group %s/%s upload error", yamlBody.Namespace, groupName), err)
+				return successfullyUpsertedRules, err
+			}
+		}
+
 		payload := &sirenv1beta1.UpdateRuleRequest{
 			GroupName:         groupName,
 			Namespace:         yamlBody.Namespace,
 			Template:          v.Template,
+			TemplateVersion:   v.TemplateVersion,
 			Variables:         ruleVariables,
 			ProviderNamespace: providerNamespace.Id,
 			Enabled:           v.Enabled,
+			DryRun:            dryRun,
 		}
 
 		result, err := client.UpdateRule(context.Background(), payload)
+		if dryRun {
+			if err != nil {
+				fmt.Println(fmt.Sprintf("rule %s/%s/%s plan error",
+					payload.Namespace, payload.GroupName, payload.Template), err)
+				return nil, err
+			}
+			printRuleGroupDiff(result.Diff)
+			continue
+		}
 		if err != nil {
 			fmt.Println(fmt.Sprintf("rule %s/%s/%s upload error",
 				payload.Namespace, payload.GroupName, payload.Template), err)
@@ -313,6 +680,322 @@ func uploadRule(client sirenv1beta1.SirenServiceClient, yamlFile []byte) ([]*sir
 	return successfullyUpsertedRules, nil
 }
 
+func downloadRuleCmd(c *configuration) *cobra.Command {
+	var providerNamespace uint64
+	var namespace string
+	var groupName string
+	var outputDir string
+	cmd := &cobra.Command{
+		Use:   "download",
+		Short: "Download rules as Rules YAML files",
+		Long: heredoc.Doc(`
+			Download the rules of a provider namespace in the format of siren rule upload.
+
+			A rule file holds one rule per rule group, so a namespace whose rule
+			groups have several rules is written as several files. The files are
+			printed as YAML documents, or written to a directory with --output-dir
+			as <namespace>.yaml, <namespace>_2.yaml and so on.
+		`),
+		Example: heredoc.Doc(`
+			$ siren rule download --provider-namespace 1
+			$ siren rule download --provider-namespace 1 --namespace demo --group-name TestGroup
+			$ siren rule download --provider-namespace 1 --output-dir rules
+		`),
+		Annotations: map[string]string{
+			"group:core": "true",
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := context.Background()
+			client, cancel, err := createClient(ctx, c.Host)
+			if err != nil {
+				return err
+			}
+			defer cancel()
+
+			res, err := client.ExportRules(ctx, &sirenv1beta1.ExportRulesRequest{
+				ProviderNamespace: providerNamespace,
+				Namespace:         namespace,
+				GroupName:         groupName,
+			})
+			if err != nil {
+				return err
+			}
+
+			documents := ruleDocuments(res)
+			if outputDir != "" {
+				if err := os.MkdirAll(outputDir, 0755); err != nil {
+					return err
+				}
+			}
+			for i, document := range documents {
+				content, err := yaml.Marshal(document.body)
+				if err != nil {
+					return err
+				}
+				if outputDir == "" {
+					if i != 0 {
+						fmt.Println("---")
+					}
+					fmt.Print(string(content))
+					continue
+				}
+				path := filepath.Join(outputDir, document.fileName)
+				if err := ioutil.WriteFile(path, content, 0644); err != nil {
+					return err
+				}
+				fmt.Printf("written %s\n", path)
+			}
+			return nil
+		},
+	}
+
+	cmd.Flags().Uint64Var(&providerNamespace, "provider-namespace", 0, "rule provider namespace id")
+	cmd.MarkFlagRequired("provider-namespace")
+	cmd.Flags().StringVar(&namespace, "namespace", "", "rule namespace")
+	cmd.Flags().StringVar(&groupName, "group-name", "", "rule group name")
+	cmd.Flags().StringVar(&outputDir, "output-dir", "", "directory to write the rule files to")
+
+	return cmd
+}
+
+type ruleDocument struct {
+	fileName string
+	body     ruleYaml
+}
+
+// ruleDocuments lays exported rules out as rule files of their namespaces.
+// The nth file of a namespace holds the nth rule of each of its rule groups.
+func ruleDocuments(res *sirenv1beta1.ExportRulesResponse) []ruleDocument {
+	var documents []ruleDocument
+	namespaceDocuments := make(map[string][]int)
+	for _, ruleGroup := range res.GetRuleGroups() {
+		group := ruleGroup.GetGroup()
+		for i, r := range ruleGroup.GetRules() {
+			indexes := namespaceDocuments[r.GetNamespace()]
+			if i == len(indexes) {
+				fileName := fmt.Sprintf("%s.yaml", r.GetNamespace())
+				if i != 0 {
+					fileName = fmt.Sprintf("%s_%d.yaml", r.GetNamespace(), i+1)
+				}
+				documents = append(documents, ruleDocument{
+					fileName: fileName,
+					body: ruleYaml{
+						ApiVersion:        "v2",
+						Type:              "rule",
+						Namespace:         r.GetNamespace(),
+						Provider:          res.GetProvider(),
+						ProviderNamespace: res.GetProviderNamespace(),
+						Rules:             make(map[string]rule),
+					},
+				})
+				indexes = append(indexes, len(documents)-1)
+				namespaceDocuments[r.GetNamespace()] = indexes
+			}
+
+			ruleVariables := make([]variables, 0, len(r.GetVariables()))
+			for _, variable := range r.GetVariables() {
+				ruleVariables = append(ruleVariables, variables{Name: variable.GetName(), Value: variable.GetValue()})
+			}
+			documents[indexes[i]].body.Rules[r.GetGroupName()] = rule{
+				Template:        r.GetTemplate(),
+				TemplateVersion: r.GetTemplateVersion(),
+				Enabled:         r.GetEnabled(),
+				Variables:       ruleVariables,
+				Interval:        group.GetInterval(),
+				Limit:           group.GetLimit(),
+				SourceTenants:   group.GetSourceTenants(),
+			}
+		}
+	}
+	return documents
+}
+
+func testRuleCmd(c *configuration) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "test",
+		Short: "Test rules of a template against synthetic series",
+		Long: heredoc.Doc(`
+			Unit test the rules rendered from a template.
+
+			The template is rendered with the given variables and evaluated with an in-memory
+			Prometheus engine against the input series of the test file. The template is read
+			from templateFile if set, otherwise it is fetched from siren by name. Partials
+			included by the template are fetched from siren.
+		`),
+		Example: heredoc.Doc(`
+			$ siren rule test cpu_rule_test.yaml
+		`),
+		Annotations: map[string]string{
+			"group:core": "true",
+		},
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			var testFile ruleTestYaml
+			if err := parseFile(args[0], &testFile); err != nil {
+				return err
+			}
+
+			var tmpl *domain.Template
+			var err error
+			if testFile.TemplateFile != "" {
+				templateFile := testFile.TemplateFile
+				if !filepath.IsAbs(templateFile) {
+					templateFile = filepath.Join(filepath.Dir(args[0]), templateFile)
+				}
+				tmpl, err = readTemplateFile(templateFile)
+			} else {
+				tmpl, err = fetchTemplate(c, testFile.Template)
+			}
+			if err != nil {
+				return err
+			}
+			includedPartials, err := templates.IncludedPartials(tmpl.Body)
+			if err != nil {
+				return err
+			}
+			if len(includedPartials) != 0 {
+				tmpl.Partials, err = fetchPartials(c)
+				if err != nil {
+					return err
+				}
+			}
+
+			inputValue := make(map[string]string)
+			for _, v := range testFile.Variables {
+				inputValue[v.Name] = v.Value
+			}
+			renderedBody, err := templates.RenderBody(tmpl, inputValue)
+			if err != nil {
+				return err
+			}
+
+			failures, err := ruletest.Run(renderedBody, testFile.EvaluationInterval, testFile.Tests)
+			if err != nil {
+				return err
+			}
+			if len(failures) != 0 {
+				fmt.Println("FAILED:")
+				for _, failure := range failures {
+					fmt.Println("   ", failure)
+				}
+				return fmt.Errorf("%d rule test(s) failed", len(failures))
+			}
+
+			fmt.Println("SUCCESS")
+			return nil
+		},
+	}
+
+	return cmd
+}
+
+func readTemplateFile(filePath string) (*domain.Template, error) {
+	var t template
+	if err := parseFile(filePath, &t); err != nil {
+		return nil, err
+	}
+	body, err := yaml.Marshal(t.Body)
+	if err != nil {
+		return nil, err
+	}
+	return &domain.Template{
+		Name:      t.Name,
+		Body:      string(body),
+		Variables: t.Variables,
+	}, nil
+}
+
+func fetchTemplate(c *configuration, name string) (*domain.Template, error) {
+	if name == "" {
+		return nil, errors.New("template or templateFile is required")
+	}
+
+	ctx := context.Background()
+	client, cancel, err := createClient(ctx, c.Host)
+	if err != nil {
+		return nil, err
+	}
+	defer cancel()
+
+	res, err := client.GetTemplateByName(ctx, &sirenv1beta1.GetTemplateByNameRequest{
+		Name: name,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	variables := make([]domain.Variable, 0)
+	for _, variable := range res.Template.GetVariables() {
+		variables = append(variables, domain.Variable{
+			Name:          variable.Name,
+			Type:          variable.Type,
+			Default:       variable.Default,
+			Description:   variable.Description,
+			AllowedValues: variable.AllowedValues,
+			Pattern:       variable.Pattern,
+			Min:           variable.Min,
+			Max:           variable.Max,
+			Required:      variable.Required,
+		})
+	}
+	return &domain.Template{
+		Name:      res.Template.GetName(),
+		Body:      res.Template.GetBody(),
+		Variables: variables,
+	}, nil
+}
+
+func fetchPartials(c *configuration) ([]domain.Partial, error) {
+	ctx := context.Background()
+	client, cancel, err := createClient(ctx, c.Host)
+	if err != nil {
+		return nil, err
+	}
+	defer cancel()
+
+	res, err := client.ListTemplatePartials(ctx, &emptypb.Empty{})
+	if err != nil {
+		return nil, err
+	}
+	partials := make([]domain.Partial, 0, len(res.GetPartials()))
+	for _, p := range res.GetPartials() {
+		partials = append(partials, domain.Partial{
+			Name: p.GetName(),
+			Body: p.GetBody(),
+		})
+	}
+	return partials, nil
+}
+
+func printRuleGroupDiff(diff *sirenv1beta1.RuleGroupDiff) {
+	fmt.Printf("Rule group %s/%s\n", diff.Namespace, diff.GroupName)
+	if len(diff.Added) == 0 && len(diff.Changed) == 0 && len(diff.Removed) == 0 {
+		fmt.Println("No changes")
+		fmt.Println()
+		return
+	}
+	for _, ruleDiff := range diff.Added {
+		fmt.Println("+ added:", ruleDiff.Name)
+		printPrefixedLines("+ ", ruleDiff.Desired)
+	}
+	for _, ruleDiff := range diff.Changed {
+		fmt.Println("~ changed:", ruleDiff.Name)
+		printPrefixedLines("- ", ruleDiff.Current)
+		printPrefixedLines("+ ", ruleDiff.Desired)
+	}
+	for _, ruleDiff := range diff.Removed {
+		fmt.Println("- removed:", ruleDiff.Name)
+		printPrefixedLines("- ", ruleDiff.Current)
+	}
+	fmt.Println()
+}
+
+func printPrefixedLines(prefix string, body string) {
+	for _, line := range strings.Split(strings.TrimSuffix(body, "\n"), "\n") {
+		fmt.Println(prefix + line)
+	}
+}
+
 func printRules(rules []*sirenv1beta1.Rule) {
 	for i := 0; i < len(rules); i++ {
 		fmt.Println("Upserted Rule")
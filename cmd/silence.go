@@ -0,0 +1,248 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/odpf/salt/printer"
+	sirenv1beta1 "github.com/odpf/siren/api/proto/odpf/siren/v1beta1"
+	"github.com/odpf/siren/domain"
+	"github.com/spf13/cobra"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func silencesCmd(c *configuration) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "silence",
+		Aliases: []string{"silences"},
+		Short:   "Manage silences",
+		Long: heredoc.Doc(`
+			Work with silences.
+
+			Silences mute the alerts of a namespace matching all of their matchers
+			for a period of time. They are kept by the alertmanager of the provider
+			of the namespace, using the namespace urn as tenant.
+		`),
+		Annotations: map[string]string{
+			"group:core": "true",
+		},
+	}
+
+	cmd.AddCommand(listSilencesCmd(c))
+	cmd.AddCommand(createSilenceCmd(c))
+	cmd.AddCommand(getSilenceCmd(c))
+	cmd.AddCommand(expireSilenceCmd(c))
+	return cmd
+}
+
+func listSilencesCmd(c *configuration) *cobra.Command {
+	var namespace uint64
+	cmd := &cobra.Command{
+		Use:   "list",
+		Short: "List silences of a namespace",
+		Long: heredoc.Doc(`
+			List all silences of a namespace, including the expired ones.
+		`),
+		Example: heredoc.Doc(`
+			$ siren silence list --namespace 1
+		`),
+		Annotations: map[string]string{
+			"group:core": "true",
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := context.Background()
+			client, cancel, err := createClient(ctx, c.Host)
+			if err != nil {
+				return err
+			}
+			defer cancel()
+
+			res, err := client.ListSilences(ctx, &sirenv1beta1.ListSilencesRequest{
+				Namespace: namespace,
+			})
+			if err != nil {
+				return err
+			}
+
+			silences := res.Silences
+			report := [][]string{}
+
+			fmt.Printf(" \nShowing %d of %d silences\n \n", len(silences), len(silences))
+			report = append(report, []string{"ID", "STATE", "ENDS AT", "CREATED BY", "COMMENT"})
+
+			for _, p := range silences {
+				report = append(report, []string{
+					p.GetId(),
+					p.GetState(),
+					p.GetEndsAt().AsTime().Format(time.RFC3339),
+					p.GetCreatedBy(),
+					p.GetComment(),
+				})
+			}
+			printer.Table(os.Stdout, report)
+
+			fmt.Println("\nFor details on a silence, try: siren silence view <id> --namespace <namespace>")
+			return nil
+		},
+	}
+
+	cmd.Flags().Uint64Var(&namespace, "namespace", 0, "namespace id")
+	cmd.MarkFlagRequired("namespace")
+
+	return cmd
+}
+
+func createSilenceCmd(c *configuration) *cobra.Command {
+	var filePath string
+	cmd := &cobra.Command{
+		Use:   "create",
+		Short: "Create a new silence",
+		Long: heredoc.Doc(`
+			Create a new silence.
+
+			The silence starts right away unless a start time is given. Its creator
+			and the reason for it are recorded in createdBy and comment.
+		`),
+		Annotations: map[string]string{
+			"group:core": "true",
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			var silenceConfig domain.Silence
+			if err := parseFile(filePath, &silenceConfig); err != nil {
+				return err
+			}
+
+			ctx := context.Background()
+			client, cancel, err := createClient(ctx, c.Host)
+			if err != nil {
+				return err
+			}
+			defer cancel()
+
+			req := &sirenv1beta1.CreateSilenceRequest{
+				Namespace: silenceConfig.Namespace,
+				Matchers:  getMatchers(silenceConfig.Matchers),
+				EndsAt:    timestamppb.New(silenceConfig.EndsAt),
+				CreatedBy: silenceConfig.CreatedBy,
+				Comment:   silenceConfig.Comment,
+			}
+			if !silenceConfig.StartsAt.IsZero() {
+				req.StartsAt = timestamppb.New(silenceConfig.StartsAt)
+			}
+			res, err := client.CreateSilence(ctx, req)
+			if err != nil {
+				return err
+			}
+
+			fmt.Printf("silence created with id: %v\n", res.GetId())
+
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVarP(&filePath, "file", "f", "", "path to the silence config")
+	cmd.MarkFlagRequired("file")
+
+	return cmd
+}
+
+func getSilenceCmd(c *configuration) *cobra.Command {
+	var namespace uint64
+	var format string
+	cmd := &cobra.Command{
+		Use:   "view",
+		Short: "View a silence details",
+		Long: heredoc.Doc(`
+			View a silence.
+
+			Display the Id, matchers, period, state, creator and comment of a silence.
+		`),
+		Example: heredoc.Doc(`
+			$ siren silence view 9e6f5a7c-0d4b-4bd9-8d4c-2f8f0e0a1b2c --namespace 1
+		`),
+		Annotations: map[string]string{
+			"group:core": "true",
+		},
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := context.Background()
+			client, cancel, err := createClient(ctx, c.Host)
+			if err != nil {
+				return err
+			}
+			defer cancel()
+
+			res, err := client.GetSilence(ctx, &sirenv1beta1.GetSilenceRequest{
+				Id:        args[0],
+				Namespace: namespace,
+			})
+			if err != nil {
+				return err
+			}
+
+			silence := &domain.Silence{
+				Id:        res.GetId(),
+				Namespace: res.GetNamespace(),
+				Matchers:  getDomainMatchers(res.GetMatchers()),
+				StartsAt:  res.StartsAt.AsTime(),
+				EndsAt:    res.EndsAt.AsTime(),
+				CreatedBy: res.GetCreatedBy(),
+				Comment:   res.GetComment(),
+				State:     res.GetState(),
+			}
+
+			if err := printer.Text(silence, format); err != nil {
+				return fmt.Errorf("failed to format silence: %v", err)
+			}
+			return nil
+		},
+	}
+
+	cmd.Flags().Uint64Var(&namespace, "namespace", 0, "namespace id")
+	cmd.MarkFlagRequired("namespace")
+	cmd.Flags().StringVar(&format, "format", "yaml", "Print output with the selected format")
+
+	return cmd
+}
+
+func expireSilenceCmd(c *configuration) *cobra.Command {
+	var namespace uint64
+	cmd := &cobra.Command{
+		Use:   "expire",
+		Short: "Expire a silence",
+		Example: heredoc.Doc(`
+			$ siren silence expire 9e6f5a7c-0d4b-4bd9-8d4c-2f8f0e0a1b2c --namespace 1
+		`),
+		Annotations: map[string]string{
+			"group:core": "true",
+		},
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := context.Background()
+			client, cancel, err := createClient(ctx, c.Host)
+			if err != nil {
+				return err
+			}
+			defer cancel()
+
+			_, err = client.ExpireSilence(ctx, &sirenv1beta1.ExpireSilenceRequest{
+				Id:        args[0],
+				Namespace: namespace,
+			})
+			if err != nil {
+				return err
+			}
+
+			fmt.Println("Successfully expired silence")
+			return nil
+		},
+	}
+
+	cmd.Flags().Uint64Var(&namespace, "namespace", 0, "namespace id")
+	cmd.MarkFlagRequired("namespace")
+
+	return cmd
+}
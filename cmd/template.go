@@ -7,6 +7,7 @@ import (
 	"io/ioutil"
 	"os"
 	"strings"
+	"time"
 
 	"gopkg.in/yaml.v3"
 
@@ -14,7 +15,10 @@ import (
 	"github.com/odpf/salt/printer"
 	sirenv1beta1 "github.com/odpf/siren/api/proto/odpf/siren/v1beta1"
 	"github.com/odpf/siren/domain"
+	"github.com/odpf/siren/pkg/templates"
 	"github.com/spf13/cobra"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 type templatedRule struct {
@@ -35,6 +39,13 @@ type template struct {
 	Variables  []domain.Variable `yaml:"variables"`
 }
 
+type partial struct {
+	Name       string `yaml:"name"`
+	ApiVersion string `yaml:"apiVersion"`
+	Type       string `yaml:"type"`
+	Body       string `yaml:"body"`
+}
+
 func templatesCmd(c *configuration) *cobra.Command {
 	cmd := &cobra.Command{
 		Use:     "template",
@@ -53,25 +64,163 @@ func templatesCmd(c *configuration) *cobra.Command {
 	cmd.AddCommand(listTemplatesCmd(c))
 	cmd.AddCommand(upsertTemplateCmd(c))
 	cmd.AddCommand(getTemplateCmd(c))
+	cmd.AddCommand(listTemplateVersionsCmd(c))
+	cmd.AddCommand(templateUsageCmd(c))
 	cmd.AddCommand(deleteTemplateCmd(c))
 	cmd.AddCommand(renderTemplateCmd(c))
 	cmd.AddCommand(uploadTemplateCmd(c))
+	cmd.AddCommand(importTemplateCmd(c))
+	cmd.AddCommand(partialsCmd(c))
+
+	return cmd
+}
+
+func partialsCmd(c *configuration) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "partial",
+		Aliases: []string{"partials"},
+		Short:   "Manage template partials",
+		Long: heredoc.Doc(`
+			Work with template partials.
+
+			Partials are named template bodies that templates include with
+			the template action or the include function. Partials are
+			uploaded with siren template upload.
+		`),
+		Annotations: map[string]string{
+			"group:core": "true",
+		},
+	}
+
+	cmd.AddCommand(listPartialsCmd(c))
+	cmd.AddCommand(deletePartialCmd(c))
+
+	return cmd
+}
+
+func listPartialsCmd(c *configuration) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list",
+		Short: "List template partials",
+		Long: heredoc.Doc(`
+			List all template partials.
+		`),
+		Annotations: map[string]string{
+			"group:core": "true",
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := context.Background()
+			client, cancel, err := createClient(ctx, c.Host)
+			if err != nil {
+				return err
+			}
+			defer cancel()
+
+			res, err := client.ListTemplatePartials(ctx, &emptypb.Empty{})
+			if err != nil {
+				return err
+			}
+
+			partials := res.Partials
+			report := [][]string{}
+
+			fmt.Printf(" \nShowing %d of %d partials\n \n", len(partials), len(partials))
+			report = append(report, []string{"ID", "NAME", "UPDATED_AT"})
+
+			for _, p := range partials {
+				report = append(report, []string{
+					fmt.Sprintf("%v", p.GetId()),
+					p.GetName(),
+					p.GetUpdatedAt().AsTime().Format(time.RFC3339),
+				})
+			}
+			printer.Table(os.Stdout, report)
+			return nil
+		},
+	}
+
+	return cmd
+}
+
+func deletePartialCmd(c *configuration) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "delete",
+		Short: "Delete a template partial",
+		Example: heredoc.Doc(`
+			$ siren template partial delete labels
+		`),
+		Annotations: map[string]string{
+			"group:core": "true",
+		},
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := context.Background()
+			client, cancel, err := createClient(ctx, c.Host)
+			if err != nil {
+				return err
+			}
+			defer cancel()
+
+			_, err = client.DeleteTemplatePartial(ctx, &sirenv1beta1.DeleteTemplatePartialRequest{
+				Name: args[0],
+			})
+			if err != nil {
+				return err
+			}
+
+			fmt.Println("Successfully deleted partial")
+			return nil
+		},
+	}
 
 	return cmd
 }
 
 func listTemplatesCmd(c *configuration) *cobra.Command {
-	var tag string
+	var tags []string
+	var matchAllTags bool
+	var namePrefix, nameContains string
+	var updatedSince string
+	var pageSize uint32
+	var pageToken string
+	var sortBy, sortOrder string
 	cmd := &cobra.Command{
 		Use:   "list",
 		Short: "List templates",
 		Long: heredoc.Doc(`
 			List all registered templates.
+
+			Templates are filtered by tags, with any of the tags matching unless
+			--all-tags is set, by name and by update time. Pages of --page-size
+			templates are fetched with the page token printed below each page.
+		`),
+		Example: heredoc.Doc(`
+			$ siren template list --tag systems --tag kafka --all-tags
+			$ siren template list --name-prefix cpu --sort updated_at --order desc
+			$ siren template list --updated-since 2021-10-01T00:00:00Z --page-size 20
 		`),
 		Annotations: map[string]string{
 			"group:core": "true",
 		},
 		RunE: func(cmd *cobra.Command, args []string) error {
+			req := &sirenv1beta1.ListTemplatesRequest{
+				Tags:         tags,
+				MatchAllTags: matchAllTags,
+				NamePrefix:   namePrefix,
+				NameContains: nameContains,
+				PageSize:     pageSize,
+				PageToken:    pageToken,
+				SortBy:       sortBy,
+				SortOrder:    sortOrder,
+			}
+			if updatedSince != "" {
+				since, err := time.Parse(time.RFC3339, updatedSince)
+				if err != nil {
+					return fmt.Errorf("invalid updated since %q, use RFC3339 format", updatedSince)
+				}
+				req.UpdatedSince = timestamppb.New(since)
+			}
+
 			ctx := context.Background()
 			client, cancel, err := createClient(ctx, c.Host)
 			if err != nil {
@@ -79,9 +228,7 @@ func listTemplatesCmd(c *configuration) *cobra.Command {
 			}
 			defer cancel()
 
-			res, err := client.ListTemplates(ctx, &sirenv1beta1.ListTemplatesRequest{
-				Tag: tag,
-			})
+			res, err := client.ListTemplates(ctx, req)
 			if err != nil {
 				return err
 			}
@@ -90,34 +237,50 @@ func listTemplatesCmd(c *configuration) *cobra.Command {
 			report := [][]string{}
 
 			fmt.Printf(" \nShowing %d of %d templates\n \n", len(templates), len(templates))
-			report = append(report, []string{"ID", "NAME", "TAGS"})
+			report = append(report, []string{"ID", "NAME", "TAGS", "UPDATED_AT"})
 
 			for _, p := range templates {
 				report = append(report, []string{
 					fmt.Sprintf("%v", p.GetId()),
 					p.GetName(),
 					strings.Join(p.GetTags(), ","),
+					p.GetUpdatedAt().AsTime().Format(time.RFC3339),
 				})
 			}
 			printer.Table(os.Stdout, report)
 
+			if res.GetNextPageToken() != "" {
+				fmt.Printf("\nFor the next page, try: siren template list --page-token %s\n", res.GetNextPageToken())
+			}
 			fmt.Println("\nFor details on a template, try: siren template view <name>")
 			return nil
 		},
 	}
 
-	cmd.Flags().StringVar(&tag, "tag", "", "template tag name")
+	cmd.Flags().StringSliceVar(&tags, "tag", nil, "template tag name, repeat for more tags")
+	cmd.Flags().BoolVar(&matchAllTags, "all-tags", false, "Only list templates with all the tags instead of any of them")
+	cmd.Flags().StringVar(&namePrefix, "name-prefix", "", "Only list templates whose name starts with the prefix")
+	cmd.Flags().StringVar(&nameContains, "name", "", "Only list templates whose name contains the text")
+	cmd.Flags().StringVar(&updatedSince, "updated-since", "", "Only list templates updated since the time, in RFC3339 format")
+	cmd.Flags().Uint32Var(&pageSize, "page-size", 0, "Number of templates of a page, all templates if 0")
+	cmd.Flags().StringVar(&pageToken, "page-token", "", "Token of the page to list")
+	cmd.Flags().StringVar(&sortBy, "sort", "name", "Sort templates by name, created_at or updated_at")
+	cmd.Flags().StringVar(&sortOrder, "order", "asc", "Sort order, asc or desc")
 
 	return cmd
 }
 
 func upsertTemplateCmd(c *configuration) *cobra.Command {
 	var filePath string
+	var dryRun bool
 	cmd := &cobra.Command{
 		Use:   "upsert",
 		Short: "Create or edit a new template",
 		Long: heredoc.Doc(`
 			Create or edit a new template.
+
+			The rule groups with rules of the template are rendered again and
+			pushed to their providers.
 		`),
 		Annotations: map[string]string{
 			"group:core": "true",
@@ -138,10 +301,15 @@ func upsertTemplateCmd(c *configuration) *cobra.Command {
 			variables := make([]*sirenv1beta1.TemplateVariables, 0)
 			for _, variable := range templateConfig.Variables {
 				variables = append(variables, &sirenv1beta1.TemplateVariables{
-					Name:        variable.Name,
-					Type:        variable.Type,
-					Default:     variable.Default,
-					Description: variable.Description,
+					Name:          variable.Name,
+					Type:          variable.Type,
+					Default:       variable.Default,
+					Description:   variable.Description,
+					AllowedValues: variable.AllowedValues,
+					Pattern:       variable.Pattern,
+					Min:           variable.Min,
+					Max:           variable.Max,
+					Required:      variable.Required,
 				})
 			}
 
@@ -150,13 +318,17 @@ func upsertTemplateCmd(c *configuration) *cobra.Command {
 				Body:      templateConfig.Body,
 				Tags:      templateConfig.Tags,
 				Variables: variables,
+				DryRun:    dryRun,
 			})
 
 			if err != nil {
 				return err
 			}
 
-			fmt.Printf("template created with id: %v\n", res.GetTemplate().GetId())
+			printRuleGroupSyncs(res.GetRuleGroups(), dryRun)
+			if !dryRun {
+				fmt.Printf("template created with id: %v\n", res.GetTemplate().GetId())
+			}
 
 			return nil
 		},
@@ -164,6 +336,7 @@ func upsertTemplateCmd(c *configuration) *cobra.Command {
 
 	cmd.Flags().StringVarP(&filePath, "file", "f", "", "path to the template config")
 	cmd.MarkFlagRequired("file")
+	cmd.Flags().BoolVar(&dryRun, "dry-run", false, "Show changes to the rule groups of the template without applying them")
 
 	return cmd
 }
@@ -206,10 +379,15 @@ func getTemplateCmd(c *configuration) *cobra.Command {
 			variables := make([]domain.Variable, 0)
 			for _, variable := range templateData.GetVariables() {
 				variables = append(variables, domain.Variable{
-					Name:        variable.Name,
-					Type:        variable.Type,
-					Default:     variable.Default,
-					Description: variable.Description,
+					Name:          variable.Name,
+					Type:          variable.Type,
+					Default:       variable.Default,
+					Description:   variable.Description,
+					AllowedValues: variable.AllowedValues,
+					Pattern:       variable.Pattern,
+					Min:           variable.Min,
+					Max:           variable.Max,
+					Required:      variable.Required,
 				})
 			}
 
@@ -219,6 +397,7 @@ func getTemplateCmd(c *configuration) *cobra.Command {
 				Body:      templateData.GetBody(),
 				Tags:      templateData.GetTags(),
 				Variables: variables,
+				Version:   uint(templateData.GetVersion()),
 				CreatedAt: templateData.CreatedAt.AsTime(),
 				UpdatedAt: templateData.UpdatedAt.AsTime(),
 			}
@@ -235,12 +414,132 @@ func getTemplateCmd(c *configuration) *cobra.Command {
 	return cmd
 }
 
+func listTemplateVersionsCmd(c *configuration) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "versions",
+		Short: "List versions of a template",
+		Long: heredoc.Doc(`
+			List the versions of a template, latest first.
+
+			A version is stored every time the template is created or updated.
+			Rules can be pinned to a version with templateVersion.
+		`),
+		Example: heredoc.Doc(`
+			$ siren template versions <template_name>
+		`),
+		Annotations: map[string]string{
+			"group:core": "true",
+		},
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := context.Background()
+			client, cancel, err := createClient(ctx, c.Host)
+			if err != nil {
+				return err
+			}
+			defer cancel()
+
+			res, err := client.ListTemplateVersions(ctx, &sirenv1beta1.ListTemplateVersionsRequest{
+				Name: args[0],
+			})
+			if err != nil {
+				return err
+			}
+
+			report := [][]string{}
+			report = append(report, []string{"VERSION", "CREATED_AT", "TAGS", "VARIABLES"})
+			for _, version := range res.GetVersions() {
+				variables := make([]string, 0, len(version.GetVariables()))
+				for _, variable := range version.GetVariables() {
+					variables = append(variables, variable.GetName())
+				}
+				report = append(report, []string{
+					fmt.Sprintf("%v", version.GetVersion()),
+					version.GetCreatedAt().AsTime().Format("2006-01-02T15:04:05Z07:00"),
+					strings.Join(version.GetTags(), ","),
+					strings.Join(variables, ","),
+				})
+			}
+			printer.Table(os.Stdout, report)
+
+			fmt.Println("\nTo move rules to another version, try: siren rule upgrade --template <name> --from <version> --to <version>")
+			return nil
+		},
+	}
+
+	return cmd
+}
+
+func templateUsageCmd(c *configuration) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "usage",
+		Short: "List the rules using a template",
+		Long: heredoc.Doc(`
+			List the rules using a template, with the namespaces and providers
+			their rule groups are pushed to.
+		`),
+		Example: heredoc.Doc(`
+			$ siren template usage CPU
+		`),
+		Annotations: map[string]string{
+			"group:core": "true",
+		},
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := context.Background()
+			client, cancel, err := createClient(ctx, c.Host)
+			if err != nil {
+				return err
+			}
+			defer cancel()
+
+			res, err := client.GetTemplateUsage(ctx, &sirenv1beta1.GetTemplateUsageRequest{
+				Name: args[0],
+			})
+			if err != nil {
+				return err
+			}
+
+			fmt.Printf(" \nTemplate %s is used by %d rules\n \n", res.GetTemplate(), len(res.GetRules()))
+			report := [][]string{{"ID", "NAME", "NAMESPACE", "GROUP", "ENABLED", "TEMPLATE_VERSION", "PROVIDER_NAMESPACE", "PROVIDER"}}
+			for _, rule := range res.GetRules() {
+				report = append(report, []string{
+					fmt.Sprintf("%v", rule.GetId()),
+					rule.GetName(),
+					rule.GetNamespace(),
+					rule.GetGroupName(),
+					fmt.Sprintf("%v", rule.GetEnabled()),
+					formatTemplateVersion(rule.GetTemplateVersion()),
+					rule.GetNamespaceUrn(),
+					rule.GetProviderUrn(),
+				})
+			}
+			printer.Table(os.Stdout, report)
+
+			fmt.Println("\nNamespaces:", strings.Join(res.GetNamespaces(), ", "))
+			fmt.Println("Providers:", strings.Join(res.GetProviders(), ", "))
+			return nil
+		},
+	}
+
+	return cmd
+}
+
 func deleteTemplateCmd(c *configuration) *cobra.Command {
+	var force bool
 	cmd := &cobra.Command{
 		Use:   "delete",
 		Short: "Delete a template details",
+		Long: heredoc.Doc(`
+			Delete a template.
+
+			A template used by rules is not deleted unless forced, in which case
+			its rules are deleted too and their rule groups are pushed again.
+			See the rules of a template with siren template usage.
+		`),
 		Example: heredoc.Doc(`
-			$ siren template delete 1
+			$ siren template delete CPU
+			$ siren template delete CPU --force
 		`),
 		Annotations: map[string]string{
 			"group:core": "true",
@@ -255,18 +554,25 @@ func deleteTemplateCmd(c *configuration) *cobra.Command {
 			defer cancel()
 
 			name := args[0]
-			_, err = client.DeleteTemplate(ctx, &sirenv1beta1.DeleteTemplateRequest{
-				Name: name,
+			res, err := client.DeleteTemplate(ctx, &sirenv1beta1.DeleteTemplateRequest{
+				Name:  name,
+				Force: force,
 			})
 			if err != nil {
 				return err
 			}
 
+			for _, rule := range res.GetRemovedRules() {
+				fmt.Printf("deleted rule %s of group %s/%s of provider namespace %d\n",
+					rule.GetName(), rule.GetNamespace(), rule.GetGroupName(), rule.GetProviderNamespace())
+			}
 			fmt.Println("Successfully deleted template")
 			return nil
 		},
 	}
 
+	cmd.Flags().BoolVar(&force, "force", false, "Delete the template and the rules using it")
+
 	return cmd
 }
 
@@ -323,9 +629,23 @@ func renderTemplateCmd(c *configuration) *cobra.Command {
 
 func uploadTemplateCmd(c *configuration) *cobra.Command {
 	var fileReader = ioutil.ReadFile
-	return &cobra.Command{
+	var dryRun bool
+	cmd := &cobra.Command{
 		Use:   "upload",
 		Short: "Upload Templates YAML file",
+		Long: heredoc.Doc(`
+			Upload Templates YAML file.
+
+			The rule groups with rules of the template are rendered again and
+			pushed to their providers. Files of type partial upload a template
+			partial, which is refused if it includes itself directly or through
+			other partials.
+		`),
+		Example: heredoc.Doc(`
+			$ siren template upload cpu_template.yaml
+			$ siren template upload cpu_template.yaml --dry-run
+			$ siren template upload labels_partial.yaml
+		`),
 		Annotations: map[string]string{
 			"group:core": "true",
 		},
@@ -353,89 +673,202 @@ func uploadTemplateCmd(c *configuration) *cobra.Command {
 			}
 
 			if strings.ToLower(yamlObject.Type) == "template" {
-				result, err := uploadTemplate(client, yamlFile)
+				result, err := uploadTemplate(client, yamlFile, dryRun)
 				if err != nil {
 					return err
 				}
-				printTemplate(result)
+				if !dryRun {
+					printTemplate(result)
+				}
+			} else if strings.ToLower(yamlObject.Type) == "partial" {
+				if dryRun {
+					return errors.New("dry run is not supported for partials")
+				}
+				result, err := uploadPartial(client, yamlFile)
+				if err != nil {
+					return err
+				}
+				fmt.Println("Upserted Partial")
+				fmt.Println("ID:", result.Id)
+				fmt.Println("Name:", result.Name)
 			} else {
 				return errors.New("yaml is not rule type")
 			}
 			return nil
 		},
 	}
+
+	cmd.Flags().BoolVar(&dryRun, "dry-run", false, "Show changes to the rule groups of the template without applying them")
+
+	return cmd
 }
 
-func uploadTemplate(client sirenv1beta1.SirenServiceClient, yamlFile []byte) (*sirenv1beta1.Template, error) {
+func importTemplateCmd(c *configuration) *cobra.Command {
+	var fileReader = ioutil.ReadFile
+	var labels []string
+	var upload bool
+	cmd := &cobra.Command{
+		Use:   "import",
+		Short: "Convert a Prometheus rule file into templates",
+		Long: heredoc.Doc(`
+			Convert every rule of a Prometheus rule file into a template.
+
+			The numeric thresholds of comparisons in the expression, the for
+			duration and the values of the labels given with --label become
+			variables defaulting to their values. Templates are named after
+			their alert or record and tagged with the name of their group.
+
+			The templates are printed as template YAML files, or upserted
+			directly with --upload.
+		`),
+		Example: heredoc.Doc(`
+			$ siren template import rules.yaml
+			$ siren template import rules.yaml --label severity --label team
+			$ siren template import rules.yaml --upload
+		`),
+		Annotations: map[string]string{
+			"group:core": "true",
+		},
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ruleFile, err := fileReader(args[0])
+			if err != nil {
+				fmt.Printf("Error reading YAML file: %s\n", err)
+				return err
+			}
+
+			converted, err := templates.ConvertRuleFile(ruleFile, labels)
+			if err != nil {
+				return err
+			}
+
+			documents := make([][]byte, 0, len(converted))
+			for _, t := range converted {
+				var body []templatedRule
+				if err := yaml.Unmarshal([]byte(t.Body), &body); err != nil {
+					return err
+				}
+				document, err := yaml.Marshal(template{
+					Name:       t.Name,
+					ApiVersion: "v2",
+					Type:       "template",
+					Body:       body,
+					Tags:       t.Tags,
+					Variables:  t.Variables,
+				})
+				if err != nil {
+					return err
+				}
+				documents = append(documents, document)
+			}
+
+			if !upload {
+				for i, document := range documents {
+					if i != 0 {
+						fmt.Println("---")
+					}
+					fmt.Print(string(document))
+				}
+				return nil
+			}
+
+			ctx := context.Background()
+			client, cancel, err := createClient(ctx, c.Host)
+			if err != nil {
+				return err
+			}
+			defer cancel()
+
+			for _, document := range documents {
+				result, err := uploadTemplate(client, document, false)
+				if err != nil {
+					return err
+				}
+				printTemplate(result)
+			}
+			return nil
+		},
+	}
+
+	cmd.Flags().StringSliceVar(&labels, "label", nil, "Label whose values become variables of the templates")
+	cmd.Flags().BoolVar(&upload, "upload", false, "Upsert the templates instead of printing them")
+
+	return cmd
+}
+
+func uploadTemplate(client sirenv1beta1.SirenServiceClient, yamlFile []byte, dryRun bool) (*sirenv1beta1.Template, error) {
 	var t template
 	err := yaml.Unmarshal(yamlFile, &t)
 	if err != nil {
 		return nil, err
 	}
-	body, err := yaml.Marshal(t.Body)
+	template, err := upsertTemplate(client, t, dryRun)
 	if err != nil {
 		return nil, err
 	}
 
-	variables := make([]*sirenv1beta1.TemplateVariables, 0)
-	for _, variable := range t.Variables {
-		variables = append(variables, &sirenv1beta1.TemplateVariables{
-			Name:        variable.Name,
-			Type:        variable.Type,
-			Default:     variable.Default,
-			Description: variable.Description,
-		})
-	}
+	printRuleGroupSyncs(template.RuleGroups, dryRun)
+	return template.Template, nil
+}
 
-	template, err := client.UpsertTemplate(context.Background(), &sirenv1beta1.UpsertTemplateRequest{
+func upsertTemplate(client sirenv1beta1.SirenServiceClient, t template, dryRun bool) (*sirenv1beta1.TemplateResponse, error) {
+	body, err := yaml.Marshal(t.Body)
+	if err != nil {
+		return nil, err
+	}
+	return client.UpsertTemplate(context.Background(), &sirenv1beta1.UpsertTemplateRequest{
 		Name:      t.Name,
 		Body:      string(body),
-		Variables: variables,
+		Variables: templateVariables(t.Variables),
 		Tags:      t.Tags,
+		DryRun:    dryRun,
 	})
-	if err != nil {
-		return nil, err
+}
+
+func templateVariables(templateVariables []domain.Variable) []*sirenv1beta1.TemplateVariables {
+	variables := make([]*sirenv1beta1.TemplateVariables, 0)
+	for _, variable := range templateVariables {
+		variables = append(variables, &sirenv1beta1.TemplateVariables{
+			Name:          variable.Name,
+			Type:          variable.Type,
+			Default:       variable.Default,
+			Description:   variable.Description,
+			AllowedValues: variable.AllowedValues,
+			Pattern:       variable.Pattern,
+			Min:           variable.Min,
+			Max:           variable.Max,
+			Required:      variable.Required,
+		})
 	}
+	return variables
+}
 
-	//update associated rules for this template
-	data, err := client.ListRules(context.Background(), &sirenv1beta1.ListRulesRequest{
-		Template: t.Name,
-	})
+func uploadPartial(client sirenv1beta1.SirenServiceClient, yamlFile []byte) (*sirenv1beta1.TemplatePartial, error) {
+	var p partial
+	err := yaml.Unmarshal(yamlFile, &p)
 	if err != nil {
 		return nil, err
 	}
+	return client.UpsertTemplatePartial(context.Background(), &sirenv1beta1.UpsertTemplatePartialRequest{
+		Name: p.Name,
+		Body: p.Body,
+	})
+}
 
-	associatedRules := data.Rules
-	for i := 0; i < len(associatedRules); i++ {
-		associatedRule := associatedRules[i]
-
-		var updatedVariables []*sirenv1beta1.Variables
-		for j := 0; j < len(associatedRules[i].Variables); j++ {
-			ruleVar := &sirenv1beta1.Variables{
-				Name:        associatedRules[i].Variables[j].Name,
-				Value:       associatedRules[i].Variables[j].Value,
-				Type:        associatedRules[i].Variables[j].Type,
-				Description: associatedRules[i].Variables[j].Description,
-			}
-			updatedVariables = append(updatedVariables, ruleVar)
+func printRuleGroupSyncs(ruleGroups []*sirenv1beta1.RuleGroupSync, dryRun bool) {
+	for _, ruleGroup := range ruleGroups {
+		if !ruleGroup.Success {
+			fmt.Printf("rule group %s/%s of provider namespace %d sync error: %s\n",
+				ruleGroup.Namespace, ruleGroup.GroupName, ruleGroup.ProviderNamespace, ruleGroup.Error)
+			continue
 		}
-
-		_, err := client.UpdateRule(context.Background(), &sirenv1beta1.UpdateRuleRequest{
-			GroupName:         associatedRule.GroupName,
-			Namespace:         associatedRule.Namespace,
-			Template:          associatedRule.Template,
-			Variables:         updatedVariables,
-			ProviderNamespace: associatedRule.ProviderNamespace,
-			Enabled:           associatedRule.Enabled,
-		})
-
-		if err != nil {
-			fmt.Println("failed to update rule of ID: ", associatedRule.Id, "\tname: ", associatedRule.Name)
-			return nil, err
+		if dryRun {
+			printRuleGroupDiff(ruleGroup.Diff)
+			continue
 		}
-		fmt.Println("successfully updated rule of ID: ", associatedRule.Id, "\tname: ", associatedRule.Name)
+		fmt.Printf("successfully synced rule group %s/%s of provider namespace %d\n",
+			ruleGroup.Namespace, ruleGroup.GroupName, ruleGroup.ProviderNamespace)
 	}
-	return template.Template, nil
 }
 
 func printTemplate(template *sirenv1beta1.Template) {
@@ -446,6 +879,7 @@ func printTemplate(template *sirenv1beta1.Template) {
 	fmt.Println("ID:", template.Id)
 	fmt.Println("Name:", template.Name)
 	fmt.Println("Tags:", template.Tags)
+	fmt.Println("Version:", template.Version)
 	fmt.Println("Variables:", template.Variables)
 	fmt.Println("CreatedAt At:", template.CreatedAt)
 	fmt.Println("UpdatedAt At:", template.UpdatedAt)
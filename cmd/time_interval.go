@@ -0,0 +1,326 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strconv"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/odpf/salt/printer"
+	sirenv1beta1 "github.com/odpf/siren/api/proto/odpf/siren/v1beta1"
+	"github.com/odpf/siren/domain"
+	"github.com/spf13/cobra"
+)
+
+func timeIntervalsCmd(c *configuration) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "time-interval",
+		Aliases: []string{"time-intervals"},
+		Short:   "Manage time intervals",
+		Long: heredoc.Doc(`
+			Work with time intervals.
+
+			Time intervals are named periods of a namespace, in UTC, such as nights
+			or maintenance windows. Subscriptions refer to them by urn to be muted
+			during their periods, and a time interval muting the namespace mutes
+			all of its subscriptions.
+		`),
+		Annotations: map[string]string{
+			"group:core": "true",
+		},
+	}
+
+	cmd.AddCommand(listTimeIntervalsCmd(c))
+	cmd.AddCommand(createTimeIntervalCmd(c))
+	cmd.AddCommand(getTimeIntervalCmd(c))
+	cmd.AddCommand(updateTimeIntervalCmd(c))
+	cmd.AddCommand(deleteTimeIntervalCmd(c))
+	return cmd
+}
+
+func listTimeIntervalsCmd(c *configuration) *cobra.Command {
+	var namespace uint64
+	cmd := &cobra.Command{
+		Use:   "list",
+		Short: "List time intervals",
+		Long: heredoc.Doc(`
+			List all time intervals, or only the ones of a namespace.
+		`),
+		Example: heredoc.Doc(`
+			$ siren time-interval list --namespace 1
+		`),
+		Annotations: map[string]string{
+			"group:core": "true",
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := context.Background()
+			client, cancel, err := createClient(ctx, c.Host)
+			if err != nil {
+				return err
+			}
+			defer cancel()
+
+			res, err := client.ListTimeIntervals(ctx, &sirenv1beta1.ListTimeIntervalsRequest{
+				Namespace: namespace,
+			})
+			if err != nil {
+				return err
+			}
+
+			timeIntervals := res.TimeIntervals
+			report := [][]string{}
+
+			fmt.Printf(" \nShowing %d of %d time intervals\n \n", len(timeIntervals), len(timeIntervals))
+			report = append(report, []string{"ID", "URN", "NAMESPACE", "PERIODS", "MUTE NAMESPACE"})
+
+			for _, p := range timeIntervals {
+				report = append(report, []string{
+					fmt.Sprintf("%v", p.GetId()),
+					p.GetUrn(),
+					fmt.Sprintf("%v", p.GetNamespace()),
+					fmt.Sprintf("%v", len(p.GetPeriods())),
+					fmt.Sprintf("%v", p.GetMuteNamespace()),
+				})
+			}
+			printer.Table(os.Stdout, report)
+
+			fmt.Println("\nFor details on a time interval, try: siren time-interval view <id>")
+			return nil
+		},
+	}
+
+	cmd.Flags().Uint64Var(&namespace, "namespace", 0, "namespace id")
+
+	return cmd
+}
+
+func createTimeIntervalCmd(c *configuration) *cobra.Command {
+	var filePath string
+	cmd := &cobra.Command{
+		Use:   "create",
+		Short: "Create a new time interval",
+		Long: heredoc.Doc(`
+			Create a new time interval.
+		`),
+		Annotations: map[string]string{
+			"group:core": "true",
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			var timeIntervalConfig domain.TimeInterval
+			if err := parseFile(filePath, &timeIntervalConfig); err != nil {
+				return err
+			}
+
+			ctx := context.Background()
+			client, cancel, err := createClient(ctx, c.Host)
+			if err != nil {
+				return err
+			}
+			defer cancel()
+
+			res, err := client.CreateTimeInterval(ctx, &sirenv1beta1.CreateTimeIntervalRequest{
+				Urn:           timeIntervalConfig.Urn,
+				Namespace:     timeIntervalConfig.Namespace,
+				Periods:       getTimePeriods(timeIntervalConfig.Periods),
+				MuteNamespace: timeIntervalConfig.MuteNamespace,
+			})
+			if err != nil {
+				return err
+			}
+
+			fmt.Printf("time interval created with id: %v\n", res.GetId())
+
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVarP(&filePath, "file", "f", "", "path to the time interval config")
+	cmd.MarkFlagRequired("file")
+
+	return cmd
+}
+
+func getTimeIntervalCmd(c *configuration) *cobra.Command {
+	var format string
+	cmd := &cobra.Command{
+		Use:   "view",
+		Short: "View a time interval details",
+		Long: heredoc.Doc(`
+			View a time interval.
+
+			Display the Id, namespace, periods and namespace muting of a time interval.
+		`),
+		Example: heredoc.Doc(`
+			$ siren time-interval view 1
+		`),
+		Annotations: map[string]string{
+			"group:core": "true",
+		},
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := context.Background()
+			client, cancel, err := createClient(ctx, c.Host)
+			if err != nil {
+				return err
+			}
+			defer cancel()
+
+			id, err := strconv.ParseUint(args[0], 10, 32)
+			if err != nil {
+				return fmt.Errorf("invalid time interval id: %v", err)
+			}
+
+			res, err := client.GetTimeInterval(ctx, &sirenv1beta1.GetTimeIntervalRequest{
+				Id: uint64(id),
+			})
+			if err != nil {
+				return err
+			}
+
+			timeInterval := &domain.TimeInterval{
+				Id:            res.GetId(),
+				Urn:           res.GetUrn(),
+				Namespace:     res.GetNamespace(),
+				Periods:       getDomainTimePeriods(res.GetPeriods()),
+				MuteNamespace: res.GetMuteNamespace(),
+				CreatedAt:     res.CreatedAt.AsTime(),
+				UpdatedAt:     res.UpdatedAt.AsTime(),
+			}
+
+			if err := printer.Text(timeInterval, format); err != nil {
+				return fmt.Errorf("failed to format time interval: %v", err)
+			}
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVar(&format, "format", "yaml", "Print output with the selected format")
+
+	return cmd
+}
+
+func updateTimeIntervalCmd(c *configuration) *cobra.Command {
+	var id uint64
+	var filePath string
+	cmd := &cobra.Command{
+		Use:   "edit",
+		Short: "Edit a time interval",
+		Long: heredoc.Doc(`
+			Edit an existing time interval.
+		`),
+		Annotations: map[string]string{
+			"group:core": "true",
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			var timeIntervalConfig domain.TimeInterval
+			if err := parseFile(filePath, &timeIntervalConfig); err != nil {
+				return err
+			}
+
+			ctx := context.Background()
+			client, cancel, err := createClient(ctx, c.Host)
+			if err != nil {
+				return err
+			}
+			defer cancel()
+
+			_, err = client.UpdateTimeInterval(ctx, &sirenv1beta1.UpdateTimeIntervalRequest{
+				Id:            id,
+				Urn:           timeIntervalConfig.Urn,
+				Namespace:     timeIntervalConfig.Namespace,
+				Periods:       getTimePeriods(timeIntervalConfig.Periods),
+				MuteNamespace: timeIntervalConfig.MuteNamespace,
+			})
+			if err != nil {
+				return err
+			}
+
+			fmt.Println("Successfully updated time interval")
+
+			return nil
+		},
+	}
+
+	cmd.Flags().Uint64Var(&id, "id", 0, "time interval id")
+	cmd.MarkFlagRequired("id")
+	cmd.Flags().StringVarP(&filePath, "file", "f", "", "Path to the time interval config")
+	cmd.MarkFlagRequired("file")
+
+	return cmd
+}
+
+func deleteTimeIntervalCmd(c *configuration) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "delete",
+		Short: "Delete a time interval",
+		Example: heredoc.Doc(`
+			$ siren time-interval delete 1
+		`),
+		Annotations: map[string]string{
+			"group:core": "true",
+		},
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := context.Background()
+			client, cancel, err := createClient(ctx, c.Host)
+			if err != nil {
+				return err
+			}
+			defer cancel()
+
+			id, err := strconv.ParseUint(args[0], 10, 32)
+			if err != nil {
+				return fmt.Errorf("invalid time interval id: %v", err)
+			}
+
+			_, err = client.DeleteTimeInterval(ctx, &sirenv1beta1.DeleteTimeIntervalRequest{
+				Id: uint64(id),
+			})
+			if err != nil {
+				return err
+			}
+
+			fmt.Println("Successfully deleted time interval")
+			return nil
+		},
+	}
+
+	return cmd
+}
+
+func getTimePeriods(domainPeriods []domain.TimePeriod) []*sirenv1beta1.TimePeriod {
+	periods := make([]*sirenv1beta1.TimePeriod, 0, len(domainPeriods))
+	for _, period := range domainPeriods {
+		times := make([]*sirenv1beta1.TimeRange, 0, len(period.Times))
+		for _, timeRange := range period.Times {
+			times = append(times, &sirenv1beta1.TimeRange{StartTime: timeRange.StartTime, EndTime: timeRange.EndTime})
+		}
+		periods = append(periods, &sirenv1beta1.TimePeriod{
+			Times:       times,
+			Weekdays:    period.Weekdays,
+			DaysOfMonth: period.DaysOfMonth,
+			Months:      period.Months,
+			Years:       period.Years,
+		})
+	}
+	return periods
+}
+
+func getDomainTimePeriods(periods []*sirenv1beta1.TimePeriod) []domain.TimePeriod {
+	domainPeriods := make([]domain.TimePeriod, 0, len(periods))
+	for _, period := range periods {
+		var times []domain.TimeRange
+		for _, timeRange := range period.GetTimes() {
+			times = append(times, domain.TimeRange{StartTime: timeRange.GetStartTime(), EndTime: timeRange.GetEndTime()})
+		}
+		domainPeriods = append(domainPeriods, domain.TimePeriod{
+			Times:       times,
+			Weekdays:    period.GetWeekdays(),
+			DaysOfMonth: period.GetDaysOfMonth(),
+			Months:      period.GetMonths(),
+			Years:       period.GetYears(),
+		})
+	}
+	return domainPeriods
+}
@@ -1,5 +1,7 @@
 package domain
 
+import "time"
+
 // DBConfig contains the database configuration
 type DBConfig struct {
 	Host     string `mapstructure:"host" default:"localhost"`
@@ -16,6 +18,20 @@ type CortexConfig struct {
 	Address string `mapstructure:"address" default:"http://localhost:8080"`
 }
 
+// PrometheusConfig contains the configuration of the file based prometheus and vmalert providers
+type PrometheusConfig struct {
+	RulesDir string `mapstructure:"rules_dir" default:"./rules"`
+}
+
+// RuleReconcilerConfig contains the configuration of the rule drift reconciler
+type RuleReconcilerConfig struct {
+	Enabled              bool          `mapstructure:"enabled" default:"false"`
+	Interval             time.Duration `mapstructure:"interval" default:"5m"`
+	Repair               bool          `mapstructure:"repair" default:"false"`
+	Concurrency          int           `mapstructure:"concurrency" default:"4"`
+	ConcurrencyPerTenant int           `mapstructure:"concurrency_per_tenant" default:"1"`
+}
+
 type SirenServiceConfig struct {
 	Host string `mapstructure:"host" default:"http://localhost:3000"`
 }
@@ -38,12 +54,14 @@ type SlackApp struct {
 
 // Config contains the application configuration
 type Config struct {
-	Port          int                `mapstructure:"port" default:"8080"`
-	DB            DBConfig           `mapstructure:"db"`
-	Cortex        CortexConfig       `mapstructure:"cortex"`
-	NewRelic      NewRelicConfig     `mapstructure:"newrelic"`
-	SirenService  SirenServiceConfig `mapstructure:"siren_service"`
-	Log           LogConfig          `mapstructure:"log"`
-	SlackApp      SlackApp           `mapstructure:"slack_app"`
-	EncryptionKey string             `mapstructure:"encryption_key"`
+	Port           int                  `mapstructure:"port" default:"8080"`
+	DB             DBConfig             `mapstructure:"db"`
+	Cortex         CortexConfig         `mapstructure:"cortex"`
+	Prometheus     PrometheusConfig     `mapstructure:"prometheus"`
+	RuleReconciler RuleReconcilerConfig `mapstructure:"rule_reconciler"`
+	NewRelic       NewRelicConfig       `mapstructure:"newrelic"`
+	SirenService   SirenServiceConfig   `mapstructure:"siren_service"`
+	Log            LogConfig            `mapstructure:"log"`
+	SlackApp       SlackApp             `mapstructure:"slack_app"`
+	EncryptionKey  string               `mapstructure:"encryption_key"`
 }
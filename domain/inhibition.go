@@ -0,0 +1,38 @@
+package domain
+
+import (
+	"fmt"
+	"time"
+)
+
+// InhibitionRule mutes the alerts of a namespace matching the target matchers
+// while an alert matching the source matchers is firing, both alerts having
+// the same values for the equal labels
+type InhibitionRule struct {
+	Id             uint64    `json:"id"`
+	Urn            string    `json:"urn"`
+	Namespace      uint64    `json:"namespace"`
+	SourceMatchers []Matcher `json:"source_matchers" yaml:"sourceMatchers"`
+	TargetMatchers []Matcher `json:"target_matchers" yaml:"targetMatchers"`
+	Equal          []string  `json:"equal"`
+	CreatedAt      time.Time `json:"created_at" yaml:"createdAt"`
+	UpdatedAt      time.Time `json:"updated_at" yaml:"updatedAt"`
+}
+
+// InvalidInhibitionRuleError is returned when an inhibition rule fails validation
+type InvalidInhibitionRuleError struct {
+	Reason string
+}
+
+func (e *InvalidInhibitionRuleError) Error() string {
+	return fmt.Sprintf("invalid inhibition rule: %s", e.Reason)
+}
+
+type InhibitionService interface {
+	ListInhibitionRules(namespaceId uint64) ([]*InhibitionRule, error)
+	CreateInhibitionRule(*InhibitionRule) (*InhibitionRule, error)
+	GetInhibitionRule(uint64) (*InhibitionRule, error)
+	UpdateInhibitionRule(*InhibitionRule) (*InhibitionRule, error)
+	DeleteInhibitionRule(uint64) error
+	Migrate() error
+}
@@ -11,6 +11,9 @@ type Namespace struct {
 	Labels      map[string]string      `json:"labels"`
 	CreatedAt   time.Time              `json:"created_at"`
 	UpdatedAt   time.Time              `json:"updated_at"`
+
+	// Routing is the default routing of the subscriptions of the namespace
+	Routing `yaml:",inline"`
 }
 
 type NamespaceService interface {
@@ -1,6 +1,12 @@
 package domain
 
-import "time"
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+)
 
 type RuleVariable struct {
 	Name        string `json:"name" validate:"required"`
@@ -9,6 +15,8 @@ type RuleVariable struct {
 	Description string `json:"description"`
 }
 
+// Rule is rendered from the version of its template it is pinned to, or from
+// the latest version if TemplateVersion is 0
 type Rule struct {
 	Id                uint64         `json:"id"`
 	Name              string         `json:"name"`
@@ -16,15 +24,196 @@ type Rule struct {
 	GroupName         string         `json:"group_name" validate:"required"`
 	Namespace         string         `json:"namespace" validate:"required"`
 	Template          string         `json:"template" validate:"required"`
+	TemplateVersion   uint           `json:"template_version"`
 	Variables         []RuleVariable `json:"variables" validate:"required,dive,required"`
 	ProviderNamespace uint64         `json:"provider_namespace" validate:"required"`
 	CreatedAt         time.Time      `json:"created_at"`
 	UpdatedAt         time.Time      `json:"updated_at"`
 }
 
+// RuleGroup holds the settings of a rule group, which are applied every time
+// the group is pushed to its provider. An empty interval or a zero limit
+// leaves the provider default.
+type RuleGroup struct {
+	Id                uint64    `json:"id"`
+	ProviderNamespace uint64    `json:"provider_namespace" validate:"required"`
+	Namespace         string    `json:"namespace" validate:"required"`
+	GroupName         string    `json:"group_name" validate:"required"`
+	Interval          string    `json:"interval"`
+	Limit             uint32    `json:"limit"`
+	SourceTenants     []string  `json:"source_tenants"`
+	CreatedAt         time.Time `json:"created_at"`
+	UpdatedAt         time.Time `json:"updated_at"`
+}
+
+// RuleRevision is an immutable snapshot of a rule, stored at every upsert.
+// TemplateHash is the SHA-256 of the template body the rule was rendered from
+// and TemplateVersion the template version the rule was pinned to.
+type RuleRevision struct {
+	Id              uint64         `json:"id"`
+	RuleId          uint64         `json:"rule_id"`
+	Enabled         bool           `json:"enabled"`
+	Variables       []RuleVariable `json:"variables"`
+	TemplateHash    string         `json:"template_hash"`
+	TemplateVersion uint           `json:"template_version"`
+	Rendered        string         `json:"rendered"`
+	Actor           string         `json:"actor"`
+	CreatedAt       time.Time      `json:"created_at"`
+}
+
+// RuleDiff describes a single rule of a rule group, rendered as YAML,
+// as it is currently in the provider and as it would be after an upsert
+type RuleDiff struct {
+	Name    string `json:"name"`
+	Current string `json:"current"`
+	Desired string `json:"desired"`
+}
+
+// RuleGroupDiff describes the changes an upsert would make to a rule group
+type RuleGroupDiff struct {
+	Namespace string     `json:"namespace"`
+	GroupName string     `json:"group_name"`
+	Added     []RuleDiff `json:"added"`
+	Changed   []RuleDiff `json:"changed"`
+	Removed   []RuleDiff `json:"removed"`
+}
+
+// RuleDrift describes a rule group of a provider namespace that differs from
+// the rule group rendered from siren rules. Current is the provider version.
+type RuleDrift struct {
+	ProviderNamespace uint64        `json:"provider_namespace"`
+	Diff              RuleGroupDiff `json:"diff"`
+	Repaired          bool          `json:"repaired"`
+}
+
+// RuleDriftReport is the result of a reconciliation of siren rules with providers
+type RuleDriftReport struct {
+	CheckedAt time.Time   `json:"checked_at"`
+	Drifts    []RuleDrift `json:"drifts"`
+	Errors    []string    `json:"errors"`
+}
+
+// RuleGroupSync is the result of pushing a rule group again after a change of
+// a template used by its rules. Diff is only set for a dry run.
+type RuleGroupSync struct {
+	ProviderNamespace uint64         `json:"provider_namespace"`
+	Namespace         string         `json:"namespace"`
+	GroupName         string         `json:"group_name"`
+	Success           bool           `json:"success"`
+	Error             string         `json:"error"`
+	Diff              *RuleGroupDiff `json:"diff"`
+}
+
+// TemplateRuleUsage is a rule using a template, with the urns of the
+// namespace and provider its rule group is pushed to
+type TemplateRuleUsage struct {
+	Id                uint64 `json:"id"`
+	Name              string `json:"name"`
+	Namespace         string `json:"namespace"`
+	GroupName         string `json:"group_name"`
+	TemplateVersion   uint   `json:"template_version"`
+	Enabled           bool   `json:"enabled"`
+	ProviderNamespace uint64 `json:"provider_namespace"`
+	NamespaceUrn      string `json:"namespace_urn"`
+	ProviderUrn       string `json:"provider_urn"`
+}
+
+// TemplateUsage lists the rules using a template, and the distinct urns of
+// the namespaces and providers of their rule groups
+type TemplateUsage struct {
+	Template   string              `json:"template"`
+	Rules      []TemplateRuleUsage `json:"rules"`
+	Namespaces []string            `json:"namespaces"`
+	Providers  []string            `json:"providers"`
+}
+
+// RuleGroupExport is a rule group of a rule export with its settings and rules
+type RuleGroupExport struct {
+	Group RuleGroup `json:"group"`
+	Rules []Rule    `json:"rules"`
+}
+
+// RuleExport holds the rules of a provider namespace grouped by rule group,
+// with the urns of the provider namespace and its provider
+type RuleExport struct {
+	ProviderUrn  string            `json:"provider_urn"`
+	NamespaceUrn string            `json:"namespace_urn"`
+	RuleGroups   []RuleGroupExport `json:"rule_groups"`
+}
+
+// UnmappedRule is a rule of a provider rule group that could not be imported
+type UnmappedRule struct {
+	Namespace string `json:"namespace"`
+	GroupName string `json:"group_name"`
+	Name      string `json:"name"`
+	Reason    string `json:"reason"`
+}
+
+// RuleImportReport is the result of importing the rule groups of a provider namespace
+type RuleImportReport struct {
+	Rules            []Rule         `json:"rules"`
+	CreatedTemplates []Template     `json:"created_templates"`
+	Unmapped         []UnmappedRule `json:"unmapped"`
+}
+
+// InvalidRuleError is returned when a rule rendered from a template
+// fails validation
+type InvalidRuleError struct {
+	Template  string
+	Variables map[string]string
+	Rule      string
+	Reason    string
+}
+
+func (e *InvalidRuleError) Error() string {
+	variables := make([]string, 0, len(e.Variables))
+	for name, value := range e.Variables {
+		variables = append(variables, fmt.Sprintf("%s=%s", name, value))
+	}
+	sort.Strings(variables)
+	return fmt.Sprintf("invalid rule %s rendered from template %s with variables [%s]: %s",
+		e.Rule, e.Template, strings.Join(variables, ", "), e.Reason)
+}
+
+// InvalidRuleGroupError is returned when the settings of a rule group fail validation
+type InvalidRuleGroupError struct {
+	Namespace string
+	GroupName string
+	Reason    string
+}
+
+func (e *InvalidRuleGroupError) Error() string {
+	return fmt.Sprintf("invalid settings of rule group %s in namespace %s: %s", e.GroupName, e.Namespace, e.Reason)
+}
+
+// RuleNotFoundError is returned when a rule to change does not exist
+type RuleNotFoundError struct {
+	Id uint64
+}
+
+func (e *RuleNotFoundError) Error() string {
+	return fmt.Sprintf("rule %d not found", e.Id)
+}
+
 // RuleService interface
 type RuleService interface {
-	Upsert(*Rule) (*Rule, error)
+	Upsert(*Rule, string) (*Rule, error)
+	Diff(*Rule) (*RuleGroupDiff, error)
 	Get(string, string, string, string, uint64) ([]Rule, error)
+	Delete(uint64) error
+	Import(uint64, bool, bool) (*RuleImportReport, error)
+	ListRevisions(uint64) ([]RuleRevision, error)
+	Rollback(uint64, uint64, string) (*Rule, error)
+	GetGroup(uint64, string, string) (*RuleGroup, error)
+	UpsertGroup(*RuleGroup) (*RuleGroup, error)
+	UpgradeTemplateVersion(string, uint, uint, string) ([]Rule, error)
+	TemplateUsage(string) (*TemplateUsage, error)
+	Export(uint64, string, string) (*RuleExport, error)
 	Migrate() error
 }
+
+// RuleReconciler detects drift between siren rules and the rule groups in providers
+type RuleReconciler interface {
+	Run(context.Context)
+	Report() (*RuleDriftReport, error)
+}
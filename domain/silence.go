@@ -0,0 +1,42 @@
+package domain
+
+import (
+	"fmt"
+	"time"
+)
+
+// Silence states reported by alertmanager
+const (
+	SilenceStatePending = "pending"
+	SilenceStateActive  = "active"
+	SilenceStateExpired = "expired"
+)
+
+// Silence mutes the alerts of a namespace matching all of its matchers from
+// its start until its end, recording who created it and why
+type Silence struct {
+	Id        string    `json:"id"`
+	Namespace uint64    `json:"namespace"`
+	Matchers  []Matcher `json:"matchers"`
+	StartsAt  time.Time `json:"starts_at" yaml:"startsAt"`
+	EndsAt    time.Time `json:"ends_at" yaml:"endsAt"`
+	CreatedBy string    `json:"created_by" yaml:"createdBy"`
+	Comment   string    `json:"comment"`
+	State     string    `json:"state"`
+}
+
+// InvalidSilenceError is returned when a silence fails validation
+type InvalidSilenceError struct {
+	Reason string
+}
+
+func (e *InvalidSilenceError) Error() string {
+	return fmt.Sprintf("invalid silence: %s", e.Reason)
+}
+
+type SilenceService interface {
+	ListSilences(namespaceId uint64) ([]*Silence, error)
+	CreateSilence(*Silence) (*Silence, error)
+	GetSilence(namespaceId uint64, id string) (*Silence, error)
+	ExpireSilence(namespaceId uint64, id string) error
+}
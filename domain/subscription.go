@@ -1,20 +1,135 @@
 package domain
 
-import "time"
+import (
+	"fmt"
+	"regexp"
+	"time"
+
+	"github.com/prometheus/common/model"
+)
 
 type ReceiverMetadata struct {
 	Id            uint64            `json:"id"`
 	Configuration map[string]string `json:"configuration"`
 }
 
+// Matcher operators of subscriptions
+const (
+	MatchEqual     = "="
+	MatchNotEqual  = "!="
+	MatchRegexp    = "=~"
+	MatchNotRegexp = "!~"
+)
+
+// Matcher matches the alerts with a label equal to, not equal to, matching or
+// not matching its value
+type Matcher struct {
+	Name     string `json:"name"`
+	Operator string `json:"operator"`
+	Value    string `json:"value"`
+}
+
+// InvalidMatcherError is returned when a matcher of a subscription fails validation
+type InvalidMatcherError struct {
+	Matcher Matcher
+	Reason  string
+}
+
+func (e *InvalidMatcherError) Error() string {
+	return fmt.Sprintf("invalid matcher %s%s%q: %s", e.Matcher.Name, e.Matcher.Operator, e.Matcher.Value, e.Reason)
+}
+
+var labelNamePattern = regexp.MustCompile(`^[a-zA-Z_][a-zA-Z0-9_]*$`)
+
+// Validate checks the matcher the way alertmanager loads it, regular
+// expressions being anchored to match the whole label value
+func (m Matcher) Validate() error {
+	if !labelNamePattern.MatchString(m.Name) {
+		return &InvalidMatcherError{Matcher: m, Reason: "label name must match " + labelNamePattern.String()}
+	}
+	switch m.Operator {
+	case MatchEqual, MatchNotEqual:
+	case MatchRegexp, MatchNotRegexp:
+		if _, err := regexp.Compile("^(?:" + m.Value + ")$"); err != nil {
+			return &InvalidMatcherError{Matcher: m, Reason: err.Error()}
+		}
+	default:
+		return &InvalidMatcherError{Matcher: m, Reason: fmt.Sprintf("unknown operator %q", m.Operator)}
+	}
+	return nil
+}
+
+// Routing holds how the notifications of a route are grouped and how long
+// they wait before being sent. Empty fields are inherited from the parent route.
+type Routing struct {
+	GroupBy        []string `json:"group_by,omitempty" yaml:"groupBy,omitempty"`
+	GroupWait      string   `json:"group_wait,omitempty" yaml:"groupWait,omitempty"`
+	GroupInterval  string   `json:"group_interval,omitempty" yaml:"groupInterval,omitempty"`
+	RepeatInterval string   `json:"repeat_interval,omitempty" yaml:"repeatInterval,omitempty"`
+}
+
+// InvalidRoutingError is returned when the grouping or timings of a route fail validation
+type InvalidRoutingError struct {
+	Reason string
+}
+
+func (e *InvalidRoutingError) Error() string {
+	return fmt.Sprintf("invalid routing: %s", e.Reason)
+}
+
+// Validate checks the labels to group by and the timings the way alertmanager
+// loads them, where ... groups by all labels
+func (r *Routing) Validate() error {
+	for _, label := range r.GroupBy {
+		if label == "..." {
+			if len(r.GroupBy) > 1 {
+				return &InvalidRoutingError{Reason: "cannot group by ... and other labels"}
+			}
+			continue
+		}
+		if !model.LabelName(label).IsValid() {
+			return &InvalidRoutingError{Reason: fmt.Sprintf("invalid label %q to group by", label)}
+		}
+	}
+	timings := []struct {
+		name    string
+		value   string
+		nonZero bool
+	}{
+		{"group_wait", r.GroupWait, false},
+		{"group_interval", r.GroupInterval, true},
+		{"repeat_interval", r.RepeatInterval, true},
+	}
+	for _, timing := range timings {
+		if timing.value == "" {
+			continue
+		}
+		duration, err := model.ParseDuration(timing.value)
+		if err != nil {
+			return &InvalidRoutingError{Reason: fmt.Sprintf("%s: %s", timing.name, err)}
+		}
+		if timing.nonZero && duration == 0 {
+			return &InvalidRoutingError{Reason: fmt.Sprintf("%s cannot be zero", timing.name)}
+		}
+	}
+	return nil
+}
+
 type Subscription struct {
 	Id        uint64             `json:"id"`
 	Urn       string             `json:"urn"`
 	Namespace uint64             `json:"namespace"`
 	Receivers []ReceiverMetadata `json:"receivers"`
 	Match     map[string]string  `json:"match"`
+	Matchers  []Matcher          `json:"matchers"`
 	CreatedAt time.Time          `json:"created_at"`
 	UpdatedAt time.Time          `json:"updated_at"`
+
+	// MuteTimeIntervals are the urns of the time intervals of the namespace
+	// in which the notifications are muted
+	MuteTimeIntervals []string `json:"mute_time_intervals,omitempty" yaml:"muteTimeIntervals,omitempty"`
+
+	Routing `yaml:",inline"`
 }
 
 type SubscriptionService interface {
@@ -1,16 +1,40 @@
 package domain
 
 import (
+	"fmt"
 	"time"
 )
 
+// Variable is a variable of a template body. Its value is checked against
+// Type, which is one of int, float, duration, bool, string or enum. Enum values
+// must be one of AllowedValues and string values must match Pattern if set.
+// Min and Max bound int, float and duration values. A Required variable has no
+// default and must be given a value.
 type Variable struct {
-	Name        string `json:"name" validate:"required"`
-	Type        string `json:"type" validate:"required"`
-	Default     string `json:"default"`
-	Description string `json:"description"`
+	Name          string   `json:"name" validate:"required"`
+	Type          string   `json:"type" validate:"required"`
+	Default       string   `json:"default"`
+	Description   string   `json:"description"`
+	AllowedValues []string `json:"allowed_values,omitempty" yaml:"allowedValues,omitempty"`
+	Pattern       string   `json:"pattern,omitempty" yaml:"pattern,omitempty"`
+	Min           string   `json:"min,omitempty" yaml:"min,omitempty"`
+	Max           string   `json:"max,omitempty" yaml:"max,omitempty"`
+	Required      bool     `json:"required,omitempty" yaml:"required,omitempty"`
 }
 
+// InvalidVariableError is returned when a template variable or its value fails validation
+type InvalidVariableError struct {
+	Name   string
+	Reason string
+}
+
+func (e *InvalidVariableError) Error() string {
+	return fmt.Sprintf("invalid variable %s: %s", e.Name, e.Reason)
+}
+
+// Template is a templatized body of rules. Version is the number of the
+// upsert that stored the template, starting at 1. Partials holds the stored
+// partials when the body includes any, which rendering needs.
 type Template struct {
 	ID        uint       `json:"id"`
 	CreatedAt time.Time  `json:"created_at"`
@@ -19,14 +43,85 @@ type Template struct {
 	Body      string     `json:"body" validate:"required"`
 	Tags      []string   `json:"tags" validate:"required"`
 	Variables []Variable `json:"variables" validate:"required,dive,required"`
+	Version   uint       `json:"version"`
+	Partials  []Partial  `json:"-" yaml:"-"`
+}
+
+// Partial is a named template body that template bodies and other partials
+// include with the template action or the include function
+type Partial struct {
+	ID        uint      `json:"id"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+	Name      string    `json:"name" validate:"required"`
+	Body      string    `json:"body" validate:"required"`
+}
+
+// InvalidPartialError is returned when a partial fails to parse or includes itself
+type InvalidPartialError struct {
+	Name   string
+	Reason string
+}
+
+func (e *InvalidPartialError) Error() string {
+	return fmt.Sprintf("invalid partial %s: %s", e.Name, e.Reason)
+}
+
+// TemplateFilter selects, orders and pages the templates of a list. Templates
+// match any of Tags, or all of them if MatchAllTags is set. SortBy is one of
+// name, created_at or updated_at, and SortOrder is asc or desc. A PageSize of
+// 0 returns all the matching templates.
+type TemplateFilter struct {
+	Tags         []string
+	MatchAllTags bool
+	NamePrefix   string
+	NameContains string
+	UpdatedSince time.Time
+	SortBy       string
+	SortOrder    string
+	PageSize     int
+	PageToken    string
+}
+
+// TemplatePage is a page of templates. NextPageToken is empty on the last page.
+type TemplatePage struct {
+	Templates     []Template
+	NextPageToken string
+}
+
+// InvalidTemplateFilterError is returned when a template filter has an unknown
+// sort or a malformed page token
+type InvalidTemplateFilterError struct {
+	Reason string
+}
+
+func (e *InvalidTemplateFilterError) Error() string {
+	return fmt.Sprintf("invalid template filter: %s", e.Reason)
+}
+
+// TemplateInUseError is returned when a template used by rules is deleted
+// without forcing the deletion
+type TemplateInUseError struct {
+	Name  string
+	Rules int64
+}
+
+func (e *TemplateInUseError) Error() string {
+	return fmt.Sprintf("template %s is used by %d rules, delete them or force the deletion", e.Name, e.Rules)
 }
 
 // TemplatesService interface
 type TemplatesService interface {
-	Upsert(*Template) (*Template, error)
+	Upsert(*Template, bool) (*Template, []RuleGroupSync, error)
 	Index(string) ([]Template, error)
+	List(*TemplateFilter) (*TemplatePage, error)
 	GetByName(string) (*Template, error)
-	Delete(string) error
+	Delete(string, bool) ([]Rule, error)
 	Render(string, map[string]string) (string, error)
+	ListVersions(string) ([]Template, error)
+	GetVersion(string, uint) (*Template, error)
+	UpsertPartial(*Partial) (*Partial, error)
+	ListPartials() ([]Partial, error)
+	DeletePartial(string) error
 	Migrate() error
 }
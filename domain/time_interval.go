@@ -0,0 +1,54 @@
+package domain
+
+import (
+	"fmt"
+	"time"
+)
+
+// TimeRange is a range of the time of day, as HH:MM, with end time excluded
+type TimeRange struct {
+	StartTime string `json:"start_time" yaml:"startTime"`
+	EndTime   string `json:"end_time" yaml:"endTime"`
+}
+
+// TimePeriod holds the times, weekdays, days of month, months and years
+// matching a period, each being matched when empty, in UTC
+type TimePeriod struct {
+	Times       []TimeRange `json:"times,omitempty" yaml:"times,omitempty"`
+	Weekdays    []string    `json:"weekdays,omitempty" yaml:"weekdays,omitempty"`
+	DaysOfMonth []string    `json:"days_of_month,omitempty" yaml:"daysOfMonth,omitempty"`
+	Months      []string    `json:"months,omitempty" yaml:"months,omitempty"`
+	Years       []string    `json:"years,omitempty" yaml:"years,omitempty"`
+}
+
+// TimeInterval is a named set of periods of a namespace, which subscriptions
+// refer to as mute time intervals. A time interval muting the
+// namespace mutes all of its subscriptions.
+type TimeInterval struct {
+	Id            uint64       `json:"id"`
+	Urn           string       `json:"urn"`
+	Namespace     uint64       `json:"namespace"`
+	Periods       []TimePeriod `json:"periods"`
+	MuteNamespace bool         `json:"mute_namespace" yaml:"muteNamespace"`
+	CreatedAt     time.Time    `json:"created_at" yaml:"createdAt"`
+	UpdatedAt     time.Time    `json:"updated_at" yaml:"updatedAt"`
+}
+
+// InvalidTimeIntervalError is returned when a time interval fails validation
+// or a subscription refers to a time interval missing from its namespace
+type InvalidTimeIntervalError struct {
+	Reason string
+}
+
+func (e *InvalidTimeIntervalError) Error() string {
+	return fmt.Sprintf("invalid time interval: %s", e.Reason)
+}
+
+type TimeIntervalService interface {
+	ListTimeIntervals(namespaceId uint64) ([]*TimeInterval, error)
+	CreateTimeInterval(*TimeInterval) (*TimeInterval, error)
+	GetTimeInterval(uint64) (*TimeInterval, error)
+	UpdateTimeInterval(*TimeInterval) (*TimeInterval, error)
+	DeleteTimeInterval(uint64) error
+	Migrate() error
+}
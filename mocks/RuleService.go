@@ -12,6 +12,66 @@ type RuleService struct {
 	mock.Mock
 }
 
+// Delete provides a mock function with given fields: _a0
+func (_m *RuleService) Delete(_a0 uint64) error {
+	ret := _m.Called(_a0)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(uint64) error); ok {
+		r0 = rf(_a0)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Diff provides a mock function with given fields: _a0
+func (_m *RuleService) Diff(_a0 *domain.Rule) (*domain.RuleGroupDiff, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *domain.RuleGroupDiff
+	if rf, ok := ret.Get(0).(func(*domain.Rule) *domain.RuleGroupDiff); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.RuleGroupDiff)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*domain.Rule) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Export provides a mock function with given fields: _a0, _a1, _a2
+func (_m *RuleService) Export(_a0 uint64, _a1 string, _a2 string) (*domain.RuleExport, error) {
+	ret := _m.Called(_a0, _a1, _a2)
+
+	var r0 *domain.RuleExport
+	if rf, ok := ret.Get(0).(func(uint64, string, string) *domain.RuleExport); ok {
+		r0 = rf(_a0, _a1, _a2)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.RuleExport)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(uint64, string, string) error); ok {
+		r1 = rf(_a0, _a1, _a2)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // Get provides a mock function with given fields: _a0, _a1, _a2, _a3, _a4
 func (_m *RuleService) Get(_a0 string, _a1 string, _a2 string, _a3 string, _a4 uint64) ([]domain.Rule, error) {
 	ret := _m.Called(_a0, _a1, _a2, _a3, _a4)
@@ -35,6 +95,75 @@ func (_m *RuleService) Get(_a0 string, _a1 string, _a2 string, _a3 string, _a4 u
 	return r0, r1
 }
 
+// GetGroup provides a mock function with given fields: _a0, _a1, _a2
+func (_m *RuleService) GetGroup(_a0 uint64, _a1 string, _a2 string) (*domain.RuleGroup, error) {
+	ret := _m.Called(_a0, _a1, _a2)
+
+	var r0 *domain.RuleGroup
+	if rf, ok := ret.Get(0).(func(uint64, string, string) *domain.RuleGroup); ok {
+		r0 = rf(_a0, _a1, _a2)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.RuleGroup)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(uint64, string, string) error); ok {
+		r1 = rf(_a0, _a1, _a2)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Import provides a mock function with given fields: _a0, _a1, _a2
+func (_m *RuleService) Import(_a0 uint64, _a1 bool, _a2 bool) (*domain.RuleImportReport, error) {
+	ret := _m.Called(_a0, _a1, _a2)
+
+	var r0 *domain.RuleImportReport
+	if rf, ok := ret.Get(0).(func(uint64, bool, bool) *domain.RuleImportReport); ok {
+		r0 = rf(_a0, _a1, _a2)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.RuleImportReport)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(uint64, bool, bool) error); ok {
+		r1 = rf(_a0, _a1, _a2)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListRevisions provides a mock function with given fields: _a0
+func (_m *RuleService) ListRevisions(_a0 uint64) ([]domain.RuleRevision, error) {
+	ret := _m.Called(_a0)
+
+	var r0 []domain.RuleRevision
+	if rf, ok := ret.Get(0).(func(uint64) []domain.RuleRevision); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.RuleRevision)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(uint64) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // Migrate provides a mock function with given fields:
 func (_m *RuleService) Migrate() error {
 	ret := _m.Called()
@@ -49,13 +178,82 @@ func (_m *RuleService) Migrate() error {
 	return r0
 }
 
-// Upsert provides a mock function with given fields: _a0
-func (_m *RuleService) Upsert(_a0 *domain.Rule) (*domain.Rule, error) {
-	ret := _m.Called(_a0)
+// Rollback provides a mock function with given fields: _a0, _a1, _a2
+func (_m *RuleService) Rollback(_a0 uint64, _a1 uint64, _a2 string) (*domain.Rule, error) {
+	ret := _m.Called(_a0, _a1, _a2)
 
 	var r0 *domain.Rule
-	if rf, ok := ret.Get(0).(func(*domain.Rule) *domain.Rule); ok {
+	if rf, ok := ret.Get(0).(func(uint64, uint64, string) *domain.Rule); ok {
+		r0 = rf(_a0, _a1, _a2)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.Rule)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(uint64, uint64, string) error); ok {
+		r1 = rf(_a0, _a1, _a2)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// TemplateUsage provides a mock function with given fields: _a0
+func (_m *RuleService) TemplateUsage(_a0 string) (*domain.TemplateUsage, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *domain.TemplateUsage
+	if rf, ok := ret.Get(0).(func(string) *domain.TemplateUsage); ok {
 		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.TemplateUsage)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UpgradeTemplateVersion provides a mock function with given fields: _a0, _a1, _a2, _a3
+func (_m *RuleService) UpgradeTemplateVersion(_a0 string, _a1 uint, _a2 uint, _a3 string) ([]domain.Rule, error) {
+	ret := _m.Called(_a0, _a1, _a2, _a3)
+
+	var r0 []domain.Rule
+	if rf, ok := ret.Get(0).(func(string, uint, uint, string) []domain.Rule); ok {
+		r0 = rf(_a0, _a1, _a2, _a3)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.Rule)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string, uint, uint, string) error); ok {
+		r1 = rf(_a0, _a1, _a2, _a3)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Upsert provides a mock function with given fields: _a0, _a1
+func (_m *RuleService) Upsert(_a0 *domain.Rule, _a1 string) (*domain.Rule, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *domain.Rule
+	if rf, ok := ret.Get(0).(func(*domain.Rule, string) *domain.Rule); ok {
+		r0 = rf(_a0, _a1)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*domain.Rule)
@@ -63,7 +261,30 @@ func (_m *RuleService) Upsert(_a0 *domain.Rule) (*domain.Rule, error) {
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(*domain.Rule) error); ok {
+	if rf, ok := ret.Get(1).(func(*domain.Rule, string) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UpsertGroup provides a mock function with given fields: _a0
+func (_m *RuleService) UpsertGroup(_a0 *domain.RuleGroup) (*domain.RuleGroup, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *domain.RuleGroup
+	if rf, ok := ret.Get(0).(func(*domain.RuleGroup) *domain.RuleGroup); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.RuleGroup)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*domain.RuleGroup) error); ok {
 		r1 = rf(_a0)
 	} else {
 		r1 = ret.Error(1)
@@ -12,8 +12,31 @@ type TemplatesService struct {
 	mock.Mock
 }
 
-// Delete provides a mock function with given fields: _a0
-func (_m *TemplatesService) Delete(_a0 string) error {
+// Delete provides a mock function with given fields: _a0, _a1
+func (_m *TemplatesService) Delete(_a0 string, _a1 bool) ([]domain.Rule, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 []domain.Rule
+	if rf, ok := ret.Get(0).(func(string, bool) []domain.Rule); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.Rule)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string, bool) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DeletePartial provides a mock function with given fields: _a0
+func (_m *TemplatesService) DeletePartial(_a0 string) error {
 	ret := _m.Called(_a0)
 
 	var r0 error
@@ -49,6 +72,29 @@ func (_m *TemplatesService) GetByName(_a0 string) (*domain.Template, error) {
 	return r0, r1
 }
 
+// GetVersion provides a mock function with given fields: _a0, _a1
+func (_m *TemplatesService) GetVersion(_a0 string, _a1 uint) (*domain.Template, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *domain.Template
+	if rf, ok := ret.Get(0).(func(string, uint) *domain.Template); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.Template)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string, uint) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // Index provides a mock function with given fields: _a0
 func (_m *TemplatesService) Index(_a0 string) ([]domain.Template, error) {
 	ret := _m.Called(_a0)
@@ -72,6 +118,75 @@ func (_m *TemplatesService) Index(_a0 string) ([]domain.Template, error) {
 	return r0, r1
 }
 
+// List provides a mock function with given fields: _a0
+func (_m *TemplatesService) List(_a0 *domain.TemplateFilter) (*domain.TemplatePage, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *domain.TemplatePage
+	if rf, ok := ret.Get(0).(func(*domain.TemplateFilter) *domain.TemplatePage); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.TemplatePage)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*domain.TemplateFilter) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListPartials provides a mock function with given fields:
+func (_m *TemplatesService) ListPartials() ([]domain.Partial, error) {
+	ret := _m.Called()
+
+	var r0 []domain.Partial
+	if rf, ok := ret.Get(0).(func() []domain.Partial); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.Partial)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListVersions provides a mock function with given fields: _a0
+func (_m *TemplatesService) ListVersions(_a0 string) ([]domain.Template, error) {
+	ret := _m.Called(_a0)
+
+	var r0 []domain.Template
+	if rf, ok := ret.Get(0).(func(string) []domain.Template); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.Template)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // Migrate provides a mock function with given fields:
 func (_m *TemplatesService) Migrate() error {
 	ret := _m.Called()
@@ -107,21 +222,53 @@ func (_m *TemplatesService) Render(_a0 string, _a1 map[string]string) (string, e
 	return r0, r1
 }
 
-// Upsert provides a mock function with given fields: _a0
-func (_m *TemplatesService) Upsert(_a0 *domain.Template) (*domain.Template, error) {
-	ret := _m.Called(_a0)
+// Upsert provides a mock function with given fields: _a0, _a1
+func (_m *TemplatesService) Upsert(_a0 *domain.Template, _a1 bool) (*domain.Template, []domain.RuleGroupSync, error) {
+	ret := _m.Called(_a0, _a1)
 
 	var r0 *domain.Template
-	if rf, ok := ret.Get(0).(func(*domain.Template) *domain.Template); ok {
-		r0 = rf(_a0)
+	if rf, ok := ret.Get(0).(func(*domain.Template, bool) *domain.Template); ok {
+		r0 = rf(_a0, _a1)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*domain.Template)
 		}
 	}
 
+	var r1 []domain.RuleGroupSync
+	if rf, ok := ret.Get(1).(func(*domain.Template, bool) []domain.RuleGroupSync); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).([]domain.RuleGroupSync)
+		}
+	}
+
+	var r2 error
+	if rf, ok := ret.Get(2).(func(*domain.Template, bool) error); ok {
+		r2 = rf(_a0, _a1)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// UpsertPartial provides a mock function with given fields: _a0
+func (_m *TemplatesService) UpsertPartial(_a0 *domain.Partial) (*domain.Partial, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *domain.Partial
+	if rf, ok := ret.Get(0).(func(*domain.Partial) *domain.Partial); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.Partial)
+		}
+	}
+
 	var r1 error
-	if rf, ok := ret.Get(1).(func(*domain.Template) error); ok {
+	if rf, ok := ret.Get(1).(func(*domain.Partial) error); ok {
 		r1 = rf(_a0)
 	} else {
 		r1 = ret.Error(1)
@@ -0,0 +1,96 @@
+package adapter
+
+import (
+	"context"
+	"sort"
+	"sync"
+
+	"github.com/odpf/siren/domain"
+	"github.com/odpf/siren/pkg/subscription/alertmanager"
+	"github.com/prometheus/prometheus/pkg/rulefmt"
+)
+
+// Adapter talks to a monitoring provider on behalf of its tenants. A rule group
+// or tenant that does not exist in the provider is not an error.
+type Adapter interface {
+	// UpsertRuleGroup creates or replaces a rule group in a namespace of a tenant
+	UpsertRuleGroup(ctx context.Context, tenant, namespace string, ruleGroup RuleGroup) error
+	// DeleteRuleGroup deletes a rule group from a namespace of a tenant
+	DeleteRuleGroup(ctx context.Context, tenant, namespace, groupName string) error
+	// GetRuleGroup returns a rule group of a namespace of a tenant, or nil if it does not exist
+	GetRuleGroup(ctx context.Context, tenant, namespace, groupName string) (*rulefmt.RuleGroup, error)
+	// ListRuleGroups returns all rule groups of a tenant by namespace
+	ListRuleGroups(ctx context.Context, tenant string) (map[string][]rulefmt.RuleGroup, error)
+	// SyncAlertmanagerConfig replaces the alertmanager config of a tenant
+	SyncAlertmanagerConfig(ctx context.Context, tenant string, config alertmanager.AMConfig) error
+}
+
+// RuleGroup is a rule group with the settings that rulefmt does not know of.
+// Limit is the maximum number of alerts or series a rule of the group may
+// produce, and SourceTenants are the tenants a federated rule group queries.
+type RuleGroup struct {
+	rulefmt.RuleGroup `yaml:",inline"`
+	Limit             int      `yaml:"limit,omitempty"`
+	SourceTenants     []string `yaml:"source_tenants,omitempty"`
+}
+
+// RuleValidator is implemented by adapters of providers whose rule expressions
+// are not PromQL. Rules of other providers are validated with rulefmt.
+type RuleValidator interface {
+	// ValidateRuleNode returns the problems of a rendered rule
+	ValidateRuleNode(ruleNode rulefmt.RuleNode) []error
+}
+
+// SilenceManager is implemented by adapters of providers whose alertmanager
+// silences can be managed per tenant. A silence that does not exist is not an error.
+type SilenceManager interface {
+	// CreateSilence creates a silence for a tenant and returns its id
+	CreateSilence(ctx context.Context, tenant string, silence domain.Silence) (string, error)
+	// ListSilences returns all silences of a tenant, including the expired ones
+	ListSilences(ctx context.Context, tenant string) ([]domain.Silence, error)
+	// GetSilence returns a silence of a tenant, or nil if it does not exist
+	GetSilence(ctx context.Context, tenant, id string) (*domain.Silence, error)
+	// ExpireSilence ends a silence of a tenant now
+	ExpireSilence(ctx context.Context, tenant, id string) error
+}
+
+// Factory creates an adapter for the provider with the given urn at the given host
+type Factory func(providerUrn, host string) (Adapter, error)
+
+// Registry holds the adapter factories keyed by provider type
+type Registry struct {
+	mu        sync.RWMutex
+	factories map[string]Factory
+}
+
+// NewRegistry returns an empty registry
+func NewRegistry() *Registry {
+	return &Registry{factories: make(map[string]Factory)}
+}
+
+// Register sets the adapter factory of a provider type, replacing any previous one
+func (r *Registry) Register(providerType string, factory Factory) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.factories[providerType] = factory
+}
+
+// Get returns the adapter factory of a provider type
+func (r *Registry) Get(providerType string) (Factory, bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	factory, ok := r.factories[providerType]
+	return factory, ok
+}
+
+// Types returns the registered provider types in sorted order
+func (r *Registry) Types() []string {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	types := make([]string, 0, len(r.factories))
+	for providerType := range r.factories {
+		types = append(types, providerType)
+	}
+	sort.Strings(types)
+	return types
+}
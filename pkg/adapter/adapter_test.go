@@ -0,0 +1,31 @@
+package adapter
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRegistry(t *testing.T) {
+	t.Run("should return registered factories and types", func(t *testing.T) {
+		registry := NewRegistry()
+		mockAdapter := &AdapterMock{}
+		registry.Register("prometheus", func(string, string) (Adapter, error) { return nil, nil })
+		registry.Register("cortex", func(string, string) (Adapter, error) { return mockAdapter, nil })
+
+		factory, ok := registry.Get("cortex")
+		assert.True(t, ok)
+		a, err := factory("cortex-1", "http://localhost")
+		assert.Nil(t, err)
+		assert.Equal(t, mockAdapter, a)
+		assert.Equal(t, []string{"cortex", "prometheus"}, registry.Types())
+	})
+
+	t.Run("should return false for unknown provider types", func(t *testing.T) {
+		registry := NewRegistry()
+		factory, ok := registry.Get("cortex")
+		assert.False(t, ok)
+		assert.Nil(t, factory)
+		assert.Empty(t, registry.Types())
+	})
+}
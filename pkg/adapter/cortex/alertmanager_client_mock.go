@@ -1,19 +1,19 @@
 // Code generated by mockery 2.9.4. DO NOT EDIT.
 
-package subscription
+package cortex
 
 import (
 	alertmanager "github.com/odpf/siren/pkg/subscription/alertmanager"
 	mock "github.com/stretchr/testify/mock"
 )
 
-// Client is an autogenerated mock type for the Client type
-type ClientMock struct {
+// alertmanagerClientMock is an autogenerated mock type for the Client type
+type alertmanagerClientMock struct {
 	mock.Mock
 }
 
 // SyncConfig provides a mock function with given fields: _a0, _a1
-func (_m *ClientMock) SyncConfig(_a0 alertmanager.AMConfig, _a1 string) error {
+func (_m *alertmanagerClientMock) SyncConfig(_a0 alertmanager.AMConfig, _a1 string) error {
 	ret := _m.Called(_a0, _a1)
 
 	var r0 error
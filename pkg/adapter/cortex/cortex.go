@@ -0,0 +1,138 @@
+package cortex
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	cortexClient "github.com/grafana/cortex-tools/pkg/client"
+	"github.com/grafana/cortex-tools/pkg/rules/rwrulefmt"
+	"github.com/odpf/siren/domain"
+	"github.com/odpf/siren/pkg/adapter"
+	"github.com/odpf/siren/pkg/subscription/alertmanager"
+	"github.com/prometheus/prometheus/pkg/rulefmt"
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	// ProviderType is the provider type served by this adapter
+	ProviderType = "cortex"
+
+	notFoundError  = "requested resource not found"
+	rulesPath      = "/api/v1/rules"
+	tenantHeader   = "X-Scope-OrgID"
+	requestTimeout = 30 * time.Second
+)
+
+type cortexCaller interface {
+	DeleteRuleGroup(ctx context.Context, namespace, groupName string) error
+	GetRuleGroup(ctx context.Context, namespace, groupName string) (*rwrulefmt.RuleGroup, error)
+	ListRules(ctx context.Context, namespace string) (map[string][]rwrulefmt.RuleGroup, error)
+}
+
+// Adapter manages rule groups with the cortex ruler API and the
+// alertmanager config with the cortex alertmanager API
+type Adapter struct {
+	host       string
+	httpClient *http.Client
+	client     cortexCaller
+	amClient   alertmanager.Client
+}
+
+// NewFactory returns an adapter factory for cortex
+func NewFactory(httpClient *http.Client) adapter.Factory {
+	return func(_, host string) (adapter.Adapter, error) {
+		client, err := cortexClient.New(cortexClient.Config{
+			Address:         host,
+			UseLegacyRoutes: false,
+		})
+		if err != nil {
+			return nil, err
+		}
+		amClient, err := alertmanager.NewClient(domain.CortexConfig{Address: host})
+		if err != nil {
+			return nil, err
+		}
+		return &Adapter{
+			host:       strings.TrimSuffix(host, "/"),
+			httpClient: httpClient,
+			client:     client,
+			amClient:   amClient,
+		}, nil
+	}
+}
+
+// UpsertRuleGroup posts the rule group to the ruler API itself, since the
+// cortex client drops the group settings that rwrulefmt does not know of
+func (a *Adapter) UpsertRuleGroup(ctx context.Context, tenant, namespace string, ruleGroup adapter.RuleGroup) error {
+	body, err := yaml.Marshal(ruleGroup)
+	if err != nil {
+		return err
+	}
+	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	defer cancel()
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, a.host+rulesPath+"/"+url.PathEscape(namespace), bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set(tenantHeader, tenant)
+	req.Header.Set("Content-Type", "application/yaml")
+	res, err := a.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+	if res.StatusCode < 200 || res.StatusCode > 299 {
+		resBody, _ := ioutil.ReadAll(res.Body)
+		return fmt.Errorf("cortex ruler responded with status %d: %s", res.StatusCode, strings.TrimSpace(string(resBody)))
+	}
+	return nil
+}
+
+func (a *Adapter) DeleteRuleGroup(ctx context.Context, tenant, namespace, groupName string) error {
+	ctx = cortexClient.NewContextWithTenantId(ctx, tenant)
+	err := a.client.DeleteRuleGroup(ctx, namespace, groupName)
+	if err != nil && err.Error() != notFoundError {
+		return err
+	}
+	return nil
+}
+
+func (a *Adapter) GetRuleGroup(ctx context.Context, tenant, namespace, groupName string) (*rulefmt.RuleGroup, error) {
+	ctx = cortexClient.NewContextWithTenantId(ctx, tenant)
+	ruleGroup, err := a.client.GetRuleGroup(ctx, namespace, groupName)
+	if err != nil {
+		if err.Error() == notFoundError {
+			return nil, nil
+		}
+		return nil, err
+	}
+	if ruleGroup == nil {
+		return nil, nil
+	}
+	return &ruleGroup.RuleGroup, nil
+}
+
+func (a *Adapter) ListRuleGroups(ctx context.Context, tenant string) (map[string][]rulefmt.RuleGroup, error) {
+	ctx = cortexClient.NewContextWithTenantId(ctx, tenant)
+	ruleGroups, err := a.client.ListRules(ctx, "")
+	if err != nil && err.Error() != notFoundError {
+		return nil, err
+	}
+	result := make(map[string][]rulefmt.RuleGroup)
+	for namespace, groups := range ruleGroups {
+		for _, group := range groups {
+			result[namespace] = append(result[namespace], group.RuleGroup)
+		}
+	}
+	return result, nil
+}
+
+func (a *Adapter) SyncAlertmanagerConfig(_ context.Context, tenant string, config alertmanager.AMConfig) error {
+	return a.amClient.SyncConfig(config, tenant)
+}
@@ -1,6 +1,6 @@
 // Code generated by mockery v2.6.0. DO NOT EDIT.
 
-package rules
+package cortex
 
 import (
 	context "context"
@@ -15,20 +15,6 @@ type cortexCallerMock struct {
 	mock.Mock
 }
 
-// CreateRuleGroup provides a mock function with given fields: ctx, namespace, rg
-func (_m *cortexCallerMock) CreateRuleGroup(ctx context.Context, namespace string, rg rwrulefmt.RuleGroup) error {
-	ret := _m.Called(ctx, namespace, rg)
-
-	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, string, rwrulefmt.RuleGroup) error); ok {
-		r0 = rf(ctx, namespace, rg)
-	} else {
-		r0 = ret.Error(0)
-	}
-
-	return r0
-}
-
 // DeleteRuleGroup provides a mock function with given fields: ctx, namespace, groupName
 func (_m *cortexCallerMock) DeleteRuleGroup(ctx context.Context, namespace string, groupName string) error {
 	ret := _m.Called(ctx, namespace, groupName)
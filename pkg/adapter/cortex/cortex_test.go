@@ -0,0 +1,154 @@
+package cortex
+
+import (
+	"context"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/grafana/cortex-tools/pkg/rules/rwrulefmt"
+	"github.com/odpf/siren/pkg/adapter"
+	"github.com/odpf/siren/pkg/subscription/alertmanager"
+	"github.com/prometheus/common/model"
+	"github.com/prometheus/prometheus/pkg/rulefmt"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"gopkg.in/yaml.v3"
+)
+
+func TestAdapter_UpsertRuleGroup(t *testing.T) {
+	t.Run("should post rule group with its settings to the namespace of the tenant", func(t *testing.T) {
+		var method, path, tenant string
+		var body []byte
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			method, path, tenant = r.Method, r.URL.Path, r.Header.Get("X-Scope-OrgID")
+			body, _ = ioutil.ReadAll(r.Body)
+			w.WriteHeader(http.StatusAccepted)
+		}))
+		defer server.Close()
+		a := &Adapter{host: server.URL, httpClient: server.Client()}
+		ruleGroup := adapter.RuleGroup{
+			RuleGroup:     rulefmt.RuleGroup{Name: "bar", Interval: model.Duration(time.Minute), Rules: []rulefmt.RuleNode{}},
+			Limit:         10,
+			SourceTenants: []string{"tenant", "other-tenant"},
+		}
+
+		err := a.UpsertRuleGroup(context.Background(), "tenant", "foo", ruleGroup)
+		assert.Nil(t, err)
+		assert.Equal(t, http.MethodPost, method)
+		assert.Equal(t, "/api/v1/rules/foo", path)
+		assert.Equal(t, "tenant", tenant)
+		var postedRuleGroup adapter.RuleGroup
+		err = yaml.Unmarshal(body, &postedRuleGroup)
+		assert.Nil(t, err)
+		assert.Equal(t, ruleGroup, postedRuleGroup)
+	})
+
+	t.Run("should return error if ruler responds with an error", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusBadRequest)
+			_, _ = w.Write([]byte("invalid rule group"))
+		}))
+		defer server.Close()
+		a := &Adapter{host: server.URL, httpClient: server.Client()}
+
+		err := a.UpsertRuleGroup(context.Background(), "tenant", "foo", adapter.RuleGroup{RuleGroup: rulefmt.RuleGroup{Name: "bar"}})
+		assert.EqualError(t, err, "cortex ruler responded with status 400: invalid rule group")
+	})
+}
+
+func TestAdapter_DeleteRuleGroup(t *testing.T) {
+	t.Run("should ignore not found error", func(t *testing.T) {
+		mockClient := &cortexCallerMock{}
+		a := &Adapter{client: mockClient}
+		mockClient.On("DeleteRuleGroup", mock.Anything, "foo", "bar").
+			Return(errors.New("requested resource not found")).Once()
+		err := a.DeleteRuleGroup(context.Background(), "tenant", "foo", "bar")
+		assert.Nil(t, err)
+	})
+
+	t.Run("should return other errors", func(t *testing.T) {
+		mockClient := &cortexCallerMock{}
+		a := &Adapter{client: mockClient}
+		mockClient.On("DeleteRuleGroup", mock.Anything, "foo", "bar").Return(errors.New("random error")).Once()
+		err := a.DeleteRuleGroup(context.Background(), "tenant", "foo", "bar")
+		assert.EqualError(t, err, "random error")
+	})
+}
+
+func TestAdapter_GetRuleGroup(t *testing.T) {
+	t.Run("should return rule group", func(t *testing.T) {
+		mockClient := &cortexCallerMock{}
+		a := &Adapter{client: mockClient}
+		mockClient.On("GetRuleGroup", mock.Anything, "foo", "bar").
+			Return(&rwrulefmt.RuleGroup{RuleGroup: rulefmt.RuleGroup{Name: "bar"}}, nil).Once()
+		ruleGroup, err := a.GetRuleGroup(context.Background(), "tenant", "foo", "bar")
+		assert.Nil(t, err)
+		assert.Equal(t, &rulefmt.RuleGroup{Name: "bar"}, ruleGroup)
+	})
+
+	t.Run("should return nil if rule group does not exist", func(t *testing.T) {
+		mockClient := &cortexCallerMock{}
+		a := &Adapter{client: mockClient}
+		mockClient.On("GetRuleGroup", mock.Anything, "foo", "bar").
+			Return(nil, errors.New("requested resource not found")).Once()
+		ruleGroup, err := a.GetRuleGroup(context.Background(), "tenant", "foo", "bar")
+		assert.Nil(t, err)
+		assert.Nil(t, ruleGroup)
+	})
+
+	t.Run("should return other errors", func(t *testing.T) {
+		mockClient := &cortexCallerMock{}
+		a := &Adapter{client: mockClient}
+		mockClient.On("GetRuleGroup", mock.Anything, "foo", "bar").Return(nil, errors.New("random error")).Once()
+		ruleGroup, err := a.GetRuleGroup(context.Background(), "tenant", "foo", "bar")
+		assert.Nil(t, ruleGroup)
+		assert.EqualError(t, err, "random error")
+	})
+}
+
+func TestAdapter_ListRuleGroups(t *testing.T) {
+	t.Run("should return rule groups by namespace", func(t *testing.T) {
+		mockClient := &cortexCallerMock{}
+		a := &Adapter{client: mockClient}
+		mockClient.On("ListRules", mock.Anything, "").Return(map[string][]rwrulefmt.RuleGroup{
+			"foo": {{RuleGroup: rulefmt.RuleGroup{Name: "bar"}}, {RuleGroup: rulefmt.RuleGroup{Name: "baz"}}},
+		}, nil).Once()
+		ruleGroups, err := a.ListRuleGroups(context.Background(), "tenant")
+		assert.Nil(t, err)
+		assert.Equal(t, map[string][]rulefmt.RuleGroup{"foo": {{Name: "bar"}, {Name: "baz"}}}, ruleGroups)
+	})
+
+	t.Run("should return no rule groups if tenant does not exist", func(t *testing.T) {
+		mockClient := &cortexCallerMock{}
+		a := &Adapter{client: mockClient}
+		mockClient.On("ListRules", mock.Anything, "").Return(nil, errors.New("requested resource not found")).Once()
+		ruleGroups, err := a.ListRuleGroups(context.Background(), "tenant")
+		assert.Nil(t, err)
+		assert.Empty(t, ruleGroups)
+	})
+
+	t.Run("should return other errors", func(t *testing.T) {
+		mockClient := &cortexCallerMock{}
+		a := &Adapter{client: mockClient}
+		mockClient.On("ListRules", mock.Anything, "").Return(nil, errors.New("random error")).Once()
+		ruleGroups, err := a.ListRuleGroups(context.Background(), "tenant")
+		assert.Nil(t, ruleGroups)
+		assert.EqualError(t, err, "random error")
+	})
+}
+
+func TestAdapter_SyncAlertmanagerConfig(t *testing.T) {
+	t.Run("should sync config of the tenant", func(t *testing.T) {
+		amClientMock := &alertmanagerClientMock{}
+		a := &Adapter{amClient: amClientMock}
+		config := alertmanager.AMConfig{Receivers: []alertmanager.AMReceiverConfig{{Receiver: "foo"}}}
+		amClientMock.On("SyncConfig", config, "tenant").Return(nil).Once()
+		err := a.SyncAlertmanagerConfig(context.Background(), "tenant", config)
+		assert.Nil(t, err)
+		amClientMock.AssertExpectations(t)
+	})
+}
@@ -0,0 +1,166 @@
+package cortex
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/odpf/siren/domain"
+)
+
+const (
+	silencesPath = "/alertmanager/api/v2/silences"
+	silencePath  = "/alertmanager/api/v2/silence"
+)
+
+// amSilenceMatcher is a matcher of the alertmanager v2 API
+type amSilenceMatcher struct {
+	Name    string `json:"name"`
+	Value   string `json:"value"`
+	IsRegex bool   `json:"isRegex"`
+	IsEqual bool   `json:"isEqual"`
+}
+
+// amSilence is a silence of the alertmanager v2 API, with the status being
+// set only on the silences returned by alertmanager
+type amSilence struct {
+	ID        string             `json:"id,omitempty"`
+	Matchers  []amSilenceMatcher `json:"matchers"`
+	StartsAt  time.Time          `json:"startsAt"`
+	EndsAt    time.Time          `json:"endsAt"`
+	CreatedBy string             `json:"createdBy"`
+	Comment   string             `json:"comment"`
+	Status    *struct {
+		State string `json:"state"`
+	} `json:"status,omitempty"`
+}
+
+func fromDomainSilence(silence domain.Silence) amSilence {
+	s := amSilence{
+		Matchers:  make([]amSilenceMatcher, 0, len(silence.Matchers)),
+		StartsAt:  silence.StartsAt,
+		EndsAt:    silence.EndsAt,
+		CreatedBy: silence.CreatedBy,
+		Comment:   silence.Comment,
+	}
+	for _, matcher := range silence.Matchers {
+		s.Matchers = append(s.Matchers, amSilenceMatcher{
+			Name:    matcher.Name,
+			Value:   matcher.Value,
+			IsRegex: matcher.Operator == domain.MatchRegexp || matcher.Operator == domain.MatchNotRegexp,
+			IsEqual: matcher.Operator == domain.MatchEqual || matcher.Operator == domain.MatchRegexp,
+		})
+	}
+	return s
+}
+
+func (s amSilence) toDomain() domain.Silence {
+	silence := domain.Silence{
+		Id:        s.ID,
+		StartsAt:  s.StartsAt,
+		EndsAt:    s.EndsAt,
+		CreatedBy: s.CreatedBy,
+		Comment:   s.Comment,
+	}
+	if s.Status != nil {
+		silence.State = s.Status.State
+	}
+	for _, matcher := range s.Matchers {
+		operator := domain.MatchEqual
+		switch {
+		case matcher.IsRegex && matcher.IsEqual:
+			operator = domain.MatchRegexp
+		case matcher.IsRegex:
+			operator = domain.MatchNotRegexp
+		case !matcher.IsEqual:
+			operator = domain.MatchNotEqual
+		}
+		silence.Matchers = append(silence.Matchers, domain.Matcher{Name: matcher.Name, Operator: operator, Value: matcher.Value})
+	}
+	return silence
+}
+
+func (a *Adapter) CreateSilence(ctx context.Context, tenant string, silence domain.Silence) (string, error) {
+	body, err := json.Marshal(fromDomainSilence(silence))
+	if err != nil {
+		return "", err
+	}
+	var res struct {
+		SilenceID string `json:"silenceID"`
+	}
+	found, err := a.callAlertmanager(ctx, http.MethodPost, silencesPath, tenant, bytes.NewReader(body), &res)
+	if err != nil {
+		return "", err
+	}
+	if !found {
+		return "", fmt.Errorf("cortex alertmanager of tenant %s is not configured", tenant)
+	}
+	return res.SilenceID, nil
+}
+
+func (a *Adapter) ListSilences(ctx context.Context, tenant string) ([]domain.Silence, error) {
+	var res []amSilence
+	found, err := a.callAlertmanager(ctx, http.MethodGet, silencesPath, tenant, nil, &res)
+	if err != nil || !found {
+		return nil, err
+	}
+	silences := make([]domain.Silence, 0, len(res))
+	for _, silence := range res {
+		silences = append(silences, silence.toDomain())
+	}
+	return silences, nil
+}
+
+func (a *Adapter) GetSilence(ctx context.Context, tenant, id string) (*domain.Silence, error) {
+	var res amSilence
+	found, err := a.callAlertmanager(ctx, http.MethodGet, silencePath+"/"+url.PathEscape(id), tenant, nil, &res)
+	if err != nil || !found {
+		return nil, err
+	}
+	silence := res.toDomain()
+	return &silence, nil
+}
+
+func (a *Adapter) ExpireSilence(ctx context.Context, tenant, id string) error {
+	_, err := a.callAlertmanager(ctx, http.MethodDelete, silencePath+"/"+url.PathEscape(id), tenant, nil, nil)
+	return err
+}
+
+// callAlertmanager calls the alertmanager API of a tenant and decodes the
+// response into res unless it is nil, returning false if the tenant or the
+// requested resource does not exist
+func (a *Adapter) callAlertmanager(ctx context.Context, method, path, tenant string, body io.Reader, res interface{}) (bool, error) {
+	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	defer cancel()
+	req, err := http.NewRequestWithContext(ctx, method, a.host+path, body)
+	if err != nil {
+		return false, err
+	}
+	req.Header.Set(tenantHeader, tenant)
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	resp, err := a.httpClient.Do(req)
+	if err != nil {
+		return false, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode == http.StatusNotFound {
+		return false, nil
+	}
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		resBody, _ := ioutil.ReadAll(resp.Body)
+		return false, fmt.Errorf("cortex alertmanager responded with status %d: %s", resp.StatusCode, strings.TrimSpace(string(resBody)))
+	}
+	if res == nil {
+		return true, nil
+	}
+	return true, json.NewDecoder(resp.Body).Decode(res)
+}
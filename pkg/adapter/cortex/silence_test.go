@@ -0,0 +1,189 @@
+package cortex
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/odpf/siren/domain"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAdapter_CreateSilence(t *testing.T) {
+	startsAt := time.Date(2022, 1, 1, 10, 0, 0, 0, time.UTC)
+	silence := domain.Silence{
+		Matchers: []domain.Matcher{
+			{Name: "alertname", Operator: domain.MatchEqual, Value: "CPUHigh"},
+			{Name: "team", Operator: domain.MatchNotEqual, Value: "odpf"},
+			{Name: "cluster", Operator: domain.MatchRegexp, Value: "prod-.*"},
+			{Name: "env", Operator: domain.MatchNotRegexp, Value: "dev|test"},
+		},
+		StartsAt:  startsAt,
+		EndsAt:    startsAt.Add(time.Hour),
+		CreatedBy: "foo@odpf.io",
+		Comment:   "maintenance",
+	}
+
+	t.Run("should post silence to the alertmanager of the tenant", func(t *testing.T) {
+		var method, path, tenant string
+		var body []byte
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			method, path, tenant = r.Method, r.URL.Path, r.Header.Get("X-Scope-OrgID")
+			body, _ = ioutil.ReadAll(r.Body)
+			_, _ = w.Write([]byte(`{"silenceID":"bar"}`))
+		}))
+		defer server.Close()
+		a := &Adapter{host: server.URL, httpClient: server.Client()}
+
+		id, err := a.CreateSilence(context.Background(), "tenant", silence)
+		assert.Nil(t, err)
+		assert.Equal(t, "bar", id)
+		assert.Equal(t, http.MethodPost, method)
+		assert.Equal(t, "/alertmanager/api/v2/silences", path)
+		assert.Equal(t, "tenant", tenant)
+		var postedSilence amSilence
+		err = json.Unmarshal(body, &postedSilence)
+		assert.Nil(t, err)
+		assert.Equal(t, []amSilenceMatcher{
+			{Name: "alertname", Value: "CPUHigh", IsRegex: false, IsEqual: true},
+			{Name: "team", Value: "odpf", IsRegex: false, IsEqual: false},
+			{Name: "cluster", Value: "prod-.*", IsRegex: true, IsEqual: true},
+			{Name: "env", Value: "dev|test", IsRegex: true, IsEqual: false},
+		}, postedSilence.Matchers)
+		assert.Equal(t, "", postedSilence.ID)
+		assert.Equal(t, "foo@odpf.io", postedSilence.CreatedBy)
+		assert.True(t, startsAt.Equal(postedSilence.StartsAt))
+	})
+
+	t.Run("should return error if the alertmanager of the tenant is not configured", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusNotFound)
+		}))
+		defer server.Close()
+		a := &Adapter{host: server.URL, httpClient: server.Client()}
+
+		id, err := a.CreateSilence(context.Background(), "tenant", silence)
+		assert.EqualError(t, err, "cortex alertmanager of tenant tenant is not configured")
+		assert.Equal(t, "", id)
+	})
+
+	t.Run("should return error if alertmanager responds with an error", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusBadRequest)
+			_, _ = w.Write([]byte("silence invalid\n"))
+		}))
+		defer server.Close()
+		a := &Adapter{host: server.URL, httpClient: server.Client()}
+
+		_, err := a.CreateSilence(context.Background(), "tenant", silence)
+		assert.EqualError(t, err, "cortex alertmanager responded with status 400: silence invalid")
+	})
+}
+
+func TestAdapter_ListSilences(t *testing.T) {
+	t.Run("should return silences of the tenant", func(t *testing.T) {
+		var path, tenant string
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			path, tenant = r.URL.Path, r.Header.Get("X-Scope-OrgID")
+			_, _ = w.Write([]byte(`[{"id":"bar","matchers":[{"name":"cluster","value":"prod-.*","isRegex":true,"isEqual":true},` +
+				`{"name":"team","value":"odpf","isRegex":false,"isEqual":false}],"startsAt":"2022-01-01T10:00:00Z",` +
+				`"endsAt":"2022-01-01T11:00:00Z","createdBy":"foo@odpf.io","comment":"maintenance","status":{"state":"active"}}]`))
+		}))
+		defer server.Close()
+		a := &Adapter{host: server.URL, httpClient: server.Client()}
+
+		silences, err := a.ListSilences(context.Background(), "tenant")
+		assert.Nil(t, err)
+		assert.Equal(t, "/alertmanager/api/v2/silences", path)
+		assert.Equal(t, "tenant", tenant)
+		assert.Equal(t, []domain.Silence{{
+			Id: "bar",
+			Matchers: []domain.Matcher{
+				{Name: "cluster", Operator: domain.MatchRegexp, Value: "prod-.*"},
+				{Name: "team", Operator: domain.MatchNotEqual, Value: "odpf"},
+			},
+			StartsAt:  time.Date(2022, 1, 1, 10, 0, 0, 0, time.UTC),
+			EndsAt:    time.Date(2022, 1, 1, 11, 0, 0, 0, time.UTC),
+			CreatedBy: "foo@odpf.io",
+			Comment:   "maintenance",
+			State:     domain.SilenceStateActive,
+		}}, silences)
+	})
+
+	t.Run("should return nil if the alertmanager of the tenant is not configured", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusNotFound)
+		}))
+		defer server.Close()
+		a := &Adapter{host: server.URL, httpClient: server.Client()}
+
+		silences, err := a.ListSilences(context.Background(), "tenant")
+		assert.Nil(t, err)
+		assert.Nil(t, silences)
+	})
+}
+
+func TestAdapter_GetSilence(t *testing.T) {
+	t.Run("should return silence of the tenant", func(t *testing.T) {
+		var path string
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			path = r.URL.Path
+			_, _ = w.Write([]byte(`{"id":"bar","matchers":[{"name":"alertname","value":"CPUHigh","isRegex":false,"isEqual":true}],` +
+				`"createdBy":"foo@odpf.io","comment":"maintenance","status":{"state":"expired"}}`))
+		}))
+		defer server.Close()
+		a := &Adapter{host: server.URL, httpClient: server.Client()}
+
+		silence, err := a.GetSilence(context.Background(), "tenant", "bar")
+		assert.Nil(t, err)
+		assert.Equal(t, "/alertmanager/api/v2/silence/bar", path)
+		assert.Equal(t, "bar", silence.Id)
+		assert.Equal(t, []domain.Matcher{{Name: "alertname", Operator: domain.MatchEqual, Value: "CPUHigh"}}, silence.Matchers)
+		assert.Equal(t, domain.SilenceStateExpired, silence.State)
+	})
+
+	t.Run("should return nil if silence does not exist", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusNotFound)
+		}))
+		defer server.Close()
+		a := &Adapter{host: server.URL, httpClient: server.Client()}
+
+		silence, err := a.GetSilence(context.Background(), "tenant", "bar")
+		assert.Nil(t, err)
+		assert.Nil(t, silence)
+	})
+}
+
+func TestAdapter_ExpireSilence(t *testing.T) {
+	t.Run("should delete silence of the tenant", func(t *testing.T) {
+		var method, path, tenant string
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			method, path, tenant = r.Method, r.URL.Path, r.Header.Get("X-Scope-OrgID")
+		}))
+		defer server.Close()
+		a := &Adapter{host: server.URL, httpClient: server.Client()}
+
+		err := a.ExpireSilence(context.Background(), "tenant", "bar")
+		assert.Nil(t, err)
+		assert.Equal(t, http.MethodDelete, method)
+		assert.Equal(t, "/alertmanager/api/v2/silence/bar", path)
+		assert.Equal(t, "tenant", tenant)
+	})
+
+	t.Run("should return error if alertmanager responds with an error", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusInternalServerError)
+			_, _ = w.Write([]byte("failed to expire silence"))
+		}))
+		defer server.Close()
+		a := &Adapter{host: server.URL, httpClient: server.Client()}
+
+		err := a.ExpireSilence(context.Background(), "tenant", "bar")
+		assert.EqualError(t, err, "cortex alertmanager responded with status 500: failed to expire silence")
+	})
+}
@@ -0,0 +1,175 @@
+package loki
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/grafana/loki/pkg/logql"
+	"github.com/odpf/siren/pkg/adapter"
+	"github.com/odpf/siren/pkg/subscription/alertmanager"
+	"github.com/pkg/errors"
+	"github.com/prometheus/prometheus/pkg/rulefmt"
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	// ProviderType is the provider type served by this adapter
+	ProviderType = "loki"
+
+	rulesPath      = "/loki/api/v1/rules"
+	tenantHeader   = "X-Scope-OrgID"
+	requestTimeout = 30 * time.Second
+)
+
+var (
+	metricNamePattern = regexp.MustCompile(`^[a-zA-Z_:][a-zA-Z0-9_:]*$`)
+	labelNamePattern  = regexp.MustCompile(`^[a-zA-Z_][a-zA-Z0-9_]*$`)
+)
+
+// Adapter manages rule groups with the loki ruler API. The tenant is sent as
+// the X-Scope-OrgID header of every request.
+type Adapter struct {
+	host       string
+	httpClient *http.Client
+}
+
+// NewFactory returns an adapter factory for loki rulers
+func NewFactory(httpClient *http.Client) adapter.Factory {
+	return func(_, host string) (adapter.Adapter, error) {
+		return &Adapter{host: strings.TrimSuffix(host, "/"), httpClient: httpClient}, nil
+	}
+}
+
+func (a *Adapter) UpsertRuleGroup(ctx context.Context, tenant, namespace string, ruleGroup adapter.RuleGroup) error {
+	body, err := yaml.Marshal(ruleGroup)
+	if err != nil {
+		return err
+	}
+	_, err = a.do(ctx, tenant, http.MethodPost, rulesPath+"/"+url.PathEscape(namespace), body)
+	return err
+}
+
+func (a *Adapter) DeleteRuleGroup(ctx context.Context, tenant, namespace, groupName string) error {
+	_, err := a.do(ctx, tenant, http.MethodDelete, rulesPath+"/"+url.PathEscape(namespace)+"/"+url.PathEscape(groupName), nil)
+	return err
+}
+
+func (a *Adapter) GetRuleGroup(ctx context.Context, tenant, namespace, groupName string) (*rulefmt.RuleGroup, error) {
+	body, err := a.do(ctx, tenant, http.MethodGet, rulesPath+"/"+url.PathEscape(namespace)+"/"+url.PathEscape(groupName), nil)
+	if err != nil || body == nil {
+		return nil, err
+	}
+	ruleGroup := &rulefmt.RuleGroup{}
+	if err := yaml.Unmarshal(body, ruleGroup); err != nil {
+		return nil, errors.Wrap(err, "failed to parse rule group")
+	}
+	return ruleGroup, nil
+}
+
+func (a *Adapter) ListRuleGroups(ctx context.Context, tenant string) (map[string][]rulefmt.RuleGroup, error) {
+	body, err := a.do(ctx, tenant, http.MethodGet, rulesPath, nil)
+	if err != nil {
+		return nil, err
+	}
+	ruleGroups := make(map[string][]rulefmt.RuleGroup)
+	if body == nil {
+		return ruleGroups, nil
+	}
+	if err := yaml.Unmarshal(body, &ruleGroups); err != nil {
+		return nil, errors.Wrap(err, "failed to parse rule groups")
+	}
+	return ruleGroups, nil
+}
+
+func (a *Adapter) SyncAlertmanagerConfig(_ context.Context, _ string, _ alertmanager.AMConfig) error {
+	return errors.New("alertmanager config sync is not supported by loki provider")
+}
+
+// ValidateRuleNode applies the checks of rulefmt to a rule, but parses its
+// expression as a LogQL metric query
+func (a *Adapter) ValidateRuleNode(ruleNode rulefmt.RuleNode) []error {
+	var errs []error
+	if ruleNode.Record.Value != "" && ruleNode.Alert.Value != "" {
+		errs = append(errs, errors.New("only one of 'record' and 'alert' must be set"))
+	}
+	if ruleNode.Record.Value == "" && ruleNode.Alert.Value == "" {
+		errs = append(errs, errors.New("one of 'record' or 'alert' must be set"))
+	}
+	if ruleNode.Expr.Value == "" {
+		errs = append(errs, errors.New("field 'expr' must be set in rule"))
+	} else if expr, err := logql.ParseExpr(ruleNode.Expr.Value); err != nil {
+		errs = append(errs, errors.Wrap(err, "could not parse expression"))
+	} else if _, ok := expr.(logql.SampleExpr); !ok {
+		errs = append(errs, errors.New("expression must be a LogQL metric query"))
+	}
+	if ruleNode.Record.Value != "" {
+		if len(ruleNode.Annotations) > 0 {
+			errs = append(errs, errors.New("invalid field 'annotations' in recording rule"))
+		}
+		if ruleNode.For != 0 {
+			errs = append(errs, errors.New("invalid field 'for' in recording rule"))
+		}
+		if !metricNamePattern.MatchString(ruleNode.Record.Value) {
+			errs = append(errs, fmt.Errorf("invalid recording rule name: %s", ruleNode.Record.Value))
+		}
+	}
+	for _, name := range sortedKeys(ruleNode.Labels) {
+		if !labelNamePattern.MatchString(name) {
+			errs = append(errs, fmt.Errorf("invalid label name: %s", name))
+		}
+	}
+	for _, name := range sortedKeys(ruleNode.Annotations) {
+		if !labelNamePattern.MatchString(name) {
+			errs = append(errs, fmt.Errorf("invalid annotation name: %s", name))
+		}
+	}
+	return errs
+}
+
+// do sends a request to the ruler API and returns the response body, or nil
+// if the rule group or namespace to read or delete does not exist
+func (a *Adapter) do(ctx context.Context, tenant, method, path string, body []byte) ([]byte, error) {
+	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	defer cancel()
+	req, err := http.NewRequestWithContext(ctx, method, a.host+path, bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set(tenantHeader, tenant)
+	if body != nil {
+		req.Header.Set("Content-Type", "application/yaml")
+	}
+	res, err := a.httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+	resBody, err := ioutil.ReadAll(res.Body)
+	if err != nil {
+		return nil, err
+	}
+	if res.StatusCode == http.StatusNotFound && method != http.MethodPost {
+		return nil, nil
+	}
+	if res.StatusCode < 200 || res.StatusCode > 299 {
+		return nil, fmt.Errorf("loki ruler responded with status %d: %s", res.StatusCode, strings.TrimSpace(string(resBody)))
+	}
+	return resBody, nil
+}
+
+func sortedKeys(m map[string]string) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,181 @@
+package loki
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/odpf/siren/pkg/adapter"
+	"github.com/prometheus/prometheus/pkg/rulefmt"
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/yaml.v3"
+)
+
+type rulerRequest struct {
+	method string
+	path   string
+	tenant string
+	body   string
+}
+
+func newRulerStub(t *testing.T, status int, body string) (*Adapter, *[]rulerRequest) {
+	var requests []rulerRequest
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		reqBody, _ := ioutil.ReadAll(r.Body)
+		requests = append(requests, rulerRequest{
+			method: r.Method,
+			path:   r.URL.EscapedPath(),
+			tenant: r.Header.Get("X-Scope-OrgID"),
+			body:   string(reqBody),
+		})
+		w.WriteHeader(status)
+		_, _ = w.Write([]byte(body))
+	}))
+	t.Cleanup(server.Close)
+	a, err := NewFactory(server.Client())("loki-1", server.URL)
+	assert.Nil(t, err)
+	return a.(*Adapter), &requests
+}
+
+func newRuleNode(t *testing.T, body string) rulefmt.RuleNode {
+	var ruleNode rulefmt.RuleNode
+	err := yaml.Unmarshal([]byte(body), &ruleNode)
+	assert.Nil(t, err)
+	return ruleNode
+}
+
+const errorRule = `
+alert: HighErrorRate
+expr: sum by (app) (rate({app="foo"} |= "error" [5m])) > 10
+for: 5m
+labels:
+  severity: WARNING
+`
+
+func TestAdapter_UpsertRuleGroup(t *testing.T) {
+	t.Run("should post rule group to the namespace of the tenant", func(t *testing.T) {
+		a, requests := newRulerStub(t, http.StatusAccepted, "")
+		ruleGroup := adapter.RuleGroup{
+			RuleGroup: rulefmt.RuleGroup{Name: "errors", Rules: []rulefmt.RuleNode{newRuleNode(t, errorRule)}},
+			Limit:     10,
+		}
+
+		err := a.UpsertRuleGroup(context.Background(), "tenant", "foo", ruleGroup)
+		assert.Nil(t, err)
+		assert.Len(t, *requests, 1)
+		req := (*requests)[0]
+		assert.Equal(t, http.MethodPost, req.method)
+		assert.Equal(t, "/loki/api/v1/rules/foo", req.path)
+		assert.Equal(t, "tenant", req.tenant)
+		var postedRuleGroup rulefmt.RuleGroup
+		err = yaml.Unmarshal([]byte(req.body), &postedRuleGroup)
+		assert.Nil(t, err)
+		assert.Equal(t, "errors", postedRuleGroup.Name)
+		assert.Equal(t, "HighErrorRate", postedRuleGroup.Rules[0].Alert.Value)
+		assert.Contains(t, req.body, "limit: 10")
+	})
+
+	t.Run("should return error if ruler responds with an error", func(t *testing.T) {
+		a, _ := newRulerStub(t, http.StatusBadRequest, "invalid rule group")
+
+		err := a.UpsertRuleGroup(context.Background(), "tenant", "foo", adapter.RuleGroup{RuleGroup: rulefmt.RuleGroup{Name: "errors"}})
+		assert.EqualError(t, err, "loki ruler responded with status 400: invalid rule group")
+	})
+}
+
+func TestAdapter_DeleteRuleGroup(t *testing.T) {
+	t.Run("should delete rule group", func(t *testing.T) {
+		a, requests := newRulerStub(t, http.StatusAccepted, "")
+
+		err := a.DeleteRuleGroup(context.Background(), "tenant", "foo", "high errors")
+		assert.Nil(t, err)
+		assert.Equal(t, rulerRequest{
+			method: http.MethodDelete,
+			path:   "/loki/api/v1/rules/foo/high%20errors",
+			tenant: "tenant",
+		}, (*requests)[0])
+	})
+
+	t.Run("should ignore rule group that does not exist", func(t *testing.T) {
+		a, _ := newRulerStub(t, http.StatusNotFound, "group does not exist")
+
+		err := a.DeleteRuleGroup(context.Background(), "tenant", "foo", "errors")
+		assert.Nil(t, err)
+	})
+}
+
+func TestAdapter_GetRuleGroup(t *testing.T) {
+	t.Run("should return rule group", func(t *testing.T) {
+		a, _ := newRulerStub(t, http.StatusOK, "name: errors\nrules:\n  - alert: HighErrorRate\n    expr: 'sum(rate({app=\"foo\"}[5m])) > 10'\n")
+
+		ruleGroup, err := a.GetRuleGroup(context.Background(), "tenant", "foo", "errors")
+		assert.Nil(t, err)
+		assert.Equal(t, "errors", ruleGroup.Name)
+		assert.Equal(t, "HighErrorRate", ruleGroup.Rules[0].Alert.Value)
+	})
+
+	t.Run("should return nil if rule group does not exist", func(t *testing.T) {
+		a, _ := newRulerStub(t, http.StatusNotFound, "group does not exist")
+
+		ruleGroup, err := a.GetRuleGroup(context.Background(), "tenant", "foo", "errors")
+		assert.Nil(t, err)
+		assert.Nil(t, ruleGroup)
+	})
+}
+
+func TestAdapter_ListRuleGroups(t *testing.T) {
+	t.Run("should return rule groups by namespace", func(t *testing.T) {
+		a, requests := newRulerStub(t, http.StatusOK, "foo:\n  - name: errors\n    rules: []\nbar:\n  - name: latency\n    rules: []\n")
+
+		ruleGroups, err := a.ListRuleGroups(context.Background(), "tenant")
+		assert.Nil(t, err)
+		assert.Equal(t, "/loki/api/v1/rules", (*requests)[0].path)
+		assert.Equal(t, "tenant", (*requests)[0].tenant)
+		assert.Equal(t, "errors", ruleGroups["foo"][0].Name)
+		assert.Equal(t, "latency", ruleGroups["bar"][0].Name)
+	})
+
+	t.Run("should return no rule groups if tenant has none", func(t *testing.T) {
+		a, _ := newRulerStub(t, http.StatusNotFound, "no rule groups found")
+
+		ruleGroups, err := a.ListRuleGroups(context.Background(), "tenant")
+		assert.Nil(t, err)
+		assert.Empty(t, ruleGroups)
+	})
+}
+
+func TestAdapter_ValidateRuleNode(t *testing.T) {
+	a := &Adapter{}
+
+	t.Run("should accept LogQL metric queries", func(t *testing.T) {
+		errs := a.ValidateRuleNode(newRuleNode(t, errorRule))
+		assert.Empty(t, errs)
+	})
+
+	t.Run("should reject expressions that are not LogQL", func(t *testing.T) {
+		errs := a.ValidateRuleNode(newRuleNode(t, "alert: Test\nexpr: sum(rate({app=\"foo\"}[5m]) > 10\n"))
+		assert.Len(t, errs, 1)
+		assert.Contains(t, errs[0].Error(), "could not parse expression")
+	})
+
+	t.Run("should reject log queries", func(t *testing.T) {
+		errs := a.ValidateRuleNode(newRuleNode(t, "alert: Test\nexpr: '{app=\"foo\"} |= \"error\"'\n"))
+		assert.Len(t, errs, 1)
+		assert.EqualError(t, errs[0], "expression must be a LogQL metric query")
+	})
+
+	t.Run("should reject invalid recording rules", func(t *testing.T) {
+		errs := a.ValidateRuleNode(newRuleNode(t, "record: 'app errors'\nexpr: 'sum(rate({app=\"foo\"}[5m]))'\nfor: 5m\nlabels:\n  invalid-label: foo\n"))
+		var reasons []string
+		for _, err := range errs {
+			reasons = append(reasons, err.Error())
+		}
+		assert.Equal(t, []string{
+			"invalid field 'for' in recording rule",
+			"invalid recording rule name: app errors",
+			"invalid label name: invalid-label",
+		}, reasons)
+	})
+}
@@ -0,0 +1,269 @@
+package prometheus
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/odpf/siren/domain"
+	"github.com/odpf/siren/pkg/adapter"
+	"github.com/odpf/siren/pkg/subscription/alertmanager"
+	"github.com/pkg/errors"
+	"github.com/prometheus/prometheus/pkg/rulefmt"
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	// ProviderType is the provider type of plain prometheus servers
+	ProviderType = "prometheus"
+	// VMAlertProviderType is the provider type of vmalert servers, which read the same rule files
+	VMAlertProviderType = "vmalert"
+
+	ruleFileExtension = ".yaml"
+	reloadTimeout     = 30 * time.Second
+)
+
+// Adapter keeps the rule groups of every tenant as rule files under
+// <rules_dir>/<provider>/<tenant>/<namespace>.yaml and asks the server to
+// reload them after every change. Tenants are only unique per provider.
+type Adapter struct {
+	host       string
+	rulesDir   string
+	provider   string
+	httpClient *http.Client
+	mu         *sync.Mutex
+}
+
+// NewFactory returns an adapter factory writing rule files into the configured
+// rules directory. The adapters created by it share a lock, so that concurrent
+// changes to the same rule file are not lost.
+func NewFactory(config domain.PrometheusConfig, httpClient *http.Client) adapter.Factory {
+	mu := &sync.Mutex{}
+	return func(providerUrn, host string) (adapter.Adapter, error) {
+		if config.RulesDir == "" {
+			return nil, errors.New("prometheus rules directory is not configured")
+		}
+		if err := validatePathElement("provider", providerUrn); err != nil {
+			return nil, err
+		}
+		return &Adapter{
+			host:       strings.TrimSuffix(host, "/"),
+			rulesDir:   config.RulesDir,
+			provider:   providerUrn,
+			httpClient: httpClient,
+			mu:         mu,
+		}, nil
+	}
+}
+
+func (a *Adapter) UpsertRuleGroup(ctx context.Context, tenant, namespace string, ruleGroup adapter.RuleGroup) error {
+	if len(ruleGroup.SourceTenants) != 0 {
+		return errors.New("source tenants are not supported by prometheus provider")
+	}
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	path, err := a.ruleFilePath(tenant, namespace)
+	if err != nil {
+		return err
+	}
+	ruleGroups, err := readRuleFile(path)
+	if err != nil {
+		return err
+	}
+	replaced := false
+	for i := range ruleGroups.Groups {
+		if ruleGroups.Groups[i].Name == ruleGroup.Name {
+			ruleGroups.Groups[i] = ruleGroup
+			replaced = true
+		}
+	}
+	if !replaced {
+		ruleGroups.Groups = append(ruleGroups.Groups, ruleGroup)
+	}
+	if err := writeRuleFile(path, ruleGroups); err != nil {
+		return err
+	}
+	return a.reload(ctx)
+}
+
+func (a *Adapter) DeleteRuleGroup(ctx context.Context, tenant, namespace, groupName string) error {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	path, err := a.ruleFilePath(tenant, namespace)
+	if err != nil {
+		return err
+	}
+	ruleGroups, err := readRuleFile(path)
+	if err != nil {
+		return err
+	}
+	groups := make([]adapter.RuleGroup, 0, len(ruleGroups.Groups))
+	for _, group := range ruleGroups.Groups {
+		if group.Name != groupName {
+			groups = append(groups, group)
+		}
+	}
+	if len(groups) == len(ruleGroups.Groups) {
+		return nil
+	}
+	if len(groups) == 0 {
+		if err := os.Remove(path); err != nil {
+			return err
+		}
+	} else {
+		ruleGroups.Groups = groups
+		if err := writeRuleFile(path, ruleGroups); err != nil {
+			return err
+		}
+	}
+	return a.reload(ctx)
+}
+
+func (a *Adapter) GetRuleGroup(_ context.Context, tenant, namespace, groupName string) (*rulefmt.RuleGroup, error) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	path, err := a.ruleFilePath(tenant, namespace)
+	if err != nil {
+		return nil, err
+	}
+	ruleGroups, err := readRuleFile(path)
+	if err != nil {
+		return nil, err
+	}
+	for _, group := range ruleGroups.Groups {
+		if group.Name == groupName {
+			ruleGroup := group.RuleGroup
+			return &ruleGroup, nil
+		}
+	}
+	return nil, nil
+}
+
+func (a *Adapter) ListRuleGroups(_ context.Context, tenant string) (map[string][]rulefmt.RuleGroup, error) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	if err := validatePathElement("tenant", tenant); err != nil {
+		return nil, err
+	}
+	result := make(map[string][]rulefmt.RuleGroup)
+	paths, err := filepath.Glob(filepath.Join(a.rulesDir, a.provider, tenant, "*"+ruleFileExtension))
+	if err != nil {
+		return nil, err
+	}
+	for _, path := range paths {
+		ruleGroups, err := readRuleFile(path)
+		if err != nil {
+			return nil, err
+		}
+		if len(ruleGroups.Groups) == 0 {
+			continue
+		}
+		namespace := strings.TrimSuffix(filepath.Base(path), ruleFileExtension)
+		for _, group := range ruleGroups.Groups {
+			result[namespace] = append(result[namespace], group.RuleGroup)
+		}
+	}
+	return result, nil
+}
+
+func (a *Adapter) SyncAlertmanagerConfig(_ context.Context, _ string, _ alertmanager.AMConfig) error {
+	return errors.New("alertmanager config sync is not supported by prometheus provider")
+}
+
+func (a *Adapter) ruleFilePath(tenant, namespace string) (string, error) {
+	if err := validatePathElement("tenant", tenant); err != nil {
+		return "", err
+	}
+	if err := validatePathElement("namespace", namespace); err != nil {
+		return "", err
+	}
+	return filepath.Join(a.rulesDir, a.provider, tenant, namespace+ruleFileExtension), nil
+}
+
+// reload asks the server to reload its rule files. Prometheus only serves
+// this endpoint with --web.enable-lifecycle.
+func (a *Adapter) reload(ctx context.Context) error {
+	ctx, cancel := context.WithTimeout(ctx, reloadTimeout)
+	defer cancel()
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, a.host+"/-/reload", nil)
+	if err != nil {
+		return err
+	}
+	res, err := a.httpClient.Do(req)
+	if err != nil {
+		return errors.Wrap(err, "failed to reload rules")
+	}
+	defer res.Body.Close()
+	if res.StatusCode < 200 || res.StatusCode > 299 {
+		body, _ := ioutil.ReadAll(res.Body)
+		return fmt.Errorf("failed to reload rules: status %d: %s", res.StatusCode, strings.TrimSpace(string(body)))
+	}
+	return nil
+}
+
+func validatePathElement(kind, name string) error {
+	if name == "" || name == "." || name == ".." || strings.ContainsAny(name, `/\`) {
+		return fmt.Errorf("invalid %s name for a rule file: %q", kind, name)
+	}
+	return nil
+}
+
+// ruleFile is the content of a rule file. Its groups keep the settings that
+// rulefmt.RuleGroups would drop.
+type ruleFile struct {
+	Groups []adapter.RuleGroup `yaml:"groups"`
+}
+
+func readRuleFile(path string) (*ruleFile, error) {
+	ruleGroups := &ruleFile{}
+	content, err := ioutil.ReadFile(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return ruleGroups, nil
+		}
+		return nil, err
+	}
+	if err := yaml.Unmarshal(content, ruleGroups); err != nil {
+		return nil, errors.Wrapf(err, "failed to parse rule file %s", path)
+	}
+	return ruleGroups, nil
+}
+
+// writeRuleFile replaces the rule file with a rename, so that the server
+// never reads a partially written file
+func writeRuleFile(path string, ruleGroups *ruleFile) error {
+	content, err := yaml.Marshal(ruleGroups)
+	if err != nil {
+		return err
+	}
+	dir := filepath.Dir(path)
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return err
+	}
+	file, err := ioutil.TempFile(dir, "."+filepath.Base(path)+".*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(file.Name())
+	if _, err := file.Write(content); err != nil {
+		file.Close()
+		return err
+	}
+	if err := file.Sync(); err != nil {
+		file.Close()
+		return err
+	}
+	if err := file.Close(); err != nil {
+		return err
+	}
+	if err := os.Chmod(file.Name(), 0644); err != nil {
+		return err
+	}
+	return os.Rename(file.Name(), path)
+}
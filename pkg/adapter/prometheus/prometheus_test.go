@@ -0,0 +1,264 @@
+package prometheus
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/odpf/siren/domain"
+	"github.com/odpf/siren/pkg/adapter"
+	"github.com/prometheus/common/model"
+	"github.com/prometheus/prometheus/pkg/rulefmt"
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/yaml.v3"
+)
+
+type reloadStub struct {
+	server  *httptest.Server
+	reloads int
+	status  int
+}
+
+func newReloadStub(t *testing.T) *reloadStub {
+	stub := &reloadStub{status: http.StatusOK}
+	stub.server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.URL.Path != "/-/reload" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		stub.reloads++
+		w.WriteHeader(stub.status)
+		_, _ = w.Write([]byte("reload failed"))
+	}))
+	t.Cleanup(stub.server.Close)
+	return stub
+}
+
+func newTestAdapter(t *testing.T, stub *reloadStub) (adapter.Adapter, string) {
+	rulesDir := t.TempDir()
+	a, err := NewFactory(domain.PrometheusConfig{RulesDir: rulesDir}, stub.server.Client())("prometheus-1", stub.server.URL+"/")
+	assert.Nil(t, err)
+	return a, rulesDir
+}
+
+func newRuleGroup(t *testing.T, name, body string) adapter.RuleGroup {
+	var ruleNodes []rulefmt.RuleNode
+	err := yaml.Unmarshal([]byte(body), &ruleNodes)
+	assert.Nil(t, err)
+	return adapter.RuleGroup{RuleGroup: rulefmt.RuleGroup{Name: name, Rules: ruleNodes}}
+}
+
+const cpuRules = `
+- alert: CPUHigh
+  expr: avg by (host) (cpu_usage_user{cpu="cpu-total"}) > 80
+  for: 5m
+  labels:
+    severity: WARNING
+`
+
+const memoryRules = `
+- alert: MemoryHigh
+  expr: mem_used_percent > 90
+`
+
+func TestAdapter_UpsertRuleGroup(t *testing.T) {
+	t.Run("should write rule group in the rule file of the namespace and reload", func(t *testing.T) {
+		stub := newReloadStub(t)
+		a, rulesDir := newTestAdapter(t, stub)
+
+		err := a.UpsertRuleGroup(context.Background(), "tenant", "foo", newRuleGroup(t, "cpu", cpuRules))
+		assert.Nil(t, err)
+		err = a.UpsertRuleGroup(context.Background(), "tenant", "foo", newRuleGroup(t, "memory", memoryRules))
+		assert.Nil(t, err)
+		assert.Equal(t, 2, stub.reloads)
+
+		ruleGroups, errs := rulefmt.ParseFile(filepath.Join(rulesDir, "prometheus-1", "tenant", "foo.yaml"))
+		assert.Empty(t, errs)
+		assert.Len(t, ruleGroups.Groups, 2)
+		assert.Equal(t, "cpu", ruleGroups.Groups[0].Name)
+		assert.Equal(t, "CPUHigh", ruleGroups.Groups[0].Rules[0].Alert.Value)
+		assert.Equal(t, "memory", ruleGroups.Groups[1].Name)
+
+		files, err := ioutil.ReadDir(filepath.Join(rulesDir, "prometheus-1", "tenant"))
+		assert.Nil(t, err)
+		assert.Len(t, files, 1)
+	})
+
+	t.Run("should replace rule group with the same name", func(t *testing.T) {
+		stub := newReloadStub(t)
+		a, _ := newTestAdapter(t, stub)
+
+		err := a.UpsertRuleGroup(context.Background(), "tenant", "foo", newRuleGroup(t, "cpu", cpuRules))
+		assert.Nil(t, err)
+		err = a.UpsertRuleGroup(context.Background(), "tenant", "foo", newRuleGroup(t, "cpu", memoryRules))
+		assert.Nil(t, err)
+
+		ruleGroups, err := a.ListRuleGroups(context.Background(), "tenant")
+		assert.Nil(t, err)
+		assert.Len(t, ruleGroups["foo"], 1)
+		assert.Equal(t, "MemoryHigh", ruleGroups["foo"][0].Rules[0].Alert.Value)
+	})
+
+	t.Run("should write interval and limit of the rule group", func(t *testing.T) {
+		stub := newReloadStub(t)
+		a, rulesDir := newTestAdapter(t, stub)
+		ruleGroup := newRuleGroup(t, "cpu", cpuRules)
+		ruleGroup.Interval = model.Duration(2 * time.Minute)
+		ruleGroup.Limit = 10
+
+		err := a.UpsertRuleGroup(context.Background(), "tenant", "foo", ruleGroup)
+		assert.Nil(t, err)
+
+		content, err := ioutil.ReadFile(filepath.Join(rulesDir, "prometheus-1", "tenant", "foo.yaml"))
+		assert.Nil(t, err)
+		assert.Contains(t, string(content), "interval: 2m")
+		assert.Contains(t, string(content), "limit: 10")
+		storedRuleGroup, err := a.GetRuleGroup(context.Background(), "tenant", "foo", "cpu")
+		assert.Nil(t, err)
+		assert.Equal(t, model.Duration(2*time.Minute), storedRuleGroup.Interval)
+	})
+
+	t.Run("should omit a zero limit of the rule group", func(t *testing.T) {
+		stub := newReloadStub(t)
+		a, rulesDir := newTestAdapter(t, stub)
+
+		err := a.UpsertRuleGroup(context.Background(), "tenant", "foo", newRuleGroup(t, "cpu", cpuRules))
+		assert.Nil(t, err)
+
+		content, err := ioutil.ReadFile(filepath.Join(rulesDir, "prometheus-1", "tenant", "foo.yaml"))
+		assert.Nil(t, err)
+		assert.NotContains(t, string(content), "limit:")
+	})
+
+	t.Run("should keep the rule files of tenants with the same urn in different providers apart", func(t *testing.T) {
+		stub := newReloadStub(t)
+		a, rulesDir := newTestAdapter(t, stub)
+		other, err := NewFactory(domain.PrometheusConfig{RulesDir: rulesDir}, stub.server.Client())("prometheus-2", stub.server.URL)
+		assert.Nil(t, err)
+
+		err = a.UpsertRuleGroup(context.Background(), "tenant", "foo", newRuleGroup(t, "cpu", cpuRules))
+		assert.Nil(t, err)
+
+		ruleGroups, err := other.ListRuleGroups(context.Background(), "tenant")
+		assert.Nil(t, err)
+		assert.Empty(t, ruleGroups)
+	})
+
+	t.Run("should return error if rule group has source tenants", func(t *testing.T) {
+		stub := newReloadStub(t)
+		a, _ := newTestAdapter(t, stub)
+		ruleGroup := newRuleGroup(t, "cpu", cpuRules)
+		ruleGroup.SourceTenants = []string{"other-tenant"}
+
+		err := a.UpsertRuleGroup(context.Background(), "tenant", "foo", ruleGroup)
+		assert.EqualError(t, err, "source tenants are not supported by prometheus provider")
+		assert.Equal(t, 0, stub.reloads)
+	})
+
+	t.Run("should return error if reload fails", func(t *testing.T) {
+		stub := newReloadStub(t)
+		stub.status = http.StatusForbidden
+		a, _ := newTestAdapter(t, stub)
+
+		err := a.UpsertRuleGroup(context.Background(), "tenant", "foo", newRuleGroup(t, "cpu", cpuRules))
+		assert.EqualError(t, err, "failed to reload rules: status 403: reload failed")
+	})
+
+	t.Run("should return error for namespaces that are not file names", func(t *testing.T) {
+		stub := newReloadStub(t)
+		a, _ := newTestAdapter(t, stub)
+
+		err := a.UpsertRuleGroup(context.Background(), "tenant", "../foo", newRuleGroup(t, "cpu", cpuRules))
+		assert.EqualError(t, err, `invalid namespace name for a rule file: "../foo"`)
+		assert.Equal(t, 0, stub.reloads)
+	})
+}
+
+func TestAdapter_DeleteRuleGroup(t *testing.T) {
+	t.Run("should remove rule group and keep the rest of the file", func(t *testing.T) {
+		stub := newReloadStub(t)
+		a, _ := newTestAdapter(t, stub)
+		err := a.UpsertRuleGroup(context.Background(), "tenant", "foo", newRuleGroup(t, "cpu", cpuRules))
+		assert.Nil(t, err)
+		err = a.UpsertRuleGroup(context.Background(), "tenant", "foo", newRuleGroup(t, "memory", memoryRules))
+		assert.Nil(t, err)
+
+		err = a.DeleteRuleGroup(context.Background(), "tenant", "foo", "cpu")
+		assert.Nil(t, err)
+		assert.Equal(t, 3, stub.reloads)
+
+		ruleGroup, err := a.GetRuleGroup(context.Background(), "tenant", "foo", "cpu")
+		assert.Nil(t, err)
+		assert.Nil(t, ruleGroup)
+		ruleGroup, err = a.GetRuleGroup(context.Background(), "tenant", "foo", "memory")
+		assert.Nil(t, err)
+		assert.Equal(t, "memory", ruleGroup.Name)
+	})
+
+	t.Run("should remove the rule file with its last rule group", func(t *testing.T) {
+		stub := newReloadStub(t)
+		a, rulesDir := newTestAdapter(t, stub)
+		err := a.UpsertRuleGroup(context.Background(), "tenant", "foo", newRuleGroup(t, "cpu", cpuRules))
+		assert.Nil(t, err)
+
+		err = a.DeleteRuleGroup(context.Background(), "tenant", "foo", "cpu")
+		assert.Nil(t, err)
+		_, err = os.Stat(filepath.Join(rulesDir, "prometheus-1", "tenant", "foo.yaml"))
+		assert.True(t, os.IsNotExist(err))
+	})
+
+	t.Run("should not reload if rule group does not exist", func(t *testing.T) {
+		stub := newReloadStub(t)
+		a, _ := newTestAdapter(t, stub)
+
+		err := a.DeleteRuleGroup(context.Background(), "tenant", "foo", "cpu")
+		assert.Nil(t, err)
+		assert.Equal(t, 0, stub.reloads)
+	})
+}
+
+func TestAdapter_ListRuleGroups(t *testing.T) {
+	t.Run("should return rule groups of the tenant by namespace", func(t *testing.T) {
+		stub := newReloadStub(t)
+		a, _ := newTestAdapter(t, stub)
+		err := a.UpsertRuleGroup(context.Background(), "tenant", "foo", newRuleGroup(t, "cpu", cpuRules))
+		assert.Nil(t, err)
+		err = a.UpsertRuleGroup(context.Background(), "tenant", "bar", newRuleGroup(t, "memory", memoryRules))
+		assert.Nil(t, err)
+		err = a.UpsertRuleGroup(context.Background(), "other-tenant", "foo", newRuleGroup(t, "memory", memoryRules))
+		assert.Nil(t, err)
+
+		ruleGroups, err := a.ListRuleGroups(context.Background(), "tenant")
+		assert.Nil(t, err)
+		assert.Len(t, ruleGroups, 2)
+		assert.Equal(t, "cpu", ruleGroups["foo"][0].Name)
+		assert.Equal(t, "memory", ruleGroups["bar"][0].Name)
+	})
+
+	t.Run("should return no rule groups if tenant has no rule files", func(t *testing.T) {
+		stub := newReloadStub(t)
+		a, _ := newTestAdapter(t, stub)
+
+		ruleGroups, err := a.ListRuleGroups(context.Background(), "tenant")
+		assert.Nil(t, err)
+		assert.Empty(t, ruleGroups)
+	})
+}
+
+func TestNewFactory(t *testing.T) {
+	t.Run("should return error if rules directory is not configured", func(t *testing.T) {
+		a, err := NewFactory(domain.PrometheusConfig{}, http.DefaultClient)("prometheus-1", "http://localhost:9090")
+		assert.Nil(t, a)
+		assert.EqualError(t, err, "prometheus rules directory is not configured")
+	})
+	t.Run("should return error if provider urn cannot be a directory name", func(t *testing.T) {
+		a, err := NewFactory(domain.PrometheusConfig{RulesDir: "./rules"}, http.DefaultClient)("../prometheus", "http://localhost:9090")
+		assert.Nil(t, a)
+		assert.EqualError(t, err, `invalid provider name for a rule file: "../prometheus"`)
+	})
+}
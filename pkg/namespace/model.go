@@ -6,6 +6,7 @@ import (
 	"github.com/odpf/siren/domain"
 	"github.com/odpf/siren/pkg/provider"
 	"github.com/pkg/errors"
+	"gorm.io/gorm"
 	"time"
 )
 
@@ -26,16 +27,41 @@ func (a StringStringMap) Value() (driver.Value, error) {
 	return json.Marshal(a)
 }
 
+type StringList []string
+
+func (l *StringList) Scan(value interface{}) error {
+	if value == nil {
+		*l = nil
+		return nil
+	}
+	b, ok := value.([]byte)
+	if !ok {
+		return errors.New("failed type assertion to []byte")
+	}
+	return json.Unmarshal(b, &l)
+}
+
+func (l StringList) Value() (driver.Value, error) {
+	if len(l) == 0 {
+		return nil, nil
+	}
+	return json.Marshal(l)
+}
+
 type Namespace struct {
-	Id          uint64 `gorm:"primarykey"`
-	Provider    *provider.Provider
-	ProviderId  uint64 `gorm:"uniqueIndex:urn_provider_id_unique"`
-	Urn         string `gorm:"uniqueIndex:urn_provider_id_unique"`
-	Name        string
-	Credentials string
-	Labels      StringStringMap `gorm:"type:jsonb" sql:"type:jsonb" `
-	CreatedAt   time.Time
-	UpdatedAt   time.Time
+	Id             uint64 `gorm:"primarykey"`
+	Provider       *provider.Provider
+	ProviderId     uint64 `gorm:"uniqueIndex:urn_provider_id_unique"`
+	Urn            string `gorm:"uniqueIndex:urn_provider_id_unique"`
+	Name           string
+	Credentials    string
+	Labels         StringStringMap `gorm:"type:jsonb" sql:"type:jsonb" `
+	GroupBy        StringList      `gorm:"type:jsonb" sql:"type:jsonb" `
+	GroupWait      string
+	GroupInterval  string
+	RepeatInterval string
+	CreatedAt      time.Time
+	UpdatedAt      time.Time
 }
 
 func (namespace *Namespace) fromDomain(n *domain.Namespace) (*Namespace, error) {
@@ -52,6 +78,10 @@ func (namespace *Namespace) fromDomain(n *domain.Namespace) (*Namespace, error)
 	}
 	namespace.Credentials = string(credentialsBytes)
 	namespace.Labels = n.Labels
+	namespace.GroupBy = n.GroupBy
+	namespace.GroupWait = n.GroupWait
+	namespace.GroupInterval = n.GroupInterval
+	namespace.RepeatInterval = n.RepeatInterval
 	namespace.CreatedAt = n.CreatedAt
 	namespace.UpdatedAt = n.UpdatedAt
 	return namespace, nil
@@ -76,6 +106,12 @@ func (namespace *Namespace) toDomain() (*domain.Namespace, error) {
 		Labels:      namespace.Labels,
 		CreatedAt:   namespace.CreatedAt,
 		UpdatedAt:   namespace.UpdatedAt,
+		Routing: domain.Routing{
+			GroupBy:        namespace.GroupBy,
+			GroupWait:      namespace.GroupWait,
+			GroupInterval:  namespace.GroupInterval,
+			RepeatInterval: namespace.RepeatInterval,
+		},
 	}, nil
 }
 
@@ -84,6 +120,12 @@ type NamespaceRepository interface {
 	List() ([]*Namespace, error)
 	Create(*Namespace) (*Namespace, error)
 	Get(uint64) (*Namespace, error)
-	Update(*Namespace) (*Namespace, error)
+	Update(*Namespace, func(*gorm.DB, *Namespace) error) (*Namespace, error)
 	Delete(uint64) error
 }
+
+// Syncer syncs the alertmanager config of a namespace in its provider within
+// the transaction updating the namespace
+type Syncer interface {
+	SyncNamespace(*gorm.DB, *domain.Namespace) error
+}
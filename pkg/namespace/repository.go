@@ -55,24 +55,35 @@ func (r Repository) Get(id uint64) (*Namespace, error) {
 	return &namespace, nil
 }
 
-func (r Repository) Update(namespace *Namespace) (*Namespace, error) {
+// Update updates the namespace and calls sync with the updated namespace in
+// the same transaction, rolling the update back if the sync fails
+func (r Repository) Update(namespace *Namespace, sync func(*gorm.DB, *Namespace) error) (*Namespace, error) {
 	var newNamespace, existingNamespace Namespace
-	result := r.db.Where(fmt.Sprintf("id = %d", namespace.Id)).Find(&existingNamespace)
-	if result.Error != nil {
-		return nil, result.Error
-	}
-	if result.RowsAffected == 0 {
-		return nil, errors.New("namespace doesn't exist")
-	} else {
-		result = r.db.Where("id = ?", namespace.Id).Updates(namespace)
+	updateError := r.db.Transaction(func(tx *gorm.DB) error {
+		result := tx.Where(fmt.Sprintf("id = %d", namespace.Id)).Find(&existingNamespace)
 		if result.Error != nil {
-			return nil, result.Error
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return errors.New("namespace doesn't exist")
+		} else {
+			result = tx.Where("id = ?", namespace.Id).
+				Select("provider_id", "name", "credentials", "labels",
+					"group_by", "group_wait", "group_interval", "repeat_interval", "updated_at").
+				Updates(namespace)
+			if result.Error != nil {
+				return result.Error
+			}
 		}
-	}
 
-	result = r.db.Where(fmt.Sprintf("id = %d", namespace.Id)).Find(&newNamespace)
-	if result.Error != nil {
-		return nil, result.Error
+		result = tx.Where(fmt.Sprintf("id = %d", namespace.Id)).Find(&newNamespace)
+		if result.Error != nil {
+			return result.Error
+		}
+		return sync(tx, &newNamespace)
+	})
+	if updateError != nil {
+		return nil, updateError
 	}
 	return &newNamespace, nil
 }
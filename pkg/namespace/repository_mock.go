@@ -4,6 +4,7 @@ package namespace
 
 import (
 	mock "github.com/stretchr/testify/mock"
+	gorm "gorm.io/gorm"
 )
 
 // NamespaceRepository is an autogenerated mock type for the NamespaceRepository type
@@ -108,13 +109,13 @@ func (_m *NamespaceRepositoryMock) Migrate() error {
 	return r0
 }
 
-// Update provides a mock function with given fields: _a0
-func (_m *NamespaceRepositoryMock) Update(_a0 *Namespace) (*Namespace, error) {
-	ret := _m.Called(_a0)
+// Update provides a mock function with given fields: _a0, _a1
+func (_m *NamespaceRepositoryMock) Update(_a0 *Namespace, _a1 func(*gorm.DB, *Namespace) error) (*Namespace, error) {
+	ret := _m.Called(_a0, _a1)
 
 	var r0 *Namespace
-	if rf, ok := ret.Get(0).(func(*Namespace) *Namespace); ok {
-		r0 = rf(_a0)
+	if rf, ok := ret.Get(0).(func(*Namespace, func(*gorm.DB, *Namespace) error) *Namespace); ok {
+		r0 = rf(_a0, _a1)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*Namespace)
@@ -122,8 +123,8 @@ func (_m *NamespaceRepositoryMock) Update(_a0 *Namespace) (*Namespace, error) {
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(*Namespace) error); ok {
-		r1 = rf(_a0)
+	if rf, ok := ret.Get(1).(func(*Namespace, func(*gorm.DB, *Namespace) error) error); ok {
+		r1 = rf(_a0, _a1)
 	} else {
 		r1 = ret.Error(1)
 	}
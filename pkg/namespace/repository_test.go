@@ -8,6 +8,7 @@ import (
 	"github.com/odpf/siren/mocks"
 	"github.com/pkg/errors"
 	"github.com/stretchr/testify/suite"
+	"gorm.io/gorm"
 	"regexp"
 	"testing"
 	"time"
@@ -84,8 +85,8 @@ func (s *RepositoryTestSuite) TestCreate() {
 
 	s.Run("should create a namespace", func() {
 		insertQuery := regexp.QuoteMeta(`INSERT INTO "namespaces" 
-											("provider_id","urn","name","credentials","labels","created_at","updated_at","id")
-											VALUES ($1,$2,$3,$4,$5,$6,$7,$8) RETURNING "id"`)
+											("provider_id","urn","name","credentials","labels","group_by","group_wait","group_interval","repeat_interval","created_at","updated_at","id")
+											VALUES ($1,$2,$3,$4,$5,$6,$7,$8,$9,$10,$11,$12) RETURNING "id"`)
 		selectQuery := regexp.QuoteMeta(`SELECT * FROM "namespaces" WHERE id = 1`)
 		expectedNamespace := &Namespace{
 			Id:          1,
@@ -100,7 +101,8 @@ func (s *RepositoryTestSuite) TestCreate() {
 
 		s.dbmock.ExpectQuery(insertQuery).WithArgs(expectedNamespace.ProviderId, expectedNamespace.Urn,
 			expectedNamespace.Name, expectedNamespace.Credentials, expectedNamespace.Labels,
-			expectedNamespace.CreatedAt, expectedNamespace.UpdatedAt, expectedNamespace.Id).
+			expectedNamespace.GroupBy, expectedNamespace.GroupWait, expectedNamespace.GroupInterval,
+			expectedNamespace.RepeatInterval, expectedNamespace.CreatedAt, expectedNamespace.UpdatedAt, expectedNamespace.Id).
 			WillReturnRows(sqlmock.NewRows(nil))
 
 		expectedRows := sqlmock.
@@ -117,8 +119,8 @@ func (s *RepositoryTestSuite) TestCreate() {
 
 	s.Run("should return errors in creating a namespace", func() {
 		insertQuery := regexp.QuoteMeta(`INSERT INTO "namespaces" 
-											("provider_id","urn","name","credentials","labels","created_at","updated_at","id")
-											VALUES ($1,$2,$3,$4,$5,$6,$7,$8) RETURNING "id"`)
+											("provider_id","urn","name","credentials","labels","group_by","group_wait","group_interval","repeat_interval","created_at","updated_at","id")
+											VALUES ($1,$2,$3,$4,$5,$6,$7,$8,$9,$10,$11,$12) RETURNING "id"`)
 		expectedNamespace := &Namespace{
 			Id:          1,
 			ProviderId:  1,
@@ -132,7 +134,8 @@ func (s *RepositoryTestSuite) TestCreate() {
 
 		s.dbmock.ExpectQuery(insertQuery).WithArgs(expectedNamespace.ProviderId, expectedNamespace.Urn,
 			expectedNamespace.Name, expectedNamespace.Credentials, expectedNamespace.Labels,
-			expectedNamespace.CreatedAt, expectedNamespace.UpdatedAt, expectedNamespace.Id).
+			expectedNamespace.GroupBy, expectedNamespace.GroupWait, expectedNamespace.GroupInterval,
+			expectedNamespace.RepeatInterval, expectedNamespace.CreatedAt, expectedNamespace.UpdatedAt, expectedNamespace.Id).
 			WillReturnError(errors.New("random error"))
 
 		actualNamespace, err := s.repository.Create(expectedNamespace)
@@ -142,8 +145,8 @@ func (s *RepositoryTestSuite) TestCreate() {
 
 	s.Run("should return error if finding newly inserted namespace fails", func() {
 		insertQuery := regexp.QuoteMeta(`INSERT INTO "namespaces" 
-											("provider_id","urn","name","credentials","labels","created_at","updated_at","id")
-											VALUES ($1,$2,$3,$4,$5,$6,$7,$8) RETURNING "id"`)
+											("provider_id","urn","name","credentials","labels","group_by","group_wait","group_interval","repeat_interval","created_at","updated_at","id")
+											VALUES ($1,$2,$3,$4,$5,$6,$7,$8,$9,$10,$11,$12) RETURNING "id"`)
 		selectQuery := regexp.QuoteMeta(`SELECT * FROM "namespaces" WHERE id = 1`)
 		expectedNamespace := &Namespace{
 			Id:          1,
@@ -158,7 +161,8 @@ func (s *RepositoryTestSuite) TestCreate() {
 
 		s.dbmock.ExpectQuery(insertQuery).WithArgs(expectedNamespace.ProviderId, expectedNamespace.Urn,
 			expectedNamespace.Name, expectedNamespace.Credentials, expectedNamespace.Labels,
-			expectedNamespace.CreatedAt, expectedNamespace.UpdatedAt, expectedNamespace.Id).
+			expectedNamespace.GroupBy, expectedNamespace.GroupWait, expectedNamespace.GroupInterval,
+			expectedNamespace.RepeatInterval, expectedNamespace.CreatedAt, expectedNamespace.UpdatedAt, expectedNamespace.Id).
 			WillReturnRows(sqlmock.NewRows(nil))
 		s.dbmock.ExpectQuery(selectQuery).WillReturnError(errors.New("random error"))
 
@@ -219,14 +223,15 @@ func (s *RepositoryTestSuite) TestGet() {
 }
 
 func (s *RepositoryTestSuite) TestUpdate() {
+	noSync := func(*gorm.DB, *Namespace) error { return nil }
 	labels := make(StringStringMap)
 	labels["foo"] = "bar"
 
 	s.Run("should update a namespace", func() {
 		firstSelectQuery := regexp.QuoteMeta(`SELECT * FROM "namespaces" WHERE id = 1`)
 		updateQuery := regexp.QuoteMeta(`UPDATE "namespaces"
-			SET "provider_id"=$1,"name"=$2,"credentials"=$3,"labels"=$4,"created_at"=$5,"updated_at"=$6 
-			WHERE id = $7 AND "id" = $8`)
+			SET "provider_id"=$1,"name"=$2,"credentials"=$3,"labels"=$4,"group_by"=$5,"group_wait"=$6,"group_interval"=$7,"repeat_interval"=$8,"updated_at"=$9 
+			WHERE id = $10 AND "id" = $11`)
 		secondSelectQuery := regexp.QuoteMeta(`SELECT * FROM "namespaces" WHERE id = 1`)
 		expectedNamespace := &Namespace{
 			Id:          1,
@@ -258,14 +263,90 @@ func (s *RepositoryTestSuite) TestUpdate() {
 			AddRow(expectedNamespace.Urn, expectedNamespace.Name, input.ProviderId,
 				json.RawMessage(`{"foo":"bar"}`), json.RawMessage(`{"foo": "bar"}`),
 				expectedNamespace.CreatedAt, expectedNamespace.UpdatedAt, expectedNamespace.Id)
+		s.dbmock.ExpectBegin()
 		s.dbmock.ExpectQuery(firstSelectQuery).WillReturnRows(expectedRows1)
 		s.dbmock.ExpectExec(updateQuery).WithArgs(input.ProviderId, input.Name, input.Credentials, input.Labels,
-			AnyTime{}, AnyTime{}, input.Id, input.Id).WillReturnResult(sqlmock.NewResult(1, 1))
+			nil, "", "", "", AnyTime{}, input.Id, input.Id).WillReturnResult(sqlmock.NewResult(1, 1))
 		s.dbmock.ExpectQuery(secondSelectQuery).WillReturnRows(expectedRows2)
+		s.dbmock.ExpectCommit()
 
-		actualNamespace, err := s.repository.Update(input)
+		var syncedNamespace *Namespace
+		actualNamespace, err := s.repository.Update(input, func(tx *gorm.DB, namespace *Namespace) error {
+			syncedNamespace = namespace
+			return nil
+		})
 		s.Equal(uint64(2), actualNamespace.ProviderId)
+		s.Equal(actualNamespace, syncedNamespace)
 		s.Nil(err)
+		if err := s.dbmock.ExpectationsWereMet(); err != nil {
+			s.T().Errorf("there were unfulfilled expectations: %s", err)
+		}
+	})
+
+	s.Run("should roll the update back if syncing the namespace fails", func() {
+		selectQuery := regexp.QuoteMeta(`SELECT * FROM "namespaces" WHERE id = 1`)
+		updateQuery := regexp.QuoteMeta(`UPDATE "namespaces"
+			SET "provider_id"=$1,"name"=$2,"credentials"=$3,"labels"=$4,"group_by"=$5,"group_wait"=$6,"group_interval"=$7,"repeat_interval"=$8,"updated_at"=$9 
+			WHERE id = $10 AND "id" = $11`)
+		input := &Namespace{
+			Id:          1,
+			ProviderId:  1,
+			Name:        "foo",
+			Credentials: `{"foo":"bar"}`,
+			Labels:      labels,
+		}
+		columns := []string{"id", "urn", "name", "provider_id", "credentials", "labels"}
+
+		s.dbmock.ExpectBegin()
+		s.dbmock.ExpectQuery(selectQuery).WillReturnRows(sqlmock.NewRows(columns).
+			AddRow(1, "foo", "foo", 1, json.RawMessage(`{"foo":"bar"}`), json.RawMessage(`{"foo": "bar"}`)))
+		s.dbmock.ExpectExec(updateQuery).WithArgs(input.ProviderId, input.Name, input.Credentials, input.Labels,
+			nil, "", "", "", AnyTime{}, input.Id, input.Id).WillReturnResult(sqlmock.NewResult(1, 1))
+		s.dbmock.ExpectQuery(selectQuery).WillReturnRows(sqlmock.NewRows(columns).
+			AddRow(1, "foo", "foo", 1, json.RawMessage(`{"foo":"bar"}`), json.RawMessage(`{"foo": "bar"}`)))
+		s.dbmock.ExpectRollback()
+
+		actualNamespace, err := s.repository.Update(input, func(tx *gorm.DB, namespace *Namespace) error {
+			return errors.New("random error")
+		})
+		s.Nil(actualNamespace)
+		s.EqualError(err, "random error")
+		if err := s.dbmock.ExpectationsWereMet(); err != nil {
+			s.T().Errorf("there were unfulfilled expectations: %s", err)
+		}
+	})
+
+	s.Run("should clear the routing of a namespace", func() {
+		selectQuery := regexp.QuoteMeta(`SELECT * FROM "namespaces" WHERE id = 1`)
+		updateQuery := regexp.QuoteMeta(`UPDATE "namespaces"
+			SET "provider_id"=$1,"name"=$2,"credentials"=$3,"labels"=$4,"group_by"=$5,"group_wait"=$6,"group_interval"=$7,"repeat_interval"=$8,"updated_at"=$9 
+			WHERE id = $10 AND "id" = $11`)
+		input := &Namespace{
+			Id:          1,
+			ProviderId:  1,
+			Name:        "foo",
+			Credentials: `{"foo":"bar"}`,
+			Labels:      labels,
+		}
+		columns := []string{"id", "urn", "name", "provider_id", "credentials", "labels", "group_by", "group_wait", "group_interval", "repeat_interval"}
+
+		s.dbmock.ExpectBegin()
+		s.dbmock.ExpectQuery(selectQuery).WillReturnRows(sqlmock.NewRows(columns).
+			AddRow(1, "foo", "foo", 1, json.RawMessage(`{"foo":"bar"}`), json.RawMessage(`{"foo": "bar"}`),
+				json.RawMessage(`["alertname"]`), "30s", "5m", "4h"))
+		s.dbmock.ExpectExec(updateQuery).WithArgs(input.ProviderId, input.Name, input.Credentials, input.Labels,
+			nil, "", "", "", AnyTime{}, input.Id, input.Id).WillReturnResult(sqlmock.NewResult(1, 1))
+		s.dbmock.ExpectQuery(selectQuery).WillReturnRows(sqlmock.NewRows(columns).
+			AddRow(1, "foo", "foo", 1, json.RawMessage(`{"foo":"bar"}`), json.RawMessage(`{"foo": "bar"}`), nil, "", "", ""))
+		s.dbmock.ExpectCommit()
+
+		actualNamespace, err := s.repository.Update(input, noSync)
+		s.Nil(err)
+		s.Empty(actualNamespace.GroupBy)
+		s.Equal("", actualNamespace.GroupWait)
+		if err := s.dbmock.ExpectationsWereMet(); err != nil {
+			s.T().Errorf("there were unfulfilled expectations: %s", err)
+		}
 	})
 
 	s.Run("should return error if namespace does not exist", func() {
@@ -281,9 +362,11 @@ func (s *RepositoryTestSuite) TestUpdate() {
 			UpdatedAt:   time.Now(),
 		}
 
+		s.dbmock.ExpectBegin()
 		s.dbmock.ExpectQuery(firstSelectQuery).WillReturnRows(sqlmock.NewRows(nil))
+		s.dbmock.ExpectRollback()
 
-		actualNamespace, err := s.repository.Update(input)
+		actualNamespace, err := s.repository.Update(input, noSync)
 		s.Nil(actualNamespace)
 		s.EqualError(err, "namespace doesn't exist")
 	})
@@ -301,9 +384,11 @@ func (s *RepositoryTestSuite) TestUpdate() {
 			UpdatedAt:   time.Now(),
 		}
 
+		s.dbmock.ExpectBegin()
 		s.dbmock.ExpectQuery(firstSelectQuery).WillReturnError(errors.New("random error"))
+		s.dbmock.ExpectRollback()
 
-		actualNamespace, err := s.repository.Update(input)
+		actualNamespace, err := s.repository.Update(input, noSync)
 		s.Nil(actualNamespace)
 		s.EqualError(err, "random error")
 	})
@@ -311,8 +396,8 @@ func (s *RepositoryTestSuite) TestUpdate() {
 	s.Run("should return error updating the provider", func() {
 		firstSelectQuery := regexp.QuoteMeta(`SELECT * FROM "namespaces" WHERE id = 1`)
 		updateQuery := regexp.QuoteMeta(`UPDATE "namespaces"
-			SET "provider_id"=$1,"name"=$2,"credentials"=$3,"labels"=$4,"created_at"=$5,"updated_at"=$6 
-			WHERE id = $7 AND "id" = $8`)
+			SET "provider_id"=$1,"name"=$2,"credentials"=$3,"labels"=$4,"group_by"=$5,"group_wait"=$6,"group_interval"=$7,"repeat_interval"=$8,"updated_at"=$9 
+			WHERE id = $10 AND "id" = $11`)
 		expectedNamespace := &Namespace{
 			Id:          1,
 			ProviderId:  1,
@@ -338,12 +423,14 @@ func (s *RepositoryTestSuite) TestUpdate() {
 			AddRow(expectedNamespace.Urn, expectedNamespace.Name, expectedNamespace.ProviderId,
 				json.RawMessage(`{"foo":"bar"}`), json.RawMessage(`{"foo": "bar"}`), expectedNamespace.CreatedAt,
 				expectedNamespace.UpdatedAt, expectedNamespace.Id)
+		s.dbmock.ExpectBegin()
 		s.dbmock.ExpectQuery(firstSelectQuery).WillReturnRows(expectedRows)
 		s.dbmock.ExpectExec(updateQuery).WithArgs(input.ProviderId, input.Name, input.Credentials, input.Labels,
-			AnyTime{}, AnyTime{}, input.Id, input.Id).
+			nil, "", "", "", AnyTime{}, input.Id, input.Id).
 			WillReturnError(errors.New("random error"))
+		s.dbmock.ExpectRollback()
 
-		actualNamespace, err := s.repository.Update(input)
+		actualNamespace, err := s.repository.Update(input, noSync)
 		s.Nil(actualNamespace)
 		s.EqualError(err, "random error")
 	})
@@ -351,8 +438,8 @@ func (s *RepositoryTestSuite) TestUpdate() {
 	s.Run("should return error in finding the updated provider", func() {
 		firstSelectQuery := regexp.QuoteMeta(`SELECT * FROM "namespaces" WHERE id = 1`)
 		updateQuery := regexp.QuoteMeta(`UPDATE "namespaces"
-			SET "provider_id"=$1,"name"=$2,"credentials"=$3,"labels"=$4,"created_at"=$5,"updated_at"=$6 
-			WHERE id = $7 AND "id" = $8`)
+			SET "provider_id"=$1,"name"=$2,"credentials"=$3,"labels"=$4,"group_by"=$5,"group_wait"=$6,"group_interval"=$7,"repeat_interval"=$8,"updated_at"=$9 
+			WHERE id = $10 AND "id" = $11`)
 		secondSelectQuery := regexp.QuoteMeta(`SELECT * FROM "namespaces" WHERE id = 1`)
 		expectedNamespace := &Namespace{
 			Id:          1,
@@ -379,12 +466,14 @@ func (s *RepositoryTestSuite) TestUpdate() {
 			AddRow(expectedNamespace.Name, expectedNamespace.ProviderId, json.RawMessage(`{"foo":"bar"}`),
 				json.RawMessage(`{"foo": "bar"}`), expectedNamespace.CreatedAt, expectedNamespace.UpdatedAt,
 				expectedNamespace.Id)
+		s.dbmock.ExpectBegin()
 		s.dbmock.ExpectQuery(firstSelectQuery).WillReturnRows(expectedRows1)
 		s.dbmock.ExpectExec(updateQuery).WithArgs(input.ProviderId, input.Name, input.Credentials, input.Labels,
-			AnyTime{}, AnyTime{}, input.Id, input.Id).WillReturnResult(sqlmock.NewResult(1, 1))
+			nil, "", "", "", AnyTime{}, input.Id, input.Id).WillReturnResult(sqlmock.NewResult(1, 1))
 		s.dbmock.ExpectQuery(secondSelectQuery).WillReturnError(errors.New("random error"))
+		s.dbmock.ExpectRollback()
 
-		actualNamespace, err := s.repository.Update(input)
+		actualNamespace, err := s.repository.Update(input, noSync)
 		s.Nil(actualNamespace)
 		s.EqualError(err, "random error")
 	})
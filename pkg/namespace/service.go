@@ -58,16 +58,17 @@ func (t *Transformer) Decrypt(s string) (string, error) {
 type Service struct {
 	repository  NamespaceRepository
 	transformer EncryptorDecryptor
+	syncer      Syncer
 }
 
 // NewService returns service struct
-func NewService(db *gorm.DB, encryptionKey string) (domain.NamespaceService, error) {
+func NewService(db *gorm.DB, encryptionKey string, syncer Syncer) (domain.NamespaceService, error) {
 	transformer, err := NewTransformer(encryptionKey)
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to create transformer")
 	}
 
-	return &Service{NewRepository(db), transformer}, nil
+	return &Service{NewRepository(db), transformer, syncer}, nil
 }
 
 func (s Service) ListNamespaces() ([]*domain.Namespace, error) {
@@ -94,6 +95,9 @@ func (s Service) ListNamespaces() ([]*domain.Namespace, error) {
 }
 
 func (s Service) CreateNamespace(namespace *domain.Namespace) (*domain.Namespace, error) {
+	if err := namespace.Routing.Validate(); err != nil {
+		return nil, err
+	}
 	n := &Namespace{}
 	_, err := n.fromDomain(namespace)
 	if err != nil {
@@ -130,6 +134,9 @@ func (s Service) GetNamespace(id uint64) (*domain.Namespace, error) {
 }
 
 func (s Service) UpdateNamespace(namespace *domain.Namespace) (*domain.Namespace, error) {
+	if err := namespace.Routing.Validate(); err != nil {
+		return nil, err
+	}
 	w := &Namespace{}
 	_, err := w.fromDomain(namespace)
 	if err != nil {
@@ -141,7 +148,18 @@ func (s Service) UpdateNamespace(namespace *domain.Namespace) (*domain.Namespace
 		return nil, errors.Wrap(err, "s.transformer.Encrypt")
 	}
 	w.Credentials = encryptedCredentials
-	updatedNamespace, err := s.repository.Update(w)
+	updatedNamespace, err := s.repository.Update(w, func(tx *gorm.DB, updatedNamespace *Namespace) error {
+		syncedNamespace := *updatedNamespace
+		syncedNamespace.Credentials = plainTextCredentials
+		domainNamespace, err := syncedNamespace.toDomain()
+		if err != nil {
+			return errors.Wrap(err, "syncedNamespace.toDomain()")
+		}
+		if err := s.syncer.SyncNamespace(tx, domainNamespace); err != nil {
+			return errors.Wrap(err, "s.syncer.SyncNamespace")
+		}
+		return nil
+	})
 	if err != nil {
 		return nil, errors.Wrap(err, "s.repository.Update")
 	}
@@ -5,6 +5,7 @@ import (
 	"github.com/pkg/errors"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
+	"gorm.io/gorm"
 	"strings"
 	"testing"
 	"time"
@@ -206,6 +207,54 @@ func TestService_CreateNamespaces(t *testing.T) {
 		assert.Nil(t, result)
 		assert.EqualError(t, err, "n.fromDomain(): json.Marshal: json: unsupported type: chan int")
 	})
+	t.Run("should store the default routing of the namespace", func(t *testing.T) {
+		repositoryMock := &NamespaceRepositoryMock{}
+		transformerMock := &EncryptorDecryptorMock{}
+		dummyService := Service{repository: repositoryMock, transformer: transformerMock}
+		routing := domain.Routing{GroupBy: []string{"service"}, GroupWait: "1m", GroupInterval: "10m", RepeatInterval: "1h"}
+		namespaceWithRouting := &Namespace{
+			Id:             2,
+			ProviderId:     1,
+			Name:           "foo",
+			Credentials:    `encrypted-text-1`,
+			Labels:         labels,
+			GroupBy:        StringList{"service"},
+			GroupWait:      "1m",
+			GroupInterval:  "10m",
+			RepeatInterval: "1h",
+			CreatedAt:      timeNow,
+			UpdatedAt:      timeNow,
+		}
+		repositoryMock.On("Create", namespaceWithRouting).Return(namespaceWithRouting, nil).Once()
+		transformerMock.On("Encrypt", `{"foo":"bar"}`).
+			Return("encrypted-text-1", nil).Once()
+		result, err := dummyService.CreateNamespace(&domain.Namespace{
+			Id:          2,
+			Provider:    1,
+			Name:        "foo",
+			Credentials: credentials,
+			Labels:      labels,
+			CreatedAt:   timeNow,
+			UpdatedAt:   timeNow,
+			Routing:     routing,
+		})
+		assert.Nil(t, err)
+		assert.Equal(t, routing, result.Routing)
+		repositoryMock.AssertExpectations(t)
+	})
+
+	t.Run("should return error if the default routing is invalid", func(t *testing.T) {
+		repositoryMock := &NamespaceRepositoryMock{}
+		transformerMock := &EncryptorDecryptorMock{}
+		dummyService := Service{repository: repositoryMock, transformer: transformerMock}
+		result, err := dummyService.CreateNamespace(&domain.Namespace{
+			Provider: 1,
+			Name:     "foo",
+			Routing:  domain.Routing{RepeatInterval: "0s"},
+		})
+		assert.Nil(t, result)
+		assert.EqualError(t, err, "invalid routing: repeat_interval cannot be zero")
+	})
 }
 
 func TestService_GetNamespace(t *testing.T) {
@@ -367,32 +416,56 @@ func TestService_UpdateNamespaces(t *testing.T) {
 		UpdatedAt:   timeNow,
 	}
 
+	syncUpdatedNamespace := func(_ *Namespace, sync func(*gorm.DB, *Namespace) error) error {
+		return sync(nil, namespace)
+	}
+
 	t.Run("should call repository Update method and return result in domain's type", func(t *testing.T) {
 		repositoryMock := &NamespaceRepositoryMock{}
 		transformerMock := &EncryptorDecryptorMock{}
-		dummyService := Service{repository: repositoryMock, transformer: transformerMock}
-		repositoryMock.On("Update", mock.AnythingOfType("*namespace.Namespace")).
+		syncerMock := &SyncerMock{}
+		dummyService := Service{repository: repositoryMock, transformer: transformerMock, syncer: syncerMock}
+		repositoryMock.On("Update", mock.AnythingOfType("*namespace.Namespace"), mock.Anything).
 			Run(func(args mock.Arguments) {
 				rarg := args.Get(0)
 				r := rarg.(*Namespace)
 				assert.Equal(t, "foo", r.Name)
 				assert.Equal(t, uint64(2), r.Id)
 				assert.Equal(t, uint64(1), r.ProviderId)
-			}).Return(namespace, nil).Once()
+			}).Return(namespace, syncUpdatedNamespace).Once()
 		transformerMock.On("Encrypt", `{"foo":"bar"}`).
 			Return("encrypted-text-1", nil).Once()
+		syncerMock.On("SyncNamespace", (*gorm.DB)(nil), dummyNamespace).Return(nil).Once()
 		result, err := dummyService.UpdateNamespace(dummyNamespace)
 		assert.Nil(t, err)
 		assert.Equal(t, dummyNamespace, result)
 		repositoryMock.AssertExpectations(t)
 		transformerMock.AssertExpectations(t)
+		syncerMock.AssertExpectations(t)
+	})
+
+	t.Run("should return error if syncing the updated namespace fails", func(t *testing.T) {
+		repositoryMock := &NamespaceRepositoryMock{}
+		transformerMock := &EncryptorDecryptorMock{}
+		syncerMock := &SyncerMock{}
+		dummyService := Service{repository: repositoryMock, transformer: transformerMock, syncer: syncerMock}
+		repositoryMock.On("Update", mock.AnythingOfType("*namespace.Namespace"), mock.Anything).
+			Return(nil, syncUpdatedNamespace).Once()
+		transformerMock.On("Encrypt", `{"foo":"bar"}`).
+			Return("encrypted-text-1", nil).Once()
+		syncerMock.On("SyncNamespace", (*gorm.DB)(nil), dummyNamespace).Return(errors.New("random error")).Once()
+		result, err := dummyService.UpdateNamespace(dummyNamespace)
+		assert.Nil(t, result)
+		assert.EqualError(t, err, "s.repository.Update: s.syncer.SyncNamespace: random error")
+		repositoryMock.AssertExpectations(t)
+		syncerMock.AssertExpectations(t)
 	})
 
 	t.Run("should call repository Update method and return error if any", func(t *testing.T) {
 		repositoryMock := &NamespaceRepositoryMock{}
 		transformerMock := &EncryptorDecryptorMock{}
 		dummyService := Service{repository: repositoryMock, transformer: transformerMock}
-		repositoryMock.On("Update", mock.AnythingOfType("*namespace.Namespace")).
+		repositoryMock.On("Update", mock.AnythingOfType("*namespace.Namespace"), mock.Anything).
 			Return(nil, errors.New("random error")).Once()
 		transformerMock.On("Encrypt", `{"foo":"bar"}`).
 			Return("encrypted-text-1", nil).Once()
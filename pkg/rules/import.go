@@ -0,0 +1,513 @@
+package rules
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/odpf/siren/domain"
+	"github.com/odpf/siren/pkg/templates"
+	"github.com/prometheus/prometheus/pkg/rulefmt"
+	"gopkg.in/yaml.v3"
+	"gorm.io/gorm"
+)
+
+const importedTemplateTag = "imported"
+
+var (
+	variablePlaceholderRegex = regexp.MustCompile(`__siren_var_(\d+)__`)
+	invalidTemplateNameRegex = regexp.MustCompile(`[^A-Za-z0-9_-]`)
+)
+
+// importRuleNode holds the fields of a rule as strings, so that rules rendered
+// with variable placeholders can be unmarshalled regardless of field types
+type importRuleNode struct {
+	Record      string            `yaml:"record"`
+	Alert       string            `yaml:"alert"`
+	Expr        string            `yaml:"expr"`
+	For         string            `yaml:"for"`
+	Labels      map[string]string `yaml:"labels"`
+	Annotations map[string]string `yaml:"annotations"`
+}
+
+func (n importRuleNode) fields() map[string]string {
+	fields := map[string]string{
+		"record": n.Record,
+		"alert":  n.Alert,
+		"expr":   n.Expr,
+		"for":    n.For,
+	}
+	for name, value := range n.Labels {
+		fields["labels."+name] = value
+	}
+	for name, value := range n.Annotations {
+		fields["annotations."+name] = value
+	}
+	return fields
+}
+
+func importRuleNodeFrom(ruleNode rulefmt.RuleNode) importRuleNode {
+	n := importRuleNode{
+		Record:      ruleNode.Record.Value,
+		Alert:       ruleNode.Alert.Value,
+		Expr:        ruleNode.Expr.Value,
+		Labels:      ruleNode.Labels,
+		Annotations: ruleNode.Annotations,
+	}
+	if ruleNode.For != 0 {
+		n.For = ruleNode.For.String()
+	}
+	return n
+}
+
+// fieldPattern matches a field of a rule rendered from a template and
+// extracts the values of the variables used in it
+type fieldPattern struct {
+	regex     *regexp.Regexp
+	variables []string
+}
+
+func newFieldPattern(value string, variables []domain.Variable) (*fieldPattern, error) {
+	pattern := &fieldPattern{}
+	expression := "(?s)^"
+	last := 0
+	value = strings.TrimSpace(value)
+	for _, loc := range variablePlaceholderRegex.FindAllStringSubmatchIndex(value, -1) {
+		index, err := strconv.Atoi(value[loc[2]:loc[3]])
+		if err != nil || index >= len(variables) {
+			return nil, fmt.Errorf("unknown variable placeholder %s", value[loc[0]:loc[1]])
+		}
+		expression += regexp.QuoteMeta(value[last:loc[0]]) + "(.*?)"
+		pattern.variables = append(pattern.variables, variables[index].Name)
+		last = loc[1]
+	}
+	expression += regexp.QuoteMeta(value[last:]) + "$"
+	regex, err := regexp.Compile(expression)
+	if err != nil {
+		return nil, err
+	}
+	pattern.regex = regex
+	return pattern, nil
+}
+
+// match returns the variable values extracted from the field value, or false
+// if the value does not match or a variable would take two different values
+func (p *fieldPattern) match(value string, bindings map[string]string) (map[string]string, bool) {
+	submatches := p.regex.FindStringSubmatch(strings.TrimSpace(value))
+	if submatches == nil {
+		return nil, false
+	}
+	result := make(map[string]string)
+	for name, value := range bindings {
+		result[name] = value
+	}
+	for i, name := range p.variables {
+		if existing, ok := result[name]; ok && existing != submatches[i+1] {
+			return nil, false
+		}
+		result[name] = submatches[i+1]
+	}
+	return result, true
+}
+
+// templateMatcher matches provider rules against the rules of a template
+// rendered with a placeholder in place of every variable
+type templateMatcher struct {
+	template domain.Template
+	nodes    []map[string]*fieldPattern
+}
+
+func newTemplateMatcher(template domain.Template) (*templateMatcher, error) {
+	placeholders := make(map[string]string)
+	for i, v := range template.Variables {
+		placeholders[v.Name] = fmt.Sprintf("__siren_var_%d__", i)
+	}
+	renderedBody, err := templates.RenderBodyUnchecked(&template, placeholders)
+	if err != nil {
+		return nil, err
+	}
+	var renderedNodes []importRuleNode
+	err = yaml.Unmarshal([]byte(renderedBody), &renderedNodes)
+	if err != nil {
+		return nil, err
+	}
+
+	matcher := &templateMatcher{template: template}
+	for _, renderedNode := range renderedNodes {
+		if renderedNode.Alert == "" && renderedNode.Record == "" && renderedNode.Expr == "" {
+			continue
+		}
+		patterns := make(map[string]*fieldPattern)
+		for name, value := range renderedNode.fields() {
+			pattern, err := newFieldPattern(value, template.Variables)
+			if err != nil {
+				return nil, err
+			}
+			patterns[name] = pattern
+		}
+		matcher.nodes = append(matcher.nodes, patterns)
+	}
+	if len(matcher.nodes) == 0 {
+		return nil, errors.New("template has no rules")
+	}
+	return matcher, nil
+}
+
+func (m *templateMatcher) matchNode(patterns map[string]*fieldPattern, ruleNode rulefmt.RuleNode,
+	bindings map[string]string) (map[string]string, bool) {
+	fields := importRuleNodeFrom(ruleNode).fields()
+	if len(fields) != len(patterns) {
+		return nil, false
+	}
+	for name, value := range fields {
+		pattern, ok := patterns[name]
+		if !ok {
+			return nil, false
+		}
+		bindings, ok = pattern.match(value, bindings)
+		if !ok {
+			return nil, false
+		}
+	}
+	return bindings, true
+}
+
+// match finds a rule among the remaining rule nodes for every rule of the
+// template and returns the indexes of the matched rule nodes in template order
+// along with the extracted variables. The extracted variables must be valid
+// values of the template variables and the rules rendered with them must be
+// identical to the matched rule nodes.
+func (m *templateMatcher) match(ruleNodes []rulefmt.RuleNode, consumed []bool) ([]int, map[string]string, bool) {
+	bindings := make(map[string]string)
+	var indexes []int
+	used := make(map[int]bool)
+	for _, patterns := range m.nodes {
+		found := false
+		for i, ruleNode := range ruleNodes {
+			if consumed[i] || used[i] {
+				continue
+			}
+			if result, ok := m.matchNode(patterns, ruleNode, bindings); ok {
+				bindings = result
+				indexes = append(indexes, i)
+				used[i] = true
+				found = true
+				break
+			}
+		}
+		if !found {
+			return nil, nil, false
+		}
+	}
+
+	renderedBody, err := templates.RenderBody(&m.template, bindings)
+	if err != nil {
+		return nil, nil, false
+	}
+	matchedNodes := make([]rulefmt.RuleNode, 0, len(indexes))
+	for _, i := range indexes {
+		matchedNodes = append(matchedNodes, ruleNodes[i])
+	}
+	if !sameRuleNodes(renderedBody, matchedNodes) {
+		return nil, nil, false
+	}
+	return indexes, bindings, true
+}
+
+// sameRuleNodes reports whether the rules of the rendered body are identical
+// to the given rule nodes, in the same order
+func sameRuleNodes(renderedBody string, ruleNodes []rulefmt.RuleNode) bool {
+	var renderedNodes []rulefmt.RuleNode
+	if err := yaml.Unmarshal([]byte(renderedBody), &renderedNodes); err != nil {
+		return false
+	}
+	var nonEmptyNodes []rulefmt.RuleNode
+	for _, renderedNode := range renderedNodes {
+		if renderedNode.Alert.Value == "" && renderedNode.Record.Value == "" && renderedNode.Expr.Value == "" {
+			continue
+		}
+		nonEmptyNodes = append(nonEmptyNodes, renderedNode)
+	}
+	if len(nonEmptyNodes) != len(ruleNodes) {
+		return false
+	}
+	for i := range nonEmptyNodes {
+		rendered, err := ruleNodeToYaml(nonEmptyNodes[i])
+		if err != nil {
+			return false
+		}
+		expected, err := ruleNodeToYaml(ruleNodes[i])
+		if err != nil || rendered != expected {
+			return false
+		}
+	}
+	return true
+}
+
+func newTemplateMatchers(existingTemplates []domain.Template) []*templateMatcher {
+	var matchers []*templateMatcher
+	for _, template := range existingTemplates {
+		matcher, err := newTemplateMatcher(template)
+		if err != nil {
+			continue
+		}
+		matchers = append(matchers, matcher)
+	}
+	// templates with more rules are more specific, so they are tried first
+	sort.SliceStable(matchers, func(i, j int) bool {
+		if len(matchers[i].nodes) != len(matchers[j].nodes) {
+			return len(matchers[i].nodes) > len(matchers[j].nodes)
+		}
+		return matchers[i].template.Name < matchers[j].template.Name
+	})
+	return matchers
+}
+
+func importedTemplateName(tenantName, namespace, groupName string) string {
+	name := fmt.Sprintf("imported_%s_%s_%s", tenantName, namespace, groupName)
+	return invalidTemplateNameRegex.ReplaceAllString(name, "_")
+}
+
+func importedTemplateBody(ruleNodes []rulefmt.RuleNode) (string, error) {
+	rules := make([]rulefmt.Rule, 0, len(ruleNodes))
+	for _, ruleNode := range ruleNodes {
+		rules = append(rules, ruleNodeToRule(ruleNode))
+	}
+	out, err := yaml.Marshal(rules)
+	if err != nil {
+		return "", err
+	}
+	return string(out), nil
+}
+
+func importedRuleVariables(template domain.Template, bindings map[string]string) (string, error) {
+	var ruleVariables []domain.RuleVariable
+	for _, v := range template.Variables {
+		value, ok := bindings[v.Name]
+		if !ok {
+			continue
+		}
+		ruleVariables = append(ruleVariables, domain.RuleVariable{
+			Name:        v.Name,
+			Type:        v.Type,
+			Value:       value,
+			Description: v.Description,
+		})
+	}
+	finalRuleVariables, err := mergeRuleVariablesWithDefaults(template.Variables, ruleVariables)
+	if err != nil {
+		return "", err
+	}
+	if finalRuleVariables == nil {
+		finalRuleVariables = make([]domain.RuleVariable, 0)
+	}
+	jsonBytes, err := json.Marshal(finalRuleVariables)
+	if err != nil {
+		return "", err
+	}
+	return string(jsonBytes), nil
+}
+
+// Import reads the rule groups of a provider namespace and creates a rule for
+// every set of provider rules that can be rendered from an existing template.
+// The remaining rules of a group are put in an ad-hoc template if
+// createTemplates is set, otherwise they are reported as unmapped. Rule groups
+// that already have siren rules are not imported. The ad-hoc templates and the
+// rules are created in one transaction.
+func (r Repository) Import(providerNamespace uint64, createTemplates, dryRun bool,
+	templatesService domain.TemplatesService) (*domain.RuleImportReport, error) {
+	data, err := getProviderOfNamespace(r.db, providerNamespace)
+	if err != nil {
+		return nil, err
+	}
+	client, err := newProviderAdapter(r.adapters, data.ProviderType, data.ProviderUrn, data.ProviderHost)
+	if err != nil {
+		return nil, err
+	}
+
+	var existingRules []Rule
+	result := r.db.Where("provider_namespace = ?", providerNamespace).Find(&existingRules)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	managedGroups := make(map[ruleGroupKey]bool)
+	for _, rule := range existingRules {
+		managedGroups[ruleGroupKey{namespace: rule.Namespace, groupName: rule.GroupName}] = true
+	}
+
+	existingTemplates, err := templatesService.Index("")
+	if err != nil {
+		return nil, err
+	}
+	templatesByName := make(map[string]domain.Template)
+	for _, template := range existingTemplates {
+		templatesByName[template.Name] = template
+	}
+	matchers := newTemplateMatchers(existingTemplates)
+
+	ruleGroups, err := client.ListRuleGroups(context.Background(), data.NamespaceUrn)
+	if err != nil {
+		return nil, err
+	}
+	namespaces := make([]string, 0, len(ruleGroups))
+	for namespace := range ruleGroups {
+		namespaces = append(namespaces, namespace)
+	}
+	sort.Strings(namespaces)
+
+	enabled := true
+	var rules []Rule
+	report := &domain.RuleImportReport{
+		Rules:            make([]domain.Rule, 0),
+		CreatedTemplates: make([]domain.Template, 0),
+		Unmapped:         make([]domain.UnmappedRule, 0),
+	}
+	for _, namespace := range namespaces {
+		for _, ruleGroup := range ruleGroups[namespace] {
+			unmapped := func(ruleNode rulefmt.RuleNode, reason string) {
+				report.Unmapped = append(report.Unmapped, domain.UnmappedRule{
+					Namespace: namespace,
+					GroupName: ruleGroup.Name,
+					Name:      ruleNodeName(ruleNode),
+					Reason:    reason,
+				})
+			}
+			newRule := func(template domain.Template, bindings map[string]string) error {
+				variables, err := importedRuleVariables(template, bindings)
+				if err != nil {
+					return err
+				}
+				rules = append(rules, Rule{
+					Name: fmt.Sprintf("%s_%s_%s_%s_%s_%s", namePrefix, data.ProviderUrn, data.NamespaceUrn,
+						namespace, ruleGroup.Name, template.Name),
+					Namespace:         namespace,
+					GroupName:         ruleGroup.Name,
+					Template:          template.Name,
+					Enabled:           &enabled,
+					Variables:         variables,
+					ProviderNamespace: providerNamespace,
+				})
+				return nil
+			}
+
+			if managedGroups[ruleGroupKey{namespace: namespace, groupName: ruleGroup.Name}] {
+				for _, ruleNode := range ruleGroup.Rules {
+					unmapped(ruleNode, "rule group is already managed by siren")
+				}
+				continue
+			}
+
+			consumed := make([]bool, len(ruleGroup.Rules))
+			for _, matcher := range matchers {
+				indexes, bindings, ok := matcher.match(ruleGroup.Rules, consumed)
+				if !ok {
+					continue
+				}
+				for _, i := range indexes {
+					consumed[i] = true
+				}
+				if err := newRule(matcher.template, bindings); err != nil {
+					return nil, err
+				}
+			}
+
+			var remainingNodes []rulefmt.RuleNode
+			for i, ruleNode := range ruleGroup.Rules {
+				if !consumed[i] {
+					remainingNodes = append(remainingNodes, ruleNode)
+				}
+			}
+			if len(remainingNodes) == 0 {
+				continue
+			}
+			if !createTemplates {
+				for _, ruleNode := range remainingNodes {
+					unmapped(ruleNode, "no template matches the rule")
+				}
+				continue
+			}
+
+			template, reason, err := importedTemplate(data.NamespaceUrn, namespace, ruleGroup, remainingNodes, templatesByName)
+			if err != nil {
+				return nil, err
+			}
+			if reason != "" {
+				for _, ruleNode := range remainingNodes {
+					unmapped(ruleNode, reason)
+				}
+				continue
+			}
+			if _, exists := templatesByName[template.Name]; !exists {
+				templatesByName[template.Name] = *template
+				report.CreatedTemplates = append(report.CreatedTemplates, *template)
+			}
+			if err := newRule(*template, nil); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	if !dryRun {
+		err = r.db.Transaction(func(tx *gorm.DB) error {
+			txTemplatesService := templates.NewService(tx, NewRepository(tx, r.adapters))
+			for i, template := range report.CreatedTemplates {
+				createdTemplate, _, err := txTemplatesService.Upsert(&template, false)
+				if err != nil {
+					return err
+				}
+				report.CreatedTemplates[i] = *createdTemplate
+			}
+			for i := range rules {
+				result := tx.Create(&rules[i])
+				if result.Error != nil {
+					return result.Error
+				}
+			}
+			return nil
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	for i := range rules {
+		rule, err := rules[i].toDomain()
+		if err != nil {
+			return nil, err
+		}
+		report.Rules = append(report.Rules, *rule)
+	}
+	return report, nil
+}
+
+// importedTemplate returns the ad-hoc template for the rules of a group that
+// did not match any template, or the reason they cannot be put in one
+func importedTemplate(tenantName, namespace string, ruleGroup rulefmt.RuleGroup, ruleNodes []rulefmt.RuleNode,
+	templatesByName map[string]domain.Template) (*domain.Template, string, error) {
+	body, err := importedTemplateBody(ruleNodes)
+	if err != nil {
+		return nil, "", err
+	}
+	template := &domain.Template{
+		Name:      importedTemplateName(tenantName, namespace, ruleGroup.Name),
+		Body:      body,
+		Tags:      []string{importedTemplateTag},
+		Variables: make([]domain.Variable, 0),
+	}
+	if existingTemplate, exists := templatesByName[template.Name]; exists {
+		if existingTemplate.Body != template.Body {
+			return nil, fmt.Sprintf("template %s already exists with a different body", template.Name), nil
+		}
+		return &existingTemplate, "", nil
+	}
+	renderedBody, err := templates.RenderBody(template, nil)
+	if err != nil || !sameRuleNodes(renderedBody, ruleNodes) {
+		return nil, "rule cannot be represented as a template", nil
+	}
+	return template, "", nil
+}
@@ -0,0 +1,291 @@
+package rules
+
+import (
+	"database/sql"
+	"errors"
+	"regexp"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/odpf/siren/domain"
+	"github.com/odpf/siren/mocks"
+	"github.com/odpf/siren/pkg/adapter"
+	"github.com/prometheus/prometheus/pkg/rulefmt"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+	"gopkg.in/yaml.v3"
+)
+
+type ImportTestSuite struct {
+	suite.Suite
+	sqldb      *sql.DB
+	dbmock     sqlmock.Sqlmock
+	repository RuleRepository
+	adapters   *adapter.Registry
+}
+
+func (s *ImportTestSuite) SetupTest() {
+	db, mock, _ := mocks.NewStore()
+	s.sqldb, _ = db.DB()
+	s.dbmock = mock
+	s.adapters = adapter.NewRegistry()
+	s.repository = NewRepository(db, s.adapters)
+}
+
+func (s *ImportTestSuite) TearDownTest() {
+	s.sqldb.Close()
+}
+
+func TestImport(t *testing.T) {
+	suite.Run(t, new(ImportTestSuite))
+}
+
+const cpuTemplateBody = `- alert: CPUHighWarning
+  expr: avg by (host) (cpu_usage_user{cpu="cpu-total"}) > [[.warning]]
+  for: '[[.for]]'
+  labels:
+    severity: WARNING
+    team: '[[.team]]'
+`
+
+var cpuTemplate = domain.Template{
+	Name: "cpu",
+	Body: cpuTemplateBody,
+	Tags: []string{"cpu"},
+	Variables: []domain.Variable{
+		{Name: "team", Type: "string", Default: "odpf"},
+		{Name: "warning", Type: "int", Default: "80"},
+		{Name: "for", Type: "string", Default: "5m"},
+	},
+}
+
+func ruleNodesOf(body string) []rulefmt.RuleNode {
+	var ruleNodes []rulefmt.RuleNode
+	_ = yaml.Unmarshal([]byte(body), &ruleNodes)
+	return ruleNodes
+}
+
+func (s *ImportTestSuite) TestImport() {
+	namespaceQuery := regexp.QuoteMeta(`SELECT namespaces.urn as namespace_urn, providers.urn as provider_urn, providers.type as provider_type, providers.host as provider_host FROM "namespaces" RIGHT JOIN providers on providers.id = namespaces.provider_id WHERE namespaces.id = $1`)
+	existingRulesQuery := regexp.QuoteMeta(`SELECT * FROM "rules" WHERE provider_namespace = $1`)
+	insertRuleQuery := regexp.QuoteMeta(`INSERT INTO "rules" ("created_at","updated_at","name","namespace","group_name","template","template_version","enabled","variables","provider_namespace") VALUES ($1,$2,$3,$4,$5,$6,$7,$8,$9,$10) RETURNING "id"`)
+	ruleColumns := []string{"id", "created_at", "updated_at", "name", "namespace", "group_name", "template", "enabled", "variables", "provider_namespace"}
+	namespaceRow := func(providerType string) *sqlmock.Rows {
+		return sqlmock.NewRows([]string{"namespace_urn", "provider_urn", "provider_type", "provider_host"}).
+			AddRow("tenant", "cortex-1", providerType, "http://cortex")
+	}
+	ruleGroups := map[string][]rulefmt.RuleGroup{
+		"foo": {{Name: "bar", Rules: ruleNodesOf(`- alert: CPUHighWarning
+  expr: avg by (host) (cpu_usage_user{cpu="cpu-total"}) > 90
+  for: 10m
+  labels:
+    severity: WARNING
+    team: gojek
+- alert: Other
+  expr: other_metric > 1
+`)}},
+	}
+	expectedRule := domain.Rule{
+		Name:      "siren_api_cortex-1_tenant_foo_bar_cpu",
+		Enabled:   true,
+		GroupName: "bar",
+		Namespace: "foo",
+		Template:  "cpu",
+		Variables: []domain.RuleVariable{
+			{Name: "team", Type: "string", Value: "gojek"},
+			{Name: "warning", Type: "int", Value: "90"},
+			{Name: "for", Type: "string", Value: "10m"},
+		},
+		ProviderNamespace: 1,
+	}
+	setupCortex := func(rules map[string][]rulefmt.RuleGroup, err error) {
+		mockClient := &adapter.AdapterMock{}
+		s.adapters.Register("cortex", func(string, string) (adapter.Adapter, error) {
+			return mockClient, nil
+		})
+		mockClient.On("ListRuleGroups", mock.Anything, "tenant").Return(rules, err)
+	}
+
+	s.Run("should create rules matching templates and report unmapped rules", func() {
+		setupCortex(ruleGroups, nil)
+		mockTemplateService := &mocks.TemplatesService{}
+		mockTemplateService.On("Index", "").Return([]domain.Template{cpuTemplate}, nil)
+
+		s.dbmock.ExpectQuery(namespaceQuery).WillReturnRows(namespaceRow("cortex"))
+		s.dbmock.ExpectQuery(existingRulesQuery).WillReturnRows(sqlmock.NewRows(ruleColumns))
+		s.dbmock.ExpectBegin()
+		s.dbmock.ExpectQuery(insertRuleQuery).
+			WithArgs(AnyTime{}, AnyTime{}, expectedRule.Name, "foo", "bar", "cpu", 0, true, sqlmock.AnyArg(), 1).
+			WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(20))
+		s.dbmock.ExpectCommit()
+
+		report, err := s.repository.Import(1, false, false, mockTemplateService)
+		s.Nil(err)
+		s.Equal(1, len(report.Rules))
+		s.Equal(uint64(20), report.Rules[0].Id)
+		report.Rules[0].Id = 0
+		report.Rules[0].CreatedAt = expectedRule.CreatedAt
+		report.Rules[0].UpdatedAt = expectedRule.UpdatedAt
+		s.Equal(expectedRule, report.Rules[0])
+		s.Empty(report.CreatedTemplates)
+		s.Equal([]domain.UnmappedRule{{
+			Namespace: "foo", GroupName: "bar", Name: "Other", Reason: "no template matches the rule",
+		}}, report.Unmapped)
+		mockTemplateService.AssertNotCalled(s.T(), "Upsert", mock.Anything)
+		if err := s.dbmock.ExpectationsWereMet(); err != nil {
+			s.T().Errorf("there were unfulfilled expectations: %s", err)
+		}
+	})
+
+	s.Run("should create ad-hoc templates for unmatched rules if enabled", func() {
+		setupCortex(ruleGroups, nil)
+		mockTemplateService := &mocks.TemplatesService{}
+		mockTemplateService.On("Index", "").Return([]domain.Template{cpuTemplate}, nil)
+		templateQuery := regexp.QuoteMeta(`SELECT * FROM "templates" WHERE name = 'imported_tenant_foo_bar'`)
+		insertTemplateQuery := regexp.QuoteMeta(`INSERT INTO "templates" ("created_at","updated_at","name","body","tags","variables","version") VALUES ($1,$2,$3,$4,$5,$6,$7) RETURNING "id"`)
+		insertVersionQuery := regexp.QuoteMeta(`INSERT INTO "template_versions" ("created_at","template_id","version","body","tags","variables") VALUES ($1,$2,$3,$4,$5,$6) RETURNING "id"`)
+		syncTemplateRulesQuery := regexp.QuoteMeta(`SELECT * FROM "rules" WHERE template = $1 ORDER BY provider_namespace, namespace, group_name`)
+		templateColumns := []string{"id", "name", "body", "tags", "variables", "version"}
+		body := "- alert: Other\n  expr: other_metric > 1\n"
+
+		s.dbmock.ExpectQuery(namespaceQuery).WillReturnRows(namespaceRow("cortex"))
+		s.dbmock.ExpectQuery(existingRulesQuery).WillReturnRows(sqlmock.NewRows(ruleColumns))
+		s.dbmock.ExpectBegin()
+		s.dbmock.ExpectExec("SAVEPOINT").WillReturnResult(sqlmock.NewResult(0, 0))
+		s.dbmock.ExpectQuery(templateQuery).WillReturnRows(sqlmock.NewRows(nil))
+		s.dbmock.ExpectQuery(insertTemplateQuery).
+			WithArgs(AnyTime{}, AnyTime{}, "imported_tenant_foo_bar", sqlmock.AnyArg(), sqlmock.AnyArg(), "[]", 1).
+			WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(5))
+		s.dbmock.ExpectQuery(templateQuery).WillReturnRows(sqlmock.NewRows(templateColumns).
+			AddRow(5, "imported_tenant_foo_bar", body, "{imported}", "[]", 1))
+		s.dbmock.ExpectQuery(insertVersionQuery).
+			WithArgs(AnyTime{}, 5, 1, body, sqlmock.AnyArg(), "[]").
+			WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1))
+		s.dbmock.ExpectQuery(syncTemplateRulesQuery).WithArgs("imported_tenant_foo_bar").
+			WillReturnRows(sqlmock.NewRows(ruleColumns))
+		s.dbmock.ExpectQuery(insertRuleQuery).
+			WithArgs(AnyTime{}, AnyTime{}, expectedRule.Name, "foo", "bar", "cpu", 0, true, sqlmock.AnyArg(), 1).
+			WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(20))
+		s.dbmock.ExpectQuery(insertRuleQuery).
+			WithArgs(AnyTime{}, AnyTime{}, "siren_api_cortex-1_tenant_foo_bar_imported_tenant_foo_bar",
+				"foo", "bar", "imported_tenant_foo_bar", 0, true, "[]", 1).
+			WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(21))
+		s.dbmock.ExpectCommit()
+
+		report, err := s.repository.Import(1, true, false, mockTemplateService)
+		s.Nil(err)
+		s.Equal(2, len(report.Rules))
+		s.Equal("imported_tenant_foo_bar", report.Rules[1].Template)
+		s.Equal(1, len(report.CreatedTemplates))
+		s.Equal(uint(5), report.CreatedTemplates[0].ID)
+		s.Equal("imported_tenant_foo_bar", report.CreatedTemplates[0].Name)
+		s.Equal([]string{"imported"}, report.CreatedTemplates[0].Tags)
+		s.Equal(ruleNodesOf("- alert: Other\n  expr: other_metric > 1\n"), ruleNodesOf(report.CreatedTemplates[0].Body))
+		s.Empty(report.Unmapped)
+		if err := s.dbmock.ExpectationsWereMet(); err != nil {
+			s.T().Errorf("there were unfulfilled expectations: %s", err)
+		}
+	})
+
+	s.Run("should not write anything in dry run", func() {
+		setupCortex(ruleGroups, nil)
+		mockTemplateService := &mocks.TemplatesService{}
+		mockTemplateService.On("Index", "").Return([]domain.Template{cpuTemplate}, nil)
+
+		s.dbmock.ExpectQuery(namespaceQuery).WillReturnRows(namespaceRow("cortex"))
+		s.dbmock.ExpectQuery(existingRulesQuery).WillReturnRows(sqlmock.NewRows(ruleColumns))
+
+		report, err := s.repository.Import(1, true, true, mockTemplateService)
+		s.Nil(err)
+		s.Equal(2, len(report.Rules))
+		s.Equal(1, len(report.CreatedTemplates))
+		mockTemplateService.AssertNotCalled(s.T(), "Upsert", mock.Anything)
+		if err := s.dbmock.ExpectationsWereMet(); err != nil {
+			s.T().Errorf("there were unfulfilled expectations: %s", err)
+		}
+	})
+
+	s.Run("should skip rule groups already managed by siren", func() {
+		setupCortex(ruleGroups, nil)
+		mockTemplateService := &mocks.TemplatesService{}
+		mockTemplateService.On("Index", "").Return([]domain.Template{cpuTemplate}, nil)
+
+		s.dbmock.ExpectQuery(namespaceQuery).WillReturnRows(namespaceRow("cortex"))
+		s.dbmock.ExpectQuery(existingRulesQuery).WillReturnRows(sqlmock.NewRows(ruleColumns).
+			AddRow(10, nil, nil, "siren_api_cortex-1_tenant_foo_bar_tmpl", "foo", "bar", "tmpl", true, `[]`, 1))
+		s.dbmock.ExpectBegin()
+		s.dbmock.ExpectCommit()
+
+		report, err := s.repository.Import(1, true, false, mockTemplateService)
+		s.Nil(err)
+		s.Empty(report.Rules)
+		s.Equal(2, len(report.Unmapped))
+		s.Equal("rule group is already managed by siren", report.Unmapped[0].Reason)
+	})
+
+	s.Run("should return error if provider is not supported", func() {
+		mockTemplateService := &mocks.TemplatesService{}
+		s.dbmock.ExpectQuery(namespaceQuery).WillReturnRows(namespaceRow("prometheus"))
+
+		report, err := s.repository.Import(1, false, false, mockTemplateService)
+		s.Nil(report)
+		s.EqualError(err, "provider not supported")
+	})
+
+	s.Run("should return error if listing rules from cortex fails", func() {
+		setupCortex(nil, errors.New("random error"))
+		mockTemplateService := &mocks.TemplatesService{}
+		mockTemplateService.On("Index", "").Return([]domain.Template{cpuTemplate}, nil)
+
+		s.dbmock.ExpectQuery(namespaceQuery).WillReturnRows(namespaceRow("cortex"))
+		s.dbmock.ExpectQuery(existingRulesQuery).WillReturnRows(sqlmock.NewRows(ruleColumns))
+
+		report, err := s.repository.Import(1, false, false, mockTemplateService)
+		s.Nil(report)
+		s.EqualError(err, "random error")
+	})
+}
+
+func TestTemplateMatcher(t *testing.T) {
+	matcher, err := newTemplateMatcher(cpuTemplate)
+	assert.Nil(t, err)
+
+	t.Run("should extract variables from a matching rule", func(t *testing.T) {
+		ruleNodes := ruleNodesOf(`- alert: CPUHighWarning
+  expr: avg by (host) (cpu_usage_user{cpu="cpu-total"}) > 70
+  for: 5m
+  labels:
+    severity: WARNING
+    team: odpf
+`)
+		indexes, bindings, ok := matcher.match(ruleNodes, make([]bool, len(ruleNodes)))
+		assert.True(t, ok)
+		assert.Equal(t, []int{0}, indexes)
+		assert.Equal(t, map[string]string{"team": "odpf", "warning": "70", "for": "5m"}, bindings)
+	})
+
+	t.Run("should not match a rule with different labels", func(t *testing.T) {
+		ruleNodes := ruleNodesOf(`- alert: CPUHighWarning
+  expr: avg by (host) (cpu_usage_user{cpu="cpu-total"}) > 70
+  for: 5m
+  labels:
+    severity: CRITICAL
+    team: odpf
+`)
+		_, _, ok := matcher.match(ruleNodes, make([]bool, len(ruleNodes)))
+		assert.False(t, ok)
+	})
+
+	t.Run("should not match consumed rules", func(t *testing.T) {
+		ruleNodes := ruleNodesOf(`- alert: CPUHighWarning
+  expr: avg by (host) (cpu_usage_user{cpu="cpu-total"}) > 70
+  for: 5m
+  labels:
+    severity: WARNING
+    team: odpf
+`)
+		_, _, ok := matcher.match(ruleNodes, []bool{true})
+		assert.False(t, ok)
+	})
+}
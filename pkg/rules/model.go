@@ -2,6 +2,7 @@ package rules
 
 import (
 	"encoding/json"
+	"github.com/lib/pq"
 	"github.com/odpf/siren/domain"
 	"github.com/odpf/siren/pkg/namespace"
 	"time"
@@ -15,12 +16,110 @@ type Rule struct {
 	Namespace             string `gorm:"uniqueIndex:unique_name"`
 	GroupName             string `gorm:"uniqueIndex:unique_name"`
 	Template              string `gorm:"uniqueIndex:unique_name"`
+	TemplateVersion       uint   `gorm:"default:0"`
 	Enabled               *bool
 	Variables             string               `gorm:"type:jsonb" sql:"type:jsonb"`
 	ProviderNamespace     uint64               `gorm:"uniqueIndex:unique_name"`
 	ProviderNamespaceInfo *namespace.Namespace `gorm:"foreignKey:ProviderNamespace"`
 }
 
+// RuleRevision is the stored snapshot of a rule at an upsert
+type RuleRevision struct {
+	Id              uint64 `gorm:"primarykey"`
+	CreatedAt       time.Time
+	RuleId          uint64 `gorm:"index"`
+	Enabled         bool
+	Variables       string `gorm:"type:jsonb" sql:"type:jsonb"`
+	TemplateHash    string
+	TemplateVersion uint
+	Rendered        string
+	Actor           string
+}
+
+// RuleGroup holds the settings of a rule group. Rule groups without stored
+// settings use the provider defaults.
+type RuleGroup struct {
+	Id                uint64 `gorm:"primarykey"`
+	CreatedAt         time.Time
+	UpdatedAt         time.Time
+	ProviderNamespace uint64 `gorm:"uniqueIndex:unique_rule_group"`
+	Namespace         string `gorm:"uniqueIndex:unique_rule_group"`
+	GroupName         string `gorm:"uniqueIndex:unique_rule_group"`
+	Interval          string
+	Limit             uint32
+	SourceTenants     pq.StringArray `gorm:"type:text[]"`
+}
+
+// TemplateRuleUsage is a rule of a template joined with its namespace and provider
+type TemplateRuleUsage struct {
+	Id                uint64
+	Name              string
+	Namespace         string
+	GroupName         string
+	TemplateVersion   uint
+	Enabled           bool
+	ProviderNamespace uint64
+	NamespaceUrn      string
+	ProviderUrn       string
+}
+
+// RuleExport holds the rules of a provider namespace, the stored settings of
+// their groups and the urns of the provider namespace and its provider
+type RuleExport struct {
+	NamespaceUrn string
+	ProviderUrn  string
+	Rules        []Rule
+	Groups       []RuleGroup
+}
+
+func (group *RuleGroup) fromDomain(g *domain.RuleGroup) *RuleGroup {
+	group.Id = g.Id
+	group.ProviderNamespace = g.ProviderNamespace
+	group.Namespace = g.Namespace
+	group.GroupName = g.GroupName
+	group.Interval = g.Interval
+	group.Limit = g.Limit
+	group.SourceTenants = g.SourceTenants
+	group.CreatedAt = g.CreatedAt
+	group.UpdatedAt = g.UpdatedAt
+	return group
+}
+
+func (group *RuleGroup) toDomain() *domain.RuleGroup {
+	sourceTenants := make([]string, 0, len(group.SourceTenants))
+	sourceTenants = append(sourceTenants, group.SourceTenants...)
+	return &domain.RuleGroup{
+		Id:                group.Id,
+		ProviderNamespace: group.ProviderNamespace,
+		Namespace:         group.Namespace,
+		GroupName:         group.GroupName,
+		Interval:          group.Interval,
+		Limit:             group.Limit,
+		SourceTenants:     sourceTenants,
+		CreatedAt:         group.CreatedAt,
+		UpdatedAt:         group.UpdatedAt,
+	}
+}
+
+func (revision *RuleRevision) toDomain() (*domain.RuleRevision, error) {
+	var variables []domain.RuleVariable
+	err := json.Unmarshal([]byte(revision.Variables), &variables)
+	if err != nil {
+		return nil, err
+	}
+	return &domain.RuleRevision{
+		Id:              revision.Id,
+		RuleId:          revision.RuleId,
+		Enabled:         revision.Enabled,
+		Variables:       variables,
+		TemplateHash:    revision.TemplateHash,
+		TemplateVersion: revision.TemplateVersion,
+		Rendered:        revision.Rendered,
+		Actor:           revision.Actor,
+		CreatedAt:       revision.CreatedAt,
+	}, nil
+}
+
 func (rule *Rule) fromDomain(r *domain.Rule) (*Rule, error) {
 	rule.Id = r.Id
 	rule.Name = r.Name
@@ -28,6 +127,7 @@ func (rule *Rule) fromDomain(r *domain.Rule) (*Rule, error) {
 	rule.GroupName = r.GroupName
 	rule.Namespace = r.Namespace
 	rule.Template = r.Template
+	rule.TemplateVersion = r.TemplateVersion
 
 	jsonString, err := json.Marshal(r.Variables)
 	if err != nil {
@@ -55,6 +155,7 @@ func (rule *Rule) toDomain() (*domain.Rule, error) {
 		GroupName:         rule.GroupName,
 		Namespace:         rule.Namespace,
 		Template:          rule.Template,
+		TemplateVersion:   rule.TemplateVersion,
 		Variables:         variables,
 		ProviderNamespace: rule.ProviderNamespace,
 		CreatedAt:         rule.CreatedAt,
@@ -64,7 +165,18 @@ func (rule *Rule) toDomain() (*domain.Rule, error) {
 
 //Repository interface
 type RuleRepository interface {
-	Upsert(*Rule, domain.TemplatesService) (*Rule, error)
+	Upsert(*Rule, string, domain.TemplatesService) (*Rule, error)
+	Diff(*Rule, domain.TemplatesService) (*domain.RuleGroupDiff, error)
 	Get(string, string, string, string, uint64) ([]Rule, error)
+	Delete(uint64, domain.TemplatesService) error
+	Import(uint64, bool, bool, domain.TemplatesService) (*domain.RuleImportReport, error)
+	ListRevisions(uint64) ([]RuleRevision, error)
+	Rollback(uint64, uint64, string, domain.TemplatesService) (*Rule, error)
+	GetGroup(uint64, string, string) (*RuleGroup, error)
+	UpsertGroup(*RuleGroup, domain.TemplatesService) (*RuleGroup, error)
+	UpgradeTemplateVersion(string, uint, uint, string, domain.TemplatesService) ([]Rule, error)
+	SyncTemplate(*domain.Template, bool, domain.TemplatesService) ([]domain.RuleGroupSync, error)
+	TemplateUsage(string) ([]TemplateRuleUsage, error)
+	Export(uint64, string, string) (*RuleExport, error)
 	Migrate() error
 }
@@ -0,0 +1,277 @@
+package rules
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/odpf/siren/domain"
+	"github.com/odpf/siren/pkg/adapter"
+	"github.com/odpf/siren/pkg/templates"
+	"github.com/prometheus/prometheus/pkg/rulefmt"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+)
+
+type metricRecorder interface {
+	RecordCustomMetric(name string, value float64)
+}
+
+type providerNamespace struct {
+	Id           uint64
+	NamespaceUrn string
+	ProviderUrn  string
+	ProviderType string
+	ProviderHost string
+}
+
+type ruleGroupKey struct {
+	namespace string
+	groupName string
+}
+
+// Reconciler periodically compares the rule groups rendered from the rules table
+// with the rule groups in the providers and optionally re-pushes the siren version
+type Reconciler struct {
+	db              *gorm.DB
+	adapters        *adapter.Registry
+	templateService domain.TemplatesService
+	config          domain.RuleReconcilerConfig
+	metrics         metricRecorder
+	logger          *zap.Logger
+
+	mu           sync.RWMutex
+	latestReport *domain.RuleDriftReport
+}
+
+const defaultReconcilerInterval = 5 * time.Minute
+
+// NewReconciler returns reconciler struct, falling back to the default interval
+// and to a concurrency of 1 for invalid config values
+func NewReconciler(db *gorm.DB, adapters *adapter.Registry, config domain.RuleReconcilerConfig, metrics metricRecorder,
+	logger *zap.Logger) *Reconciler {
+	if config.Interval <= 0 {
+		logger.Warn("invalid rule reconciler interval, using the default",
+			zap.Duration("interval", config.Interval), zap.Duration("default", defaultReconcilerInterval))
+		config.Interval = defaultReconcilerInterval
+	}
+	if config.Concurrency < 1 {
+		config.Concurrency = 1
+	}
+	if config.ConcurrencyPerTenant < 1 {
+		config.ConcurrencyPerTenant = 1
+	}
+	return &Reconciler{
+		db:              db,
+		adapters:        adapters,
+		templateService: templates.NewService(db, NewRepository(db, adapters)),
+		config:          config,
+		metrics:         metrics,
+		logger:          logger,
+	}
+}
+
+// Run reconciles the rules every configured interval until the context is done
+func (r *Reconciler) Run(ctx context.Context) {
+	ticker := time.NewTicker(r.config.Interval)
+	defer ticker.Stop()
+	for {
+		report, err := r.reconcile(r.config.Repair)
+		if err != nil {
+			r.logger.Error("rule reconciler", zap.Error(err))
+		} else {
+			r.mu.Lock()
+			r.latestReport = report
+			r.mu.Unlock()
+			r.recordMetrics(report)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Report returns the report of the latest reconciliation, or checks the drift
+// without repairing it if the reconciler has not run yet
+func (r *Reconciler) Report() (*domain.RuleDriftReport, error) {
+	r.mu.RLock()
+	report := r.latestReport
+	r.mu.RUnlock()
+	if report != nil {
+		return report, nil
+	}
+	return r.reconcile(false)
+}
+
+func (r *Reconciler) recordMetrics(report *domain.RuleDriftReport) {
+	repaired := 0
+	for _, drift := range report.Drifts {
+		if drift.Repaired {
+			repaired++
+		}
+	}
+	r.metrics.RecordCustomMetric("Siren/RuleReconciler/DriftedGroups", float64(len(report.Drifts)))
+	r.metrics.RecordCustomMetric("Siren/RuleReconciler/RepairedGroups", float64(repaired))
+	r.metrics.RecordCustomMetric("Siren/RuleReconciler/Errors", float64(len(report.Errors)))
+}
+
+func (r *Reconciler) reconcile(repair bool) (*domain.RuleDriftReport, error) {
+	var namespaces []providerNamespace
+	result := r.db.Table("namespaces").
+		Select("namespaces.id as id, namespaces.urn as namespace_urn, providers.urn as provider_urn, providers.type as provider_type, providers.host as provider_host").
+		Joins("JOIN providers on providers.id = namespaces.provider_id").
+		Where("providers.type IN ?", r.adapters.Types()).
+		Find(&namespaces)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+
+	var rules []Rule
+	result = r.db.Order("id").Find(&rules)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	rulesOfNamespace := make(map[uint64][]Rule)
+	for _, rule := range rules {
+		rulesOfNamespace[rule.ProviderNamespace] = append(rulesOfNamespace[rule.ProviderNamespace], rule)
+	}
+
+	report := &domain.RuleDriftReport{
+		CheckedAt: time.Now(),
+		Drifts:    make([]domain.RuleDrift, 0),
+		Errors:    make([]string, 0),
+	}
+	semaphore := make(chan struct{}, r.config.Concurrency)
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+	for _, namespace := range namespaces {
+		if len(rulesOfNamespace[namespace.Id]) == 0 {
+			continue
+		}
+		wg.Add(1)
+		semaphore <- struct{}{}
+		go func(namespace providerNamespace, rules []Rule) {
+			defer func() {
+				<-semaphore
+				wg.Done()
+			}()
+			drifts, errs := r.reconcileNamespace(namespace, rules, repair)
+			mu.Lock()
+			report.Drifts = append(report.Drifts, drifts...)
+			report.Errors = append(report.Errors, errs...)
+			mu.Unlock()
+		}(namespace, rulesOfNamespace[namespace.Id])
+	}
+	wg.Wait()
+
+	sort.Slice(report.Drifts, func(i, j int) bool {
+		a, b := report.Drifts[i], report.Drifts[j]
+		if a.ProviderNamespace != b.ProviderNamespace {
+			return a.ProviderNamespace < b.ProviderNamespace
+		}
+		if a.Diff.Namespace != b.Diff.Namespace {
+			return a.Diff.Namespace < b.Diff.Namespace
+		}
+		return a.Diff.GroupName < b.Diff.GroupName
+	})
+	sort.Strings(report.Errors)
+	return report, nil
+}
+
+func (r *Reconciler) reconcileNamespace(namespace providerNamespace, rules []Rule, repair bool) ([]domain.RuleDrift, []string) {
+	errorf := func(format string, a ...interface{}) string {
+		return fmt.Sprintf("provider namespace %d: %s", namespace.Id, fmt.Sprintf(format, a...))
+	}
+
+	client, err := newProviderAdapter(r.adapters, namespace.ProviderType, namespace.ProviderUrn, namespace.ProviderHost)
+	if err != nil {
+		return nil, []string{errorf("%s", err)}
+	}
+	currentRuleGroups, err := client.ListRuleGroups(context.Background(), namespace.NamespaceUrn)
+	if err != nil {
+		return nil, []string{errorf("%s", err)}
+	}
+
+	var keys []ruleGroupKey
+	rulesWithinGroups := make(map[ruleGroupKey][]Rule)
+	for _, rule := range rules {
+		key := ruleGroupKey{namespace: rule.Namespace, groupName: rule.GroupName}
+		if _, exists := rulesWithinGroups[key]; !exists {
+			keys = append(keys, key)
+		}
+		rulesWithinGroups[key] = append(rulesWithinGroups[key], rule)
+	}
+
+	var drifts []domain.RuleDrift
+	var errs []string
+	var driftedKeys []ruleGroupKey
+	for _, key := range keys {
+		desiredRuleNodes, err := renderRuleGroup(rulesWithinGroups[key], r.templateService, client)
+		if err != nil {
+			errs = append(errs, errorf("rule group %s/%s: %s", key.namespace, key.groupName, err))
+			continue
+		}
+		currentRuleNodes := findRuleNodes(currentRuleGroups[key.namespace], key.groupName)
+		added, changed, removed, err := diffRuleNodes(currentRuleNodes, desiredRuleNodes)
+		if err != nil {
+			errs = append(errs, errorf("rule group %s/%s: %s", key.namespace, key.groupName, err))
+			continue
+		}
+		if len(added) == 0 && len(changed) == 0 && len(removed) == 0 {
+			continue
+		}
+		drifts = append(drifts, domain.RuleDrift{
+			ProviderNamespace: namespace.Id,
+			Diff: domain.RuleGroupDiff{
+				Namespace: key.namespace,
+				GroupName: key.groupName,
+				Added:     added,
+				Changed:   changed,
+				Removed:   removed,
+			},
+		})
+		driftedKeys = append(driftedKeys, key)
+	}
+	if !repair {
+		return drifts, errs
+	}
+
+	semaphore := make(chan struct{}, r.config.ConcurrencyPerTenant)
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+	for i, key := range driftedKeys {
+		wg.Add(1)
+		semaphore <- struct{}{}
+		go func(i int, key ruleGroupKey) {
+			defer func() {
+				<-semaphore
+				wg.Done()
+			}()
+			rulesWithinGroup := rulesWithinGroups[key]
+			err := postRuleGroupWith(r.db, &rulesWithinGroup[0], rulesWithinGroup, client, r.templateService, namespace.NamespaceUrn)
+			mu.Lock()
+			defer mu.Unlock()
+			if err != nil {
+				errs = append(errs, errorf("rule group %s/%s: %s", key.namespace, key.groupName, err))
+				return
+			}
+			drifts[i].Repaired = true
+		}(i, key)
+	}
+	wg.Wait()
+	return drifts, errs
+}
+
+func findRuleNodes(ruleGroups []rulefmt.RuleGroup, groupName string) []rulefmt.RuleNode {
+	for _, ruleGroup := range ruleGroups {
+		if ruleGroup.Name == groupName {
+			return ruleGroup.Rules
+		}
+	}
+	return nil
+}
@@ -0,0 +1,246 @@
+package rules
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/odpf/siren/domain"
+	"github.com/odpf/siren/mocks"
+	"github.com/odpf/siren/pkg/adapter"
+	"github.com/prometheus/prometheus/pkg/rulefmt"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/zap/zaptest"
+	"gopkg.in/yaml.v3"
+)
+
+type metricRecorderMock struct {
+	metrics map[string]float64
+}
+
+func (m *metricRecorderMock) RecordCustomMetric(name string, value float64) {
+	m.metrics[name] = value
+}
+
+type ReconcilerTestSuite struct {
+	suite.Suite
+	sqldb      *sql.DB
+	dbmock     sqlmock.Sqlmock
+	metrics    *metricRecorderMock
+	adapters   *adapter.Registry
+	reconciler *Reconciler
+}
+
+func (s *ReconcilerTestSuite) SetupTest() {
+	db, mock, _ := mocks.NewStore()
+	s.sqldb, _ = db.DB()
+	s.dbmock = mock
+	s.metrics = &metricRecorderMock{metrics: make(map[string]float64)}
+	s.adapters = adapter.NewRegistry()
+	s.reconciler = NewReconciler(db, s.adapters, domain.RuleReconcilerConfig{
+		Interval:             time.Hour,
+		ConcurrencyPerTenant: 2,
+	}, s.metrics, zaptest.NewLogger(s.T()))
+}
+
+func (s *ReconcilerTestSuite) TearDownTest() {
+	s.sqldb.Close()
+}
+
+func TestReconciler(t *testing.T) {
+	suite.Run(t, new(ReconcilerTestSuite))
+}
+
+func (s *ReconcilerTestSuite) TestReconcile() {
+	var truebool = true
+	namespacesQuery := regexp.QuoteMeta(`SELECT namespaces.id as id, namespaces.urn as namespace_urn, providers.urn as provider_urn, providers.type as provider_type, providers.host as provider_host FROM "namespaces" JOIN providers on providers.id = namespaces.provider_id WHERE providers.type IN ($1)`)
+	rulesQuery := regexp.QuoteMeta(`SELECT * FROM "rules" ORDER BY id`)
+	ruleColumns := []string{"id", "created_at", "updated_at", "name", "namespace", "group_name", "template", "enabled", "variables", "provider_namespace"}
+	namespaceRows := func() *sqlmock.Rows {
+		return sqlmock.NewRows([]string{"id", "namespace_urn", "provider_urn", "provider_type", "provider_host"}).
+			AddRow(1, "tenant", "bar", "cortex", "http://cortex")
+	}
+	ruleRows := func() *sqlmock.Rows {
+		return sqlmock.NewRows(ruleColumns).
+			AddRow(10, time.Now(), time.Now(), "siren_api_bar_tenant_foo_drifted_tmpl", "foo", "drifted", "tmpl", truebool, `[]`, 1).
+			AddRow(11, time.Now(), time.Now(), "siren_api_bar_tenant_foo_synced_tmpl2", "foo", "synced", "tmpl2", truebool, `[]`, 1)
+	}
+	driftedBody := "- alert: Test\n  expr: test_metric > 10\n"
+	syncedBody := "- alert: Test2\n  expr: test_metric > 20\n"
+	ruleGroupOf := func(name, body string) rulefmt.RuleGroup {
+		var ruleNodes []rulefmt.RuleNode
+		_ = yaml.Unmarshal([]byte(body), &ruleNodes)
+		return rulefmt.RuleGroup{Name: name, Rules: ruleNodes}
+	}
+	currentRuleGroups := map[string][]rulefmt.RuleGroup{
+		"foo": {
+			ruleGroupOf("drifted", "- alert: Test\n  expr: test_metric > 5\n"),
+			ruleGroupOf("synced", syncedBody),
+		},
+	}
+	newTemplateService := func() *mocks.TemplatesService {
+		templateService := &mocks.TemplatesService{}
+		templateService.On("Render", "tmpl", mock.Anything).Return(driftedBody, nil)
+		templateService.On("Render", "tmpl2", mock.Anything).Return(syncedBody, nil)
+		return templateService
+	}
+	expectedDrift := domain.RuleDrift{
+		ProviderNamespace: 1,
+		Diff: domain.RuleGroupDiff{
+			Namespace: "foo",
+			GroupName: "drifted",
+			Added:     []domain.RuleDiff{},
+			Changed: []domain.RuleDiff{{
+				Name:    "Test",
+				Current: "alert: Test\nexpr: test_metric > 5\n",
+				Desired: "alert: Test\nexpr: test_metric > 10\n",
+			}},
+			Removed: []domain.RuleDiff{},
+		},
+	}
+
+	s.Run("should report drifted rule groups without repairing them", func() {
+		mockClient := &adapter.AdapterMock{}
+		s.adapters.Register("cortex", func(string, string) (adapter.Adapter, error) {
+			return mockClient, nil
+		})
+		mockClient.On("ListRuleGroups", mock.Anything, "tenant").Return(currentRuleGroups, nil)
+		s.reconciler.templateService = newTemplateService()
+
+		s.dbmock.ExpectQuery(namespacesQuery).WillReturnRows(namespaceRows())
+		s.dbmock.ExpectQuery(rulesQuery).WillReturnRows(ruleRows())
+		report, err := s.reconciler.reconcile(false)
+		s.Nil(err)
+		s.Equal([]domain.RuleDrift{expectedDrift}, report.Drifts)
+		s.Empty(report.Errors)
+		mockClient.AssertNotCalled(s.T(), "UpsertRuleGroup", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+		if err := s.dbmock.ExpectationsWereMet(); err != nil {
+			s.T().Errorf("there were unfulfilled expectations: %s", err)
+		}
+	})
+
+	s.Run("should re-push drifted rule groups if repair is enabled", func() {
+		mockClient := &adapter.AdapterMock{}
+		s.adapters.Register("cortex", func(string, string) (adapter.Adapter, error) {
+			return mockClient, nil
+		})
+		mockClient.On("ListRuleGroups", mock.Anything, "tenant").Return(currentRuleGroups, nil)
+		mockClient.On("UpsertRuleGroup", mock.Anything, mock.Anything, "foo", mock.Anything).Return(nil)
+		s.reconciler.templateService = newTemplateService()
+
+		s.dbmock.ExpectQuery(namespacesQuery).WillReturnRows(namespaceRows())
+		s.dbmock.ExpectQuery(rulesQuery).WillReturnRows(ruleRows())
+		s.dbmock.ExpectQuery(selectRuleGroupQuery).WillReturnRows(sqlmock.NewRows(nil))
+		report, err := s.reconciler.reconcile(true)
+		s.Nil(err)
+		repairedDrift := expectedDrift
+		repairedDrift.Repaired = true
+		s.Equal([]domain.RuleDrift{repairedDrift}, report.Drifts)
+		s.Empty(report.Errors)
+		mockClient.AssertNumberOfCalls(s.T(), "UpsertRuleGroup", 1)
+	})
+
+	s.Run("should report error if repairing a rule group fails", func() {
+		mockClient := &adapter.AdapterMock{}
+		s.adapters.Register("cortex", func(string, string) (adapter.Adapter, error) {
+			return mockClient, nil
+		})
+		mockClient.On("ListRuleGroups", mock.Anything, "tenant").Return(currentRuleGroups, nil)
+		mockClient.On("UpsertRuleGroup", mock.Anything, mock.Anything, "foo", mock.Anything).Return(errors.New("random error"))
+		s.reconciler.templateService = newTemplateService()
+
+		s.dbmock.ExpectQuery(namespacesQuery).WillReturnRows(namespaceRows())
+		s.dbmock.ExpectQuery(rulesQuery).WillReturnRows(ruleRows())
+		s.dbmock.ExpectQuery(selectRuleGroupQuery).WillReturnRows(sqlmock.NewRows(nil))
+		report, err := s.reconciler.reconcile(true)
+		s.Nil(err)
+		s.Equal([]domain.RuleDrift{expectedDrift}, report.Drifts)
+		s.Equal([]string{"provider namespace 1: rule group foo/drifted: random error"}, report.Errors)
+	})
+
+	s.Run("should report all rules as added if tenant has no rule groups in cortex", func() {
+		mockClient := &adapter.AdapterMock{}
+		s.adapters.Register("cortex", func(string, string) (adapter.Adapter, error) {
+			return mockClient, nil
+		})
+		mockClient.On("ListRuleGroups", mock.Anything, "tenant").Return(map[string][]rulefmt.RuleGroup{}, nil)
+		s.reconciler.templateService = newTemplateService()
+
+		s.dbmock.ExpectQuery(namespacesQuery).WillReturnRows(namespaceRows())
+		s.dbmock.ExpectQuery(rulesQuery).WillReturnRows(ruleRows())
+		report, err := s.reconciler.reconcile(false)
+		s.Nil(err)
+		s.Equal(2, len(report.Drifts))
+		s.Equal("drifted", report.Drifts[0].Diff.GroupName)
+		s.Equal(1, len(report.Drifts[0].Diff.Added))
+		s.Equal("synced", report.Drifts[1].Diff.GroupName)
+		s.Equal(1, len(report.Drifts[1].Diff.Added))
+	})
+
+	s.Run("should report error if listing rules from cortex fails", func() {
+		mockClient := &adapter.AdapterMock{}
+		s.adapters.Register("cortex", func(string, string) (adapter.Adapter, error) {
+			return mockClient, nil
+		})
+		mockClient.On("ListRuleGroups", mock.Anything, "tenant").Return(nil, errors.New("random error"))
+		s.reconciler.templateService = newTemplateService()
+
+		s.dbmock.ExpectQuery(namespacesQuery).WillReturnRows(namespaceRows())
+		s.dbmock.ExpectQuery(rulesQuery).WillReturnRows(ruleRows())
+		report, err := s.reconciler.reconcile(false)
+		s.Nil(err)
+		s.Empty(report.Drifts)
+		s.Equal([]string{"provider namespace 1: random error"}, report.Errors)
+	})
+
+	s.Run("should return error if fetching namespaces fails", func() {
+		s.dbmock.ExpectQuery(namespacesQuery).WillReturnError(errors.New("random error"))
+		report, err := s.reconciler.reconcile(false)
+		s.Nil(report)
+		s.EqualError(err, "random error")
+	})
+}
+
+func (s *ReconcilerTestSuite) TestRun() {
+	s.Run("should store the report and record metrics until context is done", func() {
+		mockClient := &adapter.AdapterMock{}
+		s.adapters.Register("cortex", func(string, string) (adapter.Adapter, error) {
+			return mockClient, nil
+		})
+		s.dbmock.ExpectQuery(regexp.QuoteMeta(`FROM "namespaces"`)).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "namespace_urn", "provider_urn", "provider_type", "provider_host"}))
+		s.dbmock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "rules" ORDER BY id`)).
+			WillReturnRows(sqlmock.NewRows(nil))
+
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		s.reconciler.Run(ctx)
+
+		report, err := s.reconciler.Report()
+		s.Nil(err)
+		s.Empty(report.Drifts)
+		s.Equal(float64(0), s.metrics.metrics["Siren/RuleReconciler/DriftedGroups"])
+		s.Contains(s.metrics.metrics, "Siren/RuleReconciler/Errors")
+		if err := s.dbmock.ExpectationsWereMet(); err != nil {
+			s.T().Errorf("there were unfulfilled expectations: %s", err)
+		}
+	})
+}
+
+func (s *ReconcilerTestSuite) TestNewReconciler() {
+	s.Run("should fall back to the default interval and a concurrency of 1 for invalid config", func() {
+		reconciler := NewReconciler(nil, s.adapters, domain.RuleReconcilerConfig{
+			Interval:             -time.Second,
+			Concurrency:          0,
+			ConcurrencyPerTenant: -1,
+		}, s.metrics, zaptest.NewLogger(s.T()))
+		s.Equal(5*time.Minute, reconciler.config.Interval)
+		s.Equal(1, reconciler.config.Concurrency)
+		s.Equal(1, reconciler.config.ConcurrencyPerTenant)
+	})
+}
@@ -2,15 +2,19 @@ package rules
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"encoding/json"
 	"errors"
 	"fmt"
-	cortexClient "github.com/grafana/cortex-tools/pkg/client"
-	"github.com/grafana/cortex-tools/pkg/rules/rwrulefmt"
 	"github.com/odpf/siren/domain"
+	"github.com/odpf/siren/pkg/adapter"
+	"github.com/odpf/siren/pkg/templates"
+	"github.com/prometheus/common/model"
 	"github.com/prometheus/prometheus/pkg/rulefmt"
 	"gopkg.in/yaml.v3"
 	"gorm.io/gorm"
+	"strings"
 )
 
 const (
@@ -34,47 +38,75 @@ type RuleResponse struct {
 	ProviderHost string
 }
 
-type cortexCaller interface {
-	CreateRuleGroup(ctx context.Context, namespace string, rg rwrulefmt.RuleGroup) error
-	DeleteRuleGroup(ctx context.Context, namespace, groupName string) error
-	GetRuleGroup(ctx context.Context, namespace, groupName string) (*rwrulefmt.RuleGroup, error)
-	ListRules(ctx context.Context, namespace string) (map[string][]rwrulefmt.RuleGroup, error)
+// Repository talks to the store to read or insert data
+type Repository struct {
+	db       *gorm.DB
+	adapters *adapter.Registry
 }
 
-func newCortexClient(host string) (cortexCaller, error) {
-	cortexConfig := cortexClient.Config{
-		Address:         host,
-		UseLegacyRoutes: false,
-	}
-
-	client, err := cortexClient.New(cortexConfig)
-	if err != nil {
-		return nil, err
-	}
-
-	return client, nil
+// NewRepository returns repository struct
+func NewRepository(db *gorm.DB, adapters *adapter.Registry) *Repository {
+	return &Repository{db: db, adapters: adapters}
 }
 
-// Repository talks to the store to read or insert data
-type Repository struct {
-	db *gorm.DB
+// getProviderOfNamespace returns the urns of a provider namespace and of its
+// provider, along with the type and host of the provider
+func getProviderOfNamespace(db *gorm.DB, providerNamespace uint64) (*RuleResponse, error) {
+	var data RuleResponse
+	result := db.Table("namespaces").
+		Select("namespaces.urn as namespace_urn, providers.urn as provider_urn, providers.type as provider_type, providers.host as provider_host").
+		Joins("RIGHT JOIN providers on providers.id = namespaces.provider_id").
+		Where("namespaces.id = ?", providerNamespace).
+		Find(&data)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	if result.RowsAffected == 0 {
+		return nil, errors.New("provider not found")
+	}
+	return &data, nil
 }
 
-// NewRepository returns repository struct
-func NewRepository(db *gorm.DB) *Repository {
-	return &Repository{db: db}
+func newProviderAdapter(adapters *adapter.Registry, providerType, providerUrn, host string) (adapter.Adapter, error) {
+	newAdapter, ok := adapters.Get(providerType)
+	if !ok {
+		return nil, errors.New("provider not supported")
+	}
+	return newAdapter(providerUrn, host)
 }
 
 func (r Repository) Migrate() error {
-	err := r.db.AutoMigrate(&Rule{})
+	err := r.db.AutoMigrate(&Rule{}, &RuleRevision{}, &RuleGroup{})
 	if err != nil {
 		return err
 	}
 	return nil
 }
 
-func postRuleGroupWith(rule *Rule, rulesWithinGroup []Rule, client cortexCaller, templateService domain.TemplatesService, tenantName string) error {
-	renderedBodyForThisGroup := ""
+// validateRuleNode validates a rule node with the adapter if it is a
+// RuleValidator, or else with rulefmt, which also parses its PromQL expression
+// and locates its errors by the group and position of the rule node
+func validateRuleNode(client adapter.Adapter, group string, position int, ruleNode rulefmt.RuleNode) []error {
+	if validator, ok := client.(adapter.RuleValidator); ok {
+		return validator.ValidateRuleNode(ruleNode)
+	}
+	var errs []error
+	for _, nodeErr := range ruleNode.Validate() {
+		errs = append(errs, &rulefmt.Error{
+			Group:    group,
+			Rule:     position,
+			RuleName: ruleNodeName(ruleNode),
+			Err:      nodeErr,
+		})
+	}
+	return errs
+}
+
+// renderRuleGroup renders the enabled rules of a group and validates every
+// resulting rule node for the provider of the client
+func renderRuleGroup(rulesWithinGroup []Rule, templateService domain.TemplatesService, client adapter.Adapter) ([]rulefmt.RuleNode, error) {
+	var ruleNodes []rulefmt.RuleNode
+	templateOfRuleNode := make(map[string]string)
 	for i := 0; i < len(rulesWithinGroup); i++ {
 		if *rulesWithinGroup[i].Enabled == false {
 			continue
@@ -86,40 +118,207 @@ func postRuleGroupWith(rule *Rule, rulesWithinGroup []Rule, client cortexCaller,
 		for _, v := range variables {
 			inputValue[v.Name] = v.Value
 		}
-		renderedBody, err := templateService.Render(rulesWithinGroup[i].Template, inputValue)
+		renderedBody, err := renderRule(&rulesWithinGroup[i], inputValue, templateService)
 		if err != nil {
-			return err
+			return nil, err
 		}
-		renderedBodyForThisGroup += renderedBody
-	}
-	ctx := cortexClient.NewContextWithTenantId(context.Background(), tenantName)
-	if renderedBodyForThisGroup == "" {
-		err := client.DeleteRuleGroup(ctx, rule.Namespace, rule.GroupName)
+		var renderedRuleNodes []rulefmt.RuleNode
+		err = yaml.Unmarshal([]byte(renderedBody), &renderedRuleNodes)
 		if err != nil {
-			if err.Error() == "requested resource not found" {
-				return nil
-			} else {
-				return err
+			return nil, &domain.InvalidRuleError{
+				Template:  rulesWithinGroup[i].Template,
+				Variables: inputValue,
+				Rule:      rulesWithinGroup[i].Name,
+				Reason:    err.Error(),
 			}
 		}
-		return nil
+		for _, ruleNode := range renderedRuleNodes {
+			if ruleNode.Alert.Value == "" && ruleNode.Record.Value == "" && ruleNode.Expr.Value == "" {
+				continue
+			}
+			name := ruleNodeName(ruleNode)
+			var reasons []string
+			for _, nodeErr := range validateRuleNode(client, rulesWithinGroup[i].GroupName, len(ruleNodes)+1, ruleNode) {
+				reasons = append(reasons, nodeErr.Error())
+			}
+			if otherTemplate, exists := templateOfRuleNode[name]; exists {
+				reasons = append(reasons, fmt.Sprintf("duplicate rule name in group, also rendered from template %s", otherTemplate))
+			}
+			if len(reasons) != 0 {
+				return nil, &domain.InvalidRuleError{
+					Template:  rulesWithinGroup[i].Template,
+					Variables: inputValue,
+					Rule:      name,
+					Reason:    strings.Join(reasons, "; "),
+				}
+			}
+			templateOfRuleNode[name] = rulesWithinGroup[i].Template
+			ruleNodes = append(ruleNodes, ruleNode)
+		}
 	}
-	var ruleNodes []rulefmt.RuleNode
-	err := yaml.Unmarshal([]byte(renderedBodyForThisGroup), &ruleNodes)
+	return ruleNodes, nil
+}
+
+// renderRule renders a rule from the template version it is pinned to, or
+// else from the latest version of its template
+func renderRule(rule *Rule, variables map[string]string, templateService domain.TemplatesService) (string, error) {
+	if rule.TemplateVersion == 0 {
+		return templateService.Render(rule.Template, variables)
+	}
+	template, err := getTemplateVersion(rule.Template, rule.TemplateVersion, templateService)
+	if err != nil {
+		return "", err
+	}
+	return templates.RenderBody(template, variables)
+}
+
+func getTemplateVersion(name string, version uint, templatesService domain.TemplatesService) (*domain.Template, error) {
+	template, err := templatesService.GetVersion(name, version)
+	if err != nil {
+		return nil, err
+	}
+	if template == nil {
+		return nil, fmt.Errorf("version %d of template %s not found", version, name)
+	}
+	return template, nil
+}
+
+func postRuleGroupWith(db *gorm.DB, rule *Rule, rulesWithinGroup []Rule, client adapter.Adapter, templateService domain.TemplatesService, tenantName string) error {
+	ruleNodes, err := renderRuleGroup(rulesWithinGroup, templateService, client)
 	if err != nil {
 		return err
 	}
-	y := rwrulefmt.RuleGroup{
-		RuleGroup: rulefmt.RuleGroup{
-			Name:  rule.GroupName,
-			Rules: ruleNodes,
-		},
+	ctx := context.Background()
+	if len(ruleNodes) == 0 {
+		return client.DeleteRuleGroup(ctx, tenantName, rule.Namespace, rule.GroupName)
+	}
+	y, err := newRuleGroupWithSettings(db, rule.ProviderNamespace, rule.Namespace, rule.GroupName)
+	if err != nil {
+		return err
 	}
-	err = client.CreateRuleGroup(ctx, rule.Namespace, y)
+	y.Rules = ruleNodes
+	err = client.UpsertRuleGroup(ctx, tenantName, rule.Namespace, y)
 	return err
 }
 
-func mergeRuleVariablesWithDefaults(templateVariables []domain.Variable, ruleVariables []domain.RuleVariable) []domain.RuleVariable {
+// newRuleGroupWithSettings returns a rule group without rules, carrying the
+// settings stored for it
+func newRuleGroupWithSettings(db *gorm.DB, providerNamespace uint64, namespace, groupName string) (adapter.RuleGroup, error) {
+	ruleGroup := adapter.RuleGroup{RuleGroup: rulefmt.RuleGroup{Name: groupName}}
+	var settings RuleGroup
+	result := db.Where("provider_namespace = ? AND namespace = ? AND group_name = ?",
+		providerNamespace, namespace, groupName).Find(&settings)
+	if result.Error != nil {
+		return ruleGroup, result.Error
+	}
+	if settings.Interval != "" {
+		interval, err := model.ParseDuration(settings.Interval)
+		if err != nil {
+			return ruleGroup, err
+		}
+		ruleGroup.Interval = interval
+	}
+	ruleGroup.Limit = int(settings.Limit)
+	if len(settings.SourceTenants) != 0 {
+		ruleGroup.SourceTenants = settings.SourceTenants
+	}
+	return ruleGroup, nil
+}
+
+func validateRuleGroup(group *RuleGroup) error {
+	var reasons []string
+	if group.Interval != "" {
+		interval, err := model.ParseDuration(group.Interval)
+		if err != nil {
+			reasons = append(reasons, fmt.Sprintf("invalid interval: %s", err))
+		} else if interval == 0 {
+			reasons = append(reasons, "interval must be greater than 0")
+		}
+	}
+	for _, tenant := range group.SourceTenants {
+		if tenant == "" {
+			reasons = append(reasons, "source tenants must not be empty")
+			break
+		}
+	}
+	if len(reasons) != 0 {
+		return &domain.InvalidRuleGroupError{
+			Namespace: group.Namespace,
+			GroupName: group.GroupName,
+			Reason:    strings.Join(reasons, "; "),
+		}
+	}
+	return nil
+}
+
+func ruleNodeName(ruleNode rulefmt.RuleNode) string {
+	if ruleNode.Alert.Value != "" {
+		return ruleNode.Alert.Value
+	}
+	return ruleNode.Record.Value
+}
+
+func ruleNodeToRule(ruleNode rulefmt.RuleNode) rulefmt.Rule {
+	return rulefmt.Rule{
+		Record:      ruleNode.Record.Value,
+		Alert:       ruleNode.Alert.Value,
+		Expr:        ruleNode.Expr.Value,
+		For:         ruleNode.For,
+		Labels:      ruleNode.Labels,
+		Annotations: ruleNode.Annotations,
+	}
+}
+
+func ruleNodeToYaml(ruleNode rulefmt.RuleNode) (string, error) {
+	out, err := yaml.Marshal(ruleNodeToRule(ruleNode))
+	if err != nil {
+		return "", err
+	}
+	return string(out), nil
+}
+
+func diffRuleNodes(current, desired []rulefmt.RuleNode) (added, changed, removed []domain.RuleDiff, err error) {
+	added = make([]domain.RuleDiff, 0)
+	changed = make([]domain.RuleDiff, 0)
+	removed = make([]domain.RuleDiff, 0)
+
+	currentByName := make(map[string]string)
+	for _, ruleNode := range current {
+		body, err := ruleNodeToYaml(ruleNode)
+		if err != nil {
+			return nil, nil, nil, err
+		}
+		currentByName[ruleNodeName(ruleNode)] = body
+	}
+
+	desiredByName := make(map[string]bool)
+	for _, ruleNode := range desired {
+		name := ruleNodeName(ruleNode)
+		desiredByName[name] = true
+		body, err := ruleNodeToYaml(ruleNode)
+		if err != nil {
+			return nil, nil, nil, err
+		}
+		currentBody, exists := currentByName[name]
+		if !exists {
+			added = append(added, domain.RuleDiff{Name: name, Desired: body})
+		} else if currentBody != body {
+			changed = append(changed, domain.RuleDiff{Name: name, Current: currentBody, Desired: body})
+		}
+	}
+
+	for _, ruleNode := range current {
+		name := ruleNodeName(ruleNode)
+		if !desiredByName[name] {
+			removed = append(removed, domain.RuleDiff{Name: name, Current: currentByName[name]})
+		}
+	}
+	return added, changed, removed, nil
+}
+
+// mergeRuleVariablesWithDefaults fills the variables a rule does not set with
+// the template defaults and checks every value against its template variable
+func mergeRuleVariablesWithDefaults(templateVariables []domain.Variable, ruleVariables []domain.RuleVariable) ([]domain.RuleVariable, error) {
 	var finalRuleVariables []domain.RuleVariable
 	for j := 0; j < len(templateVariables); j++ {
 		variableExist := false
@@ -140,24 +339,29 @@ func mergeRuleVariablesWithDefaults(templateVariables []domain.Variable, ruleVar
 		} else {
 			finalRuleVariables = append(finalRuleVariables, ruleVariables[matchingIndex])
 		}
+		if err := templates.ValidateVariable(templateVariables[j], finalRuleVariables[j].Value); err != nil {
+			return nil, err
+		}
 	}
-	return finalRuleVariables
+	return finalRuleVariables, nil
 }
 
-var cortexClientInstance = newCortexClient
-
-func (r Repository) Upsert(rule *Rule, templatesService domain.TemplatesService) (*Rule, error) {
-
-	rule.Name = fmt.Sprintf("%s_%s_%s_%s", namePrefix,
-		rule.Namespace, rule.GroupName, rule.Template)
-	var existingRule Rule
-	var rulesWithinGroup []Rule
-	template, err := templatesService.GetByName(rule.Template)
-	if err != nil {
-		return nil, err
-	}
-	if template == nil {
-		return nil, errors.New("template not found")
+func mergeRuleVariablesWithTemplate(rule *Rule, templatesService domain.TemplatesService) (*domain.Template, error) {
+	var template *domain.Template
+	var err error
+	if rule.TemplateVersion == 0 {
+		template, err = templatesService.GetByName(rule.Template)
+		if err != nil {
+			return nil, err
+		}
+		if template == nil {
+			return nil, errors.New("template not found")
+		}
+	} else {
+		template, err = getTemplateVersion(rule.Template, rule.TemplateVersion, templatesService)
+		if err != nil {
+			return nil, err
+		}
 	}
 	templateVariables := template.Variables
 
@@ -167,30 +371,68 @@ func (r Repository) Upsert(rule *Rule, templatesService domain.TemplatesService)
 	if err != nil {
 		return nil, err
 	}
-	finalRuleVariables := mergeRuleVariablesWithDefaults(templateVariables, ruleVariables)
+	finalRuleVariables, err := mergeRuleVariablesWithDefaults(templateVariables, ruleVariables)
+	if err != nil {
+		return nil, err
+	}
 	jsonBytes, err := json.Marshal(finalRuleVariables)
 	if err != nil {
 		return nil, err
 	}
 
 	rule.Variables = string(jsonBytes)
+	return template, nil
+}
+
+func templateBodyHash(body string) string {
+	hash := sha256.Sum256([]byte(body))
+	return hex.EncodeToString(hash[:])
+}
+
+// createRuleRevision stores a snapshot of a rule as it was pushed to the provider
+func createRuleRevision(tx *gorm.DB, rule *Rule, template *domain.Template, actor string) error {
+	inputValue := make(map[string]string)
+	var variables []variable
+	_ = json.Unmarshal([]byte(rule.Variables), &variables)
+	for _, v := range variables {
+		inputValue[v.Name] = v.Value
+	}
+	rendered, err := templates.RenderBody(template, inputValue)
+	if err != nil {
+		return err
+	}
+	revision := &RuleRevision{
+		RuleId:          rule.Id,
+		Enabled:         *rule.Enabled,
+		Variables:       rule.Variables,
+		TemplateHash:    templateBodyHash(template.Body),
+		TemplateVersion: rule.TemplateVersion,
+		Rendered:        rendered,
+		Actor:           actor,
+	}
+	return tx.Create(revision).Error
+}
+
+func (r Repository) Upsert(rule *Rule, actor string, templatesService domain.TemplatesService) (*Rule, error) {
+
+	rule.Name = fmt.Sprintf("%s_%s_%s_%s", namePrefix,
+		rule.Namespace, rule.GroupName, rule.Template)
+	var existingRule Rule
+	var rulesWithinGroup []Rule
+	template, err := mergeRuleVariablesWithTemplate(rule, templatesService)
+	if err != nil {
+		return nil, err
+	}
+
 	err = r.db.Transaction(func(tx *gorm.DB) error {
-		var data RuleResponse
-		result := r.db.Table("namespaces").
-			Select("namespaces.urn as namespace_urn, providers.urn as provider_urn, providers.type as provider_type, providers.host as provider_host").
-			Joins("RIGHT JOIN providers on providers.id = namespaces.provider_id").
-			Where("namespaces.id = ?", rule.ProviderNamespace).
-			Find(&data)
-		if result.Error != nil {
-			return result.Error
-		}
-		if result.RowsAffected == 0 {
-			return errors.New("provider not found")
+		data, err := getProviderOfNamespace(tx, rule.ProviderNamespace)
+		if err != nil {
+			return err
 		}
 
 		rule.Name = fmt.Sprintf("%s_%s_%s_%s_%s_%s", namePrefix, data.ProviderUrn, data.NamespaceUrn,
 			rule.Namespace, rule.GroupName, rule.Template)
-		result = tx.Where(fmt.Sprintf("name = '%s'", rule.Name)).Find(&existingRule)
+		result := tx.Where("name = ?", rule.Name).Find(&existingRule)
 		if result.Error != nil {
 			return result.Error
 		}
@@ -199,40 +441,556 @@ func (r Repository) Upsert(rule *Rule, templatesService domain.TemplatesService)
 		} else {
 			rule.Id = existingRule.Id
 			result = tx.Where("id = ?", existingRule.Id).Updates(rule)
+			if result.Error == nil && rule.TemplateVersion == 0 && existingRule.TemplateVersion != 0 {
+				// Updates skips zero values, so following the latest template version again is set on its own
+				result = tx.Model(&Rule{}).Where("id = ?", existingRule.Id).Update("template_version", 0)
+			}
 		}
 		if result.Error != nil {
 			return result.Error
 		}
-		result = tx.Where(fmt.Sprintf("name = '%s'", rule.Name)).Find(&existingRule)
+		result = tx.Where("name = ?", rule.Name).Find(&existingRule)
 		if result.Error != nil {
 			return result.Error
 		}
 
-		if data.ProviderType == "cortex" {
-			client, err := cortexClientInstance(data.ProviderHost)
-			if err != nil {
-				return err
-			}
+		client, err := newProviderAdapter(r.adapters, data.ProviderType, data.ProviderUrn, data.ProviderHost)
+		if err != nil {
+			return err
+		}
 
-			result = tx.Where(fmt.Sprintf("namespace = '%s' AND group_name = '%s' AND provider_namespace = '%d'",
-				rule.Namespace, rule.GroupName, rule.ProviderNamespace)).Find(&rulesWithinGroup)
-			if result.Error != nil {
-				return result.Error
-			}
-			err = postRuleGroupWith(rule, rulesWithinGroup, client, templatesService, data.NamespaceUrn)
-			if err != nil {
-				return err
-			}
+		result = tx.Where("namespace = ? AND group_name = ? AND provider_namespace = ?",
+			rule.Namespace, rule.GroupName, rule.ProviderNamespace).Find(&rulesWithinGroup)
+		if result.Error != nil {
+			return result.Error
+		}
+		err = postRuleGroupWith(tx, rule, rulesWithinGroup, client, templatesService, data.NamespaceUrn)
+		if err != nil {
+			return err
+		}
+		return createRuleRevision(tx, &existingRule, template, actor)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &existingRule, err
+}
+
+// ListRevisions returns the revisions of a rule, latest first
+func (r Repository) ListRevisions(ruleId uint64) ([]RuleRevision, error) {
+	var revisions []RuleRevision
+	result := r.db.Where("rule_id = ?", ruleId).Order("id desc").Find(&revisions)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	return revisions, nil
+}
+
+// templateVersionOfRevision returns the template version that an unpinned
+// revision was rendered from, found by the hash of its body, or 0 if that is
+// still the latest version of the template
+func templateVersionOfRevision(revision *RuleRevision, templateName string, templatesService domain.TemplatesService) (uint, error) {
+	template, err := templatesService.GetByName(templateName)
+	if err != nil {
+		return 0, err
+	}
+	if template == nil {
+		return 0, fmt.Errorf("template %s not found", templateName)
+	}
+	if templateBodyHash(template.Body) == revision.TemplateHash {
+		return 0, nil
+	}
+	versions, err := templatesService.ListVersions(templateName)
+	if err != nil {
+		return 0, err
+	}
+	for _, version := range versions {
+		if templateBodyHash(version.Body) == revision.TemplateHash {
+			return version.Version, nil
+		}
+	}
+	return 0, fmt.Errorf("version of template %s that revision %d was rendered from not found", templateName, revision.Id)
+}
+
+// Rollback restores the variables, enabled flag and template version pin of a
+// rule revision and upserts the rule, which pushes its group to the provider
+// again and stores a new revision. A revision that was not pinned is pinned to
+// the template version it was rendered from, unless that is still the latest.
+func (r Repository) Rollback(ruleId, revisionId uint64, actor string, templatesService domain.TemplatesService) (*Rule, error) {
+	var revision RuleRevision
+	result := r.db.Where("id = ? AND rule_id = ?", revisionId, ruleId).Find(&revision)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	if result.RowsAffected == 0 {
+		return nil, errors.New("rule revision not found")
+	}
+
+	var rule Rule
+	result = r.db.Where("id = ?", ruleId).Find(&rule)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	if result.RowsAffected == 0 {
+		return nil, errors.New("rule not found")
+	}
+	templateVersion := revision.TemplateVersion
+	if templateVersion == 0 {
+		var err error
+		templateVersion, err = templateVersionOfRevision(&revision, rule.Template, templatesService)
+		if err != nil {
+			return nil, err
+		}
+	}
+	enabled := revision.Enabled
+	return r.Upsert(&Rule{
+		Namespace:         rule.Namespace,
+		GroupName:         rule.GroupName,
+		Template:          rule.Template,
+		TemplateVersion:   templateVersion,
+		Enabled:           &enabled,
+		Variables:         revision.Variables,
+		ProviderNamespace: rule.ProviderNamespace,
+	}, actor, templatesService)
+}
+
+// UpgradeTemplateVersion pins the rules of a template that are pinned to
+// version from to version to, where version 0 stands for the latest version.
+// Every rule is upserted, which pushes its group to the provider and stores a
+// revision. Rules upgraded before a failure stay upgraded.
+func (r Repository) UpgradeTemplateVersion(templateName string, from, to uint, actor string, templatesService domain.TemplatesService) ([]Rule, error) {
+	if from == to {
+		return nil, errors.New("template versions to upgrade from and to are the same")
+	}
+	if to != 0 {
+		if _, err := getTemplateVersion(templateName, to, templatesService); err != nil {
+			return nil, err
+		}
+	}
+
+	var rules []Rule
+	result := r.db.Where("template = ? AND template_version = ?", templateName, from).Order("id").Find(&rules)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+
+	upgradedRules := make([]Rule, 0, len(rules))
+	for _, rule := range rules {
+		upgradedRule, err := r.Upsert(&Rule{
+			Namespace:         rule.Namespace,
+			GroupName:         rule.GroupName,
+			Template:          rule.Template,
+			TemplateVersion:   to,
+			Enabled:           rule.Enabled,
+			Variables:         rule.Variables,
+			ProviderNamespace: rule.ProviderNamespace,
+		}, actor, templatesService)
+		if err != nil {
+			return upgradedRules, err
+		}
+		upgradedRules = append(upgradedRules, *upgradedRule)
+	}
+	return upgradedRules, nil
+}
+
+// GetGroup returns the stored settings of a rule group, or nil if there are none
+func (r Repository) GetGroup(providerNamespace uint64, namespace, groupName string) (*RuleGroup, error) {
+	var group RuleGroup
+	result := r.db.Where("provider_namespace = ? AND namespace = ? AND group_name = ?",
+		providerNamespace, namespace, groupName).Find(&group)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	if result.RowsAffected == 0 {
+		return nil, nil
+	}
+	return &group, nil
+}
+
+// UpsertGroup stores the settings of a rule group. A group that already has
+// rules is pushed to its provider again, so that the settings apply right away.
+func (r Repository) UpsertGroup(group *RuleGroup, templatesService domain.TemplatesService) (*RuleGroup, error) {
+	if err := validateRuleGroup(group); err != nil {
+		return nil, err
+	}
+	var existingGroup RuleGroup
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		result := tx.Where("provider_namespace = ? AND namespace = ? AND group_name = ?",
+			group.ProviderNamespace, group.Namespace, group.GroupName).Find(&existingGroup)
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			result = tx.Create(group)
 		} else {
-			return errors.New("provider not supported")
+			group.Id = existingGroup.Id
+			group.CreatedAt = existingGroup.CreatedAt
+			result = tx.Save(group)
+		}
+		if result.Error != nil {
+			return result.Error
+		}
+
+		var rulesWithinGroup []Rule
+		result = tx.Where("namespace = ? AND group_name = ? AND provider_namespace = ?",
+			group.Namespace, group.GroupName, group.ProviderNamespace).Find(&rulesWithinGroup)
+		if result.Error != nil {
+			return result.Error
+		}
+		if len(rulesWithinGroup) == 0 {
+			return nil
 		}
 
-		return nil
+		data, err := getProviderOfNamespace(tx, group.ProviderNamespace)
+		if err != nil {
+			return err
+		}
+		client, err := newProviderAdapter(r.adapters, data.ProviderType, data.ProviderUrn, data.ProviderHost)
+		if err != nil {
+			return err
+		}
+		return postRuleGroupWith(tx, &rulesWithinGroup[0], rulesWithinGroup, client, templatesService, data.NamespaceUrn)
 	})
 	if err != nil {
 		return nil, err
 	}
-	return &existingRule, err
+	return group, nil
+}
+
+// templateOverride renders the given version of a template instead of the
+// stored one, and every other template with the wrapped service
+type templateOverride struct {
+	domain.TemplatesService
+	template *domain.Template
+}
+
+func (t templateOverride) Render(name string, variables map[string]string) (string, error) {
+	if name == t.template.Name {
+		return templates.RenderBody(t.template, variables)
+	}
+	return t.TemplatesService.Render(name, variables)
+}
+
+// SyncTemplate renders every rule group with rules following the latest
+// version of the template again and pushes it to its provider. A group that
+// fails is recorded in its report and does not stop the others. A dry run
+// pushes nothing and reports the diff of every group instead.
+func (r Repository) SyncTemplate(template *domain.Template, dryRun bool, templatesService domain.TemplatesService) ([]domain.RuleGroupSync, error) {
+	// a template of a dry run is not stored, so its variables are not checked yet
+	if err := templates.ValidateVariables(template.Variables); err != nil {
+		return nil, err
+	}
+	var rules []Rule
+	result := r.db.Where("template = ?", template.Name).
+		Order("provider_namespace, namespace, group_name").
+		Find(&rules)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+
+	renderer := templateOverride{TemplatesService: templatesService, template: template}
+	reports := make([]domain.RuleGroupSync, 0)
+	syncedGroups := make(map[string]bool)
+	for i := 0; i < len(rules); i++ {
+		if rules[i].TemplateVersion != 0 {
+			continue
+		}
+		key := fmt.Sprintf("%d/%s/%s", rules[i].ProviderNamespace, rules[i].Namespace, rules[i].GroupName)
+		if syncedGroups[key] {
+			continue
+		}
+		syncedGroups[key] = true
+
+		report := domain.RuleGroupSync{
+			ProviderNamespace: rules[i].ProviderNamespace,
+			Namespace:         rules[i].Namespace,
+			GroupName:         rules[i].GroupName,
+		}
+		diff, err := r.syncRuleGroup(&rules[i], dryRun, renderer)
+		if err != nil {
+			report.Error = err.Error()
+		} else {
+			report.Success = true
+			report.Diff = diff
+		}
+		reports = append(reports, report)
+	}
+	return reports, nil
+}
+
+func (r Repository) syncRuleGroup(rule *Rule, dryRun bool, templatesService domain.TemplatesService) (*domain.RuleGroupDiff, error) {
+	data, err := getProviderOfNamespace(r.db, rule.ProviderNamespace)
+	if err != nil {
+		return nil, err
+	}
+	client, err := newProviderAdapter(r.adapters, data.ProviderType, data.ProviderUrn, data.ProviderHost)
+	if err != nil {
+		return nil, err
+	}
+
+	var rulesWithinGroup []Rule
+	result := r.db.Where("namespace = ? AND group_name = ? AND provider_namespace = ?",
+		rule.Namespace, rule.GroupName, rule.ProviderNamespace).Find(&rulesWithinGroup)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	if !dryRun {
+		return nil, postRuleGroupWith(r.db, rule, rulesWithinGroup, client, templatesService, data.NamespaceUrn)
+	}
+
+	desiredRuleNodes, err := renderRuleGroup(rulesWithinGroup, templatesService, client)
+	if err != nil {
+		return nil, err
+	}
+	var currentRuleNodes []rulefmt.RuleNode
+	currentRuleGroup, err := client.GetRuleGroup(context.Background(), data.NamespaceUrn, rule.Namespace, rule.GroupName)
+	if err != nil {
+		return nil, err
+	}
+	if currentRuleGroup != nil {
+		currentRuleNodes = currentRuleGroup.Rules
+	}
+	added, changed, removed, err := diffRuleNodes(currentRuleNodes, desiredRuleNodes)
+	if err != nil {
+		return nil, err
+	}
+	return &domain.RuleGroupDiff{
+		Namespace: rule.Namespace,
+		GroupName: rule.GroupName,
+		Added:     added,
+		Changed:   changed,
+		Removed:   removed,
+	}, nil
+}
+
+func (r Repository) Diff(rule *Rule, templatesService domain.TemplatesService) (*domain.RuleGroupDiff, error) {
+	var rulesWithinGroup []Rule
+	_, err := mergeRuleVariablesWithTemplate(rule, templatesService)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := getProviderOfNamespace(r.db, rule.ProviderNamespace)
+	if err != nil {
+		return nil, err
+	}
+	client, err := newProviderAdapter(r.adapters, data.ProviderType, data.ProviderUrn, data.ProviderHost)
+	if err != nil {
+		return nil, err
+	}
+
+	rule.Name = fmt.Sprintf("%s_%s_%s_%s_%s_%s", namePrefix, data.ProviderUrn, data.NamespaceUrn,
+		rule.Namespace, rule.GroupName, rule.Template)
+	result := r.db.Where("namespace = ? AND group_name = ? AND provider_namespace = ?",
+		rule.Namespace, rule.GroupName, rule.ProviderNamespace).Find(&rulesWithinGroup)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+
+	desiredRulesWithinGroup := make([]Rule, 0, len(rulesWithinGroup)+1)
+	ruleExists := false
+	for _, ruleWithinGroup := range rulesWithinGroup {
+		if ruleWithinGroup.Name == rule.Name {
+			desiredRulesWithinGroup = append(desiredRulesWithinGroup, *rule)
+			ruleExists = true
+		} else {
+			desiredRulesWithinGroup = append(desiredRulesWithinGroup, ruleWithinGroup)
+		}
+	}
+	if !ruleExists {
+		desiredRulesWithinGroup = append(desiredRulesWithinGroup, *rule)
+	}
+	desiredRuleNodes, err := renderRuleGroup(desiredRulesWithinGroup, templatesService, client)
+	if err != nil {
+		return nil, err
+	}
+
+	var currentRuleNodes []rulefmt.RuleNode
+	currentRuleGroup, err := client.GetRuleGroup(context.Background(), data.NamespaceUrn, rule.Namespace, rule.GroupName)
+	if err != nil {
+		return nil, err
+	}
+	if currentRuleGroup != nil {
+		currentRuleNodes = currentRuleGroup.Rules
+	}
+
+	added, changed, removed, err := diffRuleNodes(currentRuleNodes, desiredRuleNodes)
+	if err != nil {
+		return nil, err
+	}
+	return &domain.RuleGroupDiff{
+		Namespace: rule.Namespace,
+		GroupName: rule.GroupName,
+		Added:     added,
+		Changed:   changed,
+		Removed:   removed,
+	}, nil
+}
+
+func (r Repository) Delete(id uint64, templatesService domain.TemplatesService) error {
+	var rulesWithinGroup []Rule
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		var rule Rule
+		result := tx.Where("id = ?", id).Find(&rule)
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return &domain.RuleNotFoundError{Id: id}
+		}
+
+		data, err := getProviderOfNamespace(tx, rule.ProviderNamespace)
+		if err != nil {
+			return err
+		}
+
+		result = tx.Delete(Rule{}, id)
+		if result.Error != nil {
+			return result.Error
+		}
+		result = tx.Where("rule_id = ?", id).Delete(&RuleRevision{})
+		if result.Error != nil {
+			return result.Error
+		}
+
+		client, err := newProviderAdapter(r.adapters, data.ProviderType, data.ProviderUrn, data.ProviderHost)
+		if err != nil {
+			return err
+		}
+
+		result = tx.Where("namespace = ? AND group_name = ? AND provider_namespace = ?",
+			rule.Namespace, rule.GroupName, rule.ProviderNamespace).Find(&rulesWithinGroup)
+		if result.Error != nil {
+			return result.Error
+		}
+		return postRuleGroupWith(tx, &rule, rulesWithinGroup, client, templatesService, data.NamespaceUrn)
+	})
+	return err
+}
+
+// TemplateUsage returns the rules of a template with the urns of their
+// namespaces and providers
+func (r Repository) TemplateUsage(template string) ([]TemplateRuleUsage, error) {
+	var usages []TemplateRuleUsage
+	result := r.db.Table("rules").
+		Select("rules.id, rules.name, rules.namespace, rules.group_name, rules.template_version, rules.enabled, rules.provider_namespace, namespaces.urn as namespace_urn, providers.urn as provider_urn").
+		Joins("LEFT JOIN namespaces on namespaces.id = rules.provider_namespace").
+		Joins("LEFT JOIN providers on providers.id = namespaces.provider_id").
+		Where("rules.template = ?", template).
+		Order("rules.id").
+		Find(&usages)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	return usages, nil
+}
+
+// DeleteTemplateRules deletes every rule of a template and pushes their rule
+// groups again without them, in the transaction of the template deletion
+func (r Repository) DeleteTemplateRules(tx *gorm.DB, template string, templatesService domain.TemplatesService) ([]domain.Rule, error) {
+	var rules []Rule
+	result := tx.Where("template = ?", template).
+		Order("provider_namespace, namespace, group_name, id").
+		Find(&rules)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	domainRules := make([]domain.Rule, 0, len(rules))
+	if len(rules) == 0 {
+		return domainRules, nil
+	}
+	result = tx.Where("template = ?", template).Delete(&Rule{})
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	ruleIds := make([]uint64, 0, len(rules))
+	for i := 0; i < len(rules); i++ {
+		ruleIds = append(ruleIds, rules[i].Id)
+	}
+	result = tx.Where("rule_id IN ?", ruleIds).Delete(&RuleRevision{})
+	if result.Error != nil {
+		return nil, result.Error
+	}
+
+	pushedGroups := make(map[string]bool)
+	for i := 0; i < len(rules); i++ {
+		key := fmt.Sprintf("%d/%s/%s", rules[i].ProviderNamespace, rules[i].Namespace, rules[i].GroupName)
+		if pushedGroups[key] {
+			continue
+		}
+		pushedGroups[key] = true
+		if err := r.pushRuleGroup(tx, &rules[i], templatesService); err != nil {
+			return nil, err
+		}
+	}
+	for i := 0; i < len(rules); i++ {
+		rule, err := rules[i].toDomain()
+		if err != nil {
+			return nil, err
+		}
+		domainRules = append(domainRules, *rule)
+	}
+	return domainRules, nil
+}
+
+// Export returns the rules of a provider namespace, of a namespace and rule
+// group if given, with the stored settings of their groups. It returns nil if
+// the provider namespace does not exist.
+func (r Repository) Export(providerNamespace uint64, namespace, groupName string) (*RuleExport, error) {
+	var data RuleResponse
+	result := r.db.Table("namespaces").
+		Select("namespaces.urn as namespace_urn, providers.urn as provider_urn").
+		Joins("LEFT JOIN providers on providers.id = namespaces.provider_id").
+		Where("namespaces.id = ?", providerNamespace).
+		Find(&data)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	if result.RowsAffected == 0 {
+		return nil, nil
+	}
+
+	rulesQuery := r.db.Where("provider_namespace = ?", providerNamespace)
+	groupsQuery := r.db.Where("provider_namespace = ?", providerNamespace)
+	if namespace != "" {
+		rulesQuery = rulesQuery.Where("namespace = ?", namespace)
+		groupsQuery = groupsQuery.Where("namespace = ?", namespace)
+	}
+	if groupName != "" {
+		rulesQuery = rulesQuery.Where("group_name = ?", groupName)
+		groupsQuery = groupsQuery.Where("group_name = ?", groupName)
+	}
+	export := &RuleExport{NamespaceUrn: data.NamespaceUrn, ProviderUrn: data.ProviderUrn}
+	result = rulesQuery.Order("namespace, group_name, id").Find(&export.Rules)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	result = groupsQuery.Order("namespace, group_name").Find(&export.Groups)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	return export, nil
+}
+
+// pushRuleGroup renders the stored rules of the rule group of a rule and
+// pushes them to its provider
+func (r Repository) pushRuleGroup(tx *gorm.DB, rule *Rule, templatesService domain.TemplatesService) error {
+	data, err := getProviderOfNamespace(tx, rule.ProviderNamespace)
+	if err != nil {
+		return err
+	}
+	client, err := newProviderAdapter(r.adapters, data.ProviderType, data.ProviderUrn, data.ProviderHost)
+	if err != nil {
+		return err
+	}
+
+	var rulesWithinGroup []Rule
+	result := tx.Where("namespace = ? AND group_name = ? AND provider_namespace = ?",
+		rule.Namespace, rule.GroupName, rule.ProviderNamespace).Find(&rulesWithinGroup)
+	if result.Error != nil {
+		return result.Error
+	}
+	return postRuleGroupWith(tx, rule, rulesWithinGroup, client, templatesService, data.NamespaceUrn)
 }
 
 func (r Repository) Get(name, namespace, groupName, template string, providerNamespace uint64) ([]Rule, error) {
@@ -12,6 +12,66 @@ type RuleRepositoryMock struct {
 	mock.Mock
 }
 
+// Delete provides a mock function with given fields: _a0, _a1
+func (_m *RuleRepositoryMock) Delete(_a0 uint64, _a1 domain.TemplatesService) error {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(uint64, domain.TemplatesService) error); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Diff provides a mock function with given fields: _a0, _a1
+func (_m *RuleRepositoryMock) Diff(_a0 *Rule, _a1 domain.TemplatesService) (*domain.RuleGroupDiff, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *domain.RuleGroupDiff
+	if rf, ok := ret.Get(0).(func(*Rule, domain.TemplatesService) *domain.RuleGroupDiff); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.RuleGroupDiff)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*Rule, domain.TemplatesService) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Export provides a mock function with given fields: _a0, _a1, _a2
+func (_m *RuleRepositoryMock) Export(_a0 uint64, _a1 string, _a2 string) (*RuleExport, error) {
+	ret := _m.Called(_a0, _a1, _a2)
+
+	var r0 *RuleExport
+	if rf, ok := ret.Get(0).(func(uint64, string, string) *RuleExport); ok {
+		r0 = rf(_a0, _a1, _a2)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*RuleExport)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(uint64, string, string) error); ok {
+		r1 = rf(_a0, _a1, _a2)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // Get provides a mock function with given fields: _a0, _a1, _a2, _a3, _a4
 func (_m *RuleRepositoryMock) Get(_a0 string, _a1 string, _a2 string, _a3 string, _a4 uint64) ([]Rule, error) {
 	ret := _m.Called(_a0, _a1, _a2, _a3, _a4)
@@ -35,6 +95,75 @@ func (_m *RuleRepositoryMock) Get(_a0 string, _a1 string, _a2 string, _a3 string
 	return r0, r1
 }
 
+// GetGroup provides a mock function with given fields: _a0, _a1, _a2
+func (_m *RuleRepositoryMock) GetGroup(_a0 uint64, _a1 string, _a2 string) (*RuleGroup, error) {
+	ret := _m.Called(_a0, _a1, _a2)
+
+	var r0 *RuleGroup
+	if rf, ok := ret.Get(0).(func(uint64, string, string) *RuleGroup); ok {
+		r0 = rf(_a0, _a1, _a2)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*RuleGroup)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(uint64, string, string) error); ok {
+		r1 = rf(_a0, _a1, _a2)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Import provides a mock function with given fields: _a0, _a1, _a2, _a3
+func (_m *RuleRepositoryMock) Import(_a0 uint64, _a1 bool, _a2 bool, _a3 domain.TemplatesService) (*domain.RuleImportReport, error) {
+	ret := _m.Called(_a0, _a1, _a2, _a3)
+
+	var r0 *domain.RuleImportReport
+	if rf, ok := ret.Get(0).(func(uint64, bool, bool, domain.TemplatesService) *domain.RuleImportReport); ok {
+		r0 = rf(_a0, _a1, _a2, _a3)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.RuleImportReport)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(uint64, bool, bool, domain.TemplatesService) error); ok {
+		r1 = rf(_a0, _a1, _a2, _a3)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListRevisions provides a mock function with given fields: _a0
+func (_m *RuleRepositoryMock) ListRevisions(_a0 uint64) ([]RuleRevision, error) {
+	ret := _m.Called(_a0)
+
+	var r0 []RuleRevision
+	if rf, ok := ret.Get(0).(func(uint64) []RuleRevision); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]RuleRevision)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(uint64) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // Migrate provides a mock function with given fields:
 func (_m *RuleRepositoryMock) Migrate() error {
 	ret := _m.Called()
@@ -49,13 +178,13 @@ func (_m *RuleRepositoryMock) Migrate() error {
 	return r0
 }
 
-// Upsert provides a mock function with given fields: _a0, _a1
-func (_m *RuleRepositoryMock) Upsert(_a0 *Rule, _a1 domain.TemplatesService) (*Rule, error) {
-	ret := _m.Called(_a0, _a1)
+// Rollback provides a mock function with given fields: _a0, _a1, _a2, _a3
+func (_m *RuleRepositoryMock) Rollback(_a0 uint64, _a1 uint64, _a2 string, _a3 domain.TemplatesService) (*Rule, error) {
+	ret := _m.Called(_a0, _a1, _a2, _a3)
 
 	var r0 *Rule
-	if rf, ok := ret.Get(0).(func(*Rule, domain.TemplatesService) *Rule); ok {
-		r0 = rf(_a0, _a1)
+	if rf, ok := ret.Get(0).(func(uint64, uint64, string, domain.TemplatesService) *Rule); ok {
+		r0 = rf(_a0, _a1, _a2, _a3)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*Rule)
@@ -63,7 +192,122 @@ func (_m *RuleRepositoryMock) Upsert(_a0 *Rule, _a1 domain.TemplatesService) (*R
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(*Rule, domain.TemplatesService) error); ok {
+	if rf, ok := ret.Get(1).(func(uint64, uint64, string, domain.TemplatesService) error); ok {
+		r1 = rf(_a0, _a1, _a2, _a3)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SyncTemplate provides a mock function with given fields: _a0, _a1, _a2
+func (_m *RuleRepositoryMock) SyncTemplate(_a0 *domain.Template, _a1 bool, _a2 domain.TemplatesService) ([]domain.RuleGroupSync, error) {
+	ret := _m.Called(_a0, _a1, _a2)
+
+	var r0 []domain.RuleGroupSync
+	if rf, ok := ret.Get(0).(func(*domain.Template, bool, domain.TemplatesService) []domain.RuleGroupSync); ok {
+		r0 = rf(_a0, _a1, _a2)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.RuleGroupSync)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*domain.Template, bool, domain.TemplatesService) error); ok {
+		r1 = rf(_a0, _a1, _a2)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// TemplateUsage provides a mock function with given fields: _a0
+func (_m *RuleRepositoryMock) TemplateUsage(_a0 string) ([]TemplateRuleUsage, error) {
+	ret := _m.Called(_a0)
+
+	var r0 []TemplateRuleUsage
+	if rf, ok := ret.Get(0).(func(string) []TemplateRuleUsage); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]TemplateRuleUsage)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UpgradeTemplateVersion provides a mock function with given fields: _a0, _a1, _a2, _a3, _a4
+func (_m *RuleRepositoryMock) UpgradeTemplateVersion(_a0 string, _a1 uint, _a2 uint, _a3 string, _a4 domain.TemplatesService) ([]Rule, error) {
+	ret := _m.Called(_a0, _a1, _a2, _a3, _a4)
+
+	var r0 []Rule
+	if rf, ok := ret.Get(0).(func(string, uint, uint, string, domain.TemplatesService) []Rule); ok {
+		r0 = rf(_a0, _a1, _a2, _a3, _a4)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]Rule)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string, uint, uint, string, domain.TemplatesService) error); ok {
+		r1 = rf(_a0, _a1, _a2, _a3, _a4)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Upsert provides a mock function with given fields: _a0, _a1, _a2
+func (_m *RuleRepositoryMock) Upsert(_a0 *Rule, _a1 string, _a2 domain.TemplatesService) (*Rule, error) {
+	ret := _m.Called(_a0, _a1, _a2)
+
+	var r0 *Rule
+	if rf, ok := ret.Get(0).(func(*Rule, string, domain.TemplatesService) *Rule); ok {
+		r0 = rf(_a0, _a1, _a2)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*Rule)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*Rule, string, domain.TemplatesService) error); ok {
+		r1 = rf(_a0, _a1, _a2)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UpsertGroup provides a mock function with given fields: _a0, _a1
+func (_m *RuleRepositoryMock) UpsertGroup(_a0 *RuleGroup, _a1 domain.TemplatesService) (*RuleGroup, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *RuleGroup
+	if rf, ok := ret.Get(0).(func(*RuleGroup, domain.TemplatesService) *RuleGroup); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*RuleGroup)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*RuleGroup, domain.TemplatesService) error); ok {
 		r1 = rf(_a0, _a1)
 	} else {
 		r1 = ret.Error(1)
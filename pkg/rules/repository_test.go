@@ -1,15 +1,22 @@
 package rules
 
 import (
+	"crypto/sha256"
 	"database/sql"
 	"database/sql/driver"
+	"encoding/hex"
 	"errors"
 	"github.com/DATA-DOG/go-sqlmock"
 	cortexClient "github.com/grafana/cortex-tools/pkg/client"
 	"github.com/odpf/siren/domain"
 	"github.com/odpf/siren/mocks"
+	"github.com/odpf/siren/pkg/adapter"
+	"github.com/prometheus/common/model"
+	"github.com/prometheus/prometheus/pkg/rulefmt"
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/suite"
+	"gopkg.in/yaml.v3"
 	"regexp"
 	"testing"
 	"time"
@@ -20,6 +27,8 @@ var cortextConfig = cortexClient.Config{
 	UseLegacyRoutes: false,
 }
 
+var selectRuleGroupQuery = regexp.QuoteMeta(`SELECT * FROM "rule_groups" WHERE provider_namespace = $1 AND namespace = $2 AND group_name = $3`)
+
 // AnyTime is used to expect arbitrary time value
 type AnyTime struct{}
 
@@ -34,13 +43,15 @@ type RepositoryTestSuite struct {
 	sqldb      *sql.DB
 	dbmock     sqlmock.Sqlmock
 	repository RuleRepository
+	adapters   *adapter.Registry
 }
 
 func (s *RepositoryTestSuite) SetupTest() {
 	db, mock, _ := mocks.NewStore()
 	s.sqldb, _ = db.DB()
 	s.dbmock = mock
-	s.repository = NewRepository(db)
+	s.adapters = adapter.NewRegistry()
+	s.repository = NewRepository(db, s.adapters)
 }
 
 func (s *RepositoryTestSuite) TearDownTest() {
@@ -73,23 +84,22 @@ func (s *RepositoryTestSuite) TestUpsert() {
 	var falsebool = false
 
 	dummyTemplateBody := "-\n    alert: Test\n    expr: 'test-expr'\n    for: '20m'\n    labels: {severity: WARNING, team: 'gojek' }\n    annotations: {description: 'test'}\n-\n"
+	insertRevisionQuery := regexp.QuoteMeta(`INSERT INTO "rule_revisions" ("created_at","rule_id","enabled","variables","template_hash","template_version","rendered","actor") VALUES ($1,$2,$3,$4,$5,$6,$7,$8) RETURNING "id"`)
 
 	s.Run("should insert rule merged with defaults and call cortex APIs", func() {
-		mockClient := &cortexCallerMock{}
+		mockClient := &adapter.AdapterMock{}
 		mockTemplateService := &mocks.TemplatesService{}
-		oldCortexClientCreator := cortexClientInstance
-		cortexClientInstance = func(string) (cortexCaller, error) {
+		s.adapters.Register("cortex", func(string, string) (adapter.Adapter, error) {
 			return mockClient, nil
-		}
-		defer func() { cortexClientInstance = oldCortexClientCreator }()
+		})
 		mockTemplateService.On("Render", mock.Anything, mock.Anything).Return(dummyTemplateBody, nil)
 		mockTemplateService.On("GetByName", "tmpl").Return(expectedTemplate, nil)
-		mockClient.On("CreateRuleGroup", mock.Anything, "foo", mock.Anything).Return(nil)
+		mockClient.On("UpsertRuleGroup", mock.Anything, mock.Anything, "foo", mock.Anything).Return(nil)
 		namespaceQuery := regexp.QuoteMeta(`SELECT namespaces.urn as namespace_urn, providers.urn as provider_urn, providers.type as provider_type, providers.host as provider_host FROM "namespaces" RIGHT JOIN providers on providers.id = namespaces.provider_id WHERE namespaces.id = $1`)
-		firstSelectRuleQuery := regexp.QuoteMeta(`SELECT * FROM "rules" WHERE name = 'siren_api_bar_foo_foo_bar_tmpl'`)
-		secondSelectRuleQuery := regexp.QuoteMeta(`SELECT * FROM "rules" WHERE name = 'siren_api_bar_foo_foo_bar_tmpl'`)
-		thirdSelectRuleQuery := regexp.QuoteMeta(`SELECT * FROM "rules" WHERE namespace = 'foo' AND group_name = 'bar' AND provider_namespace = '1'`)
-		insertRuleQuery := regexp.QuoteMeta(`INSERT INTO "rules" ("created_at","updated_at","name","namespace","group_name","template","enabled","variables","provider_namespace") VALUES ($1,$2,$3,$4,$5,$6,$7,$8,$9) RETURNING "id"`)
+		firstSelectRuleQuery := regexp.QuoteMeta(`SELECT * FROM "rules" WHERE name = $1`)
+		secondSelectRuleQuery := regexp.QuoteMeta(`SELECT * FROM "rules" WHERE name = $1`)
+		thirdSelectRuleQuery := regexp.QuoteMeta(`SELECT * FROM "rules" WHERE namespace = $1 AND group_name = $2 AND provider_namespace = $3`)
+		insertRuleQuery := regexp.QuoteMeta(`INSERT INTO "rules" ("created_at","updated_at","name","namespace","group_name","template","template_version","enabled","variables","provider_namespace") VALUES ($1,$2,$3,$4,$5,$6,$7,$8,$9,$10) RETURNING "id"`)
 
 		input := &Rule{
 			Namespace:         "foo",
@@ -137,35 +147,103 @@ func (s *RepositoryTestSuite) TestUpsert() {
 
 		s.dbmock.ExpectBegin()
 		s.dbmock.ExpectQuery(namespaceQuery).WillReturnRows(expectedNamespaceRow)
-		s.dbmock.ExpectQuery(firstSelectRuleQuery).WillReturnRows(sqlmock.NewRows(nil))
+		s.dbmock.ExpectQuery(firstSelectRuleQuery).WithArgs("siren_api_bar_foo_foo_bar_tmpl").WillReturnRows(sqlmock.NewRows(nil))
 		s.dbmock.ExpectQuery(insertRuleQuery).WithArgs(AnyTime{},
 			AnyTime{}, expectedRule.Name, expectedRule.Namespace,
-			expectedRule.GroupName, expectedRule.Template, expectedRule.Enabled, expectedRule.Variables,
+			expectedRule.GroupName, expectedRule.Template, expectedRule.TemplateVersion, expectedRule.Enabled, expectedRule.Variables,
 			expectedRule.ProviderNamespace).
 			WillReturnRows(sqlmock.NewRows(nil))
-		s.dbmock.ExpectQuery(secondSelectRuleQuery).WillReturnRows(expectedRuleRows)
-		s.dbmock.ExpectQuery(thirdSelectRuleQuery).WillReturnRows(expectedRuleRowsInGroup)
+		s.dbmock.ExpectQuery(secondSelectRuleQuery).WithArgs("siren_api_bar_foo_foo_bar_tmpl").WillReturnRows(expectedRuleRows)
+		s.dbmock.ExpectQuery(thirdSelectRuleQuery).WithArgs("foo", "bar", 1).WillReturnRows(expectedRuleRowsInGroup)
+		s.dbmock.ExpectQuery(selectRuleGroupQuery).WillReturnRows(sqlmock.NewRows(nil))
+		templateHash := sha256.Sum256([]byte(expectedTemplate.Body))
+		s.dbmock.ExpectQuery(insertRevisionQuery).WithArgs(AnyTime{}, expectedRule.Id, true, expectedRule.Variables,
+			hex.EncodeToString(templateHash[:]), 0,
+			"-\n    alert: Test\n    expr: 'test-expr'\n    for: '10m'\n    labels: {severity: WARNING, team:  gojek }\n    annotations: {description: 'test'}\n-\n",
+			"foo@odpf.io").
+			WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1))
 		s.dbmock.ExpectCommit()
-		actualRule, err := s.repository.Upsert(input, mockTemplateService)
+		actualRule, err := s.repository.Upsert(input, "foo@odpf.io", mockTemplateService)
 		s.Equal(expectedRule, actualRule)
 		s.Nil(err)
 	})
 
-	s.Run("should update rule merged with defaults and call cortex APIs", func() {
-		mockClient := &cortexCallerMock{}
+	s.Run("should insert a rule whose group name contains a quote", func() {
+		mockClient := &adapter.AdapterMock{}
 		mockTemplateService := &mocks.TemplatesService{}
-		oldCortexClientCreator := cortexClientInstance
-		cortexClientInstance = func(string) (cortexCaller, error) {
+		s.adapters.Register("cortex", func(string, string) (adapter.Adapter, error) {
 			return mockClient, nil
+		})
+		mockTemplateService.On("Render", mock.Anything, mock.Anything).Return(dummyTemplateBody, nil)
+		mockTemplateService.On("GetByName", "tmpl").Return(expectedTemplate, nil)
+		mockClient.On("UpsertRuleGroup", mock.Anything, mock.Anything, "foo", mock.Anything).Return(nil)
+		namespaceQuery := regexp.QuoteMeta(`SELECT namespaces.urn as namespace_urn, providers.urn as provider_urn, providers.type as provider_type, providers.host as provider_host FROM "namespaces" RIGHT JOIN providers on providers.id = namespaces.provider_id WHERE namespaces.id = $1`)
+		selectRuleQuery := regexp.QuoteMeta(`SELECT * FROM "rules" WHERE name = $1`)
+		selectRulesInGroupQuery := regexp.QuoteMeta(`SELECT * FROM "rules" WHERE namespace = $1 AND group_name = $2 AND provider_namespace = $3`)
+		insertRuleQuery := regexp.QuoteMeta(`INSERT INTO "rules" ("created_at","updated_at","name","namespace","group_name","template","template_version","enabled","variables","provider_namespace") VALUES ($1,$2,$3,$4,$5,$6,$7,$8,$9,$10) RETURNING "id"`)
+
+		input := &Rule{
+			Namespace:         "foo",
+			GroupName:         "bar's",
+			Template:          "tmpl",
+			Enabled:           &truebool,
+			Variables:         `[{"name":"for", "type":"string", "value":"10m", "description":"test"}]`,
+			ProviderNamespace: 1,
+		}
+		expectedRule := &Rule{
+			Id:                10,
+			CreatedAt:         time.Now(),
+			UpdatedAt:         time.Now(),
+			Name:              "siren_api_bar_foo_foo_bar's_tmpl",
+			Namespace:         "foo",
+			GroupName:         "bar's",
+			Template:          "tmpl",
+			Enabled:           &truebool,
+			Variables:         `[{"name":"for","type":"string","value":"10m","description":"test"},{"name":"team","type":"string","value":"gojek","description":"test"}]`,
+			ProviderNamespace: 1,
 		}
-		defer func() { cortexClientInstance = oldCortexClientCreator }()
+		ruleColumns := []string{"id", "created_at", "updated_at", "name", "namespace", "group_name", "template", "enabled", "variables", "provider_namespace"}
+		newRuleRows := func() *sqlmock.Rows {
+			return sqlmock.NewRows(ruleColumns).AddRow(expectedRule.Id, expectedRule.CreatedAt,
+				expectedRule.UpdatedAt, expectedRule.Name, expectedRule.Namespace,
+				expectedRule.GroupName, expectedRule.Template, expectedRule.Enabled,
+				expectedRule.Variables, expectedRule.ProviderNamespace)
+		}
+
+		s.dbmock.ExpectBegin()
+		s.dbmock.ExpectQuery(namespaceQuery).WillReturnRows(sqlmock.NewRows([]string{"namespace_urn", "provider_urn", "provider_type"}).
+			AddRow("foo", "bar", "cortex"))
+		s.dbmock.ExpectQuery(selectRuleQuery).WithArgs("siren_api_bar_foo_foo_bar's_tmpl").WillReturnRows(sqlmock.NewRows(nil))
+		s.dbmock.ExpectQuery(insertRuleQuery).WithArgs(AnyTime{},
+			AnyTime{}, expectedRule.Name, expectedRule.Namespace,
+			expectedRule.GroupName, expectedRule.Template, expectedRule.TemplateVersion, expectedRule.Enabled, expectedRule.Variables,
+			expectedRule.ProviderNamespace).
+			WillReturnRows(sqlmock.NewRows(nil))
+		s.dbmock.ExpectQuery(selectRuleQuery).WithArgs("siren_api_bar_foo_foo_bar's_tmpl").WillReturnRows(newRuleRows())
+		s.dbmock.ExpectQuery(selectRulesInGroupQuery).WithArgs("foo", "bar's", 1).WillReturnRows(newRuleRows())
+		s.dbmock.ExpectQuery(selectRuleGroupQuery).WillReturnRows(sqlmock.NewRows(nil))
+		s.dbmock.ExpectQuery(insertRevisionQuery).WithArgs(AnyTime{}, expectedRule.Id, true, expectedRule.Variables,
+			sqlmock.AnyArg(), 0, sqlmock.AnyArg(), "foo@odpf.io").
+			WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1))
+		s.dbmock.ExpectCommit()
+		actualRule, err := s.repository.Upsert(input, "foo@odpf.io", mockTemplateService)
+		s.Equal(expectedRule, actualRule)
+		s.Nil(err)
+	})
+
+	s.Run("should update rule merged with defaults and call cortex APIs", func() {
+		mockClient := &adapter.AdapterMock{}
+		mockTemplateService := &mocks.TemplatesService{}
+		s.adapters.Register("cortex", func(string, string) (adapter.Adapter, error) {
+			return mockClient, nil
+		})
 		mockTemplateService.On("Render", mock.Anything, mock.Anything).Return(dummyTemplateBody, nil)
 		mockTemplateService.On("GetByName", "tmpl").Return(expectedTemplate, nil)
-		mockClient.On("CreateRuleGroup", mock.Anything, "foo", mock.Anything).Return(nil)
+		mockClient.On("UpsertRuleGroup", mock.Anything, mock.Anything, "foo", mock.Anything).Return(nil)
 		namespaceQuery := regexp.QuoteMeta(`SELECT namespaces.urn as namespace_urn, providers.urn as provider_urn, providers.type as provider_type, providers.host as provider_host FROM "namespaces" RIGHT JOIN providers on providers.id = namespaces.provider_id WHERE namespaces.id = $1`)
-		firstSelectRuleQuery := regexp.QuoteMeta(`SELECT * FROM "rules" WHERE name = 'siren_api_bar_foo_foo_bar_tmpl'`)
-		secondSelectRuleQuery := regexp.QuoteMeta(`SELECT * FROM "rules" WHERE name = 'siren_api_bar_foo_foo_bar_tmpl'`)
-		thirdSelectRuleQuery := regexp.QuoteMeta(`SELECT * FROM "rules" WHERE namespace = 'foo' AND group_name = 'bar' AND provider_namespace = '1'`)
+		firstSelectRuleQuery := regexp.QuoteMeta(`SELECT * FROM "rules" WHERE name = $1`)
+		secondSelectRuleQuery := regexp.QuoteMeta(`SELECT * FROM "rules" WHERE name = $1`)
+		thirdSelectRuleQuery := regexp.QuoteMeta(`SELECT * FROM "rules" WHERE namespace = $1 AND group_name = $2 AND provider_namespace = $3`)
 		updateRuleQuery := regexp.QuoteMeta(`UPDATE "rules" SET "updated_at"=$1,"name"=$2,"namespace"=$3,"group_name"=$4,"template"=$5,"enabled"=$6,"variables"=$7,"provider_namespace"=$8 WHERE id = $9 AND "id" = $10`)
 		input := &Rule{
 			Namespace:         "foo",
@@ -220,35 +298,35 @@ func (s *RepositoryTestSuite) TestUpsert() {
 
 		s.dbmock.ExpectBegin()
 		s.dbmock.ExpectQuery(namespaceQuery).WillReturnRows(expectedNamespaceRow)
-		s.dbmock.ExpectQuery(firstSelectRuleQuery).WillReturnRows(expectedRuleRowsInFirstQuery)
+		s.dbmock.ExpectQuery(firstSelectRuleQuery).WithArgs("siren_api_bar_foo_foo_bar_tmpl").WillReturnRows(expectedRuleRowsInFirstQuery)
 		s.dbmock.ExpectExec(updateRuleQuery).
 			WithArgs(AnyTime{}, expectedRule.Name, expectedRule.Namespace,
 				expectedRule.GroupName, expectedRule.Template, expectedRule.Enabled, expectedRule.Variables,
 				expectedRule.ProviderNamespace, expectedRule.Id, expectedRule.Id).
 			WillReturnResult(sqlmock.NewResult(10, 1))
-		s.dbmock.ExpectQuery(secondSelectRuleQuery).WillReturnRows(expectedRuleRows)
-		s.dbmock.ExpectQuery(thirdSelectRuleQuery).WillReturnRows(expectedRuleRowsInGroup)
+		s.dbmock.ExpectQuery(secondSelectRuleQuery).WithArgs("siren_api_bar_foo_foo_bar_tmpl", expectedRule.Id).WillReturnRows(expectedRuleRows)
+		s.dbmock.ExpectQuery(thirdSelectRuleQuery).WithArgs("foo", "bar", 1).WillReturnRows(expectedRuleRowsInGroup)
+		s.dbmock.ExpectQuery(selectRuleGroupQuery).WillReturnRows(sqlmock.NewRows(nil))
+		s.dbmock.ExpectQuery(insertRevisionQuery).WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1))
 		s.dbmock.ExpectCommit()
-		actualRule, err := s.repository.Upsert(input, mockTemplateService)
+		actualRule, err := s.repository.Upsert(input, "", mockTemplateService)
 		s.Equal(expectedRule, actualRule)
 		s.Nil(err)
 	})
 
 	s.Run("should rollback update if cortex API call fails", func() {
-		mockClient := &cortexCallerMock{}
+		mockClient := &adapter.AdapterMock{}
 		mockTemplateService := &mocks.TemplatesService{}
-		oldCortexClientCreator := cortexClientInstance
-		cortexClientInstance = func(string) (cortexCaller, error) {
+		s.adapters.Register("cortex", func(string, string) (adapter.Adapter, error) {
 			return mockClient, nil
-		}
-		defer func() { cortexClientInstance = oldCortexClientCreator }()
+		})
 		mockTemplateService.On("Render", mock.Anything, mock.Anything).Return(dummyTemplateBody, nil)
 		mockTemplateService.On("GetByName", "tmpl").Return(expectedTemplate, nil)
-		mockClient.On("CreateRuleGroup", mock.Anything, "foo", mock.Anything).Return(errors.New("random error"))
+		mockClient.On("UpsertRuleGroup", mock.Anything, mock.Anything, "foo", mock.Anything).Return(errors.New("random error"))
 		namespaceQuery := regexp.QuoteMeta(`SELECT namespaces.urn as namespace_urn, providers.urn as provider_urn, providers.type as provider_type, providers.host as provider_host FROM "namespaces" RIGHT JOIN providers on providers.id = namespaces.provider_id WHERE namespaces.id = $1`)
-		firstSelectRuleQuery := regexp.QuoteMeta(`SELECT * FROM "rules" WHERE name = 'siren_api_bar_foo_foo_bar_tmpl'`)
-		secondSelectRuleQuery := regexp.QuoteMeta(`SELECT * FROM "rules" WHERE name = 'siren_api_bar_foo_foo_bar_tmpl'`)
-		thirdSelectRuleQuery := regexp.QuoteMeta(`SELECT * FROM "rules" WHERE namespace = 'foo' AND group_name = 'bar' AND provider_namespace = '1'`)
+		firstSelectRuleQuery := regexp.QuoteMeta(`SELECT * FROM "rules" WHERE name = $1`)
+		secondSelectRuleQuery := regexp.QuoteMeta(`SELECT * FROM "rules" WHERE name = $1`)
+		thirdSelectRuleQuery := regexp.QuoteMeta(`SELECT * FROM "rules" WHERE namespace = $1 AND group_name = $2 AND provider_namespace = $3`)
 		updateRuleQuery := regexp.QuoteMeta(`UPDATE "rules" SET "updated_at"=$1,"name"=$2,"namespace"=$3,"group_name"=$4,"template"=$5,"enabled"=$6,"variables"=$7,"provider_namespace"=$8 WHERE id = $9 AND "id" = $10`)
 		input := &Rule{
 			Namespace:         "foo",
@@ -300,14 +378,15 @@ func (s *RepositoryTestSuite) TestUpsert() {
 
 		s.dbmock.ExpectBegin()
 		s.dbmock.ExpectQuery(namespaceQuery).WillReturnRows(expectedNamespaceRow)
-		s.dbmock.ExpectQuery(firstSelectRuleQuery).WillReturnRows(expectedRuleRowsInFirstQuery)
+		s.dbmock.ExpectQuery(firstSelectRuleQuery).WithArgs("siren_api_bar_foo_foo_bar_tmpl").WillReturnRows(expectedRuleRowsInFirstQuery)
 		s.dbmock.ExpectExec(updateRuleQuery).WithArgs(AnyTime{}, expectedRule.Name, expectedRule.Namespace,
 			expectedRule.GroupName, expectedRule.Template, expectedRule.Enabled, expectedRule.Variables,
 			expectedRule.ProviderNamespace, expectedRule.Id, expectedRule.Id).WillReturnResult(sqlmock.NewResult(10, 1))
-		s.dbmock.ExpectQuery(secondSelectRuleQuery).WillReturnRows(expectedRuleRows)
-		s.dbmock.ExpectQuery(thirdSelectRuleQuery).WillReturnRows(expectedRuleRowsInGroup)
+		s.dbmock.ExpectQuery(secondSelectRuleQuery).WithArgs("siren_api_bar_foo_foo_bar_tmpl", expectedRule.Id).WillReturnRows(expectedRuleRows)
+		s.dbmock.ExpectQuery(thirdSelectRuleQuery).WithArgs("foo", "bar", 1).WillReturnRows(expectedRuleRowsInGroup)
+		s.dbmock.ExpectQuery(selectRuleGroupQuery).WillReturnRows(sqlmock.NewRows(nil))
 		s.dbmock.ExpectRollback()
-		actualRule, err := s.repository.Upsert(input, mockTemplateService)
+		actualRule, err := s.repository.Upsert(input, "", mockTemplateService)
 		s.EqualError(err, "random error")
 		s.Nil(actualRule)
 		if err := s.dbmock.ExpectationsWereMet(); err != nil {
@@ -316,19 +395,17 @@ func (s *RepositoryTestSuite) TestUpsert() {
 	})
 
 	s.Run("should rollback update if cortex client creation fails", func() {
-		mockClient := &cortexCallerMock{}
+		mockClient := &adapter.AdapterMock{}
 		mockTemplateService := &mocks.TemplatesService{}
-		oldCortexClientCreator := cortexClientInstance
-		cortexClientInstance = func(string) (cortexCaller, error) {
+		s.adapters.Register("cortex", func(string, string) (adapter.Adapter, error) {
 			return nil, errors.New("random error")
-		}
-		defer func() { cortexClientInstance = oldCortexClientCreator }()
+		})
 		mockTemplateService.On("Render", mock.Anything, mock.Anything).Return(dummyTemplateBody, nil)
 		mockTemplateService.On("GetByName", "tmpl").Return(expectedTemplate, nil)
-		mockClient.On("CreateRuleGroup", mock.Anything, "foo", mock.Anything).Return(errors.New("random error"))
+		mockClient.On("UpsertRuleGroup", mock.Anything, mock.Anything, "foo", mock.Anything).Return(errors.New("random error"))
 		namespaceQuery := regexp.QuoteMeta(`SELECT namespaces.urn as namespace_urn, providers.urn as provider_urn, providers.type as provider_type, providers.host as provider_host FROM "namespaces" RIGHT JOIN providers on providers.id = namespaces.provider_id WHERE namespaces.id = $1`)
-		firstSelectRuleQuery := regexp.QuoteMeta(`SELECT * FROM "rules" WHERE name = 'siren_api_bar_foo_foo_bar_tmpl'`)
-		secondSelectRuleQuery := regexp.QuoteMeta(`SELECT * FROM "rules" WHERE name = 'siren_api_bar_foo_foo_bar_tmpl'`)
+		firstSelectRuleQuery := regexp.QuoteMeta(`SELECT * FROM "rules" WHERE name = $1`)
+		secondSelectRuleQuery := regexp.QuoteMeta(`SELECT * FROM "rules" WHERE name = $1`)
 		updateRuleQuery := regexp.QuoteMeta(`UPDATE "rules" SET "updated_at"=$1,"name"=$2,"namespace"=$3,"group_name"=$4,"template"=$5,"enabled"=$6,"variables"=$7,"provider_namespace"=$8 WHERE id = $9 AND "id" = $10`)
 		input := &Rule{
 			Namespace:         "foo",
@@ -375,13 +452,13 @@ func (s *RepositoryTestSuite) TestUpsert() {
 
 		s.dbmock.ExpectBegin()
 		s.dbmock.ExpectQuery(namespaceQuery).WillReturnRows(expectedNamespaceRow)
-		s.dbmock.ExpectQuery(firstSelectRuleQuery).WillReturnRows(expectedRuleRowsInFirstQuery)
+		s.dbmock.ExpectQuery(firstSelectRuleQuery).WithArgs("siren_api_bar_foo_foo_bar_tmpl").WillReturnRows(expectedRuleRowsInFirstQuery)
 		s.dbmock.ExpectExec(updateRuleQuery).WithArgs(AnyTime{}, expectedRule.Name, expectedRule.Namespace,
 			expectedRule.GroupName, expectedRule.Template, expectedRule.Enabled, expectedRule.Variables,
 			expectedRule.ProviderNamespace, expectedRule.Id, expectedRule.Id).WillReturnResult(sqlmock.NewResult(10, 1))
-		s.dbmock.ExpectQuery(secondSelectRuleQuery).WillReturnRows(expectedRuleRows)
+		s.dbmock.ExpectQuery(secondSelectRuleQuery).WithArgs("siren_api_bar_foo_foo_bar_tmpl", expectedRule.Id).WillReturnRows(expectedRuleRows)
 		s.dbmock.ExpectRollback()
-		actualRule, err := s.repository.Upsert(input, mockTemplateService)
+		actualRule, err := s.repository.Upsert(input, "", mockTemplateService)
 		s.EqualError(err, "random error")
 		s.Nil(actualRule)
 		if err := s.dbmock.ExpectationsWereMet(); err != nil {
@@ -390,21 +467,19 @@ func (s *RepositoryTestSuite) TestUpsert() {
 	})
 
 	s.Run("should rollback insert if cortex API call fails", func() {
-		mockClient := &cortexCallerMock{}
+		mockClient := &adapter.AdapterMock{}
 		mockTemplateService := &mocks.TemplatesService{}
-		oldCortexClientCreator := cortexClientInstance
-		cortexClientInstance = func(string) (cortexCaller, error) {
+		s.adapters.Register("cortex", func(string, string) (adapter.Adapter, error) {
 			return mockClient, nil
-		}
-		defer func() { cortexClientInstance = oldCortexClientCreator }()
+		})
 		mockTemplateService.On("Render", mock.Anything, mock.Anything).Return(dummyTemplateBody, nil)
 		mockTemplateService.On("GetByName", "tmpl").Return(expectedTemplate, nil)
-		mockClient.On("CreateRuleGroup", mock.Anything, "foo", mock.Anything).Return(errors.New("random error"))
+		mockClient.On("UpsertRuleGroup", mock.Anything, mock.Anything, "foo", mock.Anything).Return(errors.New("random error"))
 		namespaceQuery := regexp.QuoteMeta(`SELECT namespaces.urn as namespace_urn, providers.urn as provider_urn, providers.type as provider_type, providers.host as provider_host FROM "namespaces" RIGHT JOIN providers on providers.id = namespaces.provider_id WHERE namespaces.id = $1`)
-		firstSelectRuleQuery := regexp.QuoteMeta(`SELECT * FROM "rules" WHERE name = 'siren_api_bar_foo_foo_bar_tmpl'`)
-		secondSelectRuleQuery := regexp.QuoteMeta(`SELECT * FROM "rules" WHERE name = 'siren_api_bar_foo_foo_bar_tmpl'`)
-		thirdSelectRuleQuery := regexp.QuoteMeta(`SELECT * FROM "rules" WHERE namespace = 'foo' AND group_name = 'bar' AND provider_namespace = '1'`)
-		insertRuleQuery := regexp.QuoteMeta(`INSERT INTO "rules" ("created_at","updated_at","name","namespace","group_name","template","enabled","variables","provider_namespace") VALUES ($1,$2,$3,$4,$5,$6,$7,$8,$9) RETURNING "id"`)
+		firstSelectRuleQuery := regexp.QuoteMeta(`SELECT * FROM "rules" WHERE name = $1`)
+		secondSelectRuleQuery := regexp.QuoteMeta(`SELECT * FROM "rules" WHERE name = $1`)
+		thirdSelectRuleQuery := regexp.QuoteMeta(`SELECT * FROM "rules" WHERE namespace = $1 AND group_name = $2 AND provider_namespace = $3`)
+		insertRuleQuery := regexp.QuoteMeta(`INSERT INTO "rules" ("created_at","updated_at","name","namespace","group_name","template","template_version","enabled","variables","provider_namespace") VALUES ($1,$2,$3,$4,$5,$6,$7,$8,$9,$10) RETURNING "id"`)
 
 		input := &Rule{
 			Namespace:         "foo",
@@ -451,17 +526,18 @@ func (s *RepositoryTestSuite) TestUpsert() {
 
 		s.dbmock.ExpectBegin()
 		s.dbmock.ExpectQuery(namespaceQuery).WillReturnRows(expectedNamespaceRow)
-		s.dbmock.ExpectQuery(firstSelectRuleQuery).WillReturnRows(sqlmock.NewRows(nil))
+		s.dbmock.ExpectQuery(firstSelectRuleQuery).WithArgs("siren_api_bar_foo_foo_bar_tmpl").WillReturnRows(sqlmock.NewRows(nil))
 		s.dbmock.ExpectQuery(insertRuleQuery).
 			WithArgs(AnyTime{},
 				AnyTime{}, expectedRule.Name, expectedRule.Namespace,
-				expectedRule.GroupName, expectedRule.Template, expectedRule.Enabled, expectedRule.Variables,
+				expectedRule.GroupName, expectedRule.Template, expectedRule.TemplateVersion, expectedRule.Enabled, expectedRule.Variables,
 				expectedRule.ProviderNamespace).
 			WillReturnRows(sqlmock.NewRows(nil))
-		s.dbmock.ExpectQuery(secondSelectRuleQuery).WillReturnRows(expectedRuleRows)
-		s.dbmock.ExpectQuery(thirdSelectRuleQuery).WillReturnRows(expectedRuleRowsInGroup)
+		s.dbmock.ExpectQuery(secondSelectRuleQuery).WithArgs("siren_api_bar_foo_foo_bar_tmpl").WillReturnRows(expectedRuleRows)
+		s.dbmock.ExpectQuery(thirdSelectRuleQuery).WithArgs("foo", "bar", 1).WillReturnRows(expectedRuleRowsInGroup)
+		s.dbmock.ExpectQuery(selectRuleGroupQuery).WillReturnRows(sqlmock.NewRows(nil))
 		s.dbmock.ExpectRollback()
-		actualRule, err := s.repository.Upsert(input, mockTemplateService)
+		actualRule, err := s.repository.Upsert(input, "", mockTemplateService)
 		s.EqualError(err, "random error")
 		s.Nil(actualRule)
 		if err := s.dbmock.ExpectationsWereMet(); err != nil {
@@ -470,11 +546,11 @@ func (s *RepositoryTestSuite) TestUpsert() {
 	})
 
 	s.Run("should rollback if namespace select query fails", func() {
-		mockClient := &cortexCallerMock{}
+		mockClient := &adapter.AdapterMock{}
 		mockTemplateService := &mocks.TemplatesService{}
 		mockTemplateService.On("Render", mock.Anything, mock.Anything).Return(dummyTemplateBody, nil)
 		mockTemplateService.On("GetByName", "tmpl").Return(expectedTemplate, nil)
-		mockClient.On("CreateRuleGroup", mock.Anything, "foo", mock.Anything).Return(errors.New("random error"))
+		mockClient.On("UpsertRuleGroup", mock.Anything, mock.Anything, "foo", mock.Anything).Return(errors.New("random error"))
 		namespaceQuery := regexp.QuoteMeta(`SELECT namespaces.urn as namespace_urn, providers.urn as provider_urn, providers.type as provider_type, providers.host as provider_host FROM "namespaces" RIGHT JOIN providers on providers.id = namespaces.provider_id WHERE namespaces.id = $1`)
 
 		input := &Rule{
@@ -489,21 +565,21 @@ func (s *RepositoryTestSuite) TestUpsert() {
 		s.dbmock.ExpectBegin()
 		s.dbmock.ExpectQuery(namespaceQuery).WillReturnError(errors.New("random error"))
 		s.dbmock.ExpectRollback()
-		actualRule, err := s.repository.Upsert(input, mockTemplateService)
+		actualRule, err := s.repository.Upsert(input, "", mockTemplateService)
 		s.EqualError(err, "random error")
 		s.Nil(actualRule)
-		mockClient.AssertNotCalled(s.T(), "CreateRuleGroup")
+		mockClient.AssertNotCalled(s.T(), "UpsertRuleGroup")
 		if err := s.dbmock.ExpectationsWereMet(); err != nil {
 			s.T().Errorf("there were unfulfilled expectations: %s", err)
 		}
 	})
 
 	s.Run("should rollback if namespace select query returns no result", func() {
-		mockClient := &cortexCallerMock{}
+		mockClient := &adapter.AdapterMock{}
 		mockTemplateService := &mocks.TemplatesService{}
 		mockTemplateService.On("Render", mock.Anything, mock.Anything).Return(dummyTemplateBody, nil)
 		mockTemplateService.On("GetByName", "tmpl").Return(expectedTemplate, nil)
-		mockClient.On("CreateRuleGroup", mock.Anything, "foo", mock.Anything).Return(errors.New("random error"))
+		mockClient.On("UpsertRuleGroup", mock.Anything, mock.Anything, "foo", mock.Anything).Return(errors.New("random error"))
 		namespaceQuery := regexp.QuoteMeta(`SELECT namespaces.urn as namespace_urn, providers.urn as provider_urn, providers.type as provider_type, providers.host as provider_host FROM "namespaces" RIGHT JOIN providers on providers.id = namespaces.provider_id WHERE namespaces.id = $1`)
 
 		input := &Rule{
@@ -518,24 +594,24 @@ func (s *RepositoryTestSuite) TestUpsert() {
 		s.dbmock.ExpectBegin()
 		s.dbmock.ExpectQuery(namespaceQuery).WillReturnRows(sqlmock.NewRows(nil))
 		s.dbmock.ExpectRollback()
-		actualRule, err := s.repository.Upsert(input, mockTemplateService)
+		actualRule, err := s.repository.Upsert(input, "", mockTemplateService)
 		s.EqualError(err, "provider not found")
 		s.Nil(actualRule)
-		mockClient.AssertNotCalled(s.T(), "CreateRuleGroup")
+		mockClient.AssertNotCalled(s.T(), "UpsertRuleGroup")
 		if err := s.dbmock.ExpectationsWereMet(); err != nil {
 			s.T().Errorf("there were unfulfilled expectations: %s", err)
 		}
 	})
 
 	s.Run("should rollback if provider not supported", func() {
-		mockClient := &cortexCallerMock{}
+		mockClient := &adapter.AdapterMock{}
 		mockTemplateService := &mocks.TemplatesService{}
 		mockTemplateService.On("Render", mock.Anything, mock.Anything).Return(dummyTemplateBody, nil)
 		mockTemplateService.On("GetByName", "tmpl").Return(expectedTemplate, nil)
 		namespaceQuery := regexp.QuoteMeta(`SELECT namespaces.urn as namespace_urn, providers.urn as provider_urn, providers.type as provider_type, providers.host as provider_host FROM "namespaces" RIGHT JOIN providers on providers.id = namespaces.provider_id WHERE namespaces.id = $1`)
-		firstSelectRuleQuery := regexp.QuoteMeta(`SELECT * FROM "rules" WHERE name = 'siren_api_bar_foo_foo_bar_tmpl'`)
-		secondSelectRuleQuery := regexp.QuoteMeta(`SELECT * FROM "rules" WHERE name = 'siren_api_bar_foo_foo_bar_tmpl'`)
-		insertRuleQuery := regexp.QuoteMeta(`INSERT INTO "rules" ("created_at","updated_at","name","namespace","group_name","template","enabled","variables","provider_namespace") VALUES ($1,$2,$3,$4,$5,$6,$7,$8,$9) RETURNING "id"`)
+		firstSelectRuleQuery := regexp.QuoteMeta(`SELECT * FROM "rules" WHERE name = $1`)
+		secondSelectRuleQuery := regexp.QuoteMeta(`SELECT * FROM "rules" WHERE name = $1`)
+		insertRuleQuery := regexp.QuoteMeta(`INSERT INTO "rules" ("created_at","updated_at","name","namespace","group_name","template","template_version","enabled","variables","provider_namespace") VALUES ($1,$2,$3,$4,$5,$6,$7,$8,$9,$10) RETURNING "id"`)
 
 		input := &Rule{
 			Namespace:         "foo",
@@ -577,32 +653,32 @@ func (s *RepositoryTestSuite) TestUpsert() {
 
 		s.dbmock.ExpectBegin()
 		s.dbmock.ExpectQuery(namespaceQuery).WillReturnRows(expectedNamespaceRow)
-		s.dbmock.ExpectQuery(firstSelectRuleQuery).WillReturnRows(sqlmock.NewRows(nil))
+		s.dbmock.ExpectQuery(firstSelectRuleQuery).WithArgs("siren_api_bar_foo_foo_bar_tmpl").WillReturnRows(sqlmock.NewRows(nil))
 		s.dbmock.ExpectQuery(insertRuleQuery).WithArgs(AnyTime{},
 			AnyTime{}, expectedRule.Name, expectedRule.Namespace,
-			expectedRule.GroupName, expectedRule.Template, expectedRule.Enabled, expectedRule.Variables,
+			expectedRule.GroupName, expectedRule.Template, expectedRule.TemplateVersion, expectedRule.Enabled, expectedRule.Variables,
 			expectedRule.ProviderNamespace).
 			WillReturnRows(sqlmock.NewRows(nil))
-		s.dbmock.ExpectQuery(secondSelectRuleQuery).WillReturnRows(expectedRuleRows)
+		s.dbmock.ExpectQuery(secondSelectRuleQuery).WithArgs("siren_api_bar_foo_foo_bar_tmpl").WillReturnRows(expectedRuleRows)
 		s.dbmock.ExpectRollback()
-		actualRule, err := s.repository.Upsert(input, mockTemplateService)
+		actualRule, err := s.repository.Upsert(input, "", mockTemplateService)
 		s.EqualError(err, "provider not supported")
 		s.Nil(actualRule)
-		mockClient.AssertNotCalled(s.T(), "CreateRuleGroup")
+		mockClient.AssertNotCalled(s.T(), "UpsertRuleGroup")
 		if err := s.dbmock.ExpectationsWereMet(); err != nil {
 			s.T().Errorf("there were unfulfilled expectations: %s", err)
 		}
 	})
 
 	s.Run("should rollback if insert query fails", func() {
-		mockClient := &cortexCallerMock{}
+		mockClient := &adapter.AdapterMock{}
 		mockTemplateService := &mocks.TemplatesService{}
 		mockTemplateService.On("Render", mock.Anything, mock.Anything).Return(dummyTemplateBody, nil)
 		mockTemplateService.On("GetByName", "tmpl").Return(expectedTemplate, nil)
-		mockClient.On("CreateRuleGroup", mock.Anything, "foo", mock.Anything).Return(errors.New("random error"))
+		mockClient.On("UpsertRuleGroup", mock.Anything, mock.Anything, "foo", mock.Anything).Return(errors.New("random error"))
 		namespaceQuery := regexp.QuoteMeta(`SELECT namespaces.urn as namespace_urn, providers.urn as provider_urn, providers.type as provider_type, providers.host as provider_host FROM "namespaces" RIGHT JOIN providers on providers.id = namespaces.provider_id WHERE namespaces.id = $1`)
-		firstSelectRuleQuery := regexp.QuoteMeta(`SELECT * FROM "rules" WHERE name = 'siren_api_bar_foo_foo_bar_tmpl'`)
-		insertRuleQuery := regexp.QuoteMeta(`INSERT INTO "rules" ("created_at","updated_at","name","namespace","group_name","template","enabled","variables","provider_namespace") VALUES ($1,$2,$3,$4,$5,$6,$7,$8,$9) RETURNING "id"`)
+		firstSelectRuleQuery := regexp.QuoteMeta(`SELECT * FROM "rules" WHERE name = $1`)
+		insertRuleQuery := regexp.QuoteMeta(`INSERT INTO "rules" ("created_at","updated_at","name","namespace","group_name","template","template_version","enabled","variables","provider_namespace") VALUES ($1,$2,$3,$4,$5,$6,$7,$8,$9,$10) RETURNING "id"`)
 
 		input := &Rule{
 			Namespace:         "foo",
@@ -639,30 +715,30 @@ func (s *RepositoryTestSuite) TestUpsert() {
 
 		s.dbmock.ExpectBegin()
 		s.dbmock.ExpectQuery(namespaceQuery).WillReturnRows(expectedNamespaceRow)
-		s.dbmock.ExpectQuery(firstSelectRuleQuery).WillReturnRows(sqlmock.NewRows(nil))
+		s.dbmock.ExpectQuery(firstSelectRuleQuery).WithArgs("siren_api_bar_foo_foo_bar_tmpl").WillReturnRows(sqlmock.NewRows(nil))
 		s.dbmock.ExpectQuery(insertRuleQuery).WithArgs(AnyTime{},
 			AnyTime{}, expectedRule.Name, expectedRule.Namespace,
-			expectedRule.GroupName, expectedRule.Template, expectedRule.Enabled, expectedRule.Variables,
+			expectedRule.GroupName, expectedRule.Template, expectedRule.TemplateVersion, expectedRule.Enabled, expectedRule.Variables,
 			expectedRule.ProviderNamespace).
 			WillReturnError(errors.New("random error"))
 		s.dbmock.ExpectRollback()
-		actualRule, err := s.repository.Upsert(input, mockTemplateService)
+		actualRule, err := s.repository.Upsert(input, "", mockTemplateService)
 		s.EqualError(err, "random error")
 		s.Nil(actualRule)
-		mockClient.AssertNotCalled(s.T(), "CreateRuleGroup")
+		mockClient.AssertNotCalled(s.T(), "UpsertRuleGroup")
 		if err := s.dbmock.ExpectationsWereMet(); err != nil {
 			s.T().Errorf("there were unfulfilled expectations: %s", err)
 		}
 	})
 
 	s.Run("should rollback if first select query fails", func() {
-		mockClient := &cortexCallerMock{}
+		mockClient := &adapter.AdapterMock{}
 		mockTemplateService := &mocks.TemplatesService{}
 		mockTemplateService.On("Render", mock.Anything, mock.Anything).Return(dummyTemplateBody, nil)
 		mockTemplateService.On("GetByName", "tmpl").Return(expectedTemplate, nil)
-		mockClient.On("CreateRuleGroup", mock.Anything, "foo", mock.Anything).Return(errors.New("random error"))
+		mockClient.On("UpsertRuleGroup", mock.Anything, mock.Anything, "foo", mock.Anything).Return(errors.New("random error"))
 		namespaceQuery := regexp.QuoteMeta(`SELECT namespaces.urn as namespace_urn, providers.urn as provider_urn, providers.type as provider_type, providers.host as provider_host FROM "namespaces" RIGHT JOIN providers on providers.id = namespaces.provider_id WHERE namespaces.id = $1`)
-		firstSelectRuleQuery := regexp.QuoteMeta(`SELECT * FROM "rules" WHERE name = 'siren_api_bar_foo_foo_bar_tmpl'`)
+		firstSelectRuleQuery := regexp.QuoteMeta(`SELECT * FROM "rules" WHERE name = $1`)
 
 		input := &Rule{
 			Namespace:         "foo",
@@ -687,26 +763,26 @@ func (s *RepositoryTestSuite) TestUpsert() {
 
 		s.dbmock.ExpectBegin()
 		s.dbmock.ExpectQuery(namespaceQuery).WillReturnRows(expectedNamespaceRow)
-		s.dbmock.ExpectQuery(firstSelectRuleQuery).WillReturnError(errors.New("random error"))
+		s.dbmock.ExpectQuery(firstSelectRuleQuery).WithArgs("siren_api_bar_foo_foo_bar_tmpl").WillReturnError(errors.New("random error"))
 		s.dbmock.ExpectRollback()
-		actualRule, err := s.repository.Upsert(input, mockTemplateService)
+		actualRule, err := s.repository.Upsert(input, "", mockTemplateService)
 		s.EqualError(err, "random error")
 		s.Nil(actualRule)
-		mockClient.AssertNotCalled(s.T(), "CreateRuleGroup")
+		mockClient.AssertNotCalled(s.T(), "UpsertRuleGroup")
 		if err := s.dbmock.ExpectationsWereMet(); err != nil {
 			s.T().Errorf("there were unfulfilled expectations: %s", err)
 		}
 	})
 
 	s.Run("should rollback if second select query fails", func() {
-		mockClient := &cortexCallerMock{}
+		mockClient := &adapter.AdapterMock{}
 		mockTemplateService := &mocks.TemplatesService{}
 		mockTemplateService.On("Render", mock.Anything, mock.Anything).Return(dummyTemplateBody, nil)
 		mockTemplateService.On("GetByName", "tmpl").Return(expectedTemplate, nil)
 		namespaceQuery := regexp.QuoteMeta(`SELECT namespaces.urn as namespace_urn, providers.urn as provider_urn, providers.type as provider_type, providers.host as provider_host FROM "namespaces" RIGHT JOIN providers on providers.id = namespaces.provider_id WHERE namespaces.id = $1`)
-		firstSelectRuleQuery := regexp.QuoteMeta(`SELECT * FROM "rules" WHERE name = 'siren_api_bar_foo_foo_bar_tmpl'`)
-		secondSelectRuleQuery := regexp.QuoteMeta(`SELECT * FROM "rules" WHERE name = 'siren_api_bar_foo_foo_bar_tmpl'`)
-		insertRuleQuery := regexp.QuoteMeta(`INSERT INTO "rules" ("created_at","updated_at","name","namespace","group_name","template","enabled","variables","provider_namespace") VALUES ($1,$2,$3,$4,$5,$6,$7,$8,$9) RETURNING "id"`)
+		firstSelectRuleQuery := regexp.QuoteMeta(`SELECT * FROM "rules" WHERE name = $1`)
+		secondSelectRuleQuery := regexp.QuoteMeta(`SELECT * FROM "rules" WHERE name = $1`)
+		insertRuleQuery := regexp.QuoteMeta(`INSERT INTO "rules" ("created_at","updated_at","name","namespace","group_name","template","template_version","enabled","variables","provider_namespace") VALUES ($1,$2,$3,$4,$5,$6,$7,$8,$9,$10) RETURNING "id"`)
 		input := &Rule{
 			Namespace:         "foo",
 			GroupName:         "bar",
@@ -742,33 +818,33 @@ func (s *RepositoryTestSuite) TestUpsert() {
 
 		s.dbmock.ExpectBegin()
 		s.dbmock.ExpectQuery(namespaceQuery).WillReturnRows(expectedNamespaceRow)
-		s.dbmock.ExpectQuery(firstSelectRuleQuery).WillReturnRows(sqlmock.NewRows(nil))
+		s.dbmock.ExpectQuery(firstSelectRuleQuery).WithArgs("siren_api_bar_foo_foo_bar_tmpl").WillReturnRows(sqlmock.NewRows(nil))
 		s.dbmock.ExpectQuery(insertRuleQuery).WithArgs(AnyTime{},
 			AnyTime{}, expectedRule.Name, expectedRule.Namespace,
-			expectedRule.GroupName, expectedRule.Template, expectedRule.Enabled, expectedRule.Variables,
+			expectedRule.GroupName, expectedRule.Template, expectedRule.TemplateVersion, expectedRule.Enabled, expectedRule.Variables,
 			expectedRule.ProviderNamespace).
 			WillReturnRows(sqlmock.NewRows(nil))
-		s.dbmock.ExpectQuery(secondSelectRuleQuery).WillReturnError(errors.New("random error"))
+		s.dbmock.ExpectQuery(secondSelectRuleQuery).WithArgs("siren_api_bar_foo_foo_bar_tmpl").WillReturnError(errors.New("random error"))
 		s.dbmock.ExpectRollback()
-		actualRule, err := s.repository.Upsert(input, mockTemplateService)
+		actualRule, err := s.repository.Upsert(input, "", mockTemplateService)
 		s.EqualError(err, "random error")
 		s.Nil(actualRule)
-		mockClient.AssertNotCalled(s.T(), "CreateRuleGroup")
+		mockClient.AssertNotCalled(s.T(), "UpsertRuleGroup")
 		if err := s.dbmock.ExpectationsWereMet(); err != nil {
 			s.T().Errorf("there were unfulfilled expectations: %s", err)
 		}
 	})
 
 	s.Run("should rollback if third select query fails", func() {
-		mockClient := &cortexCallerMock{}
+		mockClient := &adapter.AdapterMock{}
 		mockTemplateService := &mocks.TemplatesService{}
 		mockTemplateService.On("Render", mock.Anything, mock.Anything).Return(dummyTemplateBody, nil)
 		mockTemplateService.On("GetByName", "tmpl").Return(expectedTemplate, nil)
 		namespaceQuery := regexp.QuoteMeta(`SELECT namespaces.urn as namespace_urn, providers.urn as provider_urn, providers.type as provider_type, providers.host as provider_host FROM "namespaces" RIGHT JOIN providers on providers.id = namespaces.provider_id WHERE namespaces.id = $1`)
-		firstSelectRuleQuery := regexp.QuoteMeta(`SELECT * FROM "rules" WHERE name = 'siren_api_bar_foo_foo_bar_tmpl'`)
-		secondSelectRuleQuery := regexp.QuoteMeta(`SELECT * FROM "rules" WHERE name = 'siren_api_bar_foo_foo_bar_tmpl'`)
-		thirdSelectRuleQuery := regexp.QuoteMeta(`SELECT * FROM "rules" WHERE namespace = 'foo' AND group_name = 'bar' AND provider_namespace = '1'`)
-		insertRuleQuery := regexp.QuoteMeta(`INSERT INTO "rules" ("created_at","updated_at","name","namespace","group_name","template","enabled","variables","provider_namespace") VALUES ($1,$2,$3,$4,$5,$6,$7,$8,$9) RETURNING "id"`)
+		firstSelectRuleQuery := regexp.QuoteMeta(`SELECT * FROM "rules" WHERE name = $1`)
+		secondSelectRuleQuery := regexp.QuoteMeta(`SELECT * FROM "rules" WHERE name = $1`)
+		thirdSelectRuleQuery := regexp.QuoteMeta(`SELECT * FROM "rules" WHERE namespace = $1 AND group_name = $2 AND provider_namespace = $3`)
+		insertRuleQuery := regexp.QuoteMeta(`INSERT INTO "rules" ("created_at","updated_at","name","namespace","group_name","template","template_version","enabled","variables","provider_namespace") VALUES ($1,$2,$3,$4,$5,$6,$7,$8,$9,$10) RETURNING "id"`)
 
 		input := &Rule{
 			Namespace:         "foo",
@@ -810,40 +886,38 @@ func (s *RepositoryTestSuite) TestUpsert() {
 
 		s.dbmock.ExpectBegin()
 		s.dbmock.ExpectQuery(namespaceQuery).WillReturnRows(expectedNamespaceRow)
-		s.dbmock.ExpectQuery(firstSelectRuleQuery).WillReturnRows(sqlmock.NewRows(nil))
+		s.dbmock.ExpectQuery(firstSelectRuleQuery).WithArgs("siren_api_bar_foo_foo_bar_tmpl").WillReturnRows(sqlmock.NewRows(nil))
 		s.dbmock.ExpectQuery(insertRuleQuery).WithArgs(AnyTime{},
 			AnyTime{}, expectedRule.Name, expectedRule.Namespace,
-			expectedRule.GroupName, expectedRule.Template, expectedRule.Enabled, expectedRule.Variables,
+			expectedRule.GroupName, expectedRule.Template, expectedRule.TemplateVersion, expectedRule.Enabled, expectedRule.Variables,
 			expectedRule.ProviderNamespace).
 			WillReturnRows(sqlmock.NewRows(nil))
-		s.dbmock.ExpectQuery(secondSelectRuleQuery).WillReturnRows(expectedRuleRows)
-		s.dbmock.ExpectQuery(thirdSelectRuleQuery).WillReturnError(errors.New("random error"))
+		s.dbmock.ExpectQuery(secondSelectRuleQuery).WithArgs("siren_api_bar_foo_foo_bar_tmpl").WillReturnRows(expectedRuleRows)
+		s.dbmock.ExpectQuery(thirdSelectRuleQuery).WithArgs("foo", "bar", 1).WillReturnError(errors.New("random error"))
 		s.dbmock.ExpectRollback()
-		actualRule, err := s.repository.Upsert(input, mockTemplateService)
+		actualRule, err := s.repository.Upsert(input, "", mockTemplateService)
 		s.EqualError(err, "random error")
 		s.Nil(actualRule)
-		mockClient.AssertNotCalled(s.T(), "CreateRuleGroup")
+		mockClient.AssertNotCalled(s.T(), "UpsertRuleGroup")
 		if err := s.dbmock.ExpectationsWereMet(); err != nil {
 			s.T().Errorf("there were unfulfilled expectations: %s", err)
 		}
 	})
 
 	s.Run("should disable alerts if no error from cortex", func() {
-		mockClient := &cortexCallerMock{}
-		oldCortexClientCreator := cortexClientInstance
-		cortexClientInstance = func(string) (cortexCaller, error) {
+		mockClient := &adapter.AdapterMock{}
+		s.adapters.Register("cortex", func(string, string) (adapter.Adapter, error) {
 			return mockClient, nil
-		}
-		defer func() { cortexClientInstance = oldCortexClientCreator }()
-		mockClient.On("DeleteRuleGroup", mock.Anything, "foo", "bar").Return(nil)
+		})
+		mockClient.On("DeleteRuleGroup", mock.Anything, mock.Anything, "foo", "bar").Return(nil)
 		mockTemplateService := &mocks.TemplatesService{}
 		mockTemplateService.On("Render", mock.Anything, mock.Anything).Return(dummyTemplateBody, nil)
 		mockTemplateService.On("GetByName", "tmpl").Return(expectedTemplate, nil)
 		namespaceQuery := regexp.QuoteMeta(`SELECT namespaces.urn as namespace_urn, providers.urn as provider_urn, providers.type as provider_type, providers.host as provider_host FROM "namespaces" RIGHT JOIN providers on providers.id = namespaces.provider_id WHERE namespaces.id = $1`)
-		firstSelectRuleQuery := regexp.QuoteMeta(`SELECT * FROM "rules" WHERE name = 'siren_api_bar_foo_foo_bar_tmpl'`)
-		secondSelectRuleQuery := regexp.QuoteMeta(`SELECT * FROM "rules" WHERE name = 'siren_api_bar_foo_foo_bar_tmpl'`)
-		thirdSelectRuleQuery := regexp.QuoteMeta(`SELECT * FROM "rules" WHERE namespace = 'foo' AND group_name = 'bar' AND provider_namespace = '1'`)
-		insertRuleQuery := regexp.QuoteMeta(`INSERT INTO "rules" ("created_at","updated_at","name","namespace","group_name","template","enabled","variables","provider_namespace") VALUES ($1,$2,$3,$4,$5,$6,$7,$8,$9) RETURNING "id"`)
+		firstSelectRuleQuery := regexp.QuoteMeta(`SELECT * FROM "rules" WHERE name = $1`)
+		secondSelectRuleQuery := regexp.QuoteMeta(`SELECT * FROM "rules" WHERE name = $1`)
+		thirdSelectRuleQuery := regexp.QuoteMeta(`SELECT * FROM "rules" WHERE namespace = $1 AND group_name = $2 AND provider_namespace = $3`)
+		insertRuleQuery := regexp.QuoteMeta(`INSERT INTO "rules" ("created_at","updated_at","name","namespace","group_name","template","template_version","enabled","variables","provider_namespace") VALUES ($1,$2,$3,$4,$5,$6,$7,$8,$9,$10) RETURNING "id"`)
 
 		input := &Rule{
 			Namespace:         "foo",
@@ -885,40 +959,39 @@ func (s *RepositoryTestSuite) TestUpsert() {
 
 		s.dbmock.ExpectBegin()
 		s.dbmock.ExpectQuery(namespaceQuery).WillReturnRows(expectedNamespaceRow)
-		s.dbmock.ExpectQuery(firstSelectRuleQuery).WillReturnRows(sqlmock.NewRows(nil))
+		s.dbmock.ExpectQuery(firstSelectRuleQuery).WithArgs("siren_api_bar_foo_foo_bar_tmpl").WillReturnRows(sqlmock.NewRows(nil))
 		s.dbmock.ExpectQuery(insertRuleQuery).WithArgs(AnyTime{},
 			AnyTime{}, expectedRule.Name, expectedRule.Namespace,
-			expectedRule.GroupName, expectedRule.Template, expectedRule.Enabled, expectedRule.Variables,
+			expectedRule.GroupName, expectedRule.Template, expectedRule.TemplateVersion, expectedRule.Enabled, expectedRule.Variables,
 			expectedRule.ProviderNamespace).
 			WillReturnRows(sqlmock.NewRows(nil))
-		s.dbmock.ExpectQuery(secondSelectRuleQuery).WillReturnRows(expectedRuleRows)
-		s.dbmock.ExpectQuery(thirdSelectRuleQuery).WillReturnRows(sqlmock.NewRows(nil))
+		s.dbmock.ExpectQuery(secondSelectRuleQuery).WithArgs("siren_api_bar_foo_foo_bar_tmpl").WillReturnRows(expectedRuleRows)
+		s.dbmock.ExpectQuery(thirdSelectRuleQuery).WithArgs("foo", "bar", 1).WillReturnRows(sqlmock.NewRows(nil))
+		s.dbmock.ExpectQuery(insertRevisionQuery).WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1))
 		s.dbmock.ExpectCommit()
-		actualRule, err := s.repository.Upsert(input, mockTemplateService)
+		actualRule, err := s.repository.Upsert(input, "", mockTemplateService)
 		s.Equal(expectedRule, actualRule)
 		s.Nil(err)
-		mockClient.AssertCalled(s.T(), "DeleteRuleGroup", mock.Anything, "foo", "bar")
+		mockClient.AssertCalled(s.T(), "DeleteRuleGroup", mock.Anything, mock.Anything, "foo", "bar")
 		if err := s.dbmock.ExpectationsWereMet(); err != nil {
 			s.T().Errorf("there were unfulfilled expectations: %s", err)
 		}
 	})
 
 	s.Run("should rollback if delete rule group call fails", func() {
-		mockClient := &cortexCallerMock{}
+		mockClient := &adapter.AdapterMock{}
 		mockTemplateService := &mocks.TemplatesService{}
-		oldCortexClientCreator := cortexClientInstance
-		cortexClientInstance = func(string) (cortexCaller, error) {
+		s.adapters.Register("cortex", func(string, string) (adapter.Adapter, error) {
 			return mockClient, nil
-		}
-		defer func() { cortexClientInstance = oldCortexClientCreator }()
+		})
 		mockTemplateService.On("Render", mock.Anything, mock.Anything).Return(dummyTemplateBody, nil)
 		mockTemplateService.On("GetByName", "tmpl").Return(expectedTemplate, nil)
-		mockClient.On("DeleteRuleGroup", mock.Anything, "foo", "bar").Return(errors.New("random error"))
+		mockClient.On("DeleteRuleGroup", mock.Anything, mock.Anything, "foo", "bar").Return(errors.New("random error"))
 		namespaceQuery := regexp.QuoteMeta(`SELECT namespaces.urn as namespace_urn, providers.urn as provider_urn, providers.type as provider_type, providers.host as provider_host FROM "namespaces" RIGHT JOIN providers on providers.id = namespaces.provider_id WHERE namespaces.id = $1`)
-		firstSelectRuleQuery := regexp.QuoteMeta(`SELECT * FROM "rules" WHERE name = 'siren_api_bar_foo_foo_bar_tmpl'`)
-		secondSelectRuleQuery := regexp.QuoteMeta(`SELECT * FROM "rules" WHERE name = 'siren_api_bar_foo_foo_bar_tmpl'`)
-		thirdSelectRuleQuery := regexp.QuoteMeta(`SELECT * FROM "rules" WHERE namespace = 'foo' AND group_name = 'bar' AND provider_namespace = '1'`)
-		insertRuleQuery := regexp.QuoteMeta(`INSERT INTO "rules" ("created_at","updated_at","name","namespace","group_name","template","enabled","variables","provider_namespace") VALUES ($1,$2,$3,$4,$5,$6,$7,$8,$9) RETURNING "id"`)
+		firstSelectRuleQuery := regexp.QuoteMeta(`SELECT * FROM "rules" WHERE name = $1`)
+		secondSelectRuleQuery := regexp.QuoteMeta(`SELECT * FROM "rules" WHERE name = $1`)
+		thirdSelectRuleQuery := regexp.QuoteMeta(`SELECT * FROM "rules" WHERE namespace = $1 AND group_name = $2 AND provider_namespace = $3`)
+		insertRuleQuery := regexp.QuoteMeta(`INSERT INTO "rules" ("created_at","updated_at","name","namespace","group_name","template","template_version","enabled","variables","provider_namespace") VALUES ($1,$2,$3,$4,$5,$6,$7,$8,$9,$10) RETURNING "id"`)
 
 		input := &Rule{
 			Namespace:         "foo",
@@ -960,16 +1033,16 @@ func (s *RepositoryTestSuite) TestUpsert() {
 
 		s.dbmock.ExpectBegin()
 		s.dbmock.ExpectQuery(namespaceQuery).WillReturnRows(expectedNamespaceRow)
-		s.dbmock.ExpectQuery(firstSelectRuleQuery).WillReturnRows(sqlmock.NewRows(nil))
+		s.dbmock.ExpectQuery(firstSelectRuleQuery).WithArgs("siren_api_bar_foo_foo_bar_tmpl").WillReturnRows(sqlmock.NewRows(nil))
 		s.dbmock.ExpectQuery(insertRuleQuery).WithArgs(AnyTime{},
 			AnyTime{}, expectedRule.Name, expectedRule.Namespace,
-			expectedRule.GroupName, expectedRule.Template, expectedRule.Enabled, expectedRule.Variables,
+			expectedRule.GroupName, expectedRule.Template, expectedRule.TemplateVersion, expectedRule.Enabled, expectedRule.Variables,
 			expectedRule.ProviderNamespace).
 			WillReturnRows(sqlmock.NewRows(nil))
-		s.dbmock.ExpectQuery(secondSelectRuleQuery).WillReturnRows(expectedRuleRows)
-		s.dbmock.ExpectQuery(thirdSelectRuleQuery).WillReturnRows(sqlmock.NewRows(nil))
+		s.dbmock.ExpectQuery(secondSelectRuleQuery).WithArgs("siren_api_bar_foo_foo_bar_tmpl").WillReturnRows(expectedRuleRows)
+		s.dbmock.ExpectQuery(thirdSelectRuleQuery).WithArgs("foo", "bar", 1).WillReturnRows(sqlmock.NewRows(nil))
 		s.dbmock.ExpectRollback()
-		actualRule, err := s.repository.Upsert(input, mockTemplateService)
+		actualRule, err := s.repository.Upsert(input, "", mockTemplateService)
 		s.EqualError(err, "random error")
 		s.Nil(actualRule)
 		if err := s.dbmock.ExpectationsWereMet(); err != nil {
@@ -978,21 +1051,19 @@ func (s *RepositoryTestSuite) TestUpsert() {
 	})
 
 	s.Run("should handle deletion of non-existent rule group", func() {
-		mockClient := &cortexCallerMock{}
+		mockClient := &adapter.AdapterMock{}
 		mockTemplateService := &mocks.TemplatesService{}
-		oldCortexClientCreator := cortexClientInstance
-		cortexClientInstance = func(string) (cortexCaller, error) {
+		s.adapters.Register("cortex", func(string, string) (adapter.Adapter, error) {
 			return mockClient, nil
-		}
-		defer func() { cortexClientInstance = oldCortexClientCreator }()
+		})
 		mockTemplateService.On("Render", mock.Anything, mock.Anything).Return(dummyTemplateBody, nil)
 		mockTemplateService.On("GetByName", "tmpl").Return(expectedTemplate, nil)
-		mockClient.On("DeleteRuleGroup", mock.Anything, "foo", "bar").Return(errors.New("requested resource not found"))
+		mockClient.On("DeleteRuleGroup", mock.Anything, mock.Anything, "foo", "bar").Return(nil)
 		namespaceQuery := regexp.QuoteMeta(`SELECT namespaces.urn as namespace_urn, providers.urn as provider_urn, providers.type as provider_type, providers.host as provider_host FROM "namespaces" RIGHT JOIN providers on providers.id = namespaces.provider_id WHERE namespaces.id = $1`)
-		firstSelectRuleQuery := regexp.QuoteMeta(`SELECT * FROM "rules" WHERE name = 'siren_api_bar_foo_foo_bar_tmpl'`)
-		secondSelectRuleQuery := regexp.QuoteMeta(`SELECT * FROM "rules" WHERE name = 'siren_api_bar_foo_foo_bar_tmpl'`)
-		thirdSelectRuleQuery := regexp.QuoteMeta(`SELECT * FROM "rules" WHERE namespace = 'foo' AND group_name = 'bar' AND provider_namespace = '1'`)
-		insertRuleQuery := regexp.QuoteMeta(`INSERT INTO "rules" ("created_at","updated_at","name","namespace","group_name","template","enabled","variables","provider_namespace") VALUES ($1,$2,$3,$4,$5,$6,$7,$8,$9) RETURNING "id"`)
+		firstSelectRuleQuery := regexp.QuoteMeta(`SELECT * FROM "rules" WHERE name = $1`)
+		secondSelectRuleQuery := regexp.QuoteMeta(`SELECT * FROM "rules" WHERE name = $1`)
+		thirdSelectRuleQuery := regexp.QuoteMeta(`SELECT * FROM "rules" WHERE namespace = $1 AND group_name = $2 AND provider_namespace = $3`)
+		insertRuleQuery := regexp.QuoteMeta(`INSERT INTO "rules" ("created_at","updated_at","name","namespace","group_name","template","template_version","enabled","variables","provider_namespace") VALUES ($1,$2,$3,$4,$5,$6,$7,$8,$9,$10) RETURNING "id"`)
 
 		input := &Rule{
 			Namespace:         "foo",
@@ -1034,16 +1105,17 @@ func (s *RepositoryTestSuite) TestUpsert() {
 
 		s.dbmock.ExpectBegin()
 		s.dbmock.ExpectQuery(namespaceQuery).WillReturnRows(expectedNamespaceRow)
-		s.dbmock.ExpectQuery(firstSelectRuleQuery).WillReturnRows(sqlmock.NewRows(nil))
+		s.dbmock.ExpectQuery(firstSelectRuleQuery).WithArgs("siren_api_bar_foo_foo_bar_tmpl").WillReturnRows(sqlmock.NewRows(nil))
 		s.dbmock.ExpectQuery(insertRuleQuery).WithArgs(AnyTime{},
 			AnyTime{}, expectedRule.Name, expectedRule.Namespace,
-			expectedRule.GroupName, expectedRule.Template, expectedRule.Enabled, expectedRule.Variables,
+			expectedRule.GroupName, expectedRule.Template, expectedRule.TemplateVersion, expectedRule.Enabled, expectedRule.Variables,
 			expectedRule.ProviderNamespace).
 			WillReturnRows(sqlmock.NewRows(nil))
-		s.dbmock.ExpectQuery(secondSelectRuleQuery).WillReturnRows(expectedRuleRows)
-		s.dbmock.ExpectQuery(thirdSelectRuleQuery).WillReturnRows(sqlmock.NewRows(nil))
+		s.dbmock.ExpectQuery(secondSelectRuleQuery).WithArgs("siren_api_bar_foo_foo_bar_tmpl").WillReturnRows(expectedRuleRows)
+		s.dbmock.ExpectQuery(thirdSelectRuleQuery).WithArgs("foo", "bar", 1).WillReturnRows(sqlmock.NewRows(nil))
+		s.dbmock.ExpectQuery(insertRevisionQuery).WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1))
 		s.dbmock.ExpectCommit()
-		actualRule, err := s.repository.Upsert(input, mockTemplateService)
+		actualRule, err := s.repository.Upsert(input, "", mockTemplateService)
 		s.Equal(expectedRule, actualRule)
 		s.Nil(err)
 		if err := s.dbmock.ExpectationsWereMet(); err != nil {
@@ -1052,10 +1124,10 @@ func (s *RepositoryTestSuite) TestUpsert() {
 	})
 
 	s.Run("should return error if template get query fails", func() {
-		mockClient := &cortexCallerMock{}
+		mockClient := &adapter.AdapterMock{}
 		mockTemplateService := &mocks.TemplatesService{}
 		mockTemplateService.On("GetByName", "tmpl").Return(nil, errors.New("random error"))
-		mockClient.On("CreateRuleGroup", mock.Anything, "foo", mock.Anything).Return(nil)
+		mockClient.On("UpsertRuleGroup", mock.Anything, mock.Anything, "foo", mock.Anything).Return(nil)
 		input := &Rule{
 			Namespace:         "foo",
 			GroupName:         "bar",
@@ -1065,7 +1137,7 @@ func (s *RepositoryTestSuite) TestUpsert() {
 			Variables:         `[{"name":"for", "type":"string", "value":"20m", "description":"test"}]`,
 		}
 
-		actualRule, err := s.repository.Upsert(input, mockTemplateService)
+		actualRule, err := s.repository.Upsert(input, "", mockTemplateService)
 		s.EqualError(err, "random error")
 		s.Nil(actualRule)
 		if err := s.dbmock.ExpectationsWereMet(); err != nil {
@@ -1074,16 +1146,16 @@ func (s *RepositoryTestSuite) TestUpsert() {
 	})
 
 	s.Run("should rollback if template get query fails while rendering", func() {
-		mockClient := &cortexCallerMock{}
+		mockClient := &adapter.AdapterMock{}
 		mockTemplateService := &mocks.TemplatesService{}
 		mockTemplateService.On("Render", mock.Anything, mock.Anything).Return("", errors.New("random error"))
 		mockTemplateService.On("GetByName", "tmpl").Return(expectedTemplate, nil)
-		mockClient.On("CreateRuleGroup", mock.Anything, "foo", mock.Anything).Return(nil)
+		mockClient.On("UpsertRuleGroup", mock.Anything, mock.Anything, "foo", mock.Anything).Return(nil)
 		namespaceQuery := regexp.QuoteMeta(`SELECT namespaces.urn as namespace_urn, providers.urn as provider_urn, providers.type as provider_type, providers.host as provider_host FROM "namespaces" RIGHT JOIN providers on providers.id = namespaces.provider_id WHERE namespaces.id = $1`)
-		firstSelectRuleQuery := regexp.QuoteMeta(`SELECT * FROM "rules" WHERE name = 'siren_api_bar_foo_foo_bar_tmpl'`)
-		secondSelectRuleQuery := regexp.QuoteMeta(`SELECT * FROM "rules" WHERE name = 'siren_api_bar_foo_foo_bar_tmpl'`)
-		thirdSelectRuleQuery := regexp.QuoteMeta(`SELECT * FROM "rules" WHERE namespace = 'foo' AND group_name = 'bar' AND provider_namespace = '1'`)
-		insertRuleQuery := regexp.QuoteMeta(`INSERT INTO "rules" ("created_at","updated_at","name","namespace","group_name","template","enabled","variables","provider_namespace") VALUES ($1,$2,$3,$4,$5,$6,$7,$8,$9) RETURNING "id"`)
+		firstSelectRuleQuery := regexp.QuoteMeta(`SELECT * FROM "rules" WHERE name = $1`)
+		secondSelectRuleQuery := regexp.QuoteMeta(`SELECT * FROM "rules" WHERE name = $1`)
+		thirdSelectRuleQuery := regexp.QuoteMeta(`SELECT * FROM "rules" WHERE namespace = $1 AND group_name = $2 AND provider_namespace = $3`)
+		insertRuleQuery := regexp.QuoteMeta(`INSERT INTO "rules" ("created_at","updated_at","name","namespace","group_name","template","template_version","enabled","variables","provider_namespace") VALUES ($1,$2,$3,$4,$5,$6,$7,$8,$9,$10) RETURNING "id"`)
 
 		input := &Rule{
 			Namespace:         "foo",
@@ -1130,16 +1202,16 @@ func (s *RepositoryTestSuite) TestUpsert() {
 
 		s.dbmock.ExpectBegin()
 		s.dbmock.ExpectQuery(namespaceQuery).WillReturnRows(expectedNamespaceRow)
-		s.dbmock.ExpectQuery(firstSelectRuleQuery).WillReturnRows(sqlmock.NewRows(nil))
+		s.dbmock.ExpectQuery(firstSelectRuleQuery).WithArgs("siren_api_bar_foo_foo_bar_tmpl").WillReturnRows(sqlmock.NewRows(nil))
 		s.dbmock.ExpectQuery(insertRuleQuery).WithArgs(AnyTime{},
 			AnyTime{}, expectedRule.Name, expectedRule.Namespace,
-			expectedRule.GroupName, expectedRule.Template, expectedRule.Enabled, expectedRule.Variables,
+			expectedRule.GroupName, expectedRule.Template, expectedRule.TemplateVersion, expectedRule.Enabled, expectedRule.Variables,
 			expectedRule.ProviderNamespace).
 			WillReturnRows(sqlmock.NewRows(nil))
-		s.dbmock.ExpectQuery(secondSelectRuleQuery).WillReturnRows(expectedRuleRows)
-		s.dbmock.ExpectQuery(thirdSelectRuleQuery).WillReturnRows(expectedRuleRowsInGroup)
+		s.dbmock.ExpectQuery(secondSelectRuleQuery).WithArgs("siren_api_bar_foo_foo_bar_tmpl").WillReturnRows(expectedRuleRows)
+		s.dbmock.ExpectQuery(thirdSelectRuleQuery).WithArgs("foo", "bar", 1).WillReturnRows(expectedRuleRowsInGroup)
 		s.dbmock.ExpectRollback()
-		actualRule, err := s.repository.Upsert(input, mockTemplateService)
+		actualRule, err := s.repository.Upsert(input, "", mockTemplateService)
 		s.EqualError(err, "random error")
 		s.Nil(actualRule)
 		if err := s.dbmock.ExpectationsWereMet(); err != nil {
@@ -1148,10 +1220,10 @@ func (s *RepositoryTestSuite) TestUpsert() {
 	})
 
 	s.Run("should return error if rule variables json unmarshalling fails", func() {
-		mockClient := &cortexCallerMock{}
+		mockClient := &adapter.AdapterMock{}
 		mockTemplateService := &mocks.TemplatesService{}
 		mockTemplateService.On("GetByName", "tmpl").Return(expectedTemplate, nil)
-		mockClient.On("CreateRuleGroup", mock.Anything, "foo", mock.Anything).Return(nil)
+		mockClient.On("UpsertRuleGroup", mock.Anything, mock.Anything, "foo", mock.Anything).Return(nil)
 		input := &Rule{
 			Namespace:         "foo",
 			GroupName:         "bar",
@@ -1161,7 +1233,7 @@ func (s *RepositoryTestSuite) TestUpsert() {
 			Variables:         `{}`,
 		}
 
-		actualRule, err := s.repository.Upsert(input, mockTemplateService)
+		actualRule, err := s.repository.Upsert(input, "", mockTemplateService)
 		s.EqualError(err, "json: cannot unmarshal object into Go value of type []domain.RuleVariable")
 		s.Nil(actualRule)
 		if err := s.dbmock.ExpectationsWereMet(); err != nil {
@@ -1169,8 +1241,32 @@ func (s *RepositoryTestSuite) TestUpsert() {
 		}
 	})
 
+	s.Run("should return error if a rule variable does not match the template variable type", func() {
+		mockTemplateService := &mocks.TemplatesService{}
+		mockTemplateService.On("GetByName", "tmpl").Return(&domain.Template{
+			Name:      "tmpl",
+			Body:      expectedTemplate.Body,
+			Variables: []domain.Variable{{Name: "for", Default: "10m", Type: "duration"}, {Name: "team", Default: "gojek", Type: "string"}},
+		}, nil)
+		input := &Rule{
+			Namespace:         "foo",
+			GroupName:         "bar",
+			Template:          "tmpl",
+			Enabled:           &truebool,
+			ProviderNamespace: 1,
+			Variables:         `[{"name":"for", "type":"duration", "value":"20", "description":"test"}]`,
+		}
+
+		actualRule, err := s.repository.Upsert(input, "", mockTemplateService)
+		s.EqualError(err, `invalid variable for: "20" is not a duration`)
+		s.Nil(actualRule)
+		if err := s.dbmock.ExpectationsWereMet(); err != nil {
+			s.T().Errorf("there were unfulfilled expectations: %s", err)
+		}
+	})
+
 	s.Run("should return error if rule body yaml unmarshalling fail", func() {
-		mockClient := &cortexCallerMock{}
+		mockClient := &adapter.AdapterMock{}
 		mockTemplateService := &mocks.TemplatesService{}
 		badTemplate := &domain.Template{
 			ID:        10,
@@ -1183,12 +1279,12 @@ func (s *RepositoryTestSuite) TestUpsert() {
 		}
 		mockTemplateService.On("Render", mock.Anything, mock.Anything).Return(badTemplate.Body, nil)
 		mockTemplateService.On("GetByName", "tmpl").Return(badTemplate, nil)
-		mockClient.On("CreateRuleGroup", mock.Anything, "foo", mock.Anything).Return(nil)
+		mockClient.On("UpsertRuleGroup", mock.Anything, mock.Anything, "foo", mock.Anything).Return(nil)
 		namespaceQuery := regexp.QuoteMeta(`SELECT namespaces.urn as namespace_urn, providers.urn as provider_urn, providers.type as provider_type, providers.host as provider_host FROM "namespaces" RIGHT JOIN providers on providers.id = namespaces.provider_id WHERE namespaces.id = $1`)
-		firstSelectRuleQuery := regexp.QuoteMeta(`SELECT * FROM "rules" WHERE name = 'siren_api_bar_foo_foo_bar_tmpl'`)
-		secondSelectRuleQuery := regexp.QuoteMeta(`SELECT * FROM "rules" WHERE name = 'siren_api_bar_foo_foo_bar_tmpl'`)
-		thirdSelectRuleQuery := regexp.QuoteMeta(`SELECT * FROM "rules" WHERE namespace = 'foo' AND group_name = 'bar' AND provider_namespace = '1'`)
-		insertRuleQuery := regexp.QuoteMeta(`INSERT INTO "rules" ("created_at","updated_at","name","namespace","group_name","template","enabled","variables","provider_namespace") VALUES ($1,$2,$3,$4,$5,$6,$7,$8,$9) RETURNING "id"`)
+		firstSelectRuleQuery := regexp.QuoteMeta(`SELECT * FROM "rules" WHERE name = $1`)
+		secondSelectRuleQuery := regexp.QuoteMeta(`SELECT * FROM "rules" WHERE name = $1`)
+		thirdSelectRuleQuery := regexp.QuoteMeta(`SELECT * FROM "rules" WHERE namespace = $1 AND group_name = $2 AND provider_namespace = $3`)
+		insertRuleQuery := regexp.QuoteMeta(`INSERT INTO "rules" ("created_at","updated_at","name","namespace","group_name","template","template_version","enabled","variables","provider_namespace") VALUES ($1,$2,$3,$4,$5,$6,$7,$8,$9,$10) RETURNING "id"`)
 
 		input := &Rule{
 			Namespace:         "foo",
@@ -1235,17 +1331,17 @@ func (s *RepositoryTestSuite) TestUpsert() {
 
 		s.dbmock.ExpectBegin()
 		s.dbmock.ExpectQuery(namespaceQuery).WillReturnRows(expectedNamespaceRow)
-		s.dbmock.ExpectQuery(firstSelectRuleQuery).WillReturnRows(sqlmock.NewRows(nil))
+		s.dbmock.ExpectQuery(firstSelectRuleQuery).WithArgs("siren_api_bar_foo_foo_bar_tmpl").WillReturnRows(sqlmock.NewRows(nil))
 		s.dbmock.ExpectQuery(insertRuleQuery).WithArgs(AnyTime{},
 			AnyTime{}, expectedRule.Name, expectedRule.Namespace,
-			expectedRule.GroupName, expectedRule.Template, expectedRule.Enabled, expectedRule.Variables,
+			expectedRule.GroupName, expectedRule.Template, expectedRule.TemplateVersion, expectedRule.Enabled, expectedRule.Variables,
 			expectedRule.ProviderNamespace).
 			WillReturnRows(sqlmock.NewRows(nil))
-		s.dbmock.ExpectQuery(secondSelectRuleQuery).WillReturnRows(expectedRuleRows)
-		s.dbmock.ExpectQuery(thirdSelectRuleQuery).WillReturnRows(expectedRuleRowsInGroup)
+		s.dbmock.ExpectQuery(secondSelectRuleQuery).WithArgs("siren_api_bar_foo_foo_bar_tmpl").WillReturnRows(expectedRuleRows)
+		s.dbmock.ExpectQuery(thirdSelectRuleQuery).WithArgs("foo", "bar", 1).WillReturnRows(expectedRuleRowsInGroup)
 		s.dbmock.ExpectRollback()
-		actualRule, err := s.repository.Upsert(input, mockTemplateService)
-		s.EqualError(err, "yaml: unmarshal errors:\n  line 1: cannot unmarshal !!str `abcd` into []rulefmt.RuleNode")
+		actualRule, err := s.repository.Upsert(input, "", mockTemplateService)
+		s.EqualError(err, "invalid rule siren_api_bar_foo_foo_bar_tmpl rendered from template tmpl with variables [for=20m, team=gojek]: yaml: unmarshal errors:\n  line 1: cannot unmarshal !!str `abcd` into []rulefmt.RuleNode")
 		s.Nil(actualRule)
 		if err := s.dbmock.ExpectationsWereMet(); err != nil {
 			s.T().Errorf("there were unfulfilled expectations: %s", err)
@@ -1253,20 +1349,18 @@ func (s *RepositoryTestSuite) TestUpsert() {
 	})
 
 	s.Run("should store disabled alerts", func() {
-		mockClient := &cortexCallerMock{}
+		mockClient := &adapter.AdapterMock{}
 		mockTemplateService := &mocks.TemplatesService{}
-		oldCortexClientCreator := cortexClientInstance
-		cortexClientInstance = func(string) (cortexCaller, error) {
+		s.adapters.Register("cortex", func(string, string) (adapter.Adapter, error) {
 			return mockClient, nil
-		}
-		defer func() { cortexClientInstance = oldCortexClientCreator }()
+		})
 		mockTemplateService.On("Render", mock.Anything, mock.Anything).Return(dummyTemplateBody, nil)
 		mockTemplateService.On("GetByName", "tmpl").Return(expectedTemplate, nil)
-		mockClient.On("DeleteRuleGroup", mock.Anything, "foo", "bar").Return(nil)
+		mockClient.On("DeleteRuleGroup", mock.Anything, mock.Anything, "foo", "bar").Return(nil)
 		namespaceQuery := regexp.QuoteMeta(`SELECT namespaces.urn as namespace_urn, providers.urn as provider_urn, providers.type as provider_type, providers.host as provider_host FROM "namespaces" RIGHT JOIN providers on providers.id = namespaces.provider_id WHERE namespaces.id = $1`)
-		firstSelectRuleQuery := regexp.QuoteMeta(`SELECT * FROM "rules" WHERE name = 'siren_api_bar_foo_foo_bar_tmpl'`)
-		secondSelectRuleQuery := regexp.QuoteMeta(`SELECT * FROM "rules" WHERE name = 'siren_api_bar_foo_foo_bar_tmpl'`)
-		thirdSelectRuleQuery := regexp.QuoteMeta(`SELECT * FROM "rules" WHERE namespace = 'foo' AND group_name = 'bar' AND provider_namespace = '1'`)
+		firstSelectRuleQuery := regexp.QuoteMeta(`SELECT * FROM "rules" WHERE name = $1`)
+		secondSelectRuleQuery := regexp.QuoteMeta(`SELECT * FROM "rules" WHERE name = $1`)
+		thirdSelectRuleQuery := regexp.QuoteMeta(`SELECT * FROM "rules" WHERE namespace = $1 AND group_name = $2 AND provider_namespace = $3`)
 		updateRuleQuery := regexp.QuoteMeta(`UPDATE "rules" SET "updated_at"=$1,"name"=$2,"namespace"=$3,"group_name"=$4,"template"=$5,"enabled"=$6,"variables"=$7,"provider_namespace"=$8 WHERE id = $9 AND "id" = $10`)
 		input := &Rule{
 			Namespace:         "foo",
@@ -1313,16 +1407,17 @@ func (s *RepositoryTestSuite) TestUpsert() {
 
 		s.dbmock.ExpectBegin()
 		s.dbmock.ExpectQuery(namespaceQuery).WillReturnRows(expectedNamespaceRow)
-		s.dbmock.ExpectQuery(firstSelectRuleQuery).WillReturnRows(expectedRuleRowsInFirstQuery)
+		s.dbmock.ExpectQuery(firstSelectRuleQuery).WithArgs("siren_api_bar_foo_foo_bar_tmpl").WillReturnRows(expectedRuleRowsInFirstQuery)
 		s.dbmock.ExpectExec(updateRuleQuery).
 			WithArgs(AnyTime{}, expectedRule.Name, expectedRule.Namespace,
 				expectedRule.GroupName, expectedRule.Template, expectedRule.Enabled, expectedRule.Variables,
 				expectedRule.ProviderNamespace, expectedRule.Id, expectedRule.Id).
 			WillReturnResult(sqlmock.NewResult(10, 1))
-		s.dbmock.ExpectQuery(secondSelectRuleQuery).WillReturnRows(expectedRuleRowsInGroup)
-		s.dbmock.ExpectQuery(thirdSelectRuleQuery).WillReturnRows(expectedRuleRowsInGroup)
+		s.dbmock.ExpectQuery(secondSelectRuleQuery).WithArgs("siren_api_bar_foo_foo_bar_tmpl", expectedRule.Id).WillReturnRows(expectedRuleRowsInGroup)
+		s.dbmock.ExpectQuery(thirdSelectRuleQuery).WithArgs("foo", "bar", 1).WillReturnRows(expectedRuleRowsInGroup)
+		s.dbmock.ExpectQuery(insertRevisionQuery).WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1))
 		s.dbmock.ExpectCommit()
-		actualRule, err := s.repository.Upsert(input, mockTemplateService)
+		actualRule, err := s.repository.Upsert(input, "", mockTemplateService)
 		s.Equal(expectedRule, actualRule)
 		s.Nil(err)
 		if err := s.dbmock.ExpectationsWereMet(); err != nil {
@@ -1331,11 +1426,11 @@ func (s *RepositoryTestSuite) TestUpsert() {
 	})
 
 	s.Run("should return error if template not found", func() {
-		mockClient := &cortexCallerMock{}
+		mockClient := &adapter.AdapterMock{}
 		mockTemplateService := &mocks.TemplatesService{}
 		mockTemplateService.On("Render", mock.Anything, mock.Anything).Return(dummyTemplateBody, nil)
 		mockTemplateService.On("GetByName", "tmpl").Return(nil, nil)
-		mockClient.On("CreateRuleGroup", mock.Anything, "foo", mock.Anything).Return(nil)
+		mockClient.On("UpsertRuleGroup", mock.Anything, mock.Anything, "foo", mock.Anything).Return(nil)
 		input := &Rule{
 			Namespace:         "foo",
 			GroupName:         "bar",
@@ -1344,28 +1439,26 @@ func (s *RepositoryTestSuite) TestUpsert() {
 			ProviderNamespace: 1,
 			Variables:         `[{"name":"for", "type":"string", "value":"20m", "description":"test"}]`,
 		}
-		actualRule, err := s.repository.Upsert(input, mockTemplateService)
+		actualRule, err := s.repository.Upsert(input, "", mockTemplateService)
 		s.EqualError(err, "template not found")
 		s.Nil(actualRule)
 	})
 
 	s.Run("should insert disabled rule and not call cortex APIs", func() {
-		mockClient := &cortexCallerMock{}
+		mockClient := &adapter.AdapterMock{}
 		mockTemplateService := &mocks.TemplatesService{}
-		oldCortexClientCreator := cortexClientInstance
-		cortexClientInstance = func(string) (cortexCaller, error) {
+		s.adapters.Register("cortex", func(string, string) (adapter.Adapter, error) {
 			return mockClient, nil
-		}
-		defer func() { cortexClientInstance = oldCortexClientCreator }()
+		})
 		mockTemplateService.On("Render", mock.Anything, mock.Anything).Return(dummyTemplateBody, nil)
 		mockTemplateService.On("GetByName", "tmpl").Return(expectedTemplate, nil)
-		mockClient.On("CreateRuleGroup", mock.Anything, "foo", mock.Anything).Return(nil)
-		mockClient.On("DeleteRuleGroup", mock.Anything, "foo", "bar").Return(errors.New("requested resource not found"))
+		mockClient.On("UpsertRuleGroup", mock.Anything, mock.Anything, "foo", mock.Anything).Return(nil)
+		mockClient.On("DeleteRuleGroup", mock.Anything, mock.Anything, "foo", "bar").Return(nil)
 		namespaceQuery := regexp.QuoteMeta(`SELECT namespaces.urn as namespace_urn, providers.urn as provider_urn, providers.type as provider_type, providers.host as provider_host FROM "namespaces" RIGHT JOIN providers on providers.id = namespaces.provider_id WHERE namespaces.id = $1`)
-		firstSelectRuleQuery := regexp.QuoteMeta(`SELECT * FROM "rules" WHERE name = 'siren_api_bar_foo_foo_bar_tmpl'`)
-		secondSelectRuleQuery := regexp.QuoteMeta(`SELECT * FROM "rules" WHERE name = 'siren_api_bar_foo_foo_bar_tmpl'`)
-		thirdSelectRuleQuery := regexp.QuoteMeta(`SELECT * FROM "rules" WHERE namespace = 'foo' AND group_name = 'bar' AND provider_namespace = '1'`)
-		insertRuleQuery := regexp.QuoteMeta(`INSERT INTO "rules" ("created_at","updated_at","name","namespace","group_name","template","enabled","variables","provider_namespace") VALUES ($1,$2,$3,$4,$5,$6,$7,$8,$9) RETURNING "id"`)
+		firstSelectRuleQuery := regexp.QuoteMeta(`SELECT * FROM "rules" WHERE name = $1`)
+		secondSelectRuleQuery := regexp.QuoteMeta(`SELECT * FROM "rules" WHERE name = $1`)
+		thirdSelectRuleQuery := regexp.QuoteMeta(`SELECT * FROM "rules" WHERE namespace = $1 AND group_name = $2 AND provider_namespace = $3`)
+		insertRuleQuery := regexp.QuoteMeta(`INSERT INTO "rules" ("created_at","updated_at","name","namespace","group_name","template","template_version","enabled","variables","provider_namespace") VALUES ($1,$2,$3,$4,$5,$6,$7,$8,$9,$10) RETURNING "id"`)
 
 		input := &Rule{
 			Namespace:         "foo",
@@ -1413,19 +1506,93 @@ func (s *RepositoryTestSuite) TestUpsert() {
 
 		s.dbmock.ExpectBegin()
 		s.dbmock.ExpectQuery(namespaceQuery).WillReturnRows(expectedNamespaceRow)
-		s.dbmock.ExpectQuery(firstSelectRuleQuery).WillReturnRows(sqlmock.NewRows(nil))
+		s.dbmock.ExpectQuery(firstSelectRuleQuery).WithArgs("siren_api_bar_foo_foo_bar_tmpl").WillReturnRows(sqlmock.NewRows(nil))
 		s.dbmock.ExpectQuery(insertRuleQuery).WithArgs(AnyTime{},
 			AnyTime{}, expectedRule.Name, expectedRule.Namespace,
-			expectedRule.GroupName, expectedRule.Template, expectedRule.Enabled, expectedRule.Variables,
+			expectedRule.GroupName, expectedRule.Template, expectedRule.TemplateVersion, expectedRule.Enabled, expectedRule.Variables,
 			expectedRule.ProviderNamespace).
 			WillReturnRows(sqlmock.NewRows(nil))
-		s.dbmock.ExpectQuery(secondSelectRuleQuery).WillReturnRows(expectedRuleRows)
-		s.dbmock.ExpectQuery(thirdSelectRuleQuery).WillReturnRows(expectedRuleRowsInGroup)
+		s.dbmock.ExpectQuery(secondSelectRuleQuery).WithArgs("siren_api_bar_foo_foo_bar_tmpl").WillReturnRows(expectedRuleRows)
+		s.dbmock.ExpectQuery(thirdSelectRuleQuery).WithArgs("foo", "bar", 1).WillReturnRows(expectedRuleRowsInGroup)
+		s.dbmock.ExpectQuery(insertRevisionQuery).WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1))
 		s.dbmock.ExpectCommit()
-		actualRule, err := s.repository.Upsert(input, mockTemplateService)
+		actualRule, err := s.repository.Upsert(input, "", mockTemplateService)
 		s.Equal(expectedRule, actualRule)
 		s.Nil(err)
 	})
+
+	s.Run("should return invalid rule error and rollback if rendered rule is invalid", func() {
+		mockClient := &adapter.AdapterMock{}
+		mockTemplateService := &mocks.TemplatesService{}
+		s.adapters.Register("cortex", func(string, string) (adapter.Adapter, error) {
+			return mockClient, nil
+		})
+		mockTemplateService.On("Render", mock.Anything, mock.Anything).
+			Return("-\n    alert: Test\n    expr: 'sum(test-expr'\n", nil)
+		mockTemplateService.On("GetByName", "tmpl").Return(expectedTemplate, nil)
+		namespaceQuery := regexp.QuoteMeta(`SELECT namespaces.urn as namespace_urn, providers.urn as provider_urn, providers.type as provider_type, providers.host as provider_host FROM "namespaces" RIGHT JOIN providers on providers.id = namespaces.provider_id WHERE namespaces.id = $1`)
+		firstSelectRuleQuery := regexp.QuoteMeta(`SELECT * FROM "rules" WHERE name = $1`)
+		secondSelectRuleQuery := regexp.QuoteMeta(`SELECT * FROM "rules" WHERE name = $1`)
+		thirdSelectRuleQuery := regexp.QuoteMeta(`SELECT * FROM "rules" WHERE namespace = $1 AND group_name = $2 AND provider_namespace = $3`)
+		insertRuleQuery := regexp.QuoteMeta(`INSERT INTO "rules" ("created_at","updated_at","name","namespace","group_name","template","template_version","enabled","variables","provider_namespace") VALUES ($1,$2,$3,$4,$5,$6,$7,$8,$9,$10) RETURNING "id"`)
+
+		input := &Rule{
+			Namespace:         "foo",
+			GroupName:         "bar",
+			Template:          "tmpl",
+			Enabled:           &truebool,
+			ProviderNamespace: 1,
+			Variables:         `[{"name":"for", "type":"string", "value":"20m", "description":"test"}]`,
+		}
+		expectedRule := &Rule{
+			Id:                10,
+			CreatedAt:         time.Now(),
+			UpdatedAt:         time.Now(),
+			Name:              "siren_api_bar_foo_foo_bar_tmpl",
+			Namespace:         "foo",
+			GroupName:         "bar",
+			Enabled:           &truebool,
+			Template:          "tmpl",
+			ProviderNamespace: 1,
+			Variables:         `[{"name":"for","type":"string","value":"20m","description":"test"},{"name":"team","type":"string","value":"gojek","description":"test"}]`,
+		}
+		expectedNamespaceRow := sqlmock.NewRows([]string{"namespace_urn", "provider_urn", "provider_type"}).
+			AddRow("foo", "bar", "cortex")
+		ruleColumns := []string{"id", "created_at", "updated_at", "name", "namespace", "group_name", "template", "enabled", "variables", "provider_namespace"}
+		expectedRuleRows := sqlmock.NewRows(ruleColumns).
+			AddRow(expectedRule.Id, expectedRule.CreatedAt,
+				expectedRule.UpdatedAt, expectedRule.Name, expectedRule.Namespace,
+				expectedRule.GroupName, expectedRule.Template, expectedRule.Enabled,
+				expectedRule.Variables, expectedRule.ProviderNamespace)
+		expectedRulesWithinGroupRows := sqlmock.NewRows(ruleColumns).
+			AddRow(expectedRule.Id, expectedRule.CreatedAt,
+				expectedRule.UpdatedAt, expectedRule.Name, expectedRule.Namespace,
+				expectedRule.GroupName, expectedRule.Template, expectedRule.Enabled,
+				expectedRule.Variables, expectedRule.ProviderNamespace)
+
+		s.dbmock.ExpectBegin()
+		s.dbmock.ExpectQuery(namespaceQuery).WillReturnRows(expectedNamespaceRow)
+		s.dbmock.ExpectQuery(firstSelectRuleQuery).WithArgs("siren_api_bar_foo_foo_bar_tmpl").WillReturnRows(sqlmock.NewRows(nil))
+		s.dbmock.ExpectQuery(insertRuleQuery).WithArgs(AnyTime{},
+			AnyTime{}, expectedRule.Name, expectedRule.Namespace,
+			expectedRule.GroupName, expectedRule.Template, expectedRule.TemplateVersion, expectedRule.Enabled, expectedRule.Variables,
+			expectedRule.ProviderNamespace).
+			WillReturnRows(sqlmock.NewRows(nil))
+		s.dbmock.ExpectQuery(secondSelectRuleQuery).WithArgs("siren_api_bar_foo_foo_bar_tmpl").WillReturnRows(expectedRuleRows)
+		s.dbmock.ExpectQuery(thirdSelectRuleQuery).WithArgs("foo", "bar", 1).WillReturnRows(expectedRulesWithinGroupRows)
+		s.dbmock.ExpectRollback()
+		actualRule, err := s.repository.Upsert(input, "", mockTemplateService)
+		s.Nil(actualRule)
+		var invalidRuleErr *domain.InvalidRuleError
+		s.True(errors.As(err, &invalidRuleErr))
+		s.Equal("tmpl", invalidRuleErr.Template)
+		s.Equal("Test", invalidRuleErr.Rule)
+		s.Equal(map[string]string{"for": "20m", "team": "gojek"}, invalidRuleErr.Variables)
+		mockClient.AssertNotCalled(s.T(), "UpsertRuleGroup", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+		if err := s.dbmock.ExpectationsWereMet(); err != nil {
+			s.T().Errorf("there were unfulfilled expectations: %s", err)
+		}
+	})
 }
 
 func (s *RepositoryTestSuite) TestGet() {
@@ -1500,3 +1667,1275 @@ func (s *RepositoryTestSuite) TestGet() {
 		}
 	})
 }
+
+func (s *RepositoryTestSuite) TestDelete() {
+	var truebool = true
+	dummyTemplateBody := "-\n    alert: Test\n    expr: 'test-expr'\n    for: '20m'\n    labels: {severity: WARNING, team: 'gojek' }\n    annotations: {description: 'test'}\n-\n"
+	selectRuleQuery := regexp.QuoteMeta(`SELECT * FROM "rules" WHERE id = $1`)
+	namespaceQuery := regexp.QuoteMeta(`SELECT namespaces.urn as namespace_urn, providers.urn as provider_urn, providers.type as provider_type, providers.host as provider_host FROM "namespaces" RIGHT JOIN providers on providers.id = namespaces.provider_id WHERE namespaces.id = $1`)
+	deleteRuleQuery := regexp.QuoteMeta(`DELETE FROM "rules" WHERE "rules"."id" = $1`)
+	deleteRevisionsQuery := regexp.QuoteMeta(`DELETE FROM "rule_revisions" WHERE rule_id = $1`)
+	selectRulesWithinGroupQuery := regexp.QuoteMeta(`SELECT * FROM "rules" WHERE namespace = $1 AND group_name = $2 AND provider_namespace = $3`)
+	ruleToDelete := &Rule{
+		Id:                10,
+		CreatedAt:         time.Now(),
+		UpdatedAt:         time.Now(),
+		Name:              "siren_api_bar_foo_foo_bar_tmpl",
+		Namespace:         "foo",
+		GroupName:         "bar",
+		Enabled:           &truebool,
+		Template:          "tmpl",
+		ProviderNamespace: 1,
+		Variables:         `[{"name":"for","type":"string","value":"20m","description":"test"}]`,
+	}
+	remainingRule := &Rule{
+		Id:                11,
+		CreatedAt:         time.Now(),
+		UpdatedAt:         time.Now(),
+		Name:              "siren_api_bar_foo_foo_bar_tmpl2",
+		Namespace:         "foo",
+		GroupName:         "bar",
+		Enabled:           &truebool,
+		Template:          "tmpl2",
+		ProviderNamespace: 1,
+		Variables:         `[{"name":"for","type":"string","value":"20m","description":"test"}]`,
+	}
+	ruleColumns := []string{"id", "created_at", "updated_at", "name", "namespace", "group_name", "template", "enabled", "variables", "provider_namespace"}
+	ruleRows := func(rules ...*Rule) *sqlmock.Rows {
+		rows := sqlmock.NewRows(ruleColumns)
+		for _, r := range rules {
+			rows.AddRow(r.Id, r.CreatedAt, r.UpdatedAt, r.Name, r.Namespace, r.GroupName,
+				r.Template, r.Enabled, r.Variables, r.ProviderNamespace)
+		}
+		return rows
+	}
+	namespaceRow := func(providerType string) *sqlmock.Rows {
+		return sqlmock.NewRows([]string{"namespace_urn", "provider_urn", "provider_type"}).
+			AddRow("foo", "bar", providerType)
+	}
+
+	s.Run("should delete rule and update the rule group in cortex", func() {
+		mockClient := &adapter.AdapterMock{}
+		s.adapters.Register("cortex", func(string, string) (adapter.Adapter, error) {
+			return mockClient, nil
+		})
+		mockClient.On("UpsertRuleGroup", mock.Anything, mock.Anything, "foo", mock.Anything).Return(nil)
+		mockTemplateService := &mocks.TemplatesService{}
+		mockTemplateService.On("Render", "tmpl2", mock.Anything).Return(dummyTemplateBody, nil)
+
+		s.dbmock.ExpectBegin()
+		s.dbmock.ExpectQuery(selectRuleQuery).WillReturnRows(ruleRows(ruleToDelete))
+		s.dbmock.ExpectQuery(namespaceQuery).WillReturnRows(namespaceRow("cortex"))
+		s.dbmock.ExpectExec(deleteRuleQuery).WillReturnResult(sqlmock.NewResult(0, 1))
+		s.dbmock.ExpectExec(deleteRevisionsQuery).WithArgs(10).WillReturnResult(sqlmock.NewResult(0, 2))
+		s.dbmock.ExpectQuery(selectRulesWithinGroupQuery).WithArgs("foo", "bar", 1).WillReturnRows(ruleRows(remainingRule))
+		s.dbmock.ExpectQuery(selectRuleGroupQuery).WillReturnRows(sqlmock.NewRows(nil))
+		s.dbmock.ExpectCommit()
+		err := s.repository.Delete(10, mockTemplateService)
+		s.Nil(err)
+		mockClient.AssertCalled(s.T(), "UpsertRuleGroup", mock.Anything, mock.Anything, "foo", mock.Anything)
+		mockClient.AssertNotCalled(s.T(), "DeleteRuleGroup", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+		if err := s.dbmock.ExpectationsWereMet(); err != nil {
+			s.T().Errorf("there were unfulfilled expectations: %s", err)
+		}
+	})
+
+	s.Run("should delete rule group from cortex if no rules remain in group", func() {
+		mockClient := &adapter.AdapterMock{}
+		s.adapters.Register("cortex", func(string, string) (adapter.Adapter, error) {
+			return mockClient, nil
+		})
+		mockClient.On("DeleteRuleGroup", mock.Anything, mock.Anything, "foo", "bar").Return(nil)
+		mockTemplateService := &mocks.TemplatesService{}
+
+		s.dbmock.ExpectBegin()
+		s.dbmock.ExpectQuery(selectRuleQuery).WillReturnRows(ruleRows(ruleToDelete))
+		s.dbmock.ExpectQuery(namespaceQuery).WillReturnRows(namespaceRow("cortex"))
+		s.dbmock.ExpectExec(deleteRuleQuery).WillReturnResult(sqlmock.NewResult(0, 1))
+		s.dbmock.ExpectExec(deleteRevisionsQuery).WithArgs(10).WillReturnResult(sqlmock.NewResult(0, 2))
+		s.dbmock.ExpectQuery(selectRulesWithinGroupQuery).WithArgs("foo", "bar", 1).WillReturnRows(sqlmock.NewRows(nil))
+		s.dbmock.ExpectCommit()
+		err := s.repository.Delete(10, mockTemplateService)
+		s.Nil(err)
+		mockClient.AssertCalled(s.T(), "DeleteRuleGroup", mock.Anything, mock.Anything, "foo", "bar")
+		if err := s.dbmock.ExpectationsWereMet(); err != nil {
+			s.T().Errorf("there were unfulfilled expectations: %s", err)
+		}
+	})
+
+	s.Run("should return not found error if rule does not exist", func() {
+		mockTemplateService := &mocks.TemplatesService{}
+
+		s.dbmock.ExpectBegin()
+		s.dbmock.ExpectQuery(selectRuleQuery).WillReturnRows(sqlmock.NewRows(nil))
+		s.dbmock.ExpectRollback()
+		err := s.repository.Delete(10, mockTemplateService)
+		var ruleNotFoundErr *domain.RuleNotFoundError
+		s.True(errors.As(err, &ruleNotFoundErr))
+		s.EqualError(err, "rule 10 not found")
+		if err := s.dbmock.ExpectationsWereMet(); err != nil {
+			s.T().Errorf("there were unfulfilled expectations: %s", err)
+		}
+	})
+
+	s.Run("should rollback if provider is not supported", func() {
+		mockTemplateService := &mocks.TemplatesService{}
+
+		s.dbmock.ExpectBegin()
+		s.dbmock.ExpectQuery(selectRuleQuery).WillReturnRows(ruleRows(ruleToDelete))
+		s.dbmock.ExpectQuery(namespaceQuery).WillReturnRows(namespaceRow("prometheus"))
+		s.dbmock.ExpectExec(deleteRuleQuery).WillReturnResult(sqlmock.NewResult(0, 1))
+		s.dbmock.ExpectExec(deleteRevisionsQuery).WithArgs(10).WillReturnResult(sqlmock.NewResult(0, 2))
+		s.dbmock.ExpectRollback()
+		err := s.repository.Delete(10, mockTemplateService)
+		s.EqualError(err, "provider not supported")
+		if err := s.dbmock.ExpectationsWereMet(); err != nil {
+			s.T().Errorf("there were unfulfilled expectations: %s", err)
+		}
+	})
+
+	s.Run("should rollback if provider not found", func() {
+		mockTemplateService := &mocks.TemplatesService{}
+
+		s.dbmock.ExpectBegin()
+		s.dbmock.ExpectQuery(selectRuleQuery).WillReturnRows(ruleRows(ruleToDelete))
+		s.dbmock.ExpectQuery(namespaceQuery).WillReturnRows(sqlmock.NewRows(nil))
+		s.dbmock.ExpectRollback()
+		err := s.repository.Delete(10, mockTemplateService)
+		s.EqualError(err, "provider not found")
+		if err := s.dbmock.ExpectationsWereMet(); err != nil {
+			s.T().Errorf("there were unfulfilled expectations: %s", err)
+		}
+	})
+
+	s.Run("should rollback if rule deletion fails", func() {
+		mockTemplateService := &mocks.TemplatesService{}
+
+		s.dbmock.ExpectBegin()
+		s.dbmock.ExpectQuery(selectRuleQuery).WillReturnRows(ruleRows(ruleToDelete))
+		s.dbmock.ExpectQuery(namespaceQuery).WillReturnRows(namespaceRow("cortex"))
+		s.dbmock.ExpectExec(deleteRuleQuery).WillReturnError(errors.New("random error"))
+		s.dbmock.ExpectRollback()
+		err := s.repository.Delete(10, mockTemplateService)
+		s.EqualError(err, "random error")
+		if err := s.dbmock.ExpectationsWereMet(); err != nil {
+			s.T().Errorf("there were unfulfilled expectations: %s", err)
+		}
+	})
+
+	s.Run("should rollback if deleting the revisions of the rule fails", func() {
+		mockTemplateService := &mocks.TemplatesService{}
+
+		s.dbmock.ExpectBegin()
+		s.dbmock.ExpectQuery(selectRuleQuery).WillReturnRows(ruleRows(ruleToDelete))
+		s.dbmock.ExpectQuery(namespaceQuery).WillReturnRows(namespaceRow("cortex"))
+		s.dbmock.ExpectExec(deleteRuleQuery).WillReturnResult(sqlmock.NewResult(0, 1))
+		s.dbmock.ExpectExec(deleteRevisionsQuery).WithArgs(10).WillReturnError(errors.New("random error"))
+		s.dbmock.ExpectRollback()
+		err := s.repository.Delete(10, mockTemplateService)
+		s.EqualError(err, "random error")
+		if err := s.dbmock.ExpectationsWereMet(); err != nil {
+			s.T().Errorf("there were unfulfilled expectations: %s", err)
+		}
+	})
+
+	s.Run("should rollback if cortex call fails", func() {
+		mockClient := &adapter.AdapterMock{}
+		s.adapters.Register("cortex", func(string, string) (adapter.Adapter, error) {
+			return mockClient, nil
+		})
+		mockClient.On("UpsertRuleGroup", mock.Anything, mock.Anything, "foo", mock.Anything).Return(errors.New("random error"))
+		mockTemplateService := &mocks.TemplatesService{}
+		mockTemplateService.On("Render", "tmpl2", mock.Anything).Return(dummyTemplateBody, nil)
+
+		s.dbmock.ExpectBegin()
+		s.dbmock.ExpectQuery(selectRuleQuery).WillReturnRows(ruleRows(ruleToDelete))
+		s.dbmock.ExpectQuery(namespaceQuery).WillReturnRows(namespaceRow("cortex"))
+		s.dbmock.ExpectExec(deleteRuleQuery).WillReturnResult(sqlmock.NewResult(0, 1))
+		s.dbmock.ExpectExec(deleteRevisionsQuery).WithArgs(10).WillReturnResult(sqlmock.NewResult(0, 2))
+		s.dbmock.ExpectQuery(selectRulesWithinGroupQuery).WithArgs("foo", "bar", 1).WillReturnRows(ruleRows(remainingRule))
+		s.dbmock.ExpectQuery(selectRuleGroupQuery).WillReturnRows(sqlmock.NewRows(nil))
+		s.dbmock.ExpectRollback()
+		err := s.repository.Delete(10, mockTemplateService)
+		s.EqualError(err, "random error")
+		if err := s.dbmock.ExpectationsWereMet(); err != nil {
+			s.T().Errorf("there were unfulfilled expectations: %s", err)
+		}
+	})
+}
+
+func (s *RepositoryTestSuite) TestListRevisions() {
+	selectRevisionsQuery := regexp.QuoteMeta(`SELECT * FROM "rule_revisions" WHERE rule_id = $1 ORDER BY id desc`)
+	revisionColumns := []string{"id", "created_at", "rule_id", "enabled", "variables", "template_hash", "rendered", "actor"}
+
+	s.Run("should return revisions of the rule latest first", func() {
+		createdAt := time.Now()
+		expectedRevisions := []RuleRevision{{
+			Id:           2,
+			CreatedAt:    createdAt,
+			RuleId:       10,
+			Enabled:      false,
+			Variables:    `[{"name":"for","type":"string","value":"20m","description":"test"}]`,
+			TemplateHash: "abc",
+			Rendered:     "- alert: Test\n",
+			Actor:        "bar@odpf.io",
+		}, {
+			Id:           1,
+			CreatedAt:    createdAt,
+			RuleId:       10,
+			Enabled:      true,
+			Variables:    `[{"name":"for","type":"string","value":"10m","description":"test"}]`,
+			TemplateHash: "abc",
+			Rendered:     "- alert: Test\n",
+			Actor:        "foo@odpf.io",
+		}}
+		rows := sqlmock.NewRows(revisionColumns)
+		for _, revision := range expectedRevisions {
+			rows.AddRow(revision.Id, revision.CreatedAt, revision.RuleId, revision.Enabled, revision.Variables,
+				revision.TemplateHash, revision.Rendered, revision.Actor)
+		}
+		s.dbmock.ExpectQuery(selectRevisionsQuery).WithArgs(10).WillReturnRows(rows)
+
+		actualRevisions, err := s.repository.ListRevisions(10)
+		s.Nil(err)
+		s.Equal(expectedRevisions, actualRevisions)
+	})
+
+	s.Run("should return error if any", func() {
+		s.dbmock.ExpectQuery(selectRevisionsQuery).WithArgs(10).WillReturnError(errors.New("random error"))
+
+		actualRevisions, err := s.repository.ListRevisions(10)
+		s.Nil(actualRevisions)
+		s.EqualError(err, "random error")
+	})
+}
+
+func (s *RepositoryTestSuite) TestRollback() {
+	var truebool = true
+	expectedTemplate := &domain.Template{
+		Name:      "tmpl",
+		Body:      "- alert: Test\n  expr: test_metric > [[.threshold]]\n",
+		Variables: []domain.Variable{{Name: "threshold", Default: "10", Type: "int"}},
+	}
+	selectRevisionQuery := regexp.QuoteMeta(`SELECT * FROM "rule_revisions" WHERE id = $1 AND rule_id = $2`)
+	selectRuleQuery := regexp.QuoteMeta(`SELECT * FROM "rules" WHERE id = $1`)
+	namespaceQuery := regexp.QuoteMeta(`SELECT namespaces.urn as namespace_urn, providers.urn as provider_urn, providers.type as provider_type, providers.host as provider_host FROM "namespaces" RIGHT JOIN providers on providers.id = namespaces.provider_id WHERE namespaces.id = $1`)
+	firstSelectRuleQuery := regexp.QuoteMeta(`SELECT * FROM "rules" WHERE name = $1`)
+	thirdSelectRuleQuery := regexp.QuoteMeta(`SELECT * FROM "rules" WHERE namespace = $1 AND group_name = $2 AND provider_namespace = $3`)
+	updateRuleQuery := regexp.QuoteMeta(`UPDATE "rules" SET "updated_at"=$1,"name"=$2,"namespace"=$3,"group_name"=$4,"template"=$5,"enabled"=$6,"variables"=$7,"provider_namespace"=$8 WHERE id = $9 AND "id" = $10`)
+	insertRevisionQuery := regexp.QuoteMeta(`INSERT INTO "rule_revisions" ("created_at","rule_id","enabled","variables","template_hash","template_version","rendered","actor") VALUES ($1,$2,$3,$4,$5,$6,$7,$8) RETURNING "id"`)
+	revisionColumns := []string{"id", "created_at", "rule_id", "enabled", "variables", "template_hash", "rendered", "actor"}
+	ruleColumns := []string{"id", "created_at", "updated_at", "name", "namespace", "group_name", "template", "enabled", "variables", "provider_namespace"}
+
+	s.Run("should restore variables of the revision and push the rule group", func() {
+		mockClient := &adapter.AdapterMock{}
+		mockTemplateService := &mocks.TemplatesService{}
+		s.adapters.Register("cortex", func(string, string) (adapter.Adapter, error) {
+			return mockClient, nil
+		})
+		mockTemplateService.On("GetByName", "tmpl").Return(expectedTemplate, nil)
+		mockTemplateService.On("Render", "tmpl", map[string]string{"threshold": "5"}).
+			Return("- alert: Test\n  expr: test_metric > 5\n", nil)
+		mockClient.On("UpsertRuleGroup", mock.Anything, "foo", "foo", mock.Anything).Return(nil)
+
+		oldVariables := `[{"name":"threshold","type":"int","value":"5","description":""}]`
+		currentVariables := `[{"name":"threshold","type":"int","value":"50","description":""}]`
+		restoredRule := &Rule{
+			Id:                10,
+			CreatedAt:         time.Now(),
+			UpdatedAt:         time.Now(),
+			Name:              "siren_api_bar_foo_foo_bar_tmpl",
+			Namespace:         "foo",
+			GroupName:         "bar",
+			Template:          "tmpl",
+			Enabled:           &truebool,
+			Variables:         oldVariables,
+			ProviderNamespace: 1,
+		}
+		newRuleRows := func(variables string) *sqlmock.Rows {
+			return sqlmock.NewRows(ruleColumns).AddRow(restoredRule.Id, restoredRule.CreatedAt, restoredRule.UpdatedAt,
+				restoredRule.Name, restoredRule.Namespace, restoredRule.GroupName, restoredRule.Template,
+				restoredRule.Enabled, variables, restoredRule.ProviderNamespace)
+		}
+
+		s.dbmock.ExpectQuery(selectRevisionQuery).WithArgs(1, 10).WillReturnRows(sqlmock.NewRows(revisionColumns).
+			AddRow(1, time.Now(), 10, true, oldVariables, templateBodyHash(expectedTemplate.Body),
+				"- alert: Test\n  expr: test_metric > 5\n", "foo@odpf.io"))
+		s.dbmock.ExpectQuery(selectRuleQuery).WithArgs(10).WillReturnRows(newRuleRows(currentVariables))
+		s.dbmock.ExpectBegin()
+		s.dbmock.ExpectQuery(namespaceQuery).WillReturnRows(sqlmock.NewRows([]string{"namespace_urn", "provider_urn", "provider_type"}).
+			AddRow("foo", "bar", "cortex"))
+		s.dbmock.ExpectQuery(firstSelectRuleQuery).WithArgs("siren_api_bar_foo_foo_bar_tmpl").WillReturnRows(newRuleRows(currentVariables))
+		s.dbmock.ExpectExec(updateRuleQuery).
+			WithArgs(AnyTime{}, restoredRule.Name, restoredRule.Namespace, restoredRule.GroupName,
+				restoredRule.Template, restoredRule.Enabled, oldVariables, restoredRule.ProviderNamespace,
+				restoredRule.Id, restoredRule.Id).
+			WillReturnResult(sqlmock.NewResult(10, 1))
+		s.dbmock.ExpectQuery(firstSelectRuleQuery).WithArgs("siren_api_bar_foo_foo_bar_tmpl", 10).WillReturnRows(newRuleRows(oldVariables))
+		s.dbmock.ExpectQuery(thirdSelectRuleQuery).WithArgs("foo", "bar", 1).WillReturnRows(newRuleRows(oldVariables))
+		s.dbmock.ExpectQuery(selectRuleGroupQuery).WillReturnRows(sqlmock.NewRows(nil))
+		s.dbmock.ExpectQuery(insertRevisionQuery).
+			WithArgs(AnyTime{}, restoredRule.Id, true, oldVariables, sqlmock.AnyArg(), 0,
+				"- alert: Test\n  expr: test_metric > 5\n", "bar@odpf.io").
+			WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(3))
+		s.dbmock.ExpectCommit()
+
+		actualRule, err := s.repository.Rollback(10, 1, "bar@odpf.io", mockTemplateService)
+		s.Nil(err)
+		s.Equal(restoredRule, actualRule)
+		mockClient.AssertExpectations(s.T())
+		if err := s.dbmock.ExpectationsWereMet(); err != nil {
+			s.T().Errorf("there were unfulfilled expectations: %s", err)
+		}
+	})
+
+	s.Run("should pin the rule to the template version an unpinned revision was rendered from", func() {
+		mockClient := &adapter.AdapterMock{}
+		s.adapters.Register("cortex", func(string, string) (adapter.Adapter, error) {
+			return mockClient, nil
+		})
+		oldTemplate := &domain.Template{
+			Name:      "tmpl",
+			Body:      "- alert: Test\n  expr: old_metric > [[.threshold]]\n",
+			Variables: expectedTemplate.Variables,
+			Version:   1,
+		}
+		currentTemplate := *expectedTemplate
+		currentTemplate.Version = 2
+		mockTemplateService := &mocks.TemplatesService{}
+		mockTemplateService.On("GetByName", "tmpl").Return(&currentTemplate, nil)
+		mockTemplateService.On("ListVersions", "tmpl").Return([]domain.Template{currentTemplate, *oldTemplate}, nil)
+		mockTemplateService.On("GetVersion", "tmpl", uint(1)).Return(oldTemplate, nil)
+		mockClient.On("UpsertRuleGroup", mock.Anything, "foo", "foo", mock.MatchedBy(func(ruleGroup adapter.RuleGroup) bool {
+			return len(ruleGroup.Rules) == 1 && ruleGroup.Rules[0].Expr.Value == "old_metric > 5"
+		})).Return(nil)
+
+		oldVariables := `[{"name":"threshold","type":"int","value":"5","description":""}]`
+		pinnedRuleColumns := []string{"id", "created_at", "updated_at", "name", "namespace", "group_name", "template", "template_version", "enabled", "variables", "provider_namespace"}
+		pinnedRuleRows := func(templateVersion uint) *sqlmock.Rows {
+			return sqlmock.NewRows(pinnedRuleColumns).AddRow(10, time.Now(), time.Now(), "siren_api_bar_foo_foo_bar_tmpl",
+				"foo", "bar", "tmpl", templateVersion, true, oldVariables, 1)
+		}
+		updatePinnedRuleQuery := regexp.QuoteMeta(`UPDATE "rules" SET "updated_at"=$1,"name"=$2,"namespace"=$3,"group_name"=$4,"template"=$5,"template_version"=$6,"enabled"=$7,"variables"=$8,"provider_namespace"=$9 WHERE id = $10 AND "id" = $11`)
+
+		s.dbmock.ExpectQuery(selectRevisionQuery).WithArgs(1, 10).WillReturnRows(sqlmock.NewRows(revisionColumns).
+			AddRow(1, time.Now(), 10, true, oldVariables, templateBodyHash(oldTemplate.Body),
+				"- alert: Test\n  expr: old_metric > 5\n", "foo@odpf.io"))
+		s.dbmock.ExpectQuery(selectRuleQuery).WithArgs(10).WillReturnRows(pinnedRuleRows(0))
+		s.dbmock.ExpectBegin()
+		s.dbmock.ExpectQuery(namespaceQuery).WillReturnRows(sqlmock.NewRows([]string{"namespace_urn", "provider_urn", "provider_type"}).
+			AddRow("foo", "bar", "cortex"))
+		s.dbmock.ExpectQuery(firstSelectRuleQuery).WithArgs("siren_api_bar_foo_foo_bar_tmpl").WillReturnRows(pinnedRuleRows(0))
+		s.dbmock.ExpectExec(updatePinnedRuleQuery).
+			WithArgs(AnyTime{}, "siren_api_bar_foo_foo_bar_tmpl", "foo", "bar", "tmpl", 1, true, oldVariables, 1, 10, 10).
+			WillReturnResult(sqlmock.NewResult(10, 1))
+		s.dbmock.ExpectQuery(firstSelectRuleQuery).WithArgs("siren_api_bar_foo_foo_bar_tmpl", 10).WillReturnRows(pinnedRuleRows(1))
+		s.dbmock.ExpectQuery(thirdSelectRuleQuery).WithArgs("foo", "bar", 1).WillReturnRows(pinnedRuleRows(1))
+		s.dbmock.ExpectQuery(selectRuleGroupQuery).WillReturnRows(sqlmock.NewRows(nil))
+		s.dbmock.ExpectQuery(insertRevisionQuery).
+			WithArgs(AnyTime{}, 10, true, oldVariables, templateBodyHash(oldTemplate.Body), 1,
+				"- alert: Test\n  expr: old_metric > 5\n", "bar@odpf.io").
+			WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(3))
+		s.dbmock.ExpectCommit()
+
+		actualRule, err := s.repository.Rollback(10, 1, "bar@odpf.io", mockTemplateService)
+		s.Nil(err)
+		s.Equal(uint(1), actualRule.TemplateVersion)
+		mockClient.AssertExpectations(s.T())
+		if err := s.dbmock.ExpectationsWereMet(); err != nil {
+			s.T().Errorf("there were unfulfilled expectations: %s", err)
+		}
+	})
+
+	s.Run("should return error if no template version matches an unpinned revision", func() {
+		mockTemplateService := &mocks.TemplatesService{}
+		mockTemplateService.On("GetByName", "tmpl").Return(expectedTemplate, nil)
+		mockTemplateService.On("ListVersions", "tmpl").Return([]domain.Template{*expectedTemplate}, nil)
+
+		s.dbmock.ExpectQuery(selectRevisionQuery).WithArgs(1, 10).WillReturnRows(sqlmock.NewRows(revisionColumns).
+			AddRow(1, time.Now(), 10, true, `[]`, "abc", "", "foo@odpf.io"))
+		s.dbmock.ExpectQuery(selectRuleQuery).WithArgs(10).WillReturnRows(sqlmock.NewRows(ruleColumns).
+			AddRow(10, time.Now(), time.Now(), "siren_api_bar_foo_foo_bar_tmpl", "foo", "bar", "tmpl", true, `[]`, 1))
+
+		actualRule, err := s.repository.Rollback(10, 1, "bar@odpf.io", mockTemplateService)
+		s.Nil(actualRule)
+		s.EqualError(err, "version of template tmpl that revision 1 was rendered from not found")
+		if err := s.dbmock.ExpectationsWereMet(); err != nil {
+			s.T().Errorf("there were unfulfilled expectations: %s", err)
+		}
+	})
+
+	s.Run("should return error if revision does not belong to the rule", func() {
+		s.dbmock.ExpectQuery(selectRevisionQuery).WithArgs(1, 10).WillReturnRows(sqlmock.NewRows(revisionColumns))
+
+		actualRule, err := s.repository.Rollback(10, 1, "bar@odpf.io", &mocks.TemplatesService{})
+		s.Nil(actualRule)
+		s.EqualError(err, "rule revision not found")
+	})
+
+	s.Run("should return error if rule does not exist", func() {
+		s.dbmock.ExpectQuery(selectRevisionQuery).WithArgs(1, 10).WillReturnRows(sqlmock.NewRows(revisionColumns).
+			AddRow(1, time.Now(), 10, true, `[]`, "abc", "", "foo@odpf.io"))
+		s.dbmock.ExpectQuery(selectRuleQuery).WithArgs(10).WillReturnRows(sqlmock.NewRows(ruleColumns))
+
+		actualRule, err := s.repository.Rollback(10, 1, "bar@odpf.io", &mocks.TemplatesService{})
+		s.Nil(actualRule)
+		s.EqualError(err, "rule not found")
+	})
+
+	s.Run("should return error if revision query fails", func() {
+		s.dbmock.ExpectQuery(selectRevisionQuery).WithArgs(1, 10).WillReturnError(errors.New("random error"))
+
+		actualRule, err := s.repository.Rollback(10, 1, "bar@odpf.io", &mocks.TemplatesService{})
+		s.Nil(actualRule)
+		s.EqualError(err, "random error")
+	})
+}
+
+func (s *RepositoryTestSuite) TestDiff() {
+	var truebool = true
+	expectedTemplate := &domain.Template{
+		Name: "tmpl",
+		Variables: []domain.Variable{{
+			Name:        "for",
+			Default:     "10m",
+			Description: "test",
+			Type:        "string",
+		}},
+	}
+	dummyTemplateBody := "-\n    alert: Test\n    expr: 'test-expr'\n    for: '20m'\n    labels: {severity: WARNING, team: 'gojek' }\n    annotations: {description: 'test'}\n"
+	otherTemplateBody := "-\n    alert: Test2\n    expr: 'test-expr-2'\n-\n"
+	currentBody := "-\n    alert: Test\n    expr: 'test-expr'\n    for: '10m'\n    labels: {severity: WARNING, team: 'gojek' }\n    annotations: {description: 'test'}\n-\n    alert: Stale\n    expr: 'stale-expr'\n"
+	namespaceQuery := regexp.QuoteMeta(`SELECT namespaces.urn as namespace_urn, providers.urn as provider_urn, providers.type as provider_type, providers.host as provider_host FROM "namespaces" RIGHT JOIN providers on providers.id = namespaces.provider_id WHERE namespaces.id = $1`)
+	selectRulesWithinGroupQuery := regexp.QuoteMeta(`SELECT * FROM "rules" WHERE namespace = $1 AND group_name = $2 AND provider_namespace = $3`)
+	ruleColumns := []string{"id", "created_at", "updated_at", "name", "namespace", "group_name", "template", "enabled", "variables", "provider_namespace"}
+	namespaceRow := func(providerType string) *sqlmock.Rows {
+		return sqlmock.NewRows([]string{"namespace_urn", "provider_urn", "provider_type"}).
+			AddRow("foo", "bar", providerType)
+	}
+	newInput := func() *Rule {
+		return &Rule{
+			Namespace:         "foo",
+			GroupName:         "bar",
+			Template:          "tmpl",
+			Enabled:           &truebool,
+			ProviderNamespace: 1,
+			Variables:         `[{"name":"for", "type":"string", "value":"20m", "description":"test"}]`,
+		}
+	}
+	var currentRuleNodes []rulefmt.RuleNode
+	_ = yaml.Unmarshal([]byte(currentBody), &currentRuleNodes)
+
+	s.Run("should return diff of rendered rule group against cortex", func() {
+		mockClient := &adapter.AdapterMock{}
+		s.adapters.Register("cortex", func(string, string) (adapter.Adapter, error) {
+			return mockClient, nil
+		})
+		mockClient.On("GetRuleGroup", mock.Anything, mock.Anything, "foo", "bar").Return(&rulefmt.RuleGroup{
+			Name: "bar", Rules: currentRuleNodes,
+		}, nil)
+		mockTemplateService := &mocks.TemplatesService{}
+		mockTemplateService.On("GetByName", "tmpl").Return(expectedTemplate, nil)
+		mockTemplateService.On("Render", "tmpl", mock.Anything).Return(dummyTemplateBody, nil)
+		mockTemplateService.On("Render", "tmpl2", mock.Anything).Return(otherTemplateBody, nil)
+		rulesWithinGroup := sqlmock.NewRows(ruleColumns).
+			AddRow(10, time.Now(), time.Now(), "siren_api_bar_foo_foo_bar_tmpl", "foo", "bar", "tmpl", true,
+				`[{"name":"for","type":"string","value":"10m","description":"test"}]`, 1).
+			AddRow(11, time.Now(), time.Now(), "siren_api_bar_foo_foo_bar_tmpl2", "foo", "bar", "tmpl2", true,
+				`[]`, 1)
+
+		s.dbmock.ExpectQuery(namespaceQuery).WillReturnRows(namespaceRow("cortex"))
+		s.dbmock.ExpectQuery(selectRulesWithinGroupQuery).WithArgs("foo", "bar", 1).WillReturnRows(rulesWithinGroup)
+		expectedDiff := &domain.RuleGroupDiff{
+			Namespace: "foo",
+			GroupName: "bar",
+			Added: []domain.RuleDiff{{
+				Name:    "Test2",
+				Desired: "alert: Test2\nexpr: test-expr-2\n",
+			}},
+			Changed: []domain.RuleDiff{{
+				Name:    "Test",
+				Current: "alert: Test\nexpr: test-expr\nfor: 10m\nlabels:\n    severity: WARNING\n    team: gojek\nannotations:\n    description: test\n",
+				Desired: "alert: Test\nexpr: test-expr\nfor: 20m\nlabels:\n    severity: WARNING\n    team: gojek\nannotations:\n    description: test\n",
+			}},
+			Removed: []domain.RuleDiff{{
+				Name:    "Stale",
+				Current: "alert: Stale\nexpr: stale-expr\n",
+			}},
+		}
+		actualDiff, err := s.repository.Diff(newInput(), mockTemplateService)
+		s.Nil(err)
+		s.Equal(expectedDiff, actualDiff)
+		mockClient.AssertNotCalled(s.T(), "UpsertRuleGroup", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+		mockClient.AssertNotCalled(s.T(), "DeleteRuleGroup", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+		if err := s.dbmock.ExpectationsWereMet(); err != nil {
+			s.T().Errorf("there were unfulfilled expectations: %s", err)
+		}
+	})
+
+	s.Run("should return all rules as added if rule group does not exist in cortex", func() {
+		mockClient := &adapter.AdapterMock{}
+		s.adapters.Register("cortex", func(string, string) (adapter.Adapter, error) {
+			return mockClient, nil
+		})
+		mockClient.On("GetRuleGroup", mock.Anything, mock.Anything, "foo", "bar").Return(nil, nil)
+		mockTemplateService := &mocks.TemplatesService{}
+		mockTemplateService.On("GetByName", "tmpl").Return(expectedTemplate, nil)
+		mockTemplateService.On("Render", "tmpl", mock.Anything).Return(otherTemplateBody, nil)
+
+		s.dbmock.ExpectQuery(namespaceQuery).WillReturnRows(namespaceRow("cortex"))
+		s.dbmock.ExpectQuery(selectRulesWithinGroupQuery).WithArgs("foo", "bar", 1).WillReturnRows(sqlmock.NewRows(nil))
+		expectedDiff := &domain.RuleGroupDiff{
+			Namespace: "foo",
+			GroupName: "bar",
+			Added: []domain.RuleDiff{{
+				Name:    "Test2",
+				Desired: "alert: Test2\nexpr: test-expr-2\n",
+			}},
+			Changed: []domain.RuleDiff{},
+			Removed: []domain.RuleDiff{},
+		}
+		actualDiff, err := s.repository.Diff(newInput(), mockTemplateService)
+		s.Nil(err)
+		s.Equal(expectedDiff, actualDiff)
+		if err := s.dbmock.ExpectationsWereMet(); err != nil {
+			s.T().Errorf("there were unfulfilled expectations: %s", err)
+		}
+	})
+
+	s.Run("should return error if fetching rule group from cortex fails", func() {
+		mockClient := &adapter.AdapterMock{}
+		s.adapters.Register("cortex", func(string, string) (adapter.Adapter, error) {
+			return mockClient, nil
+		})
+		mockClient.On("GetRuleGroup", mock.Anything, mock.Anything, "foo", "bar").Return(nil, errors.New("random error"))
+		mockTemplateService := &mocks.TemplatesService{}
+		mockTemplateService.On("GetByName", "tmpl").Return(expectedTemplate, nil)
+		mockTemplateService.On("Render", "tmpl", mock.Anything).Return(otherTemplateBody, nil)
+
+		s.dbmock.ExpectQuery(namespaceQuery).WillReturnRows(namespaceRow("cortex"))
+		s.dbmock.ExpectQuery(selectRulesWithinGroupQuery).WithArgs("foo", "bar", 1).WillReturnRows(sqlmock.NewRows(nil))
+		actualDiff, err := s.repository.Diff(newInput(), mockTemplateService)
+		s.EqualError(err, "random error")
+		s.Nil(actualDiff)
+	})
+
+	s.Run("should return error if provider is not supported", func() {
+		mockTemplateService := &mocks.TemplatesService{}
+		mockTemplateService.On("GetByName", "tmpl").Return(expectedTemplate, nil)
+
+		s.dbmock.ExpectQuery(namespaceQuery).WillReturnRows(namespaceRow("prometheus"))
+		actualDiff, err := s.repository.Diff(newInput(), mockTemplateService)
+		s.EqualError(err, "provider not supported")
+		s.Nil(actualDiff)
+	})
+
+	s.Run("should return error if template not found", func() {
+		mockTemplateService := &mocks.TemplatesService{}
+		mockTemplateService.On("GetByName", "tmpl").Return(nil, nil)
+
+		actualDiff, err := s.repository.Diff(newInput(), mockTemplateService)
+		s.EqualError(err, "template not found")
+		s.Nil(actualDiff)
+	})
+}
+
+func (s *RepositoryTestSuite) TestGetGroup() {
+	ruleGroupColumns := []string{"id", "created_at", "updated_at", "provider_namespace", "namespace", "group_name", "interval", "limit", "source_tenants"}
+
+	s.Run("should return stored settings of the rule group", func() {
+		createdAt := time.Now()
+		s.dbmock.ExpectQuery(selectRuleGroupQuery).WithArgs(1, "foo", "bar").
+			WillReturnRows(sqlmock.NewRows(ruleGroupColumns).
+				AddRow(3, createdAt, createdAt, 1, "foo", "bar", "1m", 10, "{odpf}"))
+		group, err := s.repository.GetGroup(1, "foo", "bar")
+		s.Nil(err)
+		s.Equal(&RuleGroup{
+			Id: 3, CreatedAt: createdAt, UpdatedAt: createdAt, ProviderNamespace: 1, Namespace: "foo", GroupName: "bar",
+			Interval: "1m", Limit: 10, SourceTenants: []string{"odpf"},
+		}, group)
+	})
+
+	s.Run("should return nil if no settings are stored", func() {
+		s.dbmock.ExpectQuery(selectRuleGroupQuery).WithArgs(1, "foo", "bar").WillReturnRows(sqlmock.NewRows(nil))
+		group, err := s.repository.GetGroup(1, "foo", "bar")
+		s.Nil(err)
+		s.Nil(group)
+	})
+
+	s.Run("should return error if any", func() {
+		s.dbmock.ExpectQuery(selectRuleGroupQuery).WillReturnError(errors.New("random error"))
+		group, err := s.repository.GetGroup(1, "foo", "bar")
+		s.Nil(group)
+		s.EqualError(err, "random error")
+	})
+}
+
+func (s *RepositoryTestSuite) TestUpsertGroup() {
+	var truebool = true
+	dummyTemplateBody := "- alert: Test\n  expr: test_metric > 10\n"
+	insertRuleGroupQuery := regexp.QuoteMeta(`INSERT INTO "rule_groups" ("created_at","updated_at","provider_namespace","namespace","group_name","interval","limit","source_tenants") VALUES ($1,$2,$3,$4,$5,$6,$7,$8) RETURNING "id"`)
+	updateRuleGroupQuery := `UPDATE "rule_groups" SET .* WHERE "id" = \$9`
+	selectRulesWithinGroupQuery := regexp.QuoteMeta(`SELECT * FROM "rules" WHERE namespace = $1 AND group_name = $2 AND provider_namespace = $3`)
+	namespaceQuery := regexp.QuoteMeta(`SELECT namespaces.urn as namespace_urn, providers.urn as provider_urn, providers.type as provider_type, providers.host as provider_host FROM "namespaces" RIGHT JOIN providers on providers.id = namespaces.provider_id WHERE namespaces.id = $1`)
+	ruleGroupColumns := []string{"id", "created_at", "updated_at", "provider_namespace", "namespace", "group_name", "interval", "limit", "source_tenants"}
+	ruleRows := func() *sqlmock.Rows {
+		return sqlmock.NewRows([]string{"id", "created_at", "updated_at", "name", "namespace", "group_name", "template", "enabled", "variables", "provider_namespace"}).
+			AddRow(10, time.Now(), time.Now(), "siren_api_bar_foo_foo_bar_tmpl", "foo", "bar", "tmpl", truebool, `[]`, 1)
+	}
+	newGroup := func() *RuleGroup {
+		return &RuleGroup{
+			ProviderNamespace: 1, Namespace: "foo", GroupName: "bar",
+			Interval: "1m", Limit: 10, SourceTenants: []string{"odpf"},
+		}
+	}
+
+	s.Run("should store settings and push the rule group with them", func() {
+		mockClient := &adapter.AdapterMock{}
+		s.adapters.Register("cortex", func(string, string) (adapter.Adapter, error) {
+			return mockClient, nil
+		})
+		mockClient.On("UpsertRuleGroup", mock.Anything, "foo", "foo", mock.MatchedBy(func(ruleGroup adapter.RuleGroup) bool {
+			return ruleGroup.Name == "bar" && ruleGroup.Interval == model.Duration(time.Minute) &&
+				ruleGroup.Limit == 10 && assert.ObjectsAreEqual([]string{"odpf"}, ruleGroup.SourceTenants) &&
+				len(ruleGroup.Rules) == 1
+		})).Return(nil)
+		mockTemplateService := &mocks.TemplatesService{}
+		mockTemplateService.On("Render", "tmpl", mock.Anything).Return(dummyTemplateBody, nil)
+
+		s.dbmock.ExpectBegin()
+		s.dbmock.ExpectQuery(selectRuleGroupQuery).WithArgs(1, "foo", "bar").WillReturnRows(sqlmock.NewRows(nil))
+		s.dbmock.ExpectQuery(insertRuleGroupQuery).
+			WithArgs(AnyTime{}, AnyTime{}, 1, "foo", "bar", "1m", 10, "{\"odpf\"}").
+			WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(3))
+		s.dbmock.ExpectQuery(selectRulesWithinGroupQuery).WithArgs("foo", "bar", 1).WillReturnRows(ruleRows())
+		s.dbmock.ExpectQuery(namespaceQuery).WillReturnRows(sqlmock.NewRows([]string{"namespace_urn", "provider_urn", "provider_type"}).
+			AddRow("foo", "bar", "cortex"))
+		s.dbmock.ExpectQuery(selectRuleGroupQuery).WithArgs(1, "foo", "bar").
+			WillReturnRows(sqlmock.NewRows(ruleGroupColumns).
+				AddRow(3, time.Now(), time.Now(), 1, "foo", "bar", "1m", 10, "{odpf}"))
+		s.dbmock.ExpectCommit()
+		group, err := s.repository.UpsertGroup(newGroup(), mockTemplateService)
+		s.Nil(err)
+		s.Equal(uint64(3), group.Id)
+		mockClient.AssertNumberOfCalls(s.T(), "UpsertRuleGroup", 1)
+		if err := s.dbmock.ExpectationsWereMet(); err != nil {
+			s.T().Errorf("there were unfulfilled expectations: %s", err)
+		}
+	})
+
+	s.Run("should update stored settings without pushing a rule group that has no rules", func() {
+		createdAt := time.Now()
+		s.dbmock.ExpectBegin()
+		s.dbmock.ExpectQuery(selectRuleGroupQuery).WithArgs(1, "foo", "bar").
+			WillReturnRows(sqlmock.NewRows(ruleGroupColumns).
+				AddRow(3, createdAt, createdAt, 1, "foo", "bar", "", 0, "{}"))
+		s.dbmock.ExpectExec(updateRuleGroupQuery).WillReturnResult(sqlmock.NewResult(3, 1))
+		s.dbmock.ExpectQuery(selectRulesWithinGroupQuery).WithArgs("foo", "bar", 1).WillReturnRows(sqlmock.NewRows(nil))
+		s.dbmock.ExpectCommit()
+		group, err := s.repository.UpsertGroup(newGroup(), &mocks.TemplatesService{})
+		s.Nil(err)
+		s.Equal(uint64(3), group.Id)
+		s.Equal(createdAt, group.CreatedAt)
+		if err := s.dbmock.ExpectationsWereMet(); err != nil {
+			s.T().Errorf("there were unfulfilled expectations: %s", err)
+		}
+	})
+
+	s.Run("should rollback if pushing the rule group fails", func() {
+		mockClient := &adapter.AdapterMock{}
+		s.adapters.Register("cortex", func(string, string) (adapter.Adapter, error) {
+			return mockClient, nil
+		})
+		mockClient.On("UpsertRuleGroup", mock.Anything, "foo", "foo", mock.Anything).Return(errors.New("random error"))
+		mockTemplateService := &mocks.TemplatesService{}
+		mockTemplateService.On("Render", "tmpl", mock.Anything).Return(dummyTemplateBody, nil)
+
+		s.dbmock.ExpectBegin()
+		s.dbmock.ExpectQuery(selectRuleGroupQuery).WillReturnRows(sqlmock.NewRows(nil))
+		s.dbmock.ExpectQuery(insertRuleGroupQuery).WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(3))
+		s.dbmock.ExpectQuery(selectRulesWithinGroupQuery).WithArgs("foo", "bar", 1).WillReturnRows(ruleRows())
+		s.dbmock.ExpectQuery(namespaceQuery).WillReturnRows(sqlmock.NewRows([]string{"namespace_urn", "provider_urn", "provider_type"}).
+			AddRow("foo", "bar", "cortex"))
+		s.dbmock.ExpectQuery(selectRuleGroupQuery).WillReturnRows(sqlmock.NewRows(nil))
+		s.dbmock.ExpectRollback()
+		group, err := s.repository.UpsertGroup(newGroup(), mockTemplateService)
+		s.Nil(group)
+		s.EqualError(err, "random error")
+		if err := s.dbmock.ExpectationsWereMet(); err != nil {
+			s.T().Errorf("there were unfulfilled expectations: %s", err)
+		}
+	})
+
+	s.Run("should return invalid rule group error if interval is not a duration", func() {
+		group := newGroup()
+		group.Interval = "1 minute"
+		_, err := s.repository.UpsertGroup(group, &mocks.TemplatesService{})
+		var invalidRuleGroupErr *domain.InvalidRuleGroupError
+		s.True(errors.As(err, &invalidRuleGroupErr))
+		s.EqualError(err, `invalid settings of rule group bar in namespace foo: invalid interval: not a valid duration string: "1 minute"`)
+	})
+}
+
+func (s *RepositoryTestSuite) TestUpgradeTemplateVersion() {
+	templateV1 := &domain.Template{
+		Name:      "tmpl",
+		Body:      "- alert: Test\n  expr: test_metric > [[.threshold]]\n",
+		Variables: []domain.Variable{{Name: "threshold", Type: "int", Default: "10"}},
+		Version:   1,
+	}
+	templateV2 := &domain.Template{
+		Name: "tmpl",
+		Body: "- alert: Test\n  expr: test_metric{team=\"[[.team]]\"} > [[.threshold]]\n",
+		Variables: []domain.Variable{
+			{Name: "threshold", Type: "int", Default: "10"},
+			{Name: "team", Type: "string", Default: "odpf"},
+		},
+		Version: 2,
+	}
+	selectRulesOfVersionQuery := regexp.QuoteMeta(`SELECT * FROM "rules" WHERE template = $1 AND template_version = $2 ORDER BY id`)
+	namespaceQuery := regexp.QuoteMeta(`SELECT namespaces.urn as namespace_urn, providers.urn as provider_urn, providers.type as provider_type, providers.host as provider_host FROM "namespaces" RIGHT JOIN providers on providers.id = namespaces.provider_id WHERE namespaces.id = $1`)
+	selectRuleByNameQuery := regexp.QuoteMeta(`SELECT * FROM "rules" WHERE name = $1`)
+	selectRulesWithinGroupQuery := regexp.QuoteMeta(`SELECT * FROM "rules" WHERE namespace = $1 AND group_name = $2 AND provider_namespace = $3`)
+	insertRevisionQuery := regexp.QuoteMeta(`INSERT INTO "rule_revisions" ("created_at","rule_id","enabled","variables","template_hash","template_version","rendered","actor") VALUES ($1,$2,$3,$4,$5,$6,$7,$8) RETURNING "id"`)
+	ruleColumns := []string{"id", "created_at", "updated_at", "name", "namespace", "group_name", "template", "template_version", "enabled", "variables", "provider_namespace"}
+	ruleRows := func(templateVersion uint, variables string) *sqlmock.Rows {
+		return sqlmock.NewRows(ruleColumns).
+			AddRow(10, time.Now(), time.Now(), "siren_api_bar_foo_foo_bar_tmpl", "foo", "bar", "tmpl", templateVersion, true, variables, 1)
+	}
+	namespaceRow := func() *sqlmock.Rows {
+		return sqlmock.NewRows([]string{"namespace_urn", "provider_urn", "provider_type"}).AddRow("foo", "bar", "cortex")
+	}
+	v1Variables := `[{"name":"threshold","type":"int","value":"20","description":""}]`
+	v2Variables := `[{"name":"threshold","type":"int","value":"20","description":""},{"name":"team","type":"string","value":"odpf","description":""}]`
+
+	s.Run("should pin the rules of a version to another version and push them", func() {
+		mockClient := &adapter.AdapterMock{}
+		s.adapters.Register("cortex", func(string, string) (adapter.Adapter, error) {
+			return mockClient, nil
+		})
+		mockClient.On("UpsertRuleGroup", mock.Anything, "foo", "foo", mock.MatchedBy(func(ruleGroup adapter.RuleGroup) bool {
+			return len(ruleGroup.Rules) == 1 && ruleGroup.Rules[0].Expr.Value == `test_metric{team="odpf"} > 20`
+		})).Return(nil)
+		mockTemplateService := &mocks.TemplatesService{}
+		mockTemplateService.On("GetVersion", "tmpl", uint(2)).Return(templateV2, nil)
+		updateRuleQuery := regexp.QuoteMeta(`UPDATE "rules" SET "updated_at"=$1,"name"=$2,"namespace"=$3,"group_name"=$4,"template"=$5,"template_version"=$6,"enabled"=$7,"variables"=$8,"provider_namespace"=$9 WHERE id = $10 AND "id" = $11`)
+
+		s.dbmock.ExpectQuery(selectRulesOfVersionQuery).WithArgs("tmpl", 1).WillReturnRows(ruleRows(1, v1Variables))
+		s.dbmock.ExpectBegin()
+		s.dbmock.ExpectQuery(namespaceQuery).WillReturnRows(namespaceRow())
+		s.dbmock.ExpectQuery(selectRuleByNameQuery).WillReturnRows(ruleRows(1, v1Variables))
+		s.dbmock.ExpectExec(updateRuleQuery).
+			WithArgs(AnyTime{}, "siren_api_bar_foo_foo_bar_tmpl", "foo", "bar", "tmpl", 2, true, v2Variables, 1, 10, 10).
+			WillReturnResult(sqlmock.NewResult(10, 1))
+		s.dbmock.ExpectQuery(selectRuleByNameQuery).WillReturnRows(ruleRows(2, v2Variables))
+		s.dbmock.ExpectQuery(selectRulesWithinGroupQuery).WillReturnRows(ruleRows(2, v2Variables))
+		s.dbmock.ExpectQuery(selectRuleGroupQuery).WillReturnRows(sqlmock.NewRows(nil))
+		s.dbmock.ExpectQuery(insertRevisionQuery).
+			WithArgs(AnyTime{}, 10, true, v2Variables, sqlmock.AnyArg(), 2,
+				"- alert: Test\n  expr: test_metric{team=\"odpf\"} > 20\n", "foo@odpf.io").
+			WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(4))
+		s.dbmock.ExpectCommit()
+		rules, err := s.repository.UpgradeTemplateVersion("tmpl", 1, 2, "foo@odpf.io", mockTemplateService)
+		s.Nil(err)
+		s.Equal(1, len(rules))
+		s.Equal(uint(2), rules[0].TemplateVersion)
+		mockClient.AssertNumberOfCalls(s.T(), "UpsertRuleGroup", 1)
+		if err := s.dbmock.ExpectationsWereMet(); err != nil {
+			s.T().Errorf("there were unfulfilled expectations: %s", err)
+		}
+	})
+
+	s.Run("should make pinned rules follow the latest version again if upgraded to version 0", func() {
+		mockClient := &adapter.AdapterMock{}
+		s.adapters.Register("cortex", func(string, string) (adapter.Adapter, error) {
+			return mockClient, nil
+		})
+		mockClient.On("UpsertRuleGroup", mock.Anything, "foo", "foo", mock.Anything).Return(nil)
+		mockTemplateService := &mocks.TemplatesService{}
+		mockTemplateService.On("GetByName", "tmpl").Return(templateV2, nil)
+		mockTemplateService.On("Render", "tmpl", mock.Anything).Return("- alert: Test\n  expr: test_metric > 20\n", nil)
+		updateRuleQuery := regexp.QuoteMeta(`UPDATE "rules" SET "updated_at"=$1,"name"=$2,"namespace"=$3,"group_name"=$4,"template"=$5,"enabled"=$6,"variables"=$7,"provider_namespace"=$8 WHERE id = $9 AND "id" = $10`)
+		unpinRuleQuery := regexp.QuoteMeta(`UPDATE "rules" SET "template_version"=$1,"updated_at"=$2 WHERE id = $3`)
+
+		s.dbmock.ExpectQuery(selectRulesOfVersionQuery).WithArgs("tmpl", 1).WillReturnRows(ruleRows(1, v1Variables))
+		s.dbmock.ExpectBegin()
+		s.dbmock.ExpectQuery(namespaceQuery).WillReturnRows(namespaceRow())
+		s.dbmock.ExpectQuery(selectRuleByNameQuery).WillReturnRows(ruleRows(1, v1Variables))
+		s.dbmock.ExpectExec(updateRuleQuery).WillReturnResult(sqlmock.NewResult(10, 1))
+		s.dbmock.ExpectExec(unpinRuleQuery).WithArgs(0, AnyTime{}, 10).WillReturnResult(sqlmock.NewResult(10, 1))
+		s.dbmock.ExpectQuery(selectRuleByNameQuery).WillReturnRows(ruleRows(0, v2Variables))
+		s.dbmock.ExpectQuery(selectRulesWithinGroupQuery).WillReturnRows(ruleRows(0, v2Variables))
+		s.dbmock.ExpectQuery(selectRuleGroupQuery).WillReturnRows(sqlmock.NewRows(nil))
+		s.dbmock.ExpectQuery(insertRevisionQuery).WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(4))
+		s.dbmock.ExpectCommit()
+		rules, err := s.repository.UpgradeTemplateVersion("tmpl", 1, 0, "foo@odpf.io", mockTemplateService)
+		s.Nil(err)
+		s.Equal(uint(0), rules[0].TemplateVersion)
+		mockTemplateService.AssertNotCalled(s.T(), "GetVersion", mock.Anything, mock.Anything)
+		if err := s.dbmock.ExpectationsWereMet(); err != nil {
+			s.T().Errorf("there were unfulfilled expectations: %s", err)
+		}
+	})
+
+	s.Run("should return the rules upgraded before a failure", func() {
+		mockClient := &adapter.AdapterMock{}
+		s.adapters.Register("cortex", func(string, string) (adapter.Adapter, error) {
+			return mockClient, nil
+		})
+		mockTemplateService := &mocks.TemplatesService{}
+		mockTemplateService.On("GetVersion", "tmpl", uint(1)).Return(templateV1, nil)
+
+		s.dbmock.ExpectQuery(selectRulesOfVersionQuery).WithArgs("tmpl", 2).WillReturnRows(ruleRows(2, v2Variables))
+		s.dbmock.ExpectBegin()
+		s.dbmock.ExpectQuery(namespaceQuery).WillReturnRows(sqlmock.NewRows(nil))
+		s.dbmock.ExpectRollback()
+		rules, err := s.repository.UpgradeTemplateVersion("tmpl", 2, 1, "foo@odpf.io", mockTemplateService)
+		s.Empty(rules)
+		s.EqualError(err, "provider not found")
+	})
+
+	s.Run("should return error if the version to upgrade to does not exist", func() {
+		mockTemplateService := &mocks.TemplatesService{}
+		mockTemplateService.On("GetVersion", "tmpl", uint(3)).Return(nil, nil)
+		rules, err := s.repository.UpgradeTemplateVersion("tmpl", 1, 3, "foo@odpf.io", mockTemplateService)
+		s.Nil(rules)
+		s.EqualError(err, "version 3 of template tmpl not found")
+	})
+
+	s.Run("should return error if the versions are the same", func() {
+		rules, err := s.repository.UpgradeTemplateVersion("tmpl", 2, 2, "foo@odpf.io", &mocks.TemplatesService{})
+		s.Nil(rules)
+		s.EqualError(err, "template versions to upgrade from and to are the same")
+	})
+}
+
+func (s *RepositoryTestSuite) TestTemplateUsage() {
+	usageQuery := regexp.QuoteMeta(`SELECT rules.id, rules.name, rules.namespace, rules.group_name, rules.template_version, rules.enabled, rules.provider_namespace, namespaces.urn as namespace_urn, providers.urn as provider_urn FROM "rules" LEFT JOIN namespaces on namespaces.id = rules.provider_namespace LEFT JOIN providers on providers.id = namespaces.provider_id WHERE rules.template = $1 ORDER BY rules.id`)
+
+	s.Run("should return the rules of the template with their namespaces and providers", func() {
+		s.dbmock.ExpectQuery(usageQuery).WithArgs("tmpl").WillReturnRows(
+			sqlmock.NewRows([]string{"id", "name", "namespace", "group_name", "template_version", "enabled", "provider_namespace", "namespace_urn", "provider_urn"}).
+				AddRow(10, "siren_api_bar_foo_foo_bar_tmpl", "foo", "bar", 0, true, 1, "odpf-prod", "cortex-prod"))
+		usages, err := s.repository.TemplateUsage("tmpl")
+		s.Nil(err)
+		s.Equal([]TemplateRuleUsage{{
+			Id:                10,
+			Name:              "siren_api_bar_foo_foo_bar_tmpl",
+			Namespace:         "foo",
+			GroupName:         "bar",
+			Enabled:           true,
+			ProviderNamespace: 1,
+			NamespaceUrn:      "odpf-prod",
+			ProviderUrn:       "cortex-prod",
+		}}, usages)
+	})
+
+	s.Run("should return error if any", func() {
+		s.dbmock.ExpectQuery(usageQuery).WithArgs("tmpl").WillReturnError(errors.New("random error"))
+		usages, err := s.repository.TemplateUsage("tmpl")
+		s.EqualError(err, "random error")
+		s.Nil(usages)
+	})
+}
+
+func (s *RepositoryTestSuite) TestDeleteTemplateRules() {
+	repository := s.repository.(*Repository)
+	dummyTemplateBody := "- alert: Test\n  expr: test_metric > 10\n"
+	selectRulesOfTemplateQuery := regexp.QuoteMeta(`SELECT * FROM "rules" WHERE template = $1 ORDER BY provider_namespace, namespace, group_name, id`)
+	deleteRulesQuery := regexp.QuoteMeta(`DELETE FROM "rules" WHERE template = $1`)
+	deleteRevisionsQuery := regexp.QuoteMeta(`DELETE FROM "rule_revisions" WHERE rule_id IN ($1,$2,$3)`)
+	namespaceQuery := regexp.QuoteMeta(`SELECT namespaces.urn as namespace_urn, providers.urn as provider_urn, providers.type as provider_type, providers.host as provider_host FROM "namespaces" RIGHT JOIN providers on providers.id = namespaces.provider_id WHERE namespaces.id = $1`)
+	selectGroupRulesQuery := regexp.QuoteMeta(`SELECT * FROM "rules" WHERE namespace = $1 AND group_name = $2 AND provider_namespace = $3`)
+	ruleColumns := []string{"id", "created_at", "updated_at", "name", "namespace", "group_name", "template", "template_version", "enabled", "variables", "provider_namespace"}
+	variables := `[{"name":"threshold","type":"int","value":"20","description":""}]`
+	namespaceRow := func() *sqlmock.Rows {
+		return sqlmock.NewRows([]string{"namespace_urn", "provider_urn", "provider_type"}).AddRow("odpf", "bar", "cortex")
+	}
+
+	s.Run("should delete the rules of the template and push their rule groups once", func() {
+		mockClient := &adapter.AdapterMock{}
+		s.adapters.Register("cortex", func(string, string) (adapter.Adapter, error) {
+			return mockClient, nil
+		})
+		mockClient.On("UpsertRuleGroup", mock.Anything, "odpf", "foo", mock.MatchedBy(func(ruleGroup adapter.RuleGroup) bool {
+			return ruleGroup.Name == "bar" && len(ruleGroup.Rules) == 1
+		})).Return(nil).Once()
+		mockClient.On("DeleteRuleGroup", mock.Anything, "odpf", "foo", "baz").Return(nil).Once()
+		mockTemplateService := &mocks.TemplatesService{}
+		mockTemplateService.On("Render", "tmpl2", mock.Anything).Return(dummyTemplateBody, nil)
+
+		s.dbmock.ExpectQuery(selectRulesOfTemplateQuery).WithArgs("tmpl").WillReturnRows(sqlmock.NewRows(ruleColumns).
+			AddRow(10, time.Now(), time.Now(), "siren_api_bar_foo_foo_bar_tmpl", "foo", "bar", "tmpl", 0, true, variables, 1).
+			AddRow(11, time.Now(), time.Now(), "siren_api_baz_foo_foo_baz_tmpl", "foo", "baz", "tmpl", 0, true, variables, 1).
+			AddRow(12, time.Now(), time.Now(), "siren_api_baz_foo_foo_baz_tmpl_2", "foo", "baz", "tmpl", 0, false, variables, 1))
+		s.dbmock.ExpectExec(deleteRulesQuery).WithArgs("tmpl").WillReturnResult(sqlmock.NewResult(0, 3))
+		s.dbmock.ExpectExec(deleteRevisionsQuery).WithArgs(10, 11, 12).WillReturnResult(sqlmock.NewResult(0, 3))
+		s.dbmock.ExpectQuery(namespaceQuery).WillReturnRows(namespaceRow())
+		s.dbmock.ExpectQuery(selectGroupRulesQuery).WithArgs("foo", "bar", 1).WillReturnRows(sqlmock.NewRows(ruleColumns).
+			AddRow(13, time.Now(), time.Now(), "siren_api_bar_foo_foo_bar_tmpl2", "foo", "bar", "tmpl2", 0, true, variables, 1))
+		s.dbmock.ExpectQuery(selectRuleGroupQuery).WillReturnRows(sqlmock.NewRows(nil))
+		s.dbmock.ExpectQuery(namespaceQuery).WillReturnRows(namespaceRow())
+		s.dbmock.ExpectQuery(selectGroupRulesQuery).WithArgs("foo", "baz", 1).WillReturnRows(sqlmock.NewRows(ruleColumns))
+		rules, err := repository.DeleteTemplateRules(repository.db, "tmpl", mockTemplateService)
+		s.Nil(err)
+		s.Equal(3, len(rules))
+		mockClient.AssertExpectations(s.T())
+		if err := s.dbmock.ExpectationsWereMet(); err != nil {
+			s.T().Errorf("there were unfulfilled expectations: %s", err)
+		}
+	})
+
+	s.Run("should delete nothing if no rules use the template", func() {
+		s.dbmock.ExpectQuery(selectRulesOfTemplateQuery).WithArgs("tmpl").WillReturnRows(sqlmock.NewRows(ruleColumns))
+		rules, err := repository.DeleteTemplateRules(repository.db, "tmpl", &mocks.TemplatesService{})
+		s.Nil(err)
+		s.Empty(rules)
+		if err := s.dbmock.ExpectationsWereMet(); err != nil {
+			s.T().Errorf("there were unfulfilled expectations: %s", err)
+		}
+	})
+
+	s.Run("should return error if pushing a rule group failed", func() {
+		mockClient := &adapter.AdapterMock{}
+		s.adapters.Register("cortex", func(string, string) (adapter.Adapter, error) {
+			return mockClient, nil
+		})
+		mockClient.On("DeleteRuleGroup", mock.Anything, "odpf", "foo", "bar").Return(errors.New("random error")).Once()
+
+		s.dbmock.ExpectQuery(selectRulesOfTemplateQuery).WithArgs("tmpl").WillReturnRows(sqlmock.NewRows(ruleColumns).
+			AddRow(10, time.Now(), time.Now(), "siren_api_bar_foo_foo_bar_tmpl", "foo", "bar", "tmpl", 0, true, variables, 1))
+		s.dbmock.ExpectExec(deleteRulesQuery).WithArgs("tmpl").WillReturnResult(sqlmock.NewResult(0, 1))
+		s.dbmock.ExpectExec(regexp.QuoteMeta(`DELETE FROM "rule_revisions" WHERE rule_id IN ($1)`)).WithArgs(10).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		s.dbmock.ExpectQuery(namespaceQuery).WillReturnRows(namespaceRow())
+		s.dbmock.ExpectQuery(selectGroupRulesQuery).WithArgs("foo", "bar", 1).WillReturnRows(sqlmock.NewRows(ruleColumns))
+		rules, err := repository.DeleteTemplateRules(repository.db, "tmpl", &mocks.TemplatesService{})
+		s.EqualError(err, "random error")
+		s.Nil(rules)
+		if err := s.dbmock.ExpectationsWereMet(); err != nil {
+			s.T().Errorf("there were unfulfilled expectations: %s", err)
+		}
+	})
+}
+
+func (s *RepositoryTestSuite) TestExport() {
+	namespaceQuery := regexp.QuoteMeta(`SELECT namespaces.urn as namespace_urn, providers.urn as provider_urn FROM "namespaces" LEFT JOIN providers on providers.id = namespaces.provider_id WHERE namespaces.id = $1`)
+	ruleColumns := []string{"id", "name", "namespace", "group_name", "template", "template_version", "enabled", "variables", "provider_namespace"}
+	variables := `[{"name":"threshold","type":"int","value":"20","description":""}]`
+
+	s.Run("should return the rules and rule group settings of the provider namespace", func() {
+		selectRulesQuery := regexp.QuoteMeta(`SELECT * FROM "rules" WHERE provider_namespace = $1 ORDER BY namespace, group_name, id`)
+		selectGroupsQuery := regexp.QuoteMeta(`SELECT * FROM "rule_groups" WHERE provider_namespace = $1 ORDER BY namespace, group_name`)
+		s.dbmock.ExpectQuery(namespaceQuery).WithArgs(1).WillReturnRows(
+			sqlmock.NewRows([]string{"namespace_urn", "provider_urn"}).AddRow("odpf", "cortex"))
+		s.dbmock.ExpectQuery(selectRulesQuery).WithArgs(1).WillReturnRows(sqlmock.NewRows(ruleColumns).
+			AddRow(1, "siren_api_odpf_foo_bar_tmpl", "foo", "bar", "tmpl", 0, true, variables, 1).
+			AddRow(2, "siren_api_odpf_foo_baz_tmpl", "foo", "baz", "tmpl", 2, false, variables, 1))
+		s.dbmock.ExpectQuery(selectGroupsQuery).WithArgs(1).WillReturnRows(
+			sqlmock.NewRows([]string{"id", "provider_namespace", "namespace", "group_name", "interval"}).AddRow(3, 1, "foo", "bar", "1m"))
+
+		export, err := s.repository.Export(1, "", "")
+		s.Nil(err)
+		s.Equal("odpf", export.NamespaceUrn)
+		s.Equal("cortex", export.ProviderUrn)
+		s.Equal(2, len(export.Rules))
+		s.Equal("baz", export.Rules[1].GroupName)
+		s.Equal(uint(2), export.Rules[1].TemplateVersion)
+		s.Equal([]RuleGroup{{Id: 3, ProviderNamespace: 1, Namespace: "foo", GroupName: "bar", Interval: "1m"}}, export.Groups)
+		s.Nil(s.dbmock.ExpectationsWereMet())
+	})
+
+	s.Run("should filter by namespace and rule group", func() {
+		selectRulesQuery := regexp.QuoteMeta(`SELECT * FROM "rules" WHERE provider_namespace = $1 AND namespace = $2 AND group_name = $3 ORDER BY namespace, group_name, id`)
+		selectGroupsQuery := regexp.QuoteMeta(`SELECT * FROM "rule_groups" WHERE provider_namespace = $1 AND namespace = $2 AND group_name = $3 ORDER BY namespace, group_name`)
+		s.dbmock.ExpectQuery(namespaceQuery).WithArgs(1).WillReturnRows(
+			sqlmock.NewRows([]string{"namespace_urn", "provider_urn"}).AddRow("odpf", "cortex"))
+		s.dbmock.ExpectQuery(selectRulesQuery).WithArgs(1, "foo", "bar").WillReturnRows(sqlmock.NewRows(ruleColumns).
+			AddRow(1, "siren_api_odpf_foo_bar_tmpl", "foo", "bar", "tmpl", 0, true, variables, 1))
+		s.dbmock.ExpectQuery(selectGroupsQuery).WithArgs(1, "foo", "bar").WillReturnRows(
+			sqlmock.NewRows([]string{"id", "provider_namespace", "namespace", "group_name"}))
+
+		export, err := s.repository.Export(1, "foo", "bar")
+		s.Nil(err)
+		s.Equal(1, len(export.Rules))
+		s.Empty(export.Groups)
+		s.Nil(s.dbmock.ExpectationsWereMet())
+	})
+
+	s.Run("should return nil if the provider namespace does not exist", func() {
+		s.dbmock.ExpectQuery(namespaceQuery).WithArgs(1).WillReturnRows(
+			sqlmock.NewRows([]string{"namespace_urn", "provider_urn"}))
+		export, err := s.repository.Export(1, "", "")
+		s.Nil(err)
+		s.Nil(export)
+	})
+
+	s.Run("should return error if any", func() {
+		selectRulesQuery := regexp.QuoteMeta(`SELECT * FROM "rules" WHERE provider_namespace = $1 ORDER BY namespace, group_name, id`)
+		s.dbmock.ExpectQuery(namespaceQuery).WithArgs(1).WillReturnRows(
+			sqlmock.NewRows([]string{"namespace_urn", "provider_urn"}).AddRow("odpf", "cortex"))
+		s.dbmock.ExpectQuery(selectRulesQuery).WithArgs(1).WillReturnError(errors.New("random error"))
+		export, err := s.repository.Export(1, "", "")
+		s.EqualError(err, "random error")
+		s.Nil(export)
+	})
+}
+
+func (s *RepositoryTestSuite) TestSyncTemplate() {
+	template := &domain.Template{
+		Name: "tmpl",
+		Body: "- alert: Test\n  expr: test_metric > [[.threshold]]\n",
+		Variables: []domain.Variable{{
+			Name:    "threshold",
+			Type:    "int",
+			Default: "10",
+		}},
+	}
+	otherTemplateBody := "- alert: Other\n  expr: other_metric > 1\n"
+	selectRulesOfTemplateQuery := regexp.QuoteMeta(`SELECT * FROM "rules" WHERE template = $1 ORDER BY provider_namespace, namespace, group_name`)
+	namespaceQuery := regexp.QuoteMeta(`SELECT namespaces.urn as namespace_urn, providers.urn as provider_urn, providers.type as provider_type, providers.host as provider_host FROM "namespaces" RIGHT JOIN providers on providers.id = namespaces.provider_id WHERE namespaces.id = $1`)
+	selectBarRulesQuery := regexp.QuoteMeta(`SELECT * FROM "rules" WHERE namespace = $1 AND group_name = $2 AND provider_namespace = $3`)
+	ruleColumns := []string{"id", "created_at", "updated_at", "name", "namespace", "group_name", "template", "enabled", "variables", "provider_namespace"}
+	barRuleVariables := `[{"name":"threshold","type":"int","value":"20","description":""}]`
+	rulesOfTemplate := func() *sqlmock.Rows {
+		return sqlmock.NewRows(ruleColumns).
+			AddRow(10, time.Now(), time.Now(), "siren_api_bar_foo_foo_bar_tmpl", "foo", "bar", "tmpl", true, barRuleVariables, 1).
+			AddRow(12, time.Now(), time.Now(), "siren_api_bar_baz_foo_baz_tmpl", "foo", "baz", "tmpl", true, `[]`, 2)
+	}
+	barRules := func() *sqlmock.Rows {
+		return sqlmock.NewRows(ruleColumns).
+			AddRow(10, time.Now(), time.Now(), "siren_api_bar_foo_foo_bar_tmpl", "foo", "bar", "tmpl", true, barRuleVariables, 1).
+			AddRow(11, time.Now(), time.Now(), "siren_api_bar_foo_foo_bar_other", "foo", "bar", "other", true, `[]`, 1)
+	}
+	namespaceRow := func() *sqlmock.Rows {
+		return sqlmock.NewRows([]string{"namespace_urn", "provider_urn", "provider_type"}).
+			AddRow("foo", "bar", "cortex")
+	}
+
+	s.Run("should push every rule group of the template and report groups that failed", func() {
+		mockClient := &adapter.AdapterMock{}
+		s.adapters.Register("cortex", func(string, string) (adapter.Adapter, error) {
+			return mockClient, nil
+		})
+		mockClient.On("UpsertRuleGroup", mock.Anything, "foo", "foo", mock.MatchedBy(func(ruleGroup adapter.RuleGroup) bool {
+			return ruleGroup.Name == "bar" && len(ruleGroup.Rules) == 2 &&
+				ruleGroup.Rules[0].Expr.Value == "test_metric > 20" && ruleGroup.Rules[1].Alert.Value == "Other"
+		})).Return(nil)
+		mockTemplateService := &mocks.TemplatesService{}
+		mockTemplateService.On("Render", "other", mock.Anything).Return(otherTemplateBody, nil)
+
+		s.dbmock.ExpectQuery(selectRulesOfTemplateQuery).WithArgs("tmpl").WillReturnRows(rulesOfTemplate())
+		s.dbmock.ExpectQuery(namespaceQuery).WithArgs(1).WillReturnRows(namespaceRow())
+		s.dbmock.ExpectQuery(selectBarRulesQuery).WithArgs("foo", "bar", 1).WillReturnRows(barRules())
+		s.dbmock.ExpectQuery(selectRuleGroupQuery).WithArgs(1, "foo", "bar").WillReturnRows(sqlmock.NewRows(nil))
+		s.dbmock.ExpectQuery(namespaceQuery).WithArgs(2).WillReturnRows(sqlmock.NewRows(nil))
+		reports, err := s.repository.SyncTemplate(template, false, mockTemplateService)
+		s.Nil(err)
+		s.Equal([]domain.RuleGroupSync{
+			{ProviderNamespace: 1, Namespace: "foo", GroupName: "bar", Success: true},
+			{ProviderNamespace: 2, Namespace: "foo", GroupName: "baz", Error: "provider not found"},
+		}, reports)
+		mockClient.AssertNumberOfCalls(s.T(), "UpsertRuleGroup", 1)
+		mockTemplateService.AssertNotCalled(s.T(), "Render", "tmpl", mock.Anything)
+		if err := s.dbmock.ExpectationsWereMet(); err != nil {
+			s.T().Errorf("there were unfulfilled expectations: %s", err)
+		}
+	})
+
+	s.Run("should push a rule group with several rules of the template once", func() {
+		mockClient := &adapter.AdapterMock{}
+		s.adapters.Register("cortex", func(string, string) (adapter.Adapter, error) {
+			return mockClient, nil
+		})
+		mockClient.On("UpsertRuleGroup", mock.Anything, "foo", "foo", mock.Anything).Return(nil)
+		mockTemplateService := &mocks.TemplatesService{}
+		mockTemplateService.On("Render", "other", mock.Anything).Return(otherTemplateBody, nil)
+
+		s.dbmock.ExpectQuery(selectRulesOfTemplateQuery).WillReturnRows(sqlmock.NewRows(ruleColumns).
+			AddRow(10, time.Now(), time.Now(), "siren_api_bar_foo_foo_bar_tmpl", "foo", "bar", "tmpl", true, barRuleVariables, 1).
+			AddRow(13, time.Now(), time.Now(), "siren_api_baz_foo_foo_bar_tmpl", "foo", "bar", "tmpl", false, barRuleVariables, 1))
+		s.dbmock.ExpectQuery(namespaceQuery).WillReturnRows(namespaceRow())
+		s.dbmock.ExpectQuery(selectBarRulesQuery).WithArgs("foo", "bar", 1).WillReturnRows(barRules())
+		s.dbmock.ExpectQuery(selectRuleGroupQuery).WillReturnRows(sqlmock.NewRows(nil))
+		reports, err := s.repository.SyncTemplate(template, false, mockTemplateService)
+		s.Nil(err)
+		s.Equal(1, len(reports))
+		mockClient.AssertNumberOfCalls(s.T(), "UpsertRuleGroup", 1)
+		if err := s.dbmock.ExpectationsWereMet(); err != nil {
+			s.T().Errorf("there were unfulfilled expectations: %s", err)
+		}
+	})
+
+	s.Run("should not push rule groups with only rules pinned to a template version", func() {
+		mockTemplateService := &mocks.TemplatesService{}
+		s.dbmock.ExpectQuery(selectRulesOfTemplateQuery).WillReturnRows(sqlmock.NewRows(append(ruleColumns, "template_version")).
+			AddRow(10, time.Now(), time.Now(), "siren_api_bar_foo_foo_bar_tmpl", "foo", "bar", "tmpl", true, barRuleVariables, 1, 2))
+		reports, err := s.repository.SyncTemplate(template, false, mockTemplateService)
+		s.Nil(err)
+		s.Empty(reports)
+		if err := s.dbmock.ExpectationsWereMet(); err != nil {
+			s.T().Errorf("there were unfulfilled expectations: %s", err)
+		}
+	})
+
+	s.Run("should report the diff of every rule group without pushing in dry run", func() {
+		mockClient := &adapter.AdapterMock{}
+		s.adapters.Register("cortex", func(string, string) (adapter.Adapter, error) {
+			return mockClient, nil
+		})
+		var currentRuleNodes []rulefmt.RuleNode
+		_ = yaml.Unmarshal([]byte("- alert: Test\n  expr: test_metric > 10\n- alert: Other\n  expr: other_metric > 1\n"), &currentRuleNodes)
+		mockClient.On("GetRuleGroup", mock.Anything, "foo", "foo", "bar").Return(&rulefmt.RuleGroup{
+			Name: "bar", Rules: currentRuleNodes,
+		}, nil)
+		mockTemplateService := &mocks.TemplatesService{}
+		mockTemplateService.On("Render", "other", mock.Anything).Return(otherTemplateBody, nil)
+
+		s.dbmock.ExpectQuery(selectRulesOfTemplateQuery).WillReturnRows(sqlmock.NewRows(ruleColumns).
+			AddRow(10, time.Now(), time.Now(), "siren_api_bar_foo_foo_bar_tmpl", "foo", "bar", "tmpl", true, barRuleVariables, 1))
+		s.dbmock.ExpectQuery(namespaceQuery).WillReturnRows(namespaceRow())
+		s.dbmock.ExpectQuery(selectBarRulesQuery).WithArgs("foo", "bar", 1).WillReturnRows(barRules())
+		reports, err := s.repository.SyncTemplate(template, true, mockTemplateService)
+		s.Nil(err)
+		s.Equal([]domain.RuleGroupSync{{
+			ProviderNamespace: 1, Namespace: "foo", GroupName: "bar", Success: true,
+			Diff: &domain.RuleGroupDiff{
+				Namespace: "foo",
+				GroupName: "bar",
+				Added:     []domain.RuleDiff{},
+				Changed: []domain.RuleDiff{{
+					Name:    "Test",
+					Current: "alert: Test\nexpr: test_metric > 10\n",
+					Desired: "alert: Test\nexpr: test_metric > 20\n",
+				}},
+				Removed: []domain.RuleDiff{},
+			},
+		}}, reports)
+		mockClient.AssertNotCalled(s.T(), "UpsertRuleGroup", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+		if err := s.dbmock.ExpectationsWereMet(); err != nil {
+			s.T().Errorf("there were unfulfilled expectations: %s", err)
+		}
+	})
+
+	s.Run("should return error if fetching the rules of the template fails", func() {
+		s.dbmock.ExpectQuery(selectRulesOfTemplateQuery).WillReturnError(errors.New("random error"))
+		reports, err := s.repository.SyncTemplate(template, false, &mocks.TemplatesService{})
+		s.Nil(reports)
+		s.EqualError(err, "random error")
+	})
+}
+
+func TestRenderRuleGroup(t *testing.T) {
+	var truebool = true
+	var falsebool = false
+	rulesWithinGroup := []Rule{{
+		Name:      "siren_api_bar_foo_foo_bar_tmpl",
+		Template:  "tmpl",
+		Enabled:   &truebool,
+		Variables: `[{"name":"for","type":"string","value":"20m","description":"test"}]`,
+	}, {
+		Name:      "siren_api_bar_foo_foo_bar_tmpl2",
+		Template:  "tmpl2",
+		Enabled:   &truebool,
+		Variables: `[]`,
+	}, {
+		Name:      "siren_api_bar_foo_foo_bar_tmpl3",
+		Template:  "tmpl3",
+		Enabled:   &falsebool,
+		Variables: `[]`,
+	}}
+
+	t.Run("should render and validate enabled rules of the group", func(t *testing.T) {
+		mockTemplateService := &mocks.TemplatesService{}
+		mockTemplateService.On("Render", "tmpl", map[string]string{"for": "20m"}).
+			Return("- alert: Test\n  expr: sum(test_metric) > 1\n  for: '20m'\n", nil)
+		mockTemplateService.On("Render", "tmpl2", map[string]string{}).
+			Return("- record: job:test_metric:sum\n  expr: sum by (job) (test_metric)\n-\n", nil)
+		ruleNodes, err := renderRuleGroup(rulesWithinGroup, mockTemplateService, &adapter.AdapterMock{})
+		assert.Nil(t, err)
+		assert.Equal(t, 2, len(ruleNodes))
+		assert.Equal(t, "Test", ruleNodes[0].Alert.Value)
+		assert.Equal(t, "job:test_metric:sum", ruleNodes[1].Record.Value)
+		mockTemplateService.AssertNotCalled(t, "Render", "tmpl3", mock.Anything)
+	})
+
+	t.Run("should return invalid rule error if expression cannot be parsed", func(t *testing.T) {
+		mockTemplateService := &mocks.TemplatesService{}
+		mockTemplateService.On("Render", "tmpl", mock.Anything).
+			Return("- alert: Test\n  expr: sum(test_metric > 1\n", nil)
+		ruleNodes, err := renderRuleGroup(rulesWithinGroup, mockTemplateService, &adapter.AdapterMock{})
+		assert.Nil(t, ruleNodes)
+		var invalidRuleErr *domain.InvalidRuleError
+		assert.True(t, errors.As(err, &invalidRuleErr))
+		assert.Equal(t, "tmpl", invalidRuleErr.Template)
+		assert.Equal(t, "Test", invalidRuleErr.Rule)
+		assert.Equal(t, map[string]string{"for": "20m"}, invalidRuleErr.Variables)
+		assert.Contains(t, err.Error(), "invalid rule Test rendered from template tmpl with variables [for=20m]")
+		assert.Equal(t, `2:9: group "", rule 1, "Test": could not parse expression: 1:20: parse error: unclosed left parenthesis`,
+			invalidRuleErr.Reason)
+	})
+
+	t.Run("should return invalid rule error if label name is invalid", func(t *testing.T) {
+		mockTemplateService := &mocks.TemplatesService{}
+		mockTemplateService.On("Render", "tmpl", mock.Anything).
+			Return("- alert: Test\n  expr: test_metric > 1\n  labels:\n    invalid-label: foo\n", nil)
+		_, err := renderRuleGroup(rulesWithinGroup, mockTemplateService, &adapter.AdapterMock{})
+		var invalidRuleErr *domain.InvalidRuleError
+		assert.True(t, errors.As(err, &invalidRuleErr))
+		assert.Equal(t, "Test", invalidRuleErr.Rule)
+		assert.Contains(t, invalidRuleErr.Reason, "invalid label name")
+	})
+
+	t.Run("should return invalid rule error if for is not a valid duration", func(t *testing.T) {
+		mockTemplateService := &mocks.TemplatesService{}
+		mockTemplateService.On("Render", "tmpl", mock.Anything).
+			Return("- alert: Test\n  expr: test_metric > 1\n  for: 'twenty minutes'\n", nil)
+		_, err := renderRuleGroup(rulesWithinGroup, mockTemplateService, &adapter.AdapterMock{})
+		var invalidRuleErr *domain.InvalidRuleError
+		assert.True(t, errors.As(err, &invalidRuleErr))
+		assert.Equal(t, "tmpl", invalidRuleErr.Template)
+		assert.Equal(t, "siren_api_bar_foo_foo_bar_tmpl", invalidRuleErr.Rule)
+	})
+
+	t.Run("should return invalid rule error if rule names are duplicated within group", func(t *testing.T) {
+		mockTemplateService := &mocks.TemplatesService{}
+		mockTemplateService.On("Render", "tmpl", mock.Anything).
+			Return("- alert: Test\n  expr: test_metric > 1\n", nil)
+		mockTemplateService.On("Render", "tmpl2", mock.Anything).
+			Return("- alert: Test\n  expr: test_metric > 2\n", nil)
+		_, err := renderRuleGroup(rulesWithinGroup, mockTemplateService, &adapter.AdapterMock{})
+		var invalidRuleErr *domain.InvalidRuleError
+		assert.True(t, errors.As(err, &invalidRuleErr))
+		assert.Equal(t, "tmpl2", invalidRuleErr.Template)
+		assert.Equal(t, "Test", invalidRuleErr.Rule)
+		assert.Equal(t, "duplicate rule name in group, also rendered from template tmpl", invalidRuleErr.Reason)
+	})
+
+	t.Run("should validate rules with the adapter if it is a rule validator", func(t *testing.T) {
+		mockTemplateService := &mocks.TemplatesService{}
+		mockTemplateService.On("Render", "tmpl", mock.Anything).
+			Return("- alert: Test\n  expr: sum(rate({app=\"foo\"} |= \"error\" [5m])) > 1\n", nil)
+		mockTemplateService.On("Render", "tmpl2", mock.Anything).Return("", nil)
+		ruleNodes, err := renderRuleGroup(rulesWithinGroup, mockTemplateService, &ruleValidatorMock{})
+		assert.Nil(t, err)
+		assert.Equal(t, 1, len(ruleNodes))
+
+		_, err = renderRuleGroup(rulesWithinGroup, mockTemplateService, &ruleValidatorMock{
+			errs: []error{errors.New("could not parse expression")},
+		})
+		var invalidRuleErr *domain.InvalidRuleError
+		assert.True(t, errors.As(err, &invalidRuleErr))
+		assert.Equal(t, "Test", invalidRuleErr.Rule)
+		assert.Equal(t, "could not parse expression", invalidRuleErr.Reason)
+	})
+}
+
+type ruleValidatorMock struct {
+	adapter.AdapterMock
+	errs []error
+}
+
+func (m *ruleValidatorMock) ValidateRuleNode(rulefmt.RuleNode) []error {
+	return m.errs
+}
@@ -2,8 +2,10 @@ package rules
 
 import (
 	"github.com/odpf/siren/domain"
+	"github.com/odpf/siren/pkg/adapter"
 	"github.com/odpf/siren/pkg/templates"
 	"gorm.io/gorm"
+	"sort"
 )
 
 // Service handles business logic
@@ -13,10 +15,11 @@ type Service struct {
 }
 
 // NewService returns repository struct
-func NewService(db *gorm.DB) domain.RuleService {
+func NewService(db *gorm.DB, adapters *adapter.Registry) domain.RuleService {
+	repository := NewRepository(db, adapters)
 	return &Service{
-		repository:      NewRepository(db),
-		templateService: templates.NewService(db),
+		repository:      repository,
+		templateService: templates.NewService(db, repository),
 	}
 }
 
@@ -24,19 +27,28 @@ func (service Service) Migrate() error {
 	return service.repository.Migrate()
 }
 
-func (service Service) Upsert(rule *domain.Rule) (*domain.Rule, error) {
+func (service Service) Upsert(rule *domain.Rule, actor string) (*domain.Rule, error) {
 	r := &Rule{}
 	r, err := r.fromDomain(rule)
 	if err != nil {
 		return nil, err
 	}
-	upsertedRule, err := service.repository.Upsert(r, service.templateService)
+	upsertedRule, err := service.repository.Upsert(r, actor, service.templateService)
 	if err != nil {
 		return nil, err
 	}
 	return upsertedRule.toDomain()
 }
 
+func (service Service) Diff(rule *domain.Rule) (*domain.RuleGroupDiff, error) {
+	r := &Rule{}
+	r, err := r.fromDomain(rule)
+	if err != nil {
+		return nil, err
+	}
+	return service.repository.Diff(r, service.templateService)
+}
+
 func (service Service) Get(name, namespace, groupName, template string, providerNamespace uint64) ([]domain.Rule, error) {
 	rules, err := service.repository.Get(name, namespace, groupName, template, providerNamespace)
 	if err != nil {
@@ -49,3 +61,155 @@ func (service Service) Get(name, namespace, groupName, template string, provider
 	}
 	return domainRules, nil
 }
+
+func (service Service) Delete(id uint64) error {
+	return service.repository.Delete(id, service.templateService)
+}
+
+func (service Service) Import(providerNamespace uint64, createTemplates, dryRun bool) (*domain.RuleImportReport, error) {
+	return service.repository.Import(providerNamespace, createTemplates, dryRun, service.templateService)
+}
+
+func (service Service) ListRevisions(ruleId uint64) ([]domain.RuleRevision, error) {
+	revisions, err := service.repository.ListRevisions(ruleId)
+	if err != nil {
+		return nil, err
+	}
+	domainRevisions := make([]domain.RuleRevision, 0, len(revisions))
+	for i := 0; i < len(revisions); i++ {
+		revision, err := revisions[i].toDomain()
+		if err != nil {
+			return nil, err
+		}
+		domainRevisions = append(domainRevisions, *revision)
+	}
+	return domainRevisions, nil
+}
+
+func (service Service) Rollback(ruleId, revisionId uint64, actor string) (*domain.Rule, error) {
+	rule, err := service.repository.Rollback(ruleId, revisionId, actor, service.templateService)
+	if err != nil {
+		return nil, err
+	}
+	return rule.toDomain()
+}
+
+// GetGroup returns the settings of a rule group, which are empty if none are stored
+func (service Service) GetGroup(providerNamespace uint64, namespace, groupName string) (*domain.RuleGroup, error) {
+	group, err := service.repository.GetGroup(providerNamespace, namespace, groupName)
+	if err != nil {
+		return nil, err
+	}
+	if group == nil {
+		group = &RuleGroup{ProviderNamespace: providerNamespace, Namespace: namespace, GroupName: groupName}
+	}
+	return group.toDomain(), nil
+}
+
+func (service Service) UpsertGroup(group *domain.RuleGroup) (*domain.RuleGroup, error) {
+	g := &RuleGroup{}
+	upsertedGroup, err := service.repository.UpsertGroup(g.fromDomain(group), service.templateService)
+	if err != nil {
+		return nil, err
+	}
+	return upsertedGroup.toDomain(), nil
+}
+
+// UpgradeTemplateVersion pins the rules of a template that are pinned to a version to another one
+func (service Service) UpgradeTemplateVersion(template string, from, to uint, actor string) ([]domain.Rule, error) {
+	rules, err := service.repository.UpgradeTemplateVersion(template, from, to, actor, service.templateService)
+	if err != nil {
+		return nil, err
+	}
+	domainRules := make([]domain.Rule, 0, len(rules))
+	for i := 0; i < len(rules); i++ {
+		r, err := rules[i].toDomain()
+		if err != nil {
+			return nil, err
+		}
+		domainRules = append(domainRules, *r)
+	}
+	return domainRules, nil
+}
+
+// TemplateUsage returns the rules using a template, and the namespaces and
+// providers of their rule groups
+func (service Service) TemplateUsage(template string) (*domain.TemplateUsage, error) {
+	usages, err := service.repository.TemplateUsage(template)
+	if err != nil {
+		return nil, err
+	}
+	usage := &domain.TemplateUsage{
+		Template:   template,
+		Rules:      make([]domain.TemplateRuleUsage, 0, len(usages)),
+		Namespaces: make([]string, 0),
+		Providers:  make([]string, 0),
+	}
+	namespaces := make(map[string]bool)
+	providers := make(map[string]bool)
+	for _, u := range usages {
+		usage.Rules = append(usage.Rules, domain.TemplateRuleUsage{
+			Id:                u.Id,
+			Name:              u.Name,
+			Namespace:         u.Namespace,
+			GroupName:         u.GroupName,
+			TemplateVersion:   u.TemplateVersion,
+			Enabled:           u.Enabled,
+			ProviderNamespace: u.ProviderNamespace,
+			NamespaceUrn:      u.NamespaceUrn,
+			ProviderUrn:       u.ProviderUrn,
+		})
+		if u.NamespaceUrn != "" && !namespaces[u.NamespaceUrn] {
+			namespaces[u.NamespaceUrn] = true
+			usage.Namespaces = append(usage.Namespaces, u.NamespaceUrn)
+		}
+		if u.ProviderUrn != "" && !providers[u.ProviderUrn] {
+			providers[u.ProviderUrn] = true
+			usage.Providers = append(usage.Providers, u.ProviderUrn)
+		}
+	}
+	sort.Strings(usage.Namespaces)
+	sort.Strings(usage.Providers)
+	return usage, nil
+}
+
+// Export returns the rules of a provider namespace grouped by rule group with
+// the settings of their groups, or nil if the provider namespace does not exist.
+// Rule groups with settings but without rules are left out.
+func (service Service) Export(providerNamespace uint64, namespace, groupName string) (*domain.RuleExport, error) {
+	export, err := service.repository.Export(providerNamespace, namespace, groupName)
+	if err != nil || export == nil {
+		return nil, err
+	}
+	settings := make(map[string]RuleGroup)
+	for _, group := range export.Groups {
+		settings[group.Namespace+"/"+group.GroupName] = group
+	}
+
+	domainExport := &domain.RuleExport{
+		ProviderUrn:  export.ProviderUrn,
+		NamespaceUrn: export.NamespaceUrn,
+		RuleGroups:   make([]domain.RuleGroupExport, 0),
+	}
+	for i := 0; i < len(export.Rules); i++ {
+		r, err := export.Rules[i].toDomain()
+		if err != nil {
+			return nil, err
+		}
+		last := len(domainExport.RuleGroups) - 1
+		if last < 0 || domainExport.RuleGroups[last].Group.Namespace != r.Namespace ||
+			domainExport.RuleGroups[last].Group.GroupName != r.GroupName {
+			group, ok := settings[r.Namespace+"/"+r.GroupName]
+			if !ok {
+				group = RuleGroup{ProviderNamespace: providerNamespace, Namespace: r.Namespace, GroupName: r.GroupName}
+			}
+			domainExport.RuleGroups = append(domainExport.RuleGroups, domain.RuleGroupExport{
+				Group: *group.toDomain(),
+				Rules: make([]domain.Rule, 0),
+			})
+			last++
+		}
+		domainExport.RuleGroups[last].Rules = append(domainExport.RuleGroups[last].Rules, *r)
+	}
+	return domainExport, nil
+}
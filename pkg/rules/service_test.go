@@ -5,7 +5,9 @@ import (
 	"github.com/odpf/siren/domain"
 	"github.com/odpf/siren/mocks"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 	"testing"
+	"time"
 )
 
 var truebool = true
@@ -30,11 +32,11 @@ func TestService_Upsert(t *testing.T) {
 			Variables:         `[{"name":"test-name","type":"test-type","value":"test-value","description":"test-description"}]`,
 			ProviderNamespace: 1,
 		}
-		repositoryMock.On("Upsert", modelRule, mockTemplateService).Return(modelRule, nil).Once()
-		result, err := dummyService.Upsert(dummyRule)
+		repositoryMock.On("Upsert", modelRule, "foo@odpf.io", mockTemplateService).Return(modelRule, nil).Once()
+		result, err := dummyService.Upsert(dummyRule, "foo@odpf.io")
 		assert.Nil(t, err)
 		assert.Equal(t, dummyRule, result)
-		repositoryMock.AssertCalled(t, "Upsert", modelRule, mockTemplateService)
+		repositoryMock.AssertCalled(t, "Upsert", modelRule, "foo@odpf.io", mockTemplateService)
 	})
 
 	t.Run("should call repository Upsert method and return error if any", func(t *testing.T) {
@@ -56,12 +58,12 @@ func TestService_Upsert(t *testing.T) {
 			Variables:         `[{"name":"test-name","type":"test-type","value":"test-value","description":"test-description"}]`,
 			ProviderNamespace: 1,
 		}
-		repositoryMock.On("Upsert", modelRule, mockTemplateService).
+		repositoryMock.On("Upsert", modelRule, "foo@odpf.io", mockTemplateService).
 			Return(nil, errors.New("random error")).Once()
-		result, err := dummyService.Upsert(dummyRule)
+		result, err := dummyService.Upsert(dummyRule, "foo@odpf.io")
 		assert.Nil(t, result)
 		assert.EqualError(t, err, "random error")
-		repositoryMock.AssertCalled(t, "Upsert", modelRule, mockTemplateService)
+		repositoryMock.AssertCalled(t, "Upsert", modelRule, "foo@odpf.io", mockTemplateService)
 	})
 
 	t.Run("should call repository Upsert method and return error if any", func(t *testing.T) {
@@ -83,12 +85,60 @@ func TestService_Upsert(t *testing.T) {
 			Variables:         `[{"name":"test-name","type":"test-type","value":"test-value","description":"test-description"}]`,
 			ProviderNamespace: 1,
 		}
-		repositoryMock.On("Upsert", modelRule, mockTemplateService).
+		repositoryMock.On("Upsert", modelRule, "foo@odpf.io", mockTemplateService).
 			Return(nil, errors.New("random error")).Once()
-		result, err := dummyService.Upsert(dummyRule)
+		result, err := dummyService.Upsert(dummyRule, "foo@odpf.io")
 		assert.Nil(t, result)
 		assert.EqualError(t, err, "random error")
-		repositoryMock.AssertCalled(t, "Upsert", modelRule, mockTemplateService)
+		repositoryMock.AssertCalled(t, "Upsert", modelRule, "foo@odpf.io", mockTemplateService)
+	})
+}
+
+func TestService_Diff(t *testing.T) {
+	dummyRule := &domain.Rule{
+		Id: 1, Name: "bar", Enabled: true, GroupName: "test-group", Namespace: "baz", Template: "test-tmpl",
+		Variables: []domain.RuleVariable{{
+			Name:        "test-name",
+			Value:       "test-value",
+			Description: "test-description",
+			Type:        "test-type",
+		}},
+		ProviderNamespace: 1,
+	}
+	modelRule := &Rule{
+		Id: 1, Name: "bar", Enabled: &truebool, GroupName: "test-group", Namespace: "baz", Template: "test-tmpl",
+		Variables:         `[{"name":"test-name","type":"test-type","value":"test-value","description":"test-description"}]`,
+		ProviderNamespace: 1,
+	}
+
+	t.Run("should call repository Diff method and return result", func(t *testing.T) {
+		repositoryMock := &RuleRepositoryMock{}
+		mockTemplateService := &mocks.TemplatesService{}
+		dummyService := Service{repository: repositoryMock, templateService: mockTemplateService}
+		dummyDiff := &domain.RuleGroupDiff{
+			Namespace: "baz",
+			GroupName: "test-group",
+			Added:     []domain.RuleDiff{{Name: "Test", Desired: "alert: Test\n"}},
+			Changed:   []domain.RuleDiff{},
+			Removed:   []domain.RuleDiff{},
+		}
+		repositoryMock.On("Diff", modelRule, mockTemplateService).Return(dummyDiff, nil).Once()
+		result, err := dummyService.Diff(dummyRule)
+		assert.Nil(t, err)
+		assert.Equal(t, dummyDiff, result)
+		repositoryMock.AssertCalled(t, "Diff", modelRule, mockTemplateService)
+	})
+
+	t.Run("should call repository Diff method and return error if any", func(t *testing.T) {
+		repositoryMock := &RuleRepositoryMock{}
+		mockTemplateService := &mocks.TemplatesService{}
+		dummyService := Service{repository: repositoryMock, templateService: mockTemplateService}
+		repositoryMock.On("Diff", modelRule, mockTemplateService).
+			Return(nil, errors.New("random error")).Once()
+		result, err := dummyService.Diff(dummyRule)
+		assert.Nil(t, result)
+		assert.EqualError(t, err, "random error")
+		repositoryMock.AssertCalled(t, "Diff", modelRule, mockTemplateService)
 	})
 }
 
@@ -134,6 +184,274 @@ func TestService_Get(t *testing.T) {
 	})
 }
 
+func TestService_Delete(t *testing.T) {
+	t.Run("should call repository Delete method and return nil if no error", func(t *testing.T) {
+		repositoryMock := &RuleRepositoryMock{}
+		mockTemplateService := &mocks.TemplatesService{}
+		dummyService := Service{repository: repositoryMock, templateService: mockTemplateService}
+		repositoryMock.On("Delete", uint64(1), mockTemplateService).Return(nil).Once()
+		err := dummyService.Delete(1)
+		assert.Nil(t, err)
+		repositoryMock.AssertCalled(t, "Delete", uint64(1), mockTemplateService)
+	})
+
+	t.Run("should call repository Delete method and return error if any", func(t *testing.T) {
+		repositoryMock := &RuleRepositoryMock{}
+		mockTemplateService := &mocks.TemplatesService{}
+		dummyService := Service{repository: repositoryMock, templateService: mockTemplateService}
+		repositoryMock.On("Delete", uint64(1), mockTemplateService).
+			Return(errors.New("random error")).Once()
+		err := dummyService.Delete(1)
+		assert.EqualError(t, err, "random error")
+		repositoryMock.AssertCalled(t, "Delete", uint64(1), mockTemplateService)
+	})
+}
+
+func TestService_Import(t *testing.T) {
+	t.Run("should call repository Import method and return result", func(t *testing.T) {
+		repositoryMock := &RuleRepositoryMock{}
+		mockTemplateService := &mocks.TemplatesService{}
+		dummyService := Service{repository: repositoryMock, templateService: mockTemplateService}
+		expectedReport := &domain.RuleImportReport{
+			Rules:            []domain.Rule{{Name: "foo"}},
+			CreatedTemplates: []domain.Template{},
+			Unmapped:         []domain.UnmappedRule{},
+		}
+		repositoryMock.On("Import", uint64(1), true, false, mockTemplateService).Return(expectedReport, nil).Once()
+		result, err := dummyService.Import(1, true, false)
+		assert.Nil(t, err)
+		assert.Equal(t, expectedReport, result)
+		repositoryMock.AssertCalled(t, "Import", uint64(1), true, false, mockTemplateService)
+	})
+
+	t.Run("should call repository Import method and return error if any", func(t *testing.T) {
+		repositoryMock := &RuleRepositoryMock{}
+		mockTemplateService := &mocks.TemplatesService{}
+		dummyService := Service{repository: repositoryMock, templateService: mockTemplateService}
+		repositoryMock.On("Import", uint64(1), false, true, mockTemplateService).
+			Return(nil, errors.New("random error")).Once()
+		result, err := dummyService.Import(1, false, true)
+		assert.Nil(t, result)
+		assert.EqualError(t, err, "random error")
+	})
+}
+
+func TestService_ListRevisions(t *testing.T) {
+	t.Run("should call repository ListRevisions method and return result in domain's type", func(t *testing.T) {
+		repositoryMock := &RuleRepositoryMock{}
+		dummyService := Service{repository: repositoryMock}
+		createdAt := time.Now()
+		repositoryMock.On("ListRevisions", uint64(10)).Return([]RuleRevision{{
+			Id: 1, CreatedAt: createdAt, RuleId: 10, Enabled: true,
+			Variables:    `[{"name":"test-name","type":"test-type","value":"test-value","description":"test-description"}]`,
+			TemplateHash: "abc", Rendered: "- alert: Test\n", Actor: "foo@odpf.io",
+		}}, nil).Once()
+		result, err := dummyService.ListRevisions(10)
+		assert.Nil(t, err)
+		assert.Equal(t, []domain.RuleRevision{{
+			Id: 1, CreatedAt: createdAt, RuleId: 10, Enabled: true,
+			Variables: []domain.RuleVariable{{
+				Name:        "test-name",
+				Value:       "test-value",
+				Description: "test-description",
+				Type:        "test-type",
+			}},
+			TemplateHash: "abc", Rendered: "- alert: Test\n", Actor: "foo@odpf.io",
+		}}, result)
+	})
+
+	t.Run("should call repository ListRevisions method and return error if any", func(t *testing.T) {
+		repositoryMock := &RuleRepositoryMock{}
+		dummyService := Service{repository: repositoryMock}
+		repositoryMock.On("ListRevisions", uint64(10)).Return(nil, errors.New("random error")).Once()
+		result, err := dummyService.ListRevisions(10)
+		assert.Nil(t, result)
+		assert.EqualError(t, err, "random error")
+	})
+}
+
+func TestService_Rollback(t *testing.T) {
+	t.Run("should call repository Rollback method and return result in domain's type", func(t *testing.T) {
+		repositoryMock := &RuleRepositoryMock{}
+		mockTemplateService := &mocks.TemplatesService{}
+		dummyService := Service{repository: repositoryMock, templateService: mockTemplateService}
+		modelRule := &Rule{
+			Id: 10, Name: "bar", Enabled: &truebool, GroupName: "test-group", Namespace: "baz", Template: "test-tmpl",
+			Variables:         `[]`,
+			ProviderNamespace: 1,
+		}
+		repositoryMock.On("Rollback", uint64(10), uint64(1), "foo@odpf.io", mockTemplateService).Return(modelRule, nil).Once()
+		result, err := dummyService.Rollback(10, 1, "foo@odpf.io")
+		assert.Nil(t, err)
+		assert.Equal(t, &domain.Rule{
+			Id: 10, Name: "bar", Enabled: true, GroupName: "test-group", Namespace: "baz", Template: "test-tmpl",
+			Variables:         []domain.RuleVariable{},
+			ProviderNamespace: 1,
+		}, result)
+	})
+
+	t.Run("should call repository Rollback method and return error if any", func(t *testing.T) {
+		repositoryMock := &RuleRepositoryMock{}
+		mockTemplateService := &mocks.TemplatesService{}
+		dummyService := Service{repository: repositoryMock, templateService: mockTemplateService}
+		repositoryMock.On("Rollback", uint64(10), uint64(1), "foo@odpf.io", mockTemplateService).
+			Return(nil, errors.New("random error")).Once()
+		result, err := dummyService.Rollback(10, 1, "foo@odpf.io")
+		assert.Nil(t, result)
+		assert.EqualError(t, err, "random error")
+	})
+}
+
+func TestService_GetGroup(t *testing.T) {
+	t.Run("should call repository GetGroup method and return result in domain's type", func(t *testing.T) {
+		repositoryMock := &RuleRepositoryMock{}
+		dummyService := Service{repository: repositoryMock}
+		repositoryMock.On("GetGroup", uint64(1), "foo", "bar").Return(&RuleGroup{
+			Id: 3, ProviderNamespace: 1, Namespace: "foo", GroupName: "bar",
+			Interval: "1m", Limit: 10, SourceTenants: []string{"odpf"},
+		}, nil).Once()
+		result, err := dummyService.GetGroup(1, "foo", "bar")
+		assert.Nil(t, err)
+		assert.Equal(t, &domain.RuleGroup{
+			Id: 3, ProviderNamespace: 1, Namespace: "foo", GroupName: "bar",
+			Interval: "1m", Limit: 10, SourceTenants: []string{"odpf"},
+		}, result)
+	})
+
+	t.Run("should return empty settings if none are stored", func(t *testing.T) {
+		repositoryMock := &RuleRepositoryMock{}
+		dummyService := Service{repository: repositoryMock}
+		repositoryMock.On("GetGroup", uint64(1), "foo", "bar").Return(nil, nil).Once()
+		result, err := dummyService.GetGroup(1, "foo", "bar")
+		assert.Nil(t, err)
+		assert.Equal(t, &domain.RuleGroup{
+			ProviderNamespace: 1, Namespace: "foo", GroupName: "bar", SourceTenants: []string{},
+		}, result)
+	})
+
+	t.Run("should call repository GetGroup method and return error if any", func(t *testing.T) {
+		repositoryMock := &RuleRepositoryMock{}
+		dummyService := Service{repository: repositoryMock}
+		repositoryMock.On("GetGroup", uint64(1), "foo", "bar").Return(nil, errors.New("random error")).Once()
+		result, err := dummyService.GetGroup(1, "foo", "bar")
+		assert.Nil(t, result)
+		assert.EqualError(t, err, "random error")
+	})
+}
+
+func TestService_UpsertGroup(t *testing.T) {
+	t.Run("should call repository UpsertGroup method and return result in domain's type", func(t *testing.T) {
+		repositoryMock := &RuleRepositoryMock{}
+		mockTemplateService := &mocks.TemplatesService{}
+		dummyService := Service{repository: repositoryMock, templateService: mockTemplateService}
+		modelGroup := &RuleGroup{
+			ProviderNamespace: 1, Namespace: "foo", GroupName: "bar",
+			Interval: "1m", Limit: 10, SourceTenants: []string{"odpf"},
+		}
+		repositoryMock.On("UpsertGroup", modelGroup, mockTemplateService).Return(modelGroup, nil).Once()
+		result, err := dummyService.UpsertGroup(&domain.RuleGroup{
+			ProviderNamespace: 1, Namespace: "foo", GroupName: "bar",
+			Interval: "1m", Limit: 10, SourceTenants: []string{"odpf"},
+		})
+		assert.Nil(t, err)
+		assert.Equal(t, "1m", result.Interval)
+		assert.Equal(t, []string{"odpf"}, result.SourceTenants)
+	})
+
+	t.Run("should call repository UpsertGroup method and return error if any", func(t *testing.T) {
+		repositoryMock := &RuleRepositoryMock{}
+		mockTemplateService := &mocks.TemplatesService{}
+		dummyService := Service{repository: repositoryMock, templateService: mockTemplateService}
+		repositoryMock.On("UpsertGroup", mock.Anything, mockTemplateService).Return(nil, errors.New("random error")).Once()
+		result, err := dummyService.UpsertGroup(&domain.RuleGroup{ProviderNamespace: 1, Namespace: "foo", GroupName: "bar"})
+		assert.Nil(t, result)
+		assert.EqualError(t, err, "random error")
+	})
+}
+
+func TestService_UpgradeTemplateVersion(t *testing.T) {
+	t.Run("should call repository UpgradeTemplateVersion method and return upgraded rules in domain form", func(t *testing.T) {
+		repositoryMock := &RuleRepositoryMock{}
+		mockTemplateService := &mocks.TemplatesService{}
+		dummyService := Service{repository: repositoryMock, templateService: mockTemplateService}
+		enabled := true
+		rules := []Rule{{
+			Id:                10,
+			Name:              "siren_api_bar_foo_foo_bar_tmpl",
+			Namespace:         "foo",
+			GroupName:         "bar",
+			Template:          "tmpl",
+			TemplateVersion:   2,
+			Enabled:           &enabled,
+			Variables:         `[{"name":"threshold","type":"int","value":"20","description":""}]`,
+			ProviderNamespace: 1,
+		}}
+		repositoryMock.On("UpgradeTemplateVersion", "tmpl", uint(1), uint(2), "foo@odpf.io", mockTemplateService).
+			Return(rules, nil).Once()
+		result, err := dummyService.UpgradeTemplateVersion("tmpl", 1, 2, "foo@odpf.io")
+		assert.Nil(t, err)
+		assert.Equal(t, 1, len(result))
+		assert.Equal(t, uint(2), result[0].TemplateVersion)
+		assert.Equal(t, "20", result[0].Variables[0].Value)
+	})
+
+	t.Run("should call repository UpgradeTemplateVersion method and return error if any", func(t *testing.T) {
+		repositoryMock := &RuleRepositoryMock{}
+		mockTemplateService := &mocks.TemplatesService{}
+		dummyService := Service{repository: repositoryMock, templateService: mockTemplateService}
+		repositoryMock.On("UpgradeTemplateVersion", "tmpl", uint(1), uint(2), "foo@odpf.io", mockTemplateService).
+			Return(nil, errors.New("random error")).Once()
+		result, err := dummyService.UpgradeTemplateVersion("tmpl", 1, 2, "foo@odpf.io")
+		assert.Nil(t, result)
+		assert.EqualError(t, err, "random error")
+	})
+}
+
+func TestService_TemplateUsage(t *testing.T) {
+	t.Run("should return the rules with the distinct namespaces and providers", func(t *testing.T) {
+		repositoryMock := &RuleRepositoryMock{}
+		dummyService := Service{repository: repositoryMock}
+		repositoryMock.On("TemplateUsage", "tmpl").Return([]TemplateRuleUsage{
+			{Id: 1, Name: "siren_api_bar_foo_foo_bar_tmpl", Namespace: "foo", GroupName: "bar", Enabled: true,
+				ProviderNamespace: 2, NamespaceUrn: "odpf-staging", ProviderUrn: "cortex"},
+			{Id: 2, Name: "siren_api_baz_foo_foo_baz_tmpl", Namespace: "foo", GroupName: "baz", Enabled: false,
+				ProviderNamespace: 1, NamespaceUrn: "odpf-prod", ProviderUrn: "cortex"},
+			{Id: 3, Name: "siren_api_baz_foo_foo_qux_tmpl", Namespace: "foo", GroupName: "baz",
+				ProviderNamespace: 1, NamespaceUrn: "odpf-prod", ProviderUrn: "cortex"},
+		}, nil).Once()
+		result, err := dummyService.TemplateUsage("tmpl")
+		assert.Nil(t, err)
+		assert.Equal(t, "tmpl", result.Template)
+		assert.Equal(t, 3, len(result.Rules))
+		assert.False(t, result.Rules[1].Enabled)
+		assert.Equal(t, []string{"odpf-prod", "odpf-staging"}, result.Namespaces)
+		assert.Equal(t, []string{"cortex"}, result.Providers)
+	})
+
+	t.Run("should return empty usage if no rules use the template", func(t *testing.T) {
+		repositoryMock := &RuleRepositoryMock{}
+		dummyService := Service{repository: repositoryMock}
+		repositoryMock.On("TemplateUsage", "tmpl").Return([]TemplateRuleUsage{}, nil).Once()
+		result, err := dummyService.TemplateUsage("tmpl")
+		assert.Nil(t, err)
+		assert.Equal(t, &domain.TemplateUsage{
+			Template:   "tmpl",
+			Rules:      []domain.TemplateRuleUsage{},
+			Namespaces: []string{},
+			Providers:  []string{},
+		}, result)
+	})
+
+	t.Run("should call repository TemplateUsage method and return error if any", func(t *testing.T) {
+		repositoryMock := &RuleRepositoryMock{}
+		dummyService := Service{repository: repositoryMock}
+		repositoryMock.On("TemplateUsage", "tmpl").Return(nil, errors.New("random error")).Once()
+		result, err := dummyService.TemplateUsage("tmpl")
+		assert.Nil(t, result)
+		assert.EqualError(t, err, "random error")
+	})
+}
+
 func TestService_Migrate(t *testing.T) {
 	t.Run("should call repository Migrate method and return result", func(t *testing.T) {
 		repositoryMock := &RuleRepositoryMock{}
@@ -144,3 +462,55 @@ func TestService_Migrate(t *testing.T) {
 		repositoryMock.AssertCalled(t, "Migrate")
 	})
 }
+
+func TestService_Export(t *testing.T) {
+	variables := `[{"name":"threshold","type":"int","value":"20","description":""}]`
+
+	t.Run("should group the rules by rule group with their settings", func(t *testing.T) {
+		repositoryMock := &RuleRepositoryMock{}
+		dummyService := Service{repository: repositoryMock}
+		repositoryMock.On("Export", uint64(1), "", "").Return(&RuleExport{
+			NamespaceUrn: "odpf",
+			ProviderUrn:  "cortex",
+			Rules: []Rule{
+				{Id: 1, Namespace: "foo", GroupName: "bar", Template: "cpu", Enabled: &truebool, Variables: variables, ProviderNamespace: 1},
+				{Id: 2, Namespace: "foo", GroupName: "bar", Template: "memory", Enabled: &truebool, Variables: variables, ProviderNamespace: 1},
+				{Id: 3, Namespace: "foo", GroupName: "baz", Template: "cpu", Enabled: &truebool, Variables: variables, ProviderNamespace: 1},
+			},
+			Groups: []RuleGroup{
+				{Id: 4, ProviderNamespace: 1, Namespace: "foo", GroupName: "baz", Interval: "1m"},
+				{Id: 5, ProviderNamespace: 1, Namespace: "foo", GroupName: "qux", Interval: "5m"},
+			},
+		}, nil).Once()
+		result, err := dummyService.Export(1, "", "")
+		assert.Nil(t, err)
+		assert.Equal(t, "odpf", result.NamespaceUrn)
+		assert.Equal(t, "cortex", result.ProviderUrn)
+		assert.Equal(t, 2, len(result.RuleGroups))
+		assert.Equal(t, "bar", result.RuleGroups[0].Group.GroupName)
+		assert.Equal(t, "", result.RuleGroups[0].Group.Interval)
+		assert.Equal(t, 2, len(result.RuleGroups[0].Rules))
+		assert.Equal(t, "memory", result.RuleGroups[0].Rules[1].Template)
+		assert.Equal(t, "baz", result.RuleGroups[1].Group.GroupName)
+		assert.Equal(t, "1m", result.RuleGroups[1].Group.Interval)
+		assert.Equal(t, []domain.RuleVariable{{Name: "threshold", Type: "int", Value: "20"}}, result.RuleGroups[1].Rules[0].Variables)
+	})
+
+	t.Run("should return nil if the provider namespace does not exist", func(t *testing.T) {
+		repositoryMock := &RuleRepositoryMock{}
+		dummyService := Service{repository: repositoryMock}
+		repositoryMock.On("Export", uint64(1), "foo", "bar").Return(nil, nil).Once()
+		result, err := dummyService.Export(1, "foo", "bar")
+		assert.Nil(t, err)
+		assert.Nil(t, result)
+	})
+
+	t.Run("should call repository Export method and return error if any", func(t *testing.T) {
+		repositoryMock := &RuleRepositoryMock{}
+		dummyService := Service{repository: repositoryMock}
+		repositoryMock.On("Export", uint64(1), "", "").Return(nil, errors.New("random error")).Once()
+		result, err := dummyService.Export(1, "", "")
+		assert.EqualError(t, err, "random error")
+		assert.Nil(t, result)
+	})
+}
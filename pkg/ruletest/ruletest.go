@@ -0,0 +1,231 @@
+package ruletest
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/go-kit/kit/log"
+	"github.com/prometheus/common/model"
+	"github.com/prometheus/prometheus/pkg/labels"
+	"github.com/prometheus/prometheus/promql"
+	"github.com/prometheus/prometheus/rules"
+	"gopkg.in/yaml.v3"
+)
+
+const defaultInterval = model.Duration(time.Minute)
+
+// InputSeries is a series with its values in promql expanding notation, e.g. '1+1x10'
+type InputSeries struct {
+	Series string `yaml:"series"`
+	Values string `yaml:"values"`
+}
+
+// ExpectedAlert is an alert expected to be firing at a given evaluation time
+type ExpectedAlert struct {
+	ExpLabels      map[string]string `yaml:"exp_labels"`
+	ExpAnnotations map[string]string `yaml:"exp_annotations"`
+}
+
+// AlertTestCase describes the alerts with the given name expected to be firing at EvalTime
+type AlertTestCase struct {
+	EvalTime  model.Duration  `yaml:"eval_time"`
+	Alertname string          `yaml:"alertname"`
+	ExpAlerts []ExpectedAlert `yaml:"exp_alerts"`
+}
+
+// TestGroup is a set of input series and the alert test cases evaluated over them
+type TestGroup struct {
+	Interval       model.Duration  `yaml:"interval"`
+	InputSeries    []InputSeries   `yaml:"input_series"`
+	AlertRuleTests []AlertTestCase `yaml:"alert_rule_test"`
+}
+
+type ruleGroups struct {
+	Groups []ruleGroup `yaml:"groups"`
+}
+
+type ruleGroup struct {
+	Name     string         `yaml:"name"`
+	Interval model.Duration `yaml:"interval,omitempty"`
+	Rules    yaml.Node      `yaml:"rules"`
+}
+
+// Run evaluates the rendered rule body, a YAML list of rules as produced by a
+// template, against every test group and returns the failures, if any
+func Run(ruleBody string, evaluationInterval model.Duration, testGroups []TestGroup) ([]error, error) {
+	if evaluationInterval == 0 {
+		evaluationInterval = defaultInterval
+	}
+	ruleFile, err := writeRuleFile(ruleBody, evaluationInterval)
+	if err != nil {
+		return nil, err
+	}
+	defer os.Remove(ruleFile)
+
+	var failures []error
+	for i, tg := range testGroups {
+		for _, err := range tg.run(ruleFile, time.Duration(evaluationInterval)) {
+			failures = append(failures, fmt.Errorf("test group %d: %w", i, err))
+		}
+	}
+	return failures, nil
+}
+
+func writeRuleFile(ruleBody string, evaluationInterval model.Duration) (string, error) {
+	var rulesNode yaml.Node
+	if err := yaml.Unmarshal([]byte(ruleBody), &rulesNode); err != nil {
+		return "", err
+	}
+	if len(rulesNode.Content) == 0 {
+		return "", errors.New("rendered template has no rules")
+	}
+	out, err := yaml.Marshal(ruleGroups{
+		Groups: []ruleGroup{{
+			Name:     "siren-rule-test",
+			Interval: evaluationInterval,
+			Rules:    *rulesNode.Content[0],
+		}},
+	})
+	if err != nil {
+		return "", err
+	}
+
+	f, err := ioutil.TempFile("", "siren-rule-test-*.yaml")
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+	if _, err := f.Write(out); err != nil {
+		os.Remove(f.Name())
+		return "", err
+	}
+	return f.Name(), nil
+}
+
+func (tg TestGroup) seriesLoadingString() string {
+	interval := tg.Interval
+	if interval == 0 {
+		interval = defaultInterval
+	}
+	result := fmt.Sprintf("load %v\n", interval)
+	for _, is := range tg.InputSeries {
+		result += fmt.Sprintf("  %v %v\n", is.Series, is.Values)
+	}
+	return result
+}
+
+func (tg TestGroup) run(ruleFile string, evaluationInterval time.Duration) []error {
+	suite, err := promql.NewLazyLoader(nil, tg.seriesLoadingString())
+	if err != nil {
+		return []error{err}
+	}
+	defer suite.Close()
+
+	opts := &rules.ManagerOptions{
+		QueryFunc:  rules.EngineQueryFunc(suite.QueryEngine(), suite.Storage()),
+		Appendable: suite.Storage(),
+		Context:    context.Background(),
+		NotifyFunc: func(ctx context.Context, expr string, alerts ...*rules.Alert) {},
+		Logger:     log.NewNopLogger(),
+	}
+	m := rules.NewManager(opts)
+	groupsMap, errs := m.LoadGroups(evaluationInterval, nil, ruleFile)
+	if errs != nil {
+		return errs
+	}
+	var groups []*rules.Group
+	for _, g := range groupsMap {
+		groups = append(groups, g)
+	}
+
+	alertsInTest := make(map[time.Duration]map[string]struct{})
+	var maxEvalTime time.Duration
+	for _, testCase := range tg.AlertRuleTests {
+		evalTime := time.Duration(testCase.EvalTime)
+		if evalTime%evaluationInterval != 0 {
+			return []error{fmt.Errorf("eval_time %v is not a multiple of the evaluation interval %v",
+				testCase.EvalTime, model.Duration(evaluationInterval))}
+		}
+		if _, ok := alertsInTest[evalTime]; !ok {
+			alertsInTest[evalTime] = make(map[string]struct{})
+		}
+		alertsInTest[evalTime][testCase.Alertname] = struct{}{}
+		if evalTime > maxEvalTime {
+			maxEvalTime = evalTime
+		}
+	}
+
+	var failures []error
+	got := make(map[time.Duration]map[string][]string)
+	mint := time.Unix(0, 0).UTC()
+	for ts := mint; ts.Sub(mint) <= maxEvalTime; ts = ts.Add(evaluationInterval) {
+		suite.WithSamplesTill(ts, func(err error) {
+			if err != nil {
+				failures = append(failures, err)
+			}
+		})
+		for _, g := range groups {
+			g.Eval(suite.Context(), ts)
+		}
+
+		evalTime := ts.Sub(mint)
+		alertNames, ok := alertsInTest[evalTime]
+		if !ok {
+			continue
+		}
+		got[evalTime] = make(map[string][]string)
+		for _, g := range groups {
+			for _, ar := range g.AlertingRules() {
+				if _, ok := alertNames[ar.Name()]; !ok {
+					continue
+				}
+				for _, a := range ar.ActiveAlerts() {
+					if a.State == rules.StateFiring {
+						got[evalTime][ar.Name()] = append(got[evalTime][ar.Name()],
+							alertString(a.Labels, a.Annotations))
+					}
+				}
+			}
+		}
+	}
+	if len(failures) != 0 {
+		return failures
+	}
+
+	for _, testCase := range tg.AlertRuleTests {
+		var expected []string
+		for _, expAlert := range testCase.ExpAlerts {
+			expLabels := make(map[string]string)
+			for name, value := range expAlert.ExpLabels {
+				expLabels[name] = value
+			}
+			expLabels[labels.AlertName] = testCase.Alertname
+			expected = append(expected, alertString(labels.FromMap(expLabels), labels.FromMap(expAlert.ExpAnnotations)))
+		}
+		actual := got[time.Duration(testCase.EvalTime)][testCase.Alertname]
+		sort.Strings(expected)
+		sort.Strings(actual)
+		if strings.Join(expected, "\n") != strings.Join(actual, "\n") {
+			failures = append(failures, fmt.Errorf("alertname: %s, time: %s,\n        exp:%s,\n        got:%s",
+				testCase.Alertname, testCase.EvalTime, alertListString(expected), alertListString(actual)))
+		}
+	}
+	return failures
+}
+
+func alertString(alertLabels labels.Labels, alertAnnotations labels.Labels) string {
+	return fmt.Sprintf("{labels: %s, annotations: %s}", alertLabels.String(), alertAnnotations.String())
+}
+
+func alertListString(alerts []string) string {
+	if len(alerts) == 0 {
+		return "[]"
+	}
+	return "[\n          " + strings.Join(alerts, "\n          ") + "\n        ]"
+}
@@ -0,0 +1,86 @@
+package ruletest
+
+import (
+	"testing"
+	"time"
+
+	"github.com/prometheus/common/model"
+	"github.com/stretchr/testify/assert"
+)
+
+const ruleBody = `- alert: CPUHighWarning
+  expr: avg by (host) (cpu_usage_user{cpu="cpu-total"}) > 80
+  for: 5m
+  labels:
+    severity: WARNING
+  annotations:
+    description: CPU has been above 80 on {{ $labels.host }}
+`
+
+func TestRun(t *testing.T) {
+	inputSeries := []InputSeries{{
+		Series: `cpu_usage_user{cpu="cpu-total",host="foo"}`,
+		Values: "90+0x20",
+	}, {
+		Series: `cpu_usage_user{cpu="cpu-total",host="bar"}`,
+		Values: "10+0x20",
+	}}
+
+	t.Run("should pass if expected alerts are firing", func(t *testing.T) {
+		testGroups := []TestGroup{{
+			Interval:    model.Duration(time.Minute),
+			InputSeries: inputSeries,
+			AlertRuleTests: []AlertTestCase{{
+				EvalTime:  model.Duration(2 * time.Minute),
+				Alertname: "CPUHighWarning",
+			}, {
+				EvalTime:  model.Duration(10 * time.Minute),
+				Alertname: "CPUHighWarning",
+				ExpAlerts: []ExpectedAlert{{
+					ExpLabels:      map[string]string{"host": "foo", "severity": "WARNING"},
+					ExpAnnotations: map[string]string{"description": "CPU has been above 80 on foo"},
+				}},
+			}},
+		}}
+		failures, err := Run(ruleBody, model.Duration(time.Minute), testGroups)
+		assert.Nil(t, err)
+		assert.Empty(t, failures)
+	})
+
+	t.Run("should return failures if expected alerts are not firing", func(t *testing.T) {
+		testGroups := []TestGroup{{
+			Interval:    model.Duration(time.Minute),
+			InputSeries: inputSeries,
+			AlertRuleTests: []AlertTestCase{{
+				EvalTime:  model.Duration(10 * time.Minute),
+				Alertname: "CPUHighWarning",
+				ExpAlerts: []ExpectedAlert{{
+					ExpLabels: map[string]string{"host": "bar", "severity": "WARNING"},
+				}},
+			}},
+		}}
+		failures, err := Run(ruleBody, model.Duration(time.Minute), testGroups)
+		assert.Nil(t, err)
+		assert.Equal(t, 1, len(failures))
+		assert.Contains(t, failures[0].Error(), "alertname: CPUHighWarning, time: 10m")
+	})
+
+	t.Run("should return failure if eval time is not a multiple of evaluation interval", func(t *testing.T) {
+		testGroups := []TestGroup{{
+			InputSeries: inputSeries,
+			AlertRuleTests: []AlertTestCase{{
+				EvalTime:  model.Duration(90 * time.Second),
+				Alertname: "CPUHighWarning",
+			}},
+		}}
+		failures, err := Run(ruleBody, model.Duration(time.Minute), testGroups)
+		assert.Nil(t, err)
+		assert.Equal(t, 1, len(failures))
+	})
+
+	t.Run("should return error if rendered body has no rules", func(t *testing.T) {
+		failures, err := Run("", model.Duration(time.Minute), nil)
+		assert.Nil(t, failures)
+		assert.EqualError(t, err, "rendered template has no rules")
+	})
+}
@@ -0,0 +1,196 @@
+package silence
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"time"
+
+	"github.com/odpf/siren/domain"
+	"github.com/odpf/siren/pkg/adapter"
+	"github.com/odpf/siren/pkg/namespace"
+	"github.com/odpf/siren/pkg/provider"
+	"github.com/odpf/siren/pkg/subscription"
+	"github.com/pkg/errors"
+	"gorm.io/gorm"
+)
+
+// Service manages the silences of a namespace in the alertmanager of its
+// provider, using the namespace urn as tenant
+type Service struct {
+	namespaceService domain.NamespaceService
+	providerService  domain.ProviderService
+	adapters         *adapter.Registry
+}
+
+// NewService returns service struct
+func NewService(db *gorm.DB, adapters *adapter.Registry, key string) (domain.SilenceService, error) {
+	namespaceSyncer, err := subscription.NewNamespaceSyncer(db, adapters, key)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create namespace syncer")
+	}
+	namespaceService, err := namespace.NewService(db, key, namespaceSyncer)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create namespace service")
+	}
+	return &Service{namespaceService, provider.NewService(db), adapters}, nil
+}
+
+func (s Service) ListSilences(namespaceId uint64) ([]*domain.Silence, error) {
+	silenceManager, tenant, err := s.getSilenceManager(namespaceId)
+	if err != nil {
+		return nil, err
+	}
+	silences, err := silenceManager.ListSilences(context.Background(), tenant)
+	if err != nil {
+		return nil, errors.Wrap(err, "silenceManager.ListSilences")
+	}
+	domainSilences := make([]*domain.Silence, 0, len(silences))
+	for i := range silences {
+		silences[i].Namespace = namespaceId
+		domainSilences = append(domainSilences, &silences[i])
+	}
+	return domainSilences, nil
+}
+
+func (s Service) CreateSilence(silence *domain.Silence) (*domain.Silence, error) {
+	newSilence := *silence
+	now := time.Now()
+	if newSilence.StartsAt.IsZero() {
+		newSilence.StartsAt = now
+	}
+	if err := validateSilence(&newSilence, now); err != nil {
+		return nil, err
+	}
+	silenceManager, tenant, err := s.getSilenceManager(newSilence.Namespace)
+	if err != nil {
+		return nil, err
+	}
+	id, err := silenceManager.CreateSilence(context.Background(), tenant, newSilence)
+	if err != nil {
+		return nil, errors.Wrap(err, "silenceManager.CreateSilence")
+	}
+	createdSilence, err := silenceManager.GetSilence(context.Background(), tenant, id)
+	if err != nil {
+		return nil, errors.Wrap(err, "silenceManager.GetSilence")
+	}
+	if createdSilence == nil {
+		return nil, fmt.Errorf("created silence %s not found", id)
+	}
+	createdSilence.Namespace = newSilence.Namespace
+	return createdSilence, nil
+}
+
+func (s Service) GetSilence(namespaceId uint64, id string) (*domain.Silence, error) {
+	silenceManager, tenant, err := s.getSilenceManager(namespaceId)
+	if err != nil {
+		return nil, err
+	}
+	silence, err := silenceManager.GetSilence(context.Background(), tenant, id)
+	if err != nil {
+		return nil, errors.Wrap(err, "silenceManager.GetSilence")
+	}
+	if silence == nil {
+		return nil, nil
+	}
+	silence.Namespace = namespaceId
+	return silence, nil
+}
+
+func (s Service) ExpireSilence(namespaceId uint64, id string) error {
+	silenceManager, tenant, err := s.getSilenceManager(namespaceId)
+	if err != nil {
+		return err
+	}
+	if err := silenceManager.ExpireSilence(context.Background(), tenant, id); err != nil {
+		return errors.Wrap(err, "silenceManager.ExpireSilence")
+	}
+	return nil
+}
+
+// getSilenceManager returns the adapter of the provider of a namespace along
+// with the tenant of the namespace
+func (s Service) getSilenceManager(namespaceId uint64) (adapter.SilenceManager, string, error) {
+	if namespaceId == 0 {
+		return nil, "", &domain.InvalidSilenceError{Reason: "namespace is required"}
+	}
+	namespaceInfo, err := s.namespaceService.GetNamespace(namespaceId)
+	if err != nil {
+		return nil, "", errors.Wrap(err, "failed to get namespace details")
+	}
+	if namespaceInfo == nil {
+		return nil, "", &domain.InvalidSilenceError{Reason: fmt.Sprintf("namespace %d does not exist", namespaceId)}
+	}
+	providerInfo, err := s.providerService.GetProvider(namespaceInfo.Provider)
+	if err != nil {
+		return nil, "", errors.Wrap(err, "failed to get provider details")
+	}
+	if providerInfo == nil {
+		return nil, "", &domain.InvalidSilenceError{Reason: fmt.Sprintf("provider %d of namespace %d does not exist", namespaceInfo.Provider, namespaceId)}
+	}
+	newAdapter, ok := s.adapters.Get(providerInfo.Type)
+	if !ok {
+		return nil, "", errors.New(fmt.Sprintf("silences for provider type '%s' not supported", providerInfo.Type))
+	}
+	providerAdapter, err := newAdapter(providerInfo.Urn, providerInfo.Host)
+	if err != nil {
+		return nil, "", errors.Wrap(err, "newAdapter")
+	}
+	silenceManager, ok := providerAdapter.(adapter.SilenceManager)
+	if !ok {
+		return nil, "", errors.New(fmt.Sprintf("silences for provider type '%s' not supported", providerInfo.Type))
+	}
+	return silenceManager, namespaceInfo.Urn, nil
+}
+
+// validateSilence checks a silence the way alertmanager does, which refuses
+// silences whose matchers all match the empty string as they mute every alert,
+// and requires who created it and why
+func validateSilence(silence *domain.Silence, now time.Time) error {
+	if len(silence.Matchers) == 0 {
+		return &domain.InvalidSilenceError{Reason: "matchers cannot be empty"}
+	}
+	matchesOnlyEmpty := true
+	for _, matcher := range silence.Matchers {
+		if err := matcher.Validate(); err != nil {
+			return err
+		}
+		if !matchesEmptyValue(matcher) {
+			matchesOnlyEmpty = false
+		}
+	}
+	if matchesOnlyEmpty {
+		return &domain.InvalidSilenceError{Reason: "at least one matcher must not match the empty string"}
+	}
+	if silence.EndsAt.IsZero() {
+		return &domain.InvalidSilenceError{Reason: "end time is required"}
+	}
+	if !silence.EndsAt.After(silence.StartsAt) {
+		return &domain.InvalidSilenceError{Reason: "end time must be after start time"}
+	}
+	if silence.EndsAt.Before(now) {
+		return &domain.InvalidSilenceError{Reason: "end time cannot be in the past"}
+	}
+	if silence.CreatedBy == "" {
+		return &domain.InvalidSilenceError{Reason: "created by cannot be empty"}
+	}
+	if silence.Comment == "" {
+		return &domain.InvalidSilenceError{Reason: "comment cannot be empty"}
+	}
+	return nil
+}
+
+// matchesEmptyValue tells whether a valid matcher matches alerts without its label
+func matchesEmptyValue(matcher domain.Matcher) bool {
+	switch matcher.Operator {
+	case domain.MatchEqual:
+		return matcher.Value == ""
+	case domain.MatchNotEqual:
+		return matcher.Value != ""
+	}
+	matches := regexp.MustCompile("^(?:" + matcher.Value + ")$").MatchString("")
+	if matcher.Operator == domain.MatchNotRegexp {
+		return !matches
+	}
+	return matches
+}
@@ -0,0 +1,269 @@
+package silence
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/odpf/siren/domain"
+	"github.com/odpf/siren/mocks"
+	"github.com/odpf/siren/pkg/adapter"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// silenceAdapterMock is an adapter able to manage silences
+type silenceAdapterMock struct {
+	*adapter.AdapterMock
+	*adapter.SilenceManagerMock
+}
+
+type testFixture struct {
+	service          Service
+	namespaceService *mocks.NamespaceService
+	providerService  *mocks.ProviderService
+	silenceManager   *adapter.SilenceManagerMock
+}
+
+func newTestFixture() *testFixture {
+	namespaceService := &mocks.NamespaceService{}
+	providerService := &mocks.ProviderService{}
+	silenceManager := &adapter.SilenceManagerMock{}
+	adapters := adapter.NewRegistry()
+	adapters.Register("cortex", func(string, string) (adapter.Adapter, error) {
+		return silenceAdapterMock{&adapter.AdapterMock{}, silenceManager}, nil
+	})
+	adapters.Register("prometheus", func(string, string) (adapter.Adapter, error) {
+		return &adapter.AdapterMock{}, nil
+	})
+	namespaceService.On("GetNamespace", uint64(1)).
+		Return(&domain.Namespace{Id: 1, Urn: "odpf", Provider: 1}, nil).Maybe()
+	providerService.On("GetProvider", uint64(1)).
+		Return(&domain.Provider{Id: 1, Type: "cortex", Host: "http://localhost:8080"}, nil).Maybe()
+	return &testFixture{
+		service:          Service{namespaceService, providerService, adapters},
+		namespaceService: namespaceService,
+		providerService:  providerService,
+		silenceManager:   silenceManager,
+	}
+}
+
+func TestService_ListSilences(t *testing.T) {
+	t.Run("should list silences of the namespace tenant", func(t *testing.T) {
+		f := newTestFixture()
+		f.silenceManager.On("ListSilences", mock.Anything, "odpf").
+			Return([]domain.Silence{{Id: "foo", State: domain.SilenceStateActive}}, nil).Once()
+
+		result, err := f.service.ListSilences(1)
+		assert.Nil(t, err)
+		assert.Equal(t, []*domain.Silence{{Id: "foo", Namespace: 1, State: domain.SilenceStateActive}}, result)
+		f.silenceManager.AssertExpectations(t)
+	})
+
+	t.Run("should return error if namespace is not given", func(t *testing.T) {
+		f := newTestFixture()
+
+		result, err := f.service.ListSilences(0)
+		assert.EqualError(t, err, "invalid silence: namespace is required")
+		assert.Nil(t, result)
+	})
+
+	t.Run("should return error if namespace does not exist", func(t *testing.T) {
+		f := newTestFixture()
+		f.namespaceService.On("GetNamespace", uint64(2)).Return(nil, nil).Once()
+
+		result, err := f.service.ListSilences(2)
+		var invalidSilenceErr *domain.InvalidSilenceError
+		assert.True(t, errors.As(err, &invalidSilenceErr))
+		assert.Equal(t, "namespace 2 does not exist", invalidSilenceErr.Reason)
+		assert.Nil(t, result)
+	})
+
+	t.Run("should return error if getting namespace fails", func(t *testing.T) {
+		f := newTestFixture()
+		f.namespaceService.On("GetNamespace", uint64(2)).Return(nil, errors.New("random error")).Once()
+
+		result, err := f.service.ListSilences(2)
+		assert.EqualError(t, err, "failed to get namespace details: random error")
+		assert.Nil(t, result)
+	})
+
+	t.Run("should return error if provider of namespace does not exist", func(t *testing.T) {
+		f := newTestFixture()
+		f.namespaceService.On("GetNamespace", uint64(2)).
+			Return(&domain.Namespace{Id: 2, Urn: "odpf", Provider: 2}, nil).Once()
+		f.providerService.On("GetProvider", uint64(2)).Return(nil, nil).Once()
+
+		result, err := f.service.ListSilences(2)
+		var invalidSilenceErr *domain.InvalidSilenceError
+		assert.True(t, errors.As(err, &invalidSilenceErr))
+		assert.Equal(t, "provider 2 of namespace 2 does not exist", invalidSilenceErr.Reason)
+		assert.Nil(t, result)
+	})
+
+	t.Run("should return error if provider does not support silences", func(t *testing.T) {
+		f := newTestFixture()
+		f.namespaceService.On("GetNamespace", uint64(2)).
+			Return(&domain.Namespace{Id: 2, Urn: "odpf", Provider: 2}, nil).Once()
+		f.providerService.On("GetProvider", uint64(2)).
+			Return(&domain.Provider{Id: 2, Type: "prometheus"}, nil).Once()
+
+		result, err := f.service.ListSilences(2)
+		assert.EqualError(t, err, "silences for provider type 'prometheus' not supported")
+		assert.Nil(t, result)
+	})
+
+	t.Run("should return error if provider type is unknown", func(t *testing.T) {
+		f := newTestFixture()
+		f.namespaceService.On("GetNamespace", uint64(2)).
+			Return(&domain.Namespace{Id: 2, Urn: "odpf", Provider: 2}, nil).Once()
+		f.providerService.On("GetProvider", uint64(2)).
+			Return(&domain.Provider{Id: 2, Type: "foo"}, nil).Once()
+
+		result, err := f.service.ListSilences(2)
+		assert.EqualError(t, err, "silences for provider type 'foo' not supported")
+		assert.Nil(t, result)
+	})
+
+	t.Run("should return error if listing silences fails", func(t *testing.T) {
+		f := newTestFixture()
+		f.silenceManager.On("ListSilences", mock.Anything, "odpf").Return(nil, errors.New("random error")).Once()
+
+		result, err := f.service.ListSilences(1)
+		assert.EqualError(t, err, "silenceManager.ListSilences: random error")
+		assert.Nil(t, result)
+	})
+}
+
+func TestService_CreateSilence(t *testing.T) {
+	matchers := []domain.Matcher{{Name: "alertname", Operator: domain.MatchEqual, Value: "CPUHigh"}}
+
+	t.Run("should create silence and return the stored one", func(t *testing.T) {
+		f := newTestFixture()
+		input := &domain.Silence{Namespace: 1, Matchers: matchers, EndsAt: time.Now().Add(time.Hour),
+			CreatedBy: "foo@odpf.io", Comment: "maintenance"}
+		f.silenceManager.On("CreateSilence", mock.Anything, "odpf", mock.MatchedBy(func(s domain.Silence) bool {
+			return !s.StartsAt.IsZero() && s.EndsAt.Equal(input.EndsAt) && s.CreatedBy == "foo@odpf.io"
+		})).Return("bar", nil).Once()
+		f.silenceManager.On("GetSilence", mock.Anything, "odpf", "bar").
+			Return(&domain.Silence{Id: "bar", State: domain.SilenceStateActive}, nil).Once()
+
+		result, err := f.service.CreateSilence(input)
+		assert.Nil(t, err)
+		assert.Equal(t, &domain.Silence{Id: "bar", Namespace: 1, State: domain.SilenceStateActive}, result)
+		assert.True(t, input.StartsAt.IsZero())
+		f.silenceManager.AssertExpectations(t)
+	})
+
+	t.Run("should return error if the silence is invalid", func(t *testing.T) {
+		now := time.Now()
+		for _, testCase := range []struct {
+			silence *domain.Silence
+			reason  string
+		}{
+			{&domain.Silence{Namespace: 1}, "matchers cannot be empty"},
+			{&domain.Silence{Namespace: 1, Matchers: []domain.Matcher{{Name: "team", Operator: domain.MatchRegexp, Value: ".*"}}},
+				"at least one matcher must not match the empty string"},
+			{&domain.Silence{Namespace: 1, Matchers: []domain.Matcher{{Name: "team", Operator: domain.MatchNotEqual, Value: "odpf"}}},
+				"at least one matcher must not match the empty string"},
+			{&domain.Silence{Namespace: 1, Matchers: matchers}, "end time is required"},
+			{&domain.Silence{Namespace: 1, Matchers: matchers, StartsAt: now.Add(time.Hour), EndsAt: now.Add(time.Minute)},
+				"end time must be after start time"},
+			{&domain.Silence{Namespace: 1, Matchers: matchers, StartsAt: now.Add(-time.Hour), EndsAt: now.Add(-time.Minute)},
+				"end time cannot be in the past"},
+			{&domain.Silence{Namespace: 1, Matchers: matchers, EndsAt: now.Add(time.Hour)}, "created by cannot be empty"},
+			{&domain.Silence{Namespace: 1, Matchers: matchers, EndsAt: now.Add(time.Hour), CreatedBy: "foo@odpf.io"},
+				"comment cannot be empty"},
+		} {
+			f := newTestFixture()
+
+			result, err := f.service.CreateSilence(testCase.silence)
+			var invalidSilenceErr *domain.InvalidSilenceError
+			assert.True(t, errors.As(err, &invalidSilenceErr))
+			assert.Equal(t, testCase.reason, invalidSilenceErr.Reason)
+			assert.Nil(t, result)
+		}
+	})
+
+	t.Run("should return error if a matcher is invalid", func(t *testing.T) {
+		f := newTestFixture()
+		input := &domain.Silence{Namespace: 1, EndsAt: time.Now().Add(time.Hour), CreatedBy: "foo@odpf.io", Comment: "maintenance",
+			Matchers: []domain.Matcher{{Name: "alertname", Operator: "==", Value: "CPUHigh"}}}
+
+		result, err := f.service.CreateSilence(input)
+		var invalidMatcherErr *domain.InvalidMatcherError
+		assert.True(t, errors.As(err, &invalidMatcherErr))
+		assert.Nil(t, result)
+	})
+
+	t.Run("should return error if creating silence fails", func(t *testing.T) {
+		f := newTestFixture()
+		input := &domain.Silence{Namespace: 1, Matchers: matchers, EndsAt: time.Now().Add(time.Hour),
+			CreatedBy: "foo@odpf.io", Comment: "maintenance"}
+		f.silenceManager.On("CreateSilence", mock.Anything, "odpf", mock.Anything).Return("", errors.New("random error")).Once()
+
+		result, err := f.service.CreateSilence(input)
+		assert.EqualError(t, err, "silenceManager.CreateSilence: random error")
+		assert.Nil(t, result)
+	})
+
+	t.Run("should return error if created silence is not found", func(t *testing.T) {
+		f := newTestFixture()
+		input := &domain.Silence{Namespace: 1, Matchers: matchers, EndsAt: time.Now().Add(time.Hour),
+			CreatedBy: "foo@odpf.io", Comment: "maintenance"}
+		f.silenceManager.On("CreateSilence", mock.Anything, "odpf", mock.Anything).Return("bar", nil).Once()
+		f.silenceManager.On("GetSilence", mock.Anything, "odpf", "bar").Return(nil, nil).Once()
+
+		result, err := f.service.CreateSilence(input)
+		assert.EqualError(t, err, "created silence bar not found")
+		assert.Nil(t, result)
+	})
+}
+
+func TestService_GetSilence(t *testing.T) {
+	t.Run("should get silence of the namespace tenant", func(t *testing.T) {
+		f := newTestFixture()
+		f.silenceManager.On("GetSilence", mock.Anything, "odpf", "foo").Return(&domain.Silence{Id: "foo"}, nil).Once()
+
+		result, err := f.service.GetSilence(1, "foo")
+		assert.Nil(t, err)
+		assert.Equal(t, &domain.Silence{Id: "foo", Namespace: 1}, result)
+	})
+
+	t.Run("should return nil if silence does not exist", func(t *testing.T) {
+		f := newTestFixture()
+		f.silenceManager.On("GetSilence", mock.Anything, "odpf", "foo").Return(nil, nil).Once()
+
+		result, err := f.service.GetSilence(1, "foo")
+		assert.Nil(t, err)
+		assert.Nil(t, result)
+	})
+
+	t.Run("should return error if getting silence fails", func(t *testing.T) {
+		f := newTestFixture()
+		f.silenceManager.On("GetSilence", mock.Anything, "odpf", "foo").Return(nil, errors.New("random error")).Once()
+
+		result, err := f.service.GetSilence(1, "foo")
+		assert.EqualError(t, err, "silenceManager.GetSilence: random error")
+		assert.Nil(t, result)
+	})
+}
+
+func TestService_ExpireSilence(t *testing.T) {
+	t.Run("should expire silence of the namespace tenant", func(t *testing.T) {
+		f := newTestFixture()
+		f.silenceManager.On("ExpireSilence", mock.Anything, "odpf", "foo").Return(nil).Once()
+
+		err := f.service.ExpireSilence(1, "foo")
+		assert.Nil(t, err)
+		f.silenceManager.AssertExpectations(t)
+	})
+
+	t.Run("should return error if expiring silence fails", func(t *testing.T) {
+		f := newTestFixture()
+		f.silenceManager.On("ExpireSilence", mock.Anything, "odpf", "foo").Return(errors.New("random error")).Once()
+
+		err := f.service.ExpireSilence(1, "foo")
+		assert.EqualError(t, err, "silenceManager.ExpireSilence: random error")
+	})
+}
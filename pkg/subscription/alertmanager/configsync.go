@@ -3,6 +3,8 @@ package alertmanager
 import (
 	"bytes"
 	"context"
+	"fmt"
+	"strings"
 	"text/template"
 
 	_ "embed"
@@ -19,15 +21,134 @@ var (
 	configYamlString string
 )
 
+// AMMatcher is a matcher of a route, with an operator of =, !=, =~ or !~
+type AMMatcher struct {
+	Name     string
+	Operator string
+	Value    string
+}
+
+var matcherValueEscaper = strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`)
+
+// String returns the matcher in the syntax of alertmanager matchers
+func (m AMMatcher) String() string {
+	return fmt.Sprintf(`%s%s"%s"`, m.Name, m.Operator, matcherValueEscaper.Replace(m.Value))
+}
+
+// AMRouting is the grouping and timings of a route, inherited from the
+// parent route when empty
+type AMRouting struct {
+	GroupBy        []string
+	GroupWait      string
+	GroupInterval  string
+	RepeatInterval string
+}
+
+var defaultRouting = AMRouting{
+	GroupBy:        []string{"alertname", "severity", "owner", "service_name", "time_stamp", "identifier"},
+	GroupWait:      "30s",
+	GroupInterval:  "5m",
+	RepeatInterval: "4h",
+}
+
+// withDefaults returns the routing with its empty fields taken from defaults
+func (r AMRouting) withDefaults(defaults AMRouting) AMRouting {
+	if len(r.GroupBy) == 0 {
+		r.GroupBy = defaults.GroupBy
+	}
+	if r.GroupWait == "" {
+		r.GroupWait = defaults.GroupWait
+	}
+	if r.GroupInterval == "" {
+		r.GroupInterval = defaults.GroupInterval
+	}
+	if r.RepeatInterval == "" {
+		r.RepeatInterval = defaults.RepeatInterval
+	}
+	return r
+}
+
 type AMReceiverConfig struct {
-	Receiver      string
-	Type          string
-	Match         map[string]string
-	Configuration map[string]string
+	Receiver          string
+	Type              string
+	Match             map[string]string
+	Matchers          []AMMatcher
+	Configuration     map[string]string
+	MuteTimeIntervals []string
+	AMRouting
+}
+
+// AMInhibitRule mutes the alerts matching the target matchers while an alert
+// matching the source matchers is firing, with the same values of the equal labels
+type AMInhibitRule struct {
+	SourceMatchers []AMMatcher
+	TargetMatchers []AMMatcher
+	Equal          []string
 }
 
+// AMTimeRange is a range of the time of day, as HH:MM, with end time excluded
+type AMTimeRange struct {
+	StartTime string
+	EndTime   string
+}
+
+// AMTimePeriod is a period of a time interval, matching all of the times,
+// weekdays, days of month, months and years given in UTC
+type AMTimePeriod struct {
+	Times       []AMTimeRange
+	Weekdays    []string
+	DaysOfMonth []string
+	Months      []string
+	Years       []string
+}
+
+// String returns the period as a YAML flow mapping in the syntax of
+// alertmanager time intervals
+func (p AMTimePeriod) String() string {
+	var fields []string
+	if len(p.Times) > 0 {
+		times := make([]string, 0, len(p.Times))
+		for _, timeRange := range p.Times {
+			times = append(times, fmt.Sprintf("{start_time: %q, end_time: %q}", timeRange.StartTime, timeRange.EndTime))
+		}
+		fields = append(fields, fmt.Sprintf("times: [%s]", strings.Join(times, ", ")))
+	}
+	ranges := []struct {
+		name   string
+		values []string
+	}{
+		{"weekdays", p.Weekdays},
+		{"days_of_month", p.DaysOfMonth},
+		{"months", p.Months},
+		{"years", p.Years},
+	}
+	for _, r := range ranges {
+		if len(r.values) == 0 {
+			continue
+		}
+		values := make([]string, 0, len(r.values))
+		for _, value := range r.values {
+			values = append(values, fmt.Sprintf("%q", value))
+		}
+		fields = append(fields, fmt.Sprintf("%s: [%s]", r.name, strings.Join(values, ", ")))
+	}
+	return "{" + strings.Join(fields, ", ") + "}"
+}
+
+// AMTimeInterval is a named time interval, which routes refer to as mute
+// time intervals
+type AMTimeInterval struct {
+	Name    string
+	Periods []AMTimePeriod
+}
+
+// AMConfig is the alertmanager configuration of a tenant, whose routing is
+// the default of the routes of its receivers
 type AMConfig struct {
-	Receivers []AMReceiverConfig
+	Receivers     []AMReceiverConfig
+	InhibitRules  []AMInhibitRule
+	TimeIntervals []AMTimeInterval
+	AMRouting
 }
 
 type Client interface {
@@ -75,6 +196,19 @@ func (am AlertmanagerClient) SyncConfig(config AMConfig, tenant string) error {
 }
 
 func generateAlertmanagerConfig(alertManagerConfig AMConfig) (string, error) {
+	alertManagerConfig.AMRouting = alertManagerConfig.AMRouting.withDefaults(defaultRouting)
+	configStr, err := renderAlertmanagerConfig(alertManagerConfig)
+	if err != nil {
+		return "", err
+	}
+	_, err = config.Load(configStr)
+	if err != nil {
+		return "", err
+	}
+	return configStr, nil
+}
+
+func renderAlertmanagerConfig(alertManagerConfig AMConfig) (string, error) {
 	delims := template.New("alertmanagerConfigTemplate").Delims("[[", "]]")
 	parse, err := delims.Parse(configYamlString)
 	if err != nil {
@@ -85,10 +219,5 @@ func generateAlertmanagerConfig(alertManagerConfig AMConfig) (string, error) {
 	if err != nil {
 		return "", err
 	}
-	configStr := tpl.String()
-	_, err = config.Load(configStr)
-	if err != nil {
-		return "", err
-	}
-	return configStr, nil
+	return tpl.String(), nil
 }
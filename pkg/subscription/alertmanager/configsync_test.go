@@ -111,6 +111,250 @@ func TestGenerateAlertmanagerConfig(t *testing.T) {
 	assert.Equal(t, strings.Fields(expectedConfigStr), strings.Fields(configStr))
 }
 
+func TestGenerateAlertmanagerConfigWithMatchers(t *testing.T) {
+	config := AMConfig{
+		Receivers: []AMReceiverConfig{{
+			Receiver: "config1",
+			Type:     "http",
+			Match:    map[string]string{"foo": "bar"},
+			Matchers: []AMMatcher{
+				{Name: "service", Operator: "=~", Value: "payments-.*"},
+				{Name: "severity", Operator: "!=", Value: "INFO"},
+				{Name: "summary", Operator: "!~", Value: `.*"test\\.*`},
+			},
+			Configuration: map[string]string{
+				"url": "http://localhost:3000",
+			},
+		}},
+	}
+
+	configStr, err := generateAlertmanagerConfig(config)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Contains(t, configStr, `
+      - receiver: http_config1
+        match:
+          foo: bar
+        matchers:
+          - "service=~\"payments-.*\""
+          - "severity!=\"INFO\""
+          - "summary!~\".*\\\"test\\\\\\\\.*\""
+        continue: true`)
+}
+
+func TestGenerateAlertmanagerConfigWithRouting(t *testing.T) {
+	config := AMConfig{
+		Receivers: []AMReceiverConfig{
+			{
+				Receiver:      "config1",
+				Type:          "http",
+				Configuration: map[string]string{"url": "http://localhost:3000"},
+				AMRouting:     AMRouting{RepeatInterval: "1h"},
+			},
+			{
+				Receiver:      "config2",
+				Type:          "http",
+				Configuration: map[string]string{"url": "http://localhost:3000"},
+				AMRouting:     AMRouting{GroupBy: []string{"service"}, GroupWait: "1m", GroupInterval: "10m"},
+			},
+		},
+		AMRouting: AMRouting{GroupBy: []string{"alertname"}, RepeatInterval: "2h"},
+	}
+
+	configStr, err := generateAlertmanagerConfig(config)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expectedRouteStr := `
+  route:
+    group_by:
+      - alertname
+    group_wait: 30s
+    group_interval: 5m
+    repeat_interval: 2h
+    receiver: default
+    routes:
+      - receiver: http_config1
+        repeat_interval: 1h
+        continue: true
+      - receiver: http_config2
+        group_by:
+          - service
+        group_wait: 1m
+        group_interval: 10m
+        continue: true`
+	assert.Equal(t, strings.Fields(expectedRouteStr), strings.Fields(configStr[strings.Index(configStr, "route:"):]))
+}
+
+func TestGenerateAlertmanagerConfigWithInhibitRules(t *testing.T) {
+	config := AMConfig{
+		Receivers: []AMReceiverConfig{
+			{
+				Receiver:      "config1",
+				Type:          "http",
+				Configuration: map[string]string{"url": "http://localhost:3000"},
+			},
+		},
+		InhibitRules: []AMInhibitRule{
+			{
+				SourceMatchers: []AMMatcher{{Name: "alertname", Operator: "=", Value: "ClusterDown"}},
+				TargetMatchers: []AMMatcher{{Name: "alertname", Operator: "=~", Value: "Pod.*"}},
+				Equal:          []string{"cluster"},
+			},
+			{
+				SourceMatchers: []AMMatcher{{Name: "severity", Operator: "=", Value: "CRITICAL"}},
+				TargetMatchers: []AMMatcher{{Name: "severity", Operator: "!=", Value: "CRITICAL"}},
+			},
+		},
+	}
+
+	configStr, err := generateAlertmanagerConfig(config)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expectedInhibitRulesStr := `
+  inhibit_rules:
+    - source_matchers:
+        - "alertname=\"ClusterDown\""
+      target_matchers:
+        - "alertname=~\"Pod.*\""
+      equal:
+        - cluster
+    - source_matchers:
+        - "severity=\"CRITICAL\""
+      target_matchers:
+        - "severity!=\"CRITICAL\""`
+	assert.Equal(t, strings.Fields(expectedInhibitRulesStr), strings.Fields(configStr[strings.Index(configStr, "inhibit_rules:"):]))
+}
+
+func TestGenerateAlertmanagerConfigWithTimeIntervals(t *testing.T) {
+	config := AMConfig{
+		Receivers: []AMReceiverConfig{
+			{
+				Receiver:          "config1",
+				Type:              "http",
+				Configuration:     map[string]string{"url": "http://localhost:3000"},
+				MuteTimeIntervals: []string{"outside-business-hours"},
+			},
+			{
+				Receiver:          "config2",
+				Type:              "http",
+				Configuration:     map[string]string{"url": "http://localhost:3000"},
+				MuteTimeIntervals: []string{"maintenance", "weekends"},
+			},
+		},
+		TimeIntervals: []AMTimeInterval{
+			{
+				Name: "outside-business-hours",
+				Periods: []AMTimePeriod{
+					{Times: []AMTimeRange{{StartTime: "00:00", EndTime: "09:00"}, {StartTime: "17:00", EndTime: "24:00"}}},
+					{Weekdays: []string{"saturday", "sunday"}},
+				},
+			},
+			{
+				Name: "maintenance",
+				Periods: []AMTimePeriod{
+					{DaysOfMonth: []string{"1", "-1"}, Months: []string{"march"}, Years: []string{"2022"}},
+					{Times: []AMTimeRange{{StartTime: "22:00", EndTime: "24:00"}}, DaysOfMonth: []string{"15"}},
+				},
+			},
+			{
+				Name:    "weekends",
+				Periods: []AMTimePeriod{{Weekdays: []string{"saturday", "sunday"}}},
+			},
+		},
+	}
+
+	configStr, err := generateAlertmanagerConfig(config)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expectedRoutesStr := `
+      - receiver: http_config1
+        mute_time_intervals:
+          - "outside-business-hours"
+        continue: true
+      - receiver: http_config2
+        mute_time_intervals:
+          - "maintenance"
+          - "weekends"
+        continue: true`
+	assert.Contains(t, configStr, expectedRoutesStr)
+	expectedTimeIntervalsStr := `
+  mute_time_intervals:
+    - name: "outside-business-hours"
+      time_intervals:
+        - {times: [{start_time: "00:00", end_time: "09:00"}, {start_time: "17:00", end_time: "24:00"}]}
+        - {weekdays: ["saturday", "sunday"]}
+    - name: "maintenance"
+      time_intervals:
+        - {days_of_month: ["1", "-1"], months: ["march"], years: ["2022"]}
+        - {times: [{start_time: "22:00", end_time: "24:00"}], days_of_month: ["15"]}
+    - name: "weekends"
+      time_intervals:
+        - {weekdays: ["saturday", "sunday"]}`
+	assert.Equal(t, strings.Fields(expectedTimeIntervalsStr), strings.Fields(configStr[strings.Index(configStr, "\n  mute_time_intervals:"):]))
+
+	var parsedConfig struct {
+		Route struct {
+			Routes []struct {
+				MuteTimeIntervals []string `yaml:"mute_time_intervals"`
+			} `yaml:"routes"`
+		} `yaml:"route"`
+		TimeIntervals []struct {
+			Name          string                   `yaml:"name"`
+			TimeIntervals []map[string]interface{} `yaml:"time_intervals"`
+		} `yaml:"mute_time_intervals"`
+	}
+	err = yaml.Unmarshal([]byte(configStr), &parsedConfig)
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"maintenance", "weekends"}, parsedConfig.Route.Routes[1].MuteTimeIntervals)
+	assert.Equal(t, 3, len(parsedConfig.TimeIntervals))
+	assert.Equal(t, []interface{}{"saturday", "sunday"}, parsedConfig.TimeIntervals[2].TimeIntervals[0]["weekdays"])
+}
+
+func TestGenerateAlertmanagerConfigWithUndefinedTimeInterval(t *testing.T) {
+	config := AMConfig{
+		Receivers: []AMReceiverConfig{{
+			Receiver:          "config1",
+			Type:              "http",
+			Configuration:     map[string]string{"url": "http://localhost:3000"},
+			MuteTimeIntervals: []string{"maintenance"},
+		}},
+		TimeIntervals: []AMTimeInterval{{Name: "weekends", Periods: []AMTimePeriod{{Weekdays: []string{"saturday", "sunday"}}}}},
+	}
+
+	_, err := generateAlertmanagerConfig(config)
+	assert.EqualError(t, err, `undefined time interval "maintenance" used in route`)
+}
+
+func TestGenerateAlertmanagerConfigWithDuplicateTimeInterval(t *testing.T) {
+	config := AMConfig{
+		TimeIntervals: []AMTimeInterval{
+			{Name: "weekends", Periods: []AMTimePeriod{{Weekdays: []string{"saturday"}}}},
+			{Name: "weekends", Periods: []AMTimePeriod{{Weekdays: []string{"sunday"}}}},
+		},
+	}
+
+	_, err := generateAlertmanagerConfig(config)
+	assert.EqualError(t, err, `mute time interval "weekends" is not unique`)
+}
+
+func TestAMTimePeriodString(t *testing.T) {
+	assert.Equal(t, "{}", AMTimePeriod{}.String())
+	assert.Equal(t, `{times: [{start_time: "00:00", end_time: "06:00"}, {start_time: "18:00", end_time: "24:00"}], years: ["2022:2023"]}`,
+		AMTimePeriod{
+			Times: []AMTimeRange{{StartTime: "00:00", EndTime: "06:00"}, {StartTime: "18:00", EndTime: "24:00"}},
+			Years: []string{"2022:2023"},
+		}.String())
+}
+
+func TestAMMatcherString(t *testing.T) {
+	assert.Equal(t, `service=~"payments-.*"`, AMMatcher{Name: "service", Operator: "=~", Value: "payments-.*"}.String())
+	assert.Equal(t, `summary="say \"hi\"\\n\n"`, AMMatcher{Name: "summary", Operator: "=", Value: "say \"hi\"\\n\n"}.String())
+}
+
 type ConfigCompat struct {
 	TemplateFiles      map[string]string `yaml:"template_files"`
 	AlertmanagerConfig string            `yaml:"alertmanager_config"`
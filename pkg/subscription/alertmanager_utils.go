@@ -59,23 +59,48 @@ func (r Repository) addReceiversConfiguration(subscriptions []Subscription, rece
 			enrichedReceivers = append(enrichedReceivers, enrichedReceiver)
 		}
 		enrichedSubscription := SubscriptionEnrichedWithReceivers{
-			Id:          item.Id,
-			NamespaceId: item.NamespaceId,
-			Urn:         item.Urn,
-			Receiver:    enrichedReceivers,
-			Match:       item.Match,
+			Id:             item.Id,
+			NamespaceId:    item.NamespaceId,
+			Urn:            item.Urn,
+			Receiver:       enrichedReceivers,
+			Match:          item.Match,
+			Matchers:       item.Matchers,
+			GroupBy:        item.GroupBy,
+			GroupWait:      item.GroupWait,
+			GroupInterval:  item.GroupInterval,
+			RepeatInterval: item.RepeatInterval,
+
+			MuteTimeIntervals: item.MuteTimeIntervals,
 		}
 		res = append(res, enrichedSubscription)
 	}
 	return res, nil
 }
 
-func getAMReceiverConfigPerSubscription(subscription SubscriptionEnrichedWithReceivers) []alertmanager.AMReceiverConfig {
+// getAMReceiverConfigPerSubscription returns the routes of the receivers of a
+// subscription, muted in its mute time intervals and the ones muting the namespace
+func getAMReceiverConfigPerSubscription(subscription SubscriptionEnrichedWithReceivers,
+	namespaceMuteTimeIntervals []string) []alertmanager.AMReceiverConfig {
 	amReceiverConfig := make([]alertmanager.AMReceiverConfig, 0)
+	matchers := getAMMatchers(subscription.Matchers)
+	muteTimeIntervals := append([]string{}, subscription.MuteTimeIntervals...)
+	for _, name := range namespaceMuteTimeIntervals {
+		if !containsString(muteTimeIntervals, name) {
+			muteTimeIntervals = append(muteTimeIntervals, name)
+		}
+	}
 	for idx, item := range subscription.Receiver {
 		newAMReceiver := alertmanager.AMReceiverConfig{
-			Receiver:      fmt.Sprintf("%s_receiverId_%d_idx_%d", subscription.Urn, item.Id, idx),
-			Match:         subscription.Match,
+			Receiver:          fmt.Sprintf("%s_receiverId_%d_idx_%d", subscription.Urn, item.Id, idx),
+			Match:             subscription.Match,
+			Matchers:          matchers,
+			MuteTimeIntervals: muteTimeIntervals,
+			AMRouting: alertmanager.AMRouting{
+				GroupBy:        subscription.GroupBy,
+				GroupWait:      subscription.GroupWait,
+				GroupInterval:  subscription.GroupInterval,
+				RepeatInterval: subscription.RepeatInterval,
+			},
 			Configuration: item.Configuration,
 			Type:          item.Type,
 		}
@@ -84,12 +109,92 @@ func getAMReceiverConfigPerSubscription(subscription SubscriptionEnrichedWithRec
 	return amReceiverConfig
 }
 
-func getAmConfigFromSubscriptions(subscriptions []SubscriptionEnrichedWithReceivers) alertmanager.AMConfig {
+func getAMMatchers(matchers MatcherList) []alertmanager.AMMatcher {
+	amMatchers := make([]alertmanager.AMMatcher, 0, len(matchers))
+	for _, item := range matchers {
+		amMatchers = append(amMatchers, alertmanager.AMMatcher(item))
+	}
+	return amMatchers
+}
+
+func getAMInhibitRules(inhibitionRules []InhibitionRule) []alertmanager.AMInhibitRule {
+	var amInhibitRules []alertmanager.AMInhibitRule
+	for _, item := range inhibitionRules {
+		amInhibitRules = append(amInhibitRules, alertmanager.AMInhibitRule{
+			SourceMatchers: getAMMatchers(item.SourceMatchers),
+			TargetMatchers: getAMMatchers(item.TargetMatchers),
+			Equal:          item.Equal,
+		})
+	}
+	return amInhibitRules
+}
+
+func getAMTimeIntervals(timeIntervals []TimeInterval) []alertmanager.AMTimeInterval {
+	var amTimeIntervals []alertmanager.AMTimeInterval
+	for _, item := range timeIntervals {
+		periods := make([]alertmanager.AMTimePeriod, 0, len(item.Periods))
+		for _, period := range item.Periods {
+			times := make([]alertmanager.AMTimeRange, 0, len(period.Times))
+			for _, timeRange := range period.Times {
+				times = append(times, alertmanager.AMTimeRange(timeRange))
+			}
+			periods = append(periods, alertmanager.AMTimePeriod{
+				Times:       times,
+				Weekdays:    period.Weekdays,
+				DaysOfMonth: period.DaysOfMonth,
+				Months:      period.Months,
+				Years:       period.Years,
+			})
+		}
+		amTimeIntervals = append(amTimeIntervals, alertmanager.AMTimeInterval{Name: item.Urn, Periods: periods})
+	}
+	return amTimeIntervals
+}
+
+// checkTimeIntervalReferences checks the subscriptions of a namespace only
+// refer to the time intervals of the namespace
+func checkTimeIntervalReferences(subscriptions []Subscription, timeIntervals []TimeInterval) error {
+	urns := make([]string, 0, len(timeIntervals))
+	for _, item := range timeIntervals {
+		urns = append(urns, item.Urn)
+	}
+	for _, subscription := range subscriptions {
+		for _, urn := range subscription.MuteTimeIntervals {
+			if !containsString(urns, urn) {
+				return &domain.InvalidTimeIntervalError{Reason: fmt.Sprintf(
+					"subscription %s refers to time interval %s missing from namespace %d",
+					subscription.Urn, urn, subscription.NamespaceId)}
+			}
+		}
+	}
+	return nil
+}
+
+func containsString(values []string, value string) bool {
+	for _, item := range values {
+		if item == value {
+			return true
+		}
+	}
+	return false
+}
+
+func getAmConfigFromSubscriptions(subscriptions []SubscriptionEnrichedWithReceivers, routing domain.Routing,
+	inhibitionRules []InhibitionRule, timeIntervals []TimeInterval) alertmanager.AMConfig {
+	var namespaceMuteTimeIntervals []string
+	for _, item := range timeIntervals {
+		if item.MuteNamespace {
+			namespaceMuteTimeIntervals = append(namespaceMuteTimeIntervals, item.Urn)
+		}
+	}
 	amConfig := make([]alertmanager.AMReceiverConfig, 0)
 	for _, item := range subscriptions {
-		amConfig = append(amConfig, getAMReceiverConfigPerSubscription(item)...)
+		amConfig = append(amConfig, getAMReceiverConfigPerSubscription(item, namespaceMuteTimeIntervals)...)
 	}
 	return alertmanager.AMConfig{
-		Receivers: amConfig,
+		Receivers:     amConfig,
+		InhibitRules:  getAMInhibitRules(inhibitionRules),
+		TimeIntervals: getAMTimeIntervals(timeIntervals),
+		AMRouting:     alertmanager.AMRouting(routing),
 	}
 }
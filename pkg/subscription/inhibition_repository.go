@@ -0,0 +1,69 @@
+package subscription
+
+import (
+	"github.com/odpf/siren/domain"
+	"github.com/odpf/siren/pkg/adapter"
+	"gorm.io/gorm"
+)
+
+// InhibitionRepository talks to the store to read or insert inhibition rules,
+// syncing the alertmanager config of their namespace along with its subscriptions
+type InhibitionRepository struct {
+	namespacedRepository
+}
+
+// NewInhibitionRepository returns repository struct
+func NewInhibitionRepository(db *gorm.DB, adapters *adapter.Registry) *InhibitionRepository {
+	return &InhibitionRepository{namespacedRepository{
+		db:            db,
+		subscriptions: *NewRepository(db, adapters),
+		name:          "inhibition rule",
+		columns:       []string{"namespace_id", "urn", "source_matchers", "target_matchers", "equal"},
+		newResource:   func() namespacedResource { return &InhibitionRule{} },
+	}}
+}
+
+func (r InhibitionRepository) List(namespaceId uint64) ([]*InhibitionRule, error) {
+	var inhibitionRules []*InhibitionRule
+	if err := r.list(namespaceId, &inhibitionRules); err != nil {
+		return nil, err
+	}
+	return inhibitionRules, nil
+}
+
+func (r InhibitionRepository) Create(rule *InhibitionRule, namespaceService domain.NamespaceService,
+	providerService domain.ProviderService, receiverService domain.ReceiverService) (*InhibitionRule, error) {
+	newInhibitionRule, err := r.create(rule, namespaceService, providerService, receiverService)
+	if err != nil {
+		return nil, err
+	}
+	return newInhibitionRule.(*InhibitionRule), nil
+}
+
+func (r InhibitionRepository) Get(id uint64) (*InhibitionRule, error) {
+	inhibitionRule, err := r.get(id)
+	if err != nil || inhibitionRule == nil {
+		return nil, err
+	}
+	return inhibitionRule.(*InhibitionRule), nil
+}
+
+// Update replaces the urn, namespace, matchers and equal labels of an
+// inhibition rule, syncing the namespace it is moved out of as well
+func (r InhibitionRepository) Update(rule *InhibitionRule, namespaceService domain.NamespaceService,
+	providerService domain.ProviderService, receiverService domain.ReceiverService) (*InhibitionRule, error) {
+	newInhibitionRule, err := r.update(rule, namespaceService, providerService, receiverService)
+	if err != nil {
+		return nil, err
+	}
+	return newInhibitionRule.(*InhibitionRule), nil
+}
+
+func (r InhibitionRepository) Delete(id uint64, namespaceService domain.NamespaceService,
+	providerService domain.ProviderService, receiverService domain.ReceiverService) error {
+	return r.delete(id, namespaceService, providerService, receiverService)
+}
+
+func (r InhibitionRepository) Migrate() error {
+	return r.migrate()
+}
@@ -0,0 +1,375 @@
+package subscription
+
+import (
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/odpf/siren/domain"
+	"github.com/odpf/siren/mocks"
+	"github.com/odpf/siren/pkg/adapter"
+	"github.com/odpf/siren/pkg/subscription/alertmanager"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+	"regexp"
+	"testing"
+	"time"
+)
+
+type InhibitionRepositoryTestSuite struct {
+	suite.Suite
+	sqldb      *sql.DB
+	dbmock     sqlmock.Sqlmock
+	repository InhibitionRuleRepository
+	adapters   *adapter.Registry
+}
+
+func (s *InhibitionRepositoryTestSuite) SetupTest() {
+	db, mock, _ := mocks.NewStore()
+	s.sqldb, _ = db.DB()
+	s.dbmock = mock
+	s.adapters = adapter.NewRegistry()
+	s.repository = NewInhibitionRepository(db, s.adapters)
+}
+
+func (s *InhibitionRepositoryTestSuite) TearDownTest() {
+	s.sqldb.Close()
+}
+
+var inhibitionRuleColumns = []string{"id", "namespace_id", "urn", "source_matchers", "target_matchers", "equal",
+	"created_at", "updated_at"}
+
+func (s *InhibitionRepositoryTestSuite) TestCreate() {
+	timenow := time.Now()
+	inhibitionRule := &InhibitionRule{
+		Id:             1,
+		NamespaceId:    1,
+		Urn:            "cluster-down",
+		SourceMatchers: MatcherList{{Name: "alertname", Operator: "=", Value: "ClusterDown"}},
+		TargetMatchers: MatcherList{{Name: "alertname", Operator: "=~", Value: "Pod.*"}},
+		Equal:          StringList{"cluster"},
+		CreatedAt:      timenow,
+		UpdatedAt:      timenow,
+	}
+	dummyNamespace := &domain.Namespace{Id: 1, Provider: 1, Urn: "dummy"}
+	dummyProvider := &domain.Provider{Id: 1, Urn: "test", Type: "cortex", Host: "http://localhost:8080"}
+
+	insertQuery := regexp.QuoteMeta(`INSERT INTO "inhibition_rules" ("namespace_id","urn","source_matchers","target_matchers","equal","created_at","updated_at","id") VALUES ($1,$2,$3,$4,$5,$6,$7,$8) RETURNING "id"`)
+	fetchLastInsertedQuery := regexp.QuoteMeta(`SELECT * FROM "inhibition_rules" WHERE id = 1`)
+	fetchSubscriptionsWithinNamespaceQuery := regexp.QuoteMeta(`SELECT * FROM "subscriptions" WHERE namespace_id = 1`)
+	fetchInhibitionRulesWithinNamespaceQuery := regexp.QuoteMeta(`SELECT * FROM "inhibition_rules" WHERE namespace_id = 1`)
+	fetchTimeIntervalsWithinNamespaceQuery := regexp.QuoteMeta(`SELECT * FROM "time_intervals" WHERE namespace_id = 1`)
+
+	s.Run("should create an inhibition rule and sync its namespace", func() {
+		providerMock := &mocks.ProviderService{}
+		namespaceMock := &mocks.NamespaceService{}
+		receiverMock := &mocks.ReceiverService{}
+		amClientMock := &adapter.AdapterMock{}
+		providerMock.On("GetProvider", uint64(1)).Return(dummyProvider, nil).Once()
+		namespaceMock.On("GetNamespace", uint64(1)).Return(dummyNamespace, nil).Once()
+		receiverMock.On("ListReceivers").Return([]*domain.Receiver{}, nil).Once()
+		amClientMock.On("SyncAlertmanagerConfig", mock.Anything, "dummy", mock.AnythingOfType("alertmanager.AMConfig")).Run(func(args mock.Arguments) {
+			r := args.Get(2).(alertmanager.AMConfig)
+			s.Equal([]alertmanager.AMInhibitRule{{
+				SourceMatchers: []alertmanager.AMMatcher{{Name: "alertname", Operator: "=", Value: "ClusterDown"}},
+				TargetMatchers: []alertmanager.AMMatcher{{Name: "alertname", Operator: "=~", Value: "Pod.*"}},
+				Equal:          []string{"cluster"},
+			}}, r.InhibitRules)
+		}).Return(nil).Once()
+		s.adapters.Register("cortex", func(string, string) (adapter.Adapter, error) {
+			return amClientMock, nil
+		})
+
+		expectedRows := func() *sqlmock.Rows {
+			return sqlmock.NewRows(inhibitionRuleColumns).
+				AddRow(inhibitionRule.Id, inhibitionRule.NamespaceId, inhibitionRule.Urn,
+					json.RawMessage(`[{"name":"alertname","operator":"=","value":"ClusterDown"}]`),
+					json.RawMessage(`[{"name":"alertname","operator":"=~","value":"Pod.*"}]`),
+					json.RawMessage(`["cluster"]`), inhibitionRule.CreatedAt, inhibitionRule.UpdatedAt)
+		}
+		s.dbmock.ExpectBegin()
+		s.dbmock.ExpectQuery(insertQuery).WithArgs(inhibitionRule.NamespaceId, inhibitionRule.Urn,
+			inhibitionRule.SourceMatchers, inhibitionRule.TargetMatchers, inhibitionRule.Equal,
+			inhibitionRule.CreatedAt, inhibitionRule.UpdatedAt, inhibitionRule.Id).WillReturnRows(sqlmock.NewRows(nil))
+		s.dbmock.ExpectQuery(fetchLastInsertedQuery).WillReturnRows(expectedRows())
+		s.dbmock.ExpectQuery(fetchSubscriptionsWithinNamespaceQuery).WillReturnRows(sqlmock.NewRows(nil))
+		s.dbmock.ExpectQuery(fetchInhibitionRulesWithinNamespaceQuery).WillReturnRows(expectedRows())
+		s.dbmock.ExpectQuery(fetchTimeIntervalsWithinNamespaceQuery).WillReturnRows(sqlmock.NewRows(nil))
+		s.dbmock.ExpectCommit()
+
+		actualInhibitionRule, err := s.repository.Create(inhibitionRule, namespaceMock, providerMock, receiverMock)
+		s.Equal(inhibitionRule, actualInhibitionRule)
+		s.Nil(err)
+		amClientMock.AssertExpectations(s.T())
+	})
+
+	s.Run("should return error in creating inhibition rule", func() {
+		s.dbmock.ExpectBegin()
+		s.dbmock.ExpectQuery(insertQuery).WillReturnError(errors.New("random error"))
+		s.dbmock.ExpectRollback()
+
+		actualInhibitionRule, err := s.repository.Create(inhibitionRule, &mocks.NamespaceService{},
+			&mocks.ProviderService{}, &mocks.ReceiverService{})
+		s.EqualError(err, "failed to insert inhibition rule: random error")
+		s.Nil(actualInhibitionRule)
+	})
+
+	s.Run("should return error in fetching newly inserted inhibition rule", func() {
+		s.dbmock.ExpectBegin()
+		s.dbmock.ExpectQuery(insertQuery).WillReturnRows(sqlmock.NewRows(nil))
+		s.dbmock.ExpectQuery(fetchLastInsertedQuery).WillReturnError(errors.New("random error"))
+		s.dbmock.ExpectRollback()
+
+		actualInhibitionRule, err := s.repository.Create(inhibitionRule, &mocks.NamespaceService{},
+			&mocks.ProviderService{}, &mocks.ReceiverService{})
+		s.EqualError(err, "failed to get newly inserted inhibition rule: random error")
+		s.Nil(actualInhibitionRule)
+	})
+
+	s.Run("should return error in syncing the namespace", func() {
+		namespaceMock := &mocks.NamespaceService{}
+		namespaceMock.On("GetNamespace", uint64(1)).Return(nil, errors.New("random error")).Once()
+
+		s.dbmock.ExpectBegin()
+		s.dbmock.ExpectQuery(insertQuery).WillReturnRows(sqlmock.NewRows(nil))
+		s.dbmock.ExpectQuery(fetchLastInsertedQuery).WillReturnRows(sqlmock.NewRows(inhibitionRuleColumns).
+			AddRow(1, 1, "cluster-down", json.RawMessage(`[]`), json.RawMessage(`[]`), json.RawMessage(`[]`), timenow, timenow))
+		s.dbmock.ExpectQuery(fetchSubscriptionsWithinNamespaceQuery).WillReturnRows(sqlmock.NewRows(nil))
+		s.dbmock.ExpectQuery(fetchInhibitionRulesWithinNamespaceQuery).WillReturnRows(sqlmock.NewRows(nil))
+		s.dbmock.ExpectQuery(fetchTimeIntervalsWithinNamespaceQuery).WillReturnRows(sqlmock.NewRows(nil))
+		s.dbmock.ExpectRollback()
+
+		actualInhibitionRule, err := s.repository.Create(inhibitionRule, namespaceMock,
+			&mocks.ProviderService{}, &mocks.ReceiverService{})
+		s.EqualError(err, "r.getProviderAndNamespaceInfoFromNamespaceId: failed to get namespace details: random error")
+		s.Nil(actualInhibitionRule)
+	})
+}
+
+func (s *InhibitionRepositoryTestSuite) TestGet() {
+	selectQuery := regexp.QuoteMeta(`SELECT * FROM "inhibition_rules" WHERE id = 1`)
+
+	s.Run("should get inhibition rule by id", func() {
+		s.dbmock.ExpectQuery(selectQuery).WillReturnRows(sqlmock.NewRows(inhibitionRuleColumns).
+			AddRow(1, 1, "cluster-down", json.RawMessage(`[{"name":"alertname","operator":"=","value":"ClusterDown"}]`),
+				json.RawMessage(`[]`), json.RawMessage(`[]`), time.Now(), time.Now()))
+		actualInhibitionRule, err := s.repository.Get(1)
+		s.Equal(uint64(1), actualInhibitionRule.Id)
+		s.Equal("cluster-down", actualInhibitionRule.Urn)
+		s.Equal(MatcherList{{Name: "alertname", Operator: "=", Value: "ClusterDown"}}, actualInhibitionRule.SourceMatchers)
+		s.Nil(err)
+	})
+
+	s.Run("should return error in finding inhibition rule", func() {
+		s.dbmock.ExpectQuery(selectQuery).WillReturnError(errors.New("random error"))
+		actualInhibitionRule, err := s.repository.Get(1)
+		s.EqualError(err, "random error")
+		s.Nil(actualInhibitionRule)
+	})
+
+	s.Run("should return nil if inhibition rule not found", func() {
+		s.dbmock.ExpectQuery(selectQuery).WillReturnRows(sqlmock.NewRows(inhibitionRuleColumns))
+		actualInhibitionRule, err := s.repository.Get(1)
+		s.Nil(actualInhibitionRule)
+		s.Nil(err)
+	})
+}
+
+func (s *InhibitionRepositoryTestSuite) TestList() {
+	s.Run("should get all inhibition rules", func() {
+		selectQuery := regexp.QuoteMeta(`SELECT * FROM "inhibition_rules"`)
+		s.dbmock.ExpectQuery(selectQuery).WillReturnRows(sqlmock.NewRows(inhibitionRuleColumns).
+			AddRow(1, 1, "cluster-down", json.RawMessage(`[]`), json.RawMessage(`[]`), json.RawMessage(`[]`), time.Now(), time.Now()).
+			AddRow(2, 2, "cluster-down", json.RawMessage(`[]`), json.RawMessage(`[]`), json.RawMessage(`[]`), time.Now(), time.Now()))
+		actualInhibitionRules, err := s.repository.List(0)
+		s.Equal(2, len(actualInhibitionRules))
+		s.Nil(err)
+	})
+
+	s.Run("should get inhibition rules of a namespace", func() {
+		selectQuery := regexp.QuoteMeta(`SELECT * FROM "inhibition_rules" WHERE namespace_id = 2`)
+		s.dbmock.ExpectQuery(selectQuery).WillReturnRows(sqlmock.NewRows(inhibitionRuleColumns).
+			AddRow(2, 2, "cluster-down", json.RawMessage(`[]`), json.RawMessage(`[]`), json.RawMessage(`[]`), time.Now(), time.Now()))
+		actualInhibitionRules, err := s.repository.List(2)
+		s.Equal(1, len(actualInhibitionRules))
+		s.Equal(uint64(2), actualInhibitionRules[0].NamespaceId)
+		s.Nil(err)
+	})
+
+	s.Run("should return error in fetching inhibition rules", func() {
+		selectQuery := regexp.QuoteMeta(`SELECT * FROM "inhibition_rules"`)
+		s.dbmock.ExpectQuery(selectQuery).WillReturnError(errors.New("random error"))
+		actualInhibitionRules, err := s.repository.List(0)
+		s.EqualError(err, "random error")
+		s.Nil(actualInhibitionRules)
+	})
+}
+
+func (s *InhibitionRepositoryTestSuite) TestUpdate() {
+	timenow := time.Now()
+	input := &InhibitionRule{
+		Id:             1,
+		NamespaceId:    2,
+		Urn:            "cluster-down",
+		SourceMatchers: MatcherList{{Name: "alertname", Operator: "=", Value: "ClusterDown"}},
+		TargetMatchers: MatcherList{{Name: "alertname", Operator: "=~", Value: "Pod.*"}},
+	}
+	dummyProvider := &domain.Provider{Id: 1, Urn: "test", Type: "cortex", Host: "http://localhost:8080"}
+
+	selectQuery := regexp.QuoteMeta(`SELECT * FROM "inhibition_rules" WHERE id = 1`)
+	updateQuery := regexp.QuoteMeta(`UPDATE "inhibition_rules" SET "namespace_id"=$1,"urn"=$2,"source_matchers"=$3,"target_matchers"=$4,"equal"=$5,"updated_at"=$6 WHERE id = $7 AND "id" = $8`)
+	fetchSubscriptionsWithinNamespaceQuery := func(namespaceId int) string {
+		return regexp.QuoteMeta(fmt.Sprintf(`SELECT * FROM "subscriptions" WHERE namespace_id = %d`, namespaceId))
+	}
+	fetchInhibitionRulesWithinNamespaceQuery := func(namespaceId int) string {
+		return regexp.QuoteMeta(fmt.Sprintf(`SELECT * FROM "inhibition_rules" WHERE namespace_id = %d`, namespaceId))
+	}
+	fetchTimeIntervalsWithinNamespaceQuery := func(namespaceId int) string {
+		return regexp.QuoteMeta(fmt.Sprintf(`SELECT * FROM "time_intervals" WHERE namespace_id = %d`, namespaceId))
+	}
+	rowsInNamespace := func(namespaceId uint64) *sqlmock.Rows {
+		return sqlmock.NewRows(inhibitionRuleColumns).
+			AddRow(1, namespaceId, "cluster-down", json.RawMessage(`[{"name":"alertname","operator":"=","value":"ClusterDown"}]`),
+				json.RawMessage(`[{"name":"alertname","operator":"=~","value":"Pod.*"}]`), json.RawMessage(`[]`), timenow, timenow)
+	}
+
+	s.Run("should update an inhibition rule and sync the old and new namespaces", func() {
+		providerMock := &mocks.ProviderService{}
+		namespaceMock := &mocks.NamespaceService{}
+		receiverMock := &mocks.ReceiverService{}
+		amClientMock := &adapter.AdapterMock{}
+		providerMock.On("GetProvider", uint64(1)).Return(dummyProvider, nil).Twice()
+		namespaceMock.On("GetNamespace", uint64(1)).Return(&domain.Namespace{Id: 1, Provider: 1, Urn: "old"}, nil).Once()
+		namespaceMock.On("GetNamespace", uint64(2)).Return(&domain.Namespace{Id: 2, Provider: 1, Urn: "new"}, nil).Once()
+		receiverMock.On("ListReceivers").Return([]*domain.Receiver{}, nil).Twice()
+		amClientMock.On("SyncAlertmanagerConfig", mock.Anything, "old", mock.AnythingOfType("alertmanager.AMConfig")).Run(func(args mock.Arguments) {
+			s.Empty(args.Get(2).(alertmanager.AMConfig).InhibitRules)
+		}).Return(nil).Once()
+		amClientMock.On("SyncAlertmanagerConfig", mock.Anything, "new", mock.AnythingOfType("alertmanager.AMConfig")).Run(func(args mock.Arguments) {
+			s.Equal(1, len(args.Get(2).(alertmanager.AMConfig).InhibitRules))
+		}).Return(nil).Once()
+		s.adapters.Register("cortex", func(string, string) (adapter.Adapter, error) {
+			return amClientMock, nil
+		})
+
+		s.dbmock.ExpectBegin()
+		s.dbmock.ExpectQuery(selectQuery).WillReturnRows(rowsInNamespace(1))
+		s.dbmock.ExpectExec(updateQuery).WithArgs(input.NamespaceId, input.Urn, input.SourceMatchers, input.TargetMatchers,
+			input.Equal, AnyTime{}, input.Id, input.Id).WillReturnResult(sqlmock.NewResult(1, 1))
+		s.dbmock.ExpectQuery(selectQuery).WillReturnRows(rowsInNamespace(2))
+		s.dbmock.ExpectQuery(fetchSubscriptionsWithinNamespaceQuery(1)).WillReturnRows(sqlmock.NewRows(nil))
+		s.dbmock.ExpectQuery(fetchInhibitionRulesWithinNamespaceQuery(1)).WillReturnRows(sqlmock.NewRows(nil))
+		s.dbmock.ExpectQuery(fetchTimeIntervalsWithinNamespaceQuery(1)).WillReturnRows(sqlmock.NewRows(nil))
+		s.dbmock.ExpectQuery(fetchSubscriptionsWithinNamespaceQuery(2)).WillReturnRows(sqlmock.NewRows(nil))
+		s.dbmock.ExpectQuery(fetchInhibitionRulesWithinNamespaceQuery(2)).WillReturnRows(rowsInNamespace(2))
+		s.dbmock.ExpectQuery(fetchTimeIntervalsWithinNamespaceQuery(2)).WillReturnRows(sqlmock.NewRows(nil))
+		s.dbmock.ExpectCommit()
+
+		actualInhibitionRule, err := s.repository.Update(input, namespaceMock, providerMock, receiverMock)
+		s.Nil(err)
+		s.Equal(uint64(2), actualInhibitionRule.NamespaceId)
+		s.Equal(input.SourceMatchers, actualInhibitionRule.SourceMatchers)
+		amClientMock.AssertExpectations(s.T())
+	})
+
+	s.Run("should return error in fetching inhibition rule before update", func() {
+		s.dbmock.ExpectBegin()
+		s.dbmock.ExpectQuery(selectQuery).WillReturnError(errors.New("random error"))
+		s.dbmock.ExpectRollback()
+
+		actualInhibitionRule, err := s.repository.Update(input, &mocks.NamespaceService{},
+			&mocks.ProviderService{}, &mocks.ReceiverService{})
+		s.EqualError(err, "random error")
+		s.Nil(actualInhibitionRule)
+	})
+
+	s.Run("should return error if inhibition rule does not exist", func() {
+		s.dbmock.ExpectBegin()
+		s.dbmock.ExpectQuery(selectQuery).WillReturnRows(sqlmock.NewRows(inhibitionRuleColumns))
+		s.dbmock.ExpectRollback()
+
+		actualInhibitionRule, err := s.repository.Update(input, &mocks.NamespaceService{},
+			&mocks.ProviderService{}, &mocks.ReceiverService{})
+		s.EqualError(err, "inhibition rule doesn't exist")
+		s.Nil(actualInhibitionRule)
+	})
+
+	s.Run("should return error if updating inhibition rule fails", func() {
+		s.dbmock.ExpectBegin()
+		s.dbmock.ExpectQuery(selectQuery).WillReturnRows(rowsInNamespace(1))
+		s.dbmock.ExpectExec(updateQuery).WillReturnError(errors.New("random error"))
+		s.dbmock.ExpectRollback()
+
+		actualInhibitionRule, err := s.repository.Update(input, &mocks.NamespaceService{},
+			&mocks.ProviderService{}, &mocks.ReceiverService{})
+		s.EqualError(err, "random error")
+		s.Nil(actualInhibitionRule)
+	})
+}
+
+func (s *InhibitionRepositoryTestSuite) TestDelete() {
+	dummyNamespace := &domain.Namespace{Id: 1, Provider: 1, Urn: "dummy"}
+	dummyProvider := &domain.Provider{Id: 1, Urn: "test", Type: "cortex", Host: "http://localhost:8080"}
+
+	selectQuery := regexp.QuoteMeta(`SELECT * FROM "inhibition_rules" WHERE id = 1`)
+	deleteQuery := regexp.QuoteMeta(`DELETE FROM "inhibition_rules" WHERE "inhibition_rules"."id" = $1`)
+	fetchSubscriptionsWithinNamespaceQuery := regexp.QuoteMeta(`SELECT * FROM "subscriptions" WHERE namespace_id = 1`)
+	fetchInhibitionRulesWithinNamespaceQuery := regexp.QuoteMeta(`SELECT * FROM "inhibition_rules" WHERE namespace_id = 1`)
+	fetchTimeIntervalsWithinNamespaceQuery := regexp.QuoteMeta(`SELECT * FROM "time_intervals" WHERE namespace_id = 1`)
+	expectedRows := func() *sqlmock.Rows {
+		return sqlmock.NewRows(inhibitionRuleColumns).
+			AddRow(1, 1, "cluster-down", json.RawMessage(`[]`), json.RawMessage(`[]`), json.RawMessage(`[]`), time.Now(), time.Now())
+	}
+
+	s.Run("should delete an inhibition rule and sync its namespace", func() {
+		providerMock := &mocks.ProviderService{}
+		namespaceMock := &mocks.NamespaceService{}
+		receiverMock := &mocks.ReceiverService{}
+		amClientMock := &adapter.AdapterMock{}
+		providerMock.On("GetProvider", uint64(1)).Return(dummyProvider, nil).Once()
+		namespaceMock.On("GetNamespace", uint64(1)).Return(dummyNamespace, nil).Once()
+		receiverMock.On("ListReceivers").Return([]*domain.Receiver{}, nil).Once()
+		amClientMock.On("SyncAlertmanagerConfig", mock.Anything, "dummy", mock.AnythingOfType("alertmanager.AMConfig")).Run(func(args mock.Arguments) {
+			s.Empty(args.Get(2).(alertmanager.AMConfig).InhibitRules)
+		}).Return(nil).Once()
+		s.adapters.Register("cortex", func(string, string) (adapter.Adapter, error) {
+			return amClientMock, nil
+		})
+
+		s.dbmock.ExpectBegin()
+		s.dbmock.ExpectQuery(selectQuery).WillReturnRows(expectedRows())
+		s.dbmock.ExpectExec(deleteQuery).WithArgs(1).WillReturnResult(sqlmock.NewResult(0, 1))
+		s.dbmock.ExpectQuery(fetchSubscriptionsWithinNamespaceQuery).WillReturnRows(sqlmock.NewRows(nil))
+		s.dbmock.ExpectQuery(fetchInhibitionRulesWithinNamespaceQuery).WillReturnRows(sqlmock.NewRows(nil))
+		s.dbmock.ExpectQuery(fetchTimeIntervalsWithinNamespaceQuery).WillReturnRows(sqlmock.NewRows(nil))
+		s.dbmock.ExpectCommit()
+
+		err := s.repository.Delete(1, namespaceMock, providerMock, receiverMock)
+		s.Nil(err)
+		amClientMock.AssertExpectations(s.T())
+	})
+
+	s.Run("should return no error if inhibition rule does not exist", func() {
+		s.dbmock.ExpectBegin()
+		s.dbmock.ExpectQuery(selectQuery).WillReturnRows(sqlmock.NewRows(inhibitionRuleColumns))
+		s.dbmock.ExpectCommit()
+
+		err := s.repository.Delete(1, &mocks.NamespaceService{}, &mocks.ProviderService{}, &mocks.ReceiverService{})
+		s.Nil(err)
+	})
+
+	s.Run("should return error in deleting inhibition rule", func() {
+		s.dbmock.ExpectBegin()
+		s.dbmock.ExpectQuery(selectQuery).WillReturnRows(expectedRows())
+		s.dbmock.ExpectExec(deleteQuery).WithArgs(1).WillReturnError(errors.New("random error"))
+		s.dbmock.ExpectRollback()
+
+		err := s.repository.Delete(1, &mocks.NamespaceService{}, &mocks.ProviderService{}, &mocks.ReceiverService{})
+		s.EqualError(err, "failed to delete inhibition rule: random error")
+	})
+}
+
+func TestInhibitionRepository(t *testing.T) {
+	suite.Run(t, new(InhibitionRepositoryTestSuite))
+}
@@ -0,0 +1,118 @@
+package subscription
+
+import (
+	"fmt"
+	"github.com/odpf/siren/domain"
+	"github.com/odpf/siren/pkg/adapter"
+	"github.com/odpf/siren/pkg/namespace"
+	"github.com/odpf/siren/pkg/provider"
+	"github.com/odpf/siren/pkg/receiver"
+	"github.com/pkg/errors"
+	"github.com/prometheus/common/model"
+	"gorm.io/gorm"
+)
+
+// InhibitionService handles business logic of inhibition rules
+type InhibitionService struct {
+	repository       InhibitionRuleRepository
+	providerService  domain.ProviderService
+	namespaceService domain.NamespaceService
+	receiverService  domain.ReceiverService
+}
+
+// NewInhibitionService returns service struct
+func NewInhibitionService(db *gorm.DB, adapters *adapter.Registry, key string) (domain.InhibitionService, error) {
+	repository := NewInhibitionRepository(db, adapters)
+	providerService := provider.NewService(db)
+	receiverService, err := receiver.NewService(db, nil, key)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create receiver service")
+	}
+	namespaceService, err := namespace.NewService(db, key,
+		&NamespaceSyncer{NewRepository(db, adapters), providerService, receiverService})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create namespace service")
+	}
+	return &InhibitionService{repository, providerService,
+		namespaceService, receiverService}, nil
+}
+
+func (s InhibitionService) ListInhibitionRules(namespaceId uint64) ([]*domain.InhibitionRule, error) {
+	inhibitionRules, err := s.repository.List(namespaceId)
+	if err != nil {
+		return nil, errors.Wrap(err, "s.repository.List")
+	}
+	domainInhibitionRules := make([]*domain.InhibitionRule, 0, len(inhibitionRules))
+	for _, inhibitionRule := range inhibitionRules {
+		domainInhibitionRules = append(domainInhibitionRules, inhibitionRule.toDomain())
+	}
+	return domainInhibitionRules, nil
+}
+
+func (s InhibitionService) CreateInhibitionRule(domainInhibitionRule *domain.InhibitionRule) (*domain.InhibitionRule, error) {
+	if err := validateInhibitionRule(domainInhibitionRule); err != nil {
+		return nil, err
+	}
+	inhibitionRule := &InhibitionRule{}
+	inhibitionRule.fromDomain(domainInhibitionRule)
+	newInhibitionRule, err := s.repository.Create(inhibitionRule, s.namespaceService, s.providerService, s.receiverService)
+	if err != nil {
+		return nil, errors.Wrap(err, "s.repository.Create")
+	}
+	return newInhibitionRule.toDomain(), nil
+}
+
+func (s InhibitionService) GetInhibitionRule(id uint64) (*domain.InhibitionRule, error) {
+	inhibitionRule, err := s.repository.Get(id)
+	if err != nil {
+		return nil, errors.Wrap(err, "s.repository.Get")
+	}
+	if inhibitionRule == nil {
+		return nil, nil
+	}
+	return inhibitionRule.toDomain(), nil
+}
+
+func (s InhibitionService) UpdateInhibitionRule(domainInhibitionRule *domain.InhibitionRule) (*domain.InhibitionRule, error) {
+	if err := validateInhibitionRule(domainInhibitionRule); err != nil {
+		return nil, err
+	}
+	inhibitionRule := &InhibitionRule{}
+	inhibitionRule.fromDomain(domainInhibitionRule)
+	updatedInhibitionRule, err := s.repository.Update(inhibitionRule, s.namespaceService, s.providerService, s.receiverService)
+	if err != nil {
+		return nil, errors.Wrap(err, "s.repository.Update")
+	}
+	return updatedInhibitionRule.toDomain(), nil
+}
+
+func (s InhibitionService) DeleteInhibitionRule(id uint64) error {
+	return s.repository.Delete(id, s.namespaceService, s.providerService, s.receiverService)
+}
+
+func (s InhibitionService) Migrate() error {
+	return s.repository.Migrate()
+}
+
+// validateInhibitionRule requires source and target matchers, without which
+// alertmanager matches every alert, and checks them like subscription matchers
+func validateInhibitionRule(inhibitionRule *domain.InhibitionRule) error {
+	if len(inhibitionRule.SourceMatchers) == 0 {
+		return &domain.InvalidInhibitionRuleError{Reason: "source matchers cannot be empty"}
+	}
+	if len(inhibitionRule.TargetMatchers) == 0 {
+		return &domain.InvalidInhibitionRuleError{Reason: "target matchers cannot be empty"}
+	}
+	if err := validateMatchers(inhibitionRule.SourceMatchers); err != nil {
+		return err
+	}
+	if err := validateMatchers(inhibitionRule.TargetMatchers); err != nil {
+		return err
+	}
+	for _, label := range inhibitionRule.Equal {
+		if !model.LabelName(label).IsValid() {
+			return &domain.InvalidInhibitionRuleError{Reason: fmt.Sprintf("invalid equal label %q", label)}
+		}
+	}
+	return nil
+}
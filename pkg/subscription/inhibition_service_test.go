@@ -0,0 +1,198 @@
+package subscription
+
+import (
+	"errors"
+	"github.com/odpf/siren/domain"
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+func TestInhibitionService_CreateInhibitionRule(t *testing.T) {
+	timeNow := time.Now()
+	input := &domain.InhibitionRule{Id: 1, Urn: "cluster-down", Namespace: 1,
+		SourceMatchers: []domain.Matcher{{Name: "alertname", Operator: domain.MatchEqual, Value: "ClusterDown"}},
+		TargetMatchers: []domain.Matcher{{Name: "alertname", Operator: domain.MatchRegexp, Value: "Pod.*"}},
+		Equal:          []string{"cluster"}, CreatedAt: timeNow, UpdatedAt: timeNow}
+	inhibitionRule := &InhibitionRule{Id: 1, Urn: "cluster-down", NamespaceId: 1,
+		SourceMatchers: MatcherList{{Name: "alertname", Operator: "=", Value: "ClusterDown"}},
+		TargetMatchers: MatcherList{{Name: "alertname", Operator: "=~", Value: "Pod.*"}},
+		Equal:          StringList{"cluster"}, CreatedAt: timeNow, UpdatedAt: timeNow}
+
+	t.Run("should call repository create method and return result in domain's type", func(t *testing.T) {
+		repositoryMock := &InhibitionRuleRepositoryMock{}
+		repositoryMock.On("Create", inhibitionRule, nil, nil, nil).Return(inhibitionRule, nil).Once()
+		dummyService := InhibitionService{repositoryMock, nil, nil, nil}
+
+		result, err := dummyService.CreateInhibitionRule(input)
+		assert.Nil(t, err)
+		assert.Equal(t, input, result)
+		repositoryMock.AssertExpectations(t)
+	})
+
+	t.Run("should return error in inhibition rule creation", func(t *testing.T) {
+		repositoryMock := &InhibitionRuleRepositoryMock{}
+		repositoryMock.On("Create", inhibitionRule, nil, nil, nil).Return(nil, errors.New("random error")).Once()
+		dummyService := InhibitionService{repositoryMock, nil, nil, nil}
+
+		result, err := dummyService.CreateInhibitionRule(input)
+		assert.EqualError(t, err, "s.repository.Create: random error")
+		assert.Nil(t, result)
+	})
+
+	t.Run("should return error if the inhibition rule is invalid", func(t *testing.T) {
+		matchers := []domain.Matcher{{Name: "alertname", Operator: domain.MatchEqual, Value: "ClusterDown"}}
+		for _, testCase := range []struct {
+			rule   *domain.InhibitionRule
+			reason string
+		}{
+			{&domain.InhibitionRule{TargetMatchers: matchers}, "source matchers cannot be empty"},
+			{&domain.InhibitionRule{SourceMatchers: matchers}, "target matchers cannot be empty"},
+			{&domain.InhibitionRule{SourceMatchers: matchers, TargetMatchers: matchers, Equal: []string{"1cluster"}},
+				`invalid equal label "1cluster"`},
+		} {
+			dummyService := InhibitionService{&InhibitionRuleRepositoryMock{}, nil, nil, nil}
+
+			result, err := dummyService.CreateInhibitionRule(testCase.rule)
+			var invalidInhibitionRuleErr *domain.InvalidInhibitionRuleError
+			assert.True(t, errors.As(err, &invalidInhibitionRuleErr))
+			assert.Equal(t, testCase.reason, invalidInhibitionRuleErr.Reason)
+			assert.Nil(t, result)
+		}
+	})
+
+	t.Run("should return error if a matcher is invalid", func(t *testing.T) {
+		matcher := domain.Matcher{Name: "service", Operator: "==", Value: "payments"}
+		dummyService := InhibitionService{&InhibitionRuleRepositoryMock{}, nil, nil, nil}
+
+		result, err := dummyService.CreateInhibitionRule(&domain.InhibitionRule{
+			SourceMatchers: input.SourceMatchers, TargetMatchers: []domain.Matcher{matcher}})
+		var invalidMatcherErr *domain.InvalidMatcherError
+		assert.True(t, errors.As(err, &invalidMatcherErr))
+		assert.Equal(t, matcher, invalidMatcherErr.Matcher)
+		assert.Nil(t, result)
+	})
+}
+
+func TestInhibitionService_GetInhibitionRule(t *testing.T) {
+	t.Run("should call repository get method and return result in domain's type", func(t *testing.T) {
+		repositoryMock := &InhibitionRuleRepositoryMock{}
+		repositoryMock.On("Get", uint64(1)).Return(&InhibitionRule{Id: 1, Urn: "cluster-down", NamespaceId: 1}, nil).Once()
+		dummyService := InhibitionService{repositoryMock, nil, nil, nil}
+
+		result, err := dummyService.GetInhibitionRule(1)
+		assert.Nil(t, err)
+		assert.Equal(t, &domain.InhibitionRule{Id: 1, Urn: "cluster-down", Namespace: 1}, result)
+	})
+
+	t.Run("should not return error if inhibition rule doesn't exist", func(t *testing.T) {
+		repositoryMock := &InhibitionRuleRepositoryMock{}
+		repositoryMock.On("Get", uint64(1)).Return(nil, nil).Once()
+		dummyService := InhibitionService{repositoryMock, nil, nil, nil}
+
+		result, err := dummyService.GetInhibitionRule(1)
+		assert.Nil(t, err)
+		assert.Nil(t, result)
+	})
+
+	t.Run("should call repository get method and return error if any", func(t *testing.T) {
+		repositoryMock := &InhibitionRuleRepositoryMock{}
+		repositoryMock.On("Get", uint64(1)).Return(nil, errors.New("random error")).Once()
+		dummyService := InhibitionService{repositoryMock, nil, nil, nil}
+
+		result, err := dummyService.GetInhibitionRule(1)
+		assert.EqualError(t, err, "s.repository.Get: random error")
+		assert.Nil(t, result)
+	})
+}
+
+func TestInhibitionService_ListInhibitionRules(t *testing.T) {
+	t.Run("should call repository list method and return result in domain's type", func(t *testing.T) {
+		repositoryMock := &InhibitionRuleRepositoryMock{}
+		repositoryMock.On("List", uint64(1)).Return([]*InhibitionRule{{Id: 1, Urn: "cluster-down", NamespaceId: 1}}, nil).Once()
+		dummyService := InhibitionService{repositoryMock, nil, nil, nil}
+
+		result, err := dummyService.ListInhibitionRules(1)
+		assert.Nil(t, err)
+		assert.Equal(t, []*domain.InhibitionRule{{Id: 1, Urn: "cluster-down", Namespace: 1}}, result)
+	})
+
+	t.Run("should call repository list method and return error if any", func(t *testing.T) {
+		repositoryMock := &InhibitionRuleRepositoryMock{}
+		repositoryMock.On("List", uint64(0)).Return(nil, errors.New("random error")).Once()
+		dummyService := InhibitionService{repositoryMock, nil, nil, nil}
+
+		result, err := dummyService.ListInhibitionRules(0)
+		assert.EqualError(t, err, "s.repository.List: random error")
+		assert.Nil(t, result)
+	})
+}
+
+func TestInhibitionService_UpdateInhibitionRule(t *testing.T) {
+	input := &domain.InhibitionRule{Id: 1, Urn: "cluster-down", Namespace: 1,
+		SourceMatchers: []domain.Matcher{{Name: "alertname", Operator: domain.MatchEqual, Value: "ClusterDown"}},
+		TargetMatchers: []domain.Matcher{{Name: "alertname", Operator: domain.MatchRegexp, Value: "Pod.*"}}}
+	inhibitionRule := &InhibitionRule{Id: 1, Urn: "cluster-down", NamespaceId: 1,
+		SourceMatchers: MatcherList{{Name: "alertname", Operator: "=", Value: "ClusterDown"}},
+		TargetMatchers: MatcherList{{Name: "alertname", Operator: "=~", Value: "Pod.*"}}}
+
+	t.Run("should call repository update method and return result in domain's type", func(t *testing.T) {
+		repositoryMock := &InhibitionRuleRepositoryMock{}
+		repositoryMock.On("Update", inhibitionRule, nil, nil, nil).Return(inhibitionRule, nil).Once()
+		dummyService := InhibitionService{repositoryMock, nil, nil, nil}
+
+		result, err := dummyService.UpdateInhibitionRule(input)
+		assert.Nil(t, err)
+		assert.Equal(t, input, result)
+	})
+
+	t.Run("should return error in inhibition rule update", func(t *testing.T) {
+		repositoryMock := &InhibitionRuleRepositoryMock{}
+		repositoryMock.On("Update", inhibitionRule, nil, nil, nil).Return(nil, errors.New("random error")).Once()
+		dummyService := InhibitionService{repositoryMock, nil, nil, nil}
+
+		result, err := dummyService.UpdateInhibitionRule(input)
+		assert.EqualError(t, err, "s.repository.Update: random error")
+		assert.Nil(t, result)
+	})
+
+	t.Run("should return error if the inhibition rule is invalid", func(t *testing.T) {
+		dummyService := InhibitionService{&InhibitionRuleRepositoryMock{}, nil, nil, nil}
+
+		result, err := dummyService.UpdateInhibitionRule(&domain.InhibitionRule{Id: 1, TargetMatchers: input.TargetMatchers})
+		assert.EqualError(t, err, "invalid inhibition rule: source matchers cannot be empty")
+		assert.Nil(t, result)
+	})
+}
+
+func TestInhibitionService_DeleteInhibitionRule(t *testing.T) {
+	t.Run("should call repository delete method and return result", func(t *testing.T) {
+		repositoryMock := &InhibitionRuleRepositoryMock{}
+		repositoryMock.On("Delete", uint64(1), nil, nil, nil).Return(nil).Once()
+		dummyService := InhibitionService{repositoryMock, nil, nil, nil}
+
+		err := dummyService.DeleteInhibitionRule(1)
+		assert.Nil(t, err)
+	})
+
+	t.Run("should return error in inhibition rule delete", func(t *testing.T) {
+		repositoryMock := &InhibitionRuleRepositoryMock{}
+		repositoryMock.On("Delete", uint64(1), nil, nil, nil).Return(errors.New("random error")).Once()
+		dummyService := InhibitionService{repositoryMock, nil, nil, nil}
+
+		err := dummyService.DeleteInhibitionRule(1)
+		assert.EqualError(t, err, "random error")
+	})
+}
+
+func TestInhibitionService_Migrate(t *testing.T) {
+	t.Run("should call repository Migrate method and return result", func(t *testing.T) {
+		repositoryMock := &InhibitionRuleRepositoryMock{}
+		repositoryMock.On("Migrate").Return(nil).Once()
+		dummyService := InhibitionService{repositoryMock, nil, nil, nil}
+
+		err := dummyService.Migrate()
+		assert.Nil(t, err)
+		repositoryMock.AssertExpectations(t)
+	})
+}
@@ -12,6 +12,10 @@ import (
 type StringStringMap map[string]string
 
 func (m *StringStringMap) Scan(value interface{}) error {
+	if value == nil {
+		*m = nil
+		return nil
+	}
 	b, ok := value.([]byte)
 	if !ok {
 		return errors.New("failed type assertion to []byte")
@@ -35,6 +39,69 @@ func (list ReceiverMetadataList) Value() (driver.Value, error) {
 	return string(val), err
 }
 
+type StringList []string
+
+func (l *StringList) Scan(value interface{}) error {
+	if value == nil {
+		*l = nil
+		return nil
+	}
+	b, ok := value.([]byte)
+	if !ok {
+		return errors.New("failed type assertion to []byte")
+	}
+	return json.Unmarshal(b, &l)
+}
+
+func (l StringList) Value() (driver.Value, error) {
+	if len(l) == 0 {
+		return nil, nil
+	}
+	return json.Marshal(l)
+}
+
+func (list *MatcherList) Scan(src interface{}) error {
+	if src == nil {
+		*list = nil
+		return nil
+	}
+	b, ok := src.([]byte)
+	if !ok {
+		return errors.New("failed type assertion to []byte")
+	}
+	return json.Unmarshal(b, &list)
+}
+
+func (list MatcherList) Value() (driver.Value, error) {
+	if len(list) == 0 {
+		return nil, nil
+	}
+	val, err := json.Marshal(list)
+	return string(val), err
+}
+
+type TimePeriodList []domain.TimePeriod
+
+func (list *TimePeriodList) Scan(src interface{}) error {
+	if src == nil {
+		*list = nil
+		return nil
+	}
+	b, ok := src.([]byte)
+	if !ok {
+		return errors.New("failed type assertion to []byte")
+	}
+	return json.Unmarshal(b, &list)
+}
+
+func (list TimePeriodList) Value() (driver.Value, error) {
+	if len(list) == 0 {
+		return nil, nil
+	}
+	val, err := json.Marshal(list)
+	return string(val), err
+}
+
 type ReceiverMetadata struct {
 	Id            uint64            `json:"id"`
 	Configuration map[string]string `json:"configuration"`
@@ -46,29 +113,48 @@ type EnrichedReceiverMetadata struct {
 	Configuration map[string]string `json:"configuration"`
 }
 
+type Matcher struct {
+	Name     string `json:"name"`
+	Operator string `json:"operator"`
+	Value    string `json:"value"`
+}
+
 type ReceiverMetadataList []ReceiverMetadata
 type EnrichedReceiverMetadataList []EnrichedReceiverMetadata
+type MatcherList []Matcher
 
 type Subscription struct {
-	Id          uint64 `gorm:"primarykey"`
-	Namespace   *namespace.Namespace
-	NamespaceId uint64
-	Urn       string               `gorm:"unique"`
-	Receiver  ReceiverMetadataList `gorm:"type:jsonb" sql:"type:jsonb" `
-	Match     StringStringMap      `gorm:"type:jsonb" sql:"type:jsonb" `
-	CreatedAt time.Time
-	UpdatedAt   time.Time
+	Id                uint64 `gorm:"primarykey"`
+	Namespace         *namespace.Namespace
+	NamespaceId       uint64
+	Urn               string               `gorm:"unique"`
+	Receiver          ReceiverMetadataList `gorm:"type:jsonb" sql:"type:jsonb" `
+	Match             StringStringMap      `gorm:"type:jsonb" sql:"type:jsonb" `
+	Matchers          MatcherList          `gorm:"type:jsonb" sql:"type:jsonb" `
+	GroupBy           StringList           `gorm:"type:jsonb" sql:"type:jsonb" `
+	GroupWait         string
+	GroupInterval     string
+	RepeatInterval    string
+	MuteTimeIntervals StringList `gorm:"type:jsonb" sql:"type:jsonb" `
+	CreatedAt         time.Time
+	UpdatedAt         time.Time
 }
 
 type SubscriptionEnrichedWithReceivers struct {
-	Id          uint64
-	Namespace   *namespace.Namespace
-	NamespaceId uint64
-	Urn       string
-	Receiver  EnrichedReceiverMetadataList
-	Match     StringStringMap
-	CreatedAt time.Time
-	UpdatedAt   time.Time
+	Id                uint64
+	Namespace         *namespace.Namespace
+	NamespaceId       uint64
+	Urn               string
+	Receiver          EnrichedReceiverMetadataList
+	Match             StringStringMap
+	Matchers          MatcherList
+	GroupBy           StringList
+	GroupWait         string
+	GroupInterval     string
+	RepeatInterval    string
+	MuteTimeIntervals StringList
+	CreatedAt         time.Time
+	UpdatedAt         time.Time
 }
 
 func (s *Subscription) fromDomain(sub *domain.Subscription) *Subscription {
@@ -79,6 +165,14 @@ func (s *Subscription) fromDomain(sub *domain.Subscription) *Subscription {
 	s.Urn = sub.Urn
 	s.NamespaceId = sub.Namespace
 	s.Match = sub.Match
+	for _, item := range sub.Matchers {
+		s.Matchers = append(s.Matchers, Matcher(item))
+	}
+	s.GroupBy = sub.GroupBy
+	s.GroupWait = sub.GroupWait
+	s.GroupInterval = sub.GroupInterval
+	s.RepeatInterval = sub.RepeatInterval
+	s.MuteTimeIntervals = sub.MuteTimeIntervals
 	s.Receiver = make([]ReceiverMetadata, 0)
 	for _, item := range sub.Receivers {
 		receiver := ReceiverMetadata{
@@ -104,14 +198,26 @@ func (s *Subscription) toDomain() *domain.Subscription {
 		}
 		receivers = append(receivers, receiver)
 	}
+	var matchers []domain.Matcher
+	for _, item := range s.Matchers {
+		matchers = append(matchers, domain.Matcher(item))
+	}
 	subscription := &domain.Subscription{
-		Id:        s.Id,
-		Urn:       s.Urn,
-		Match:     s.Match,
-		Namespace: s.NamespaceId,
-		Receivers: receivers,
-		CreatedAt: s.CreatedAt,
-		UpdatedAt: s.UpdatedAt,
+		Id:                s.Id,
+		Urn:               s.Urn,
+		Match:             s.Match,
+		Matchers:          matchers,
+		Namespace:         s.NamespaceId,
+		Receivers:         receivers,
+		CreatedAt:         s.CreatedAt,
+		UpdatedAt:         s.UpdatedAt,
+		MuteTimeIntervals: s.MuteTimeIntervals,
+		Routing: domain.Routing{
+			GroupBy:        s.GroupBy,
+			GroupWait:      s.GroupWait,
+			GroupInterval:  s.GroupInterval,
+			RepeatInterval: s.RepeatInterval,
+		},
 	}
 	return subscription
 }
@@ -124,3 +230,131 @@ type SubscriptionRepository interface {
 	Update(*Subscription, domain.NamespaceService, domain.ProviderService, domain.ReceiverService) (*Subscription, error)
 	Delete(uint64, domain.NamespaceService, domain.ProviderService, domain.ReceiverService) error
 }
+
+type InhibitionRule struct {
+	Id             uint64 `gorm:"primarykey"`
+	Namespace      *namespace.Namespace
+	NamespaceId    uint64      `gorm:"uniqueIndex:inhibition_rule_urn_namespace_id_unique"`
+	Urn            string      `gorm:"uniqueIndex:inhibition_rule_urn_namespace_id_unique"`
+	SourceMatchers MatcherList `gorm:"type:jsonb" sql:"type:jsonb" `
+	TargetMatchers MatcherList `gorm:"type:jsonb" sql:"type:jsonb" `
+	Equal          StringList  `gorm:"type:jsonb" sql:"type:jsonb" `
+	CreatedAt      time.Time
+	UpdatedAt      time.Time
+}
+
+func (rule *InhibitionRule) fromDomain(r *domain.InhibitionRule) *InhibitionRule {
+	if rule == nil {
+		return nil
+	}
+	rule.Id = r.Id
+	rule.Urn = r.Urn
+	rule.NamespaceId = r.Namespace
+	for _, item := range r.SourceMatchers {
+		rule.SourceMatchers = append(rule.SourceMatchers, Matcher(item))
+	}
+	for _, item := range r.TargetMatchers {
+		rule.TargetMatchers = append(rule.TargetMatchers, Matcher(item))
+	}
+	rule.Equal = r.Equal
+	rule.CreatedAt = r.CreatedAt
+	rule.UpdatedAt = r.UpdatedAt
+	return rule
+}
+
+func (rule *InhibitionRule) toDomain() *domain.InhibitionRule {
+	if rule == nil {
+		return nil
+	}
+	var sourceMatchers, targetMatchers []domain.Matcher
+	for _, item := range rule.SourceMatchers {
+		sourceMatchers = append(sourceMatchers, domain.Matcher(item))
+	}
+	for _, item := range rule.TargetMatchers {
+		targetMatchers = append(targetMatchers, domain.Matcher(item))
+	}
+	return &domain.InhibitionRule{
+		Id:             rule.Id,
+		Urn:            rule.Urn,
+		Namespace:      rule.NamespaceId,
+		SourceMatchers: sourceMatchers,
+		TargetMatchers: targetMatchers,
+		Equal:          rule.Equal,
+		CreatedAt:      rule.CreatedAt,
+		UpdatedAt:      rule.UpdatedAt,
+	}
+}
+
+func (rule *InhibitionRule) getId() uint64 {
+	return rule.Id
+}
+
+func (rule *InhibitionRule) getNamespaceId() uint64 {
+	return rule.NamespaceId
+}
+
+type InhibitionRuleRepository interface {
+	Migrate() error
+	List(namespaceId uint64) ([]*InhibitionRule, error)
+	Create(*InhibitionRule, domain.NamespaceService, domain.ProviderService, domain.ReceiverService) (*InhibitionRule, error)
+	Get(uint64) (*InhibitionRule, error)
+	Update(*InhibitionRule, domain.NamespaceService, domain.ProviderService, domain.ReceiverService) (*InhibitionRule, error)
+	Delete(uint64, domain.NamespaceService, domain.ProviderService, domain.ReceiverService) error
+}
+
+type TimeInterval struct {
+	Id            uint64 `gorm:"primarykey"`
+	Namespace     *namespace.Namespace
+	NamespaceId   uint64         `gorm:"uniqueIndex:time_interval_urn_namespace_id_unique"`
+	Urn           string         `gorm:"uniqueIndex:time_interval_urn_namespace_id_unique"`
+	Periods       TimePeriodList `gorm:"type:jsonb" sql:"type:jsonb" `
+	MuteNamespace bool
+	CreatedAt     time.Time
+	UpdatedAt     time.Time
+}
+
+func (timeInterval *TimeInterval) fromDomain(t *domain.TimeInterval) *TimeInterval {
+	if timeInterval == nil {
+		return nil
+	}
+	timeInterval.Id = t.Id
+	timeInterval.Urn = t.Urn
+	timeInterval.NamespaceId = t.Namespace
+	timeInterval.Periods = t.Periods
+	timeInterval.MuteNamespace = t.MuteNamespace
+	timeInterval.CreatedAt = t.CreatedAt
+	timeInterval.UpdatedAt = t.UpdatedAt
+	return timeInterval
+}
+
+func (timeInterval *TimeInterval) toDomain() *domain.TimeInterval {
+	if timeInterval == nil {
+		return nil
+	}
+	return &domain.TimeInterval{
+		Id:            timeInterval.Id,
+		Urn:           timeInterval.Urn,
+		Namespace:     timeInterval.NamespaceId,
+		Periods:       timeInterval.Periods,
+		MuteNamespace: timeInterval.MuteNamespace,
+		CreatedAt:     timeInterval.CreatedAt,
+		UpdatedAt:     timeInterval.UpdatedAt,
+	}
+}
+
+func (timeInterval *TimeInterval) getId() uint64 {
+	return timeInterval.Id
+}
+
+func (timeInterval *TimeInterval) getNamespaceId() uint64 {
+	return timeInterval.NamespaceId
+}
+
+type TimeIntervalRepository interface {
+	Migrate() error
+	List(namespaceId uint64) ([]*TimeInterval, error)
+	Create(*TimeInterval, domain.NamespaceService, domain.ProviderService, domain.ReceiverService) (*TimeInterval, error)
+	Get(uint64) (*TimeInterval, error)
+	Update(*TimeInterval, domain.NamespaceService, domain.ProviderService, domain.ReceiverService) (*TimeInterval, error)
+	Delete(uint64, domain.NamespaceService, domain.ProviderService, domain.ReceiverService) error
+}
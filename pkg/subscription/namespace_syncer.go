@@ -0,0 +1,61 @@
+package subscription
+
+import (
+	"github.com/odpf/siren/domain"
+	"github.com/odpf/siren/pkg/adapter"
+	"github.com/odpf/siren/pkg/provider"
+	"github.com/odpf/siren/pkg/receiver"
+	"github.com/pkg/errors"
+	"gorm.io/gorm"
+)
+
+// NamespaceSyncer syncs the alertmanager config of a namespace when the
+// namespace itself is updated, rendering its new default routing
+type NamespaceSyncer struct {
+	repository      *Repository
+	providerService domain.ProviderService
+	receiverService domain.ReceiverService
+}
+
+// NewNamespaceSyncer returns namespace syncer struct
+func NewNamespaceSyncer(db *gorm.DB, adapters *adapter.Registry, key string) (*NamespaceSyncer, error) {
+	receiverService, err := receiver.NewService(db, nil, key)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create receiver service")
+	}
+	return &NamespaceSyncer{NewRepository(db, adapters), provider.NewService(db), receiverService}, nil
+}
+
+// SyncNamespace syncs the config of the updated namespace with the
+// subscriptions, inhibition rules and time intervals read in tx. A namespace
+// without any of them is not synced, its provider may lack an alertmanager.
+func (s NamespaceSyncer) SyncNamespace(tx *gorm.DB, namespace *domain.Namespace) error {
+	rendered, err := hasRenderedResources(tx, namespace.Id)
+	if err != nil {
+		return errors.Wrap(err, "hasRenderedResources")
+	}
+	if !rendered {
+		return nil
+	}
+	getNamespace := func(uint64) (*domain.Namespace, error) {
+		return namespace, nil
+	}
+	return s.repository.syncInUpstreamSubscriptionsOfNamespace(tx, namespace.Id, getNamespace,
+		s.providerService, s.receiverService)
+}
+
+// hasRenderedResources tells whether the namespace has any subscription,
+// inhibition rule or time interval rendered into its alertmanager config
+func hasRenderedResources(tx *gorm.DB, namespaceId uint64) (bool, error) {
+	for _, model := range []interface{}{&Subscription{}, &InhibitionRule{}, &TimeInterval{}} {
+		var count int64
+		result := tx.Model(model).Where("namespace_id = ?", namespaceId).Count(&count)
+		if result.Error != nil {
+			return false, result.Error
+		}
+		if count > 0 {
+			return true, nil
+		}
+	}
+	return false, nil
+}
@@ -0,0 +1,77 @@
+package subscription
+
+import (
+	"encoding/json"
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/odpf/siren/domain"
+	"github.com/odpf/siren/mocks"
+	"github.com/odpf/siren/pkg/adapter"
+	"github.com/odpf/siren/pkg/subscription/alertmanager"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"regexp"
+	"testing"
+)
+
+func TestNamespaceSyncer_SyncNamespace(t *testing.T) {
+	countSubscriptionsQuery := regexp.QuoteMeta(`SELECT count(1) FROM "subscriptions" WHERE namespace_id = $1`)
+	countInhibitionRulesQuery := regexp.QuoteMeta(`SELECT count(1) FROM "inhibition_rules" WHERE namespace_id = $1`)
+	countTimeIntervalsQuery := regexp.QuoteMeta(`SELECT count(1) FROM "time_intervals" WHERE namespace_id = $1`)
+	namespace := &domain.Namespace{Id: 1, Provider: 1, Urn: "dummy",
+		Routing: domain.Routing{GroupBy: []string{"alertname"}, GroupWait: "1m"}}
+
+	t.Run("should sync the alertmanager config with the routing of the updated namespace", func(t *testing.T) {
+		db, dbmock, _ := mocks.NewStore()
+		adapters := adapter.NewRegistry()
+		providerMock := &mocks.ProviderService{}
+		receiverMock := &mocks.ReceiverService{}
+		amClientMock := &adapter.AdapterMock{}
+		syncer := NamespaceSyncer{NewRepository(db, adapters), providerMock, receiverMock}
+		adapters.Register("cortex", func(string, string) (adapter.Adapter, error) {
+			return amClientMock, nil
+		})
+		providerMock.On("GetProvider", uint64(1)).
+			Return(&domain.Provider{Id: 1, Urn: "test", Type: "cortex", Host: "http://localhost:8080"}, nil).Once()
+		receiverMock.On("ListReceivers").Return([]*domain.Receiver{{Id: 1, Type: "slack",
+			Configurations: map[string]interface{}{"token": "xoxb"}}}, nil).Once()
+		amClientMock.On("SyncAlertmanagerConfig", mock.Anything, "dummy", mock.AnythingOfType("alertmanager.AMConfig")).
+			Run(func(args mock.Arguments) {
+				r := args.Get(2).(alertmanager.AMConfig)
+				assert.Equal(t, 1, len(r.Receivers))
+				assert.Equal(t, []string{"alertname"}, r.GroupBy)
+				assert.Equal(t, "1m", r.GroupWait)
+			}).Return(nil).Once()
+
+		dbmock.ExpectQuery(countSubscriptionsQuery).WithArgs(1).WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(1))
+		dbmock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "subscriptions" WHERE namespace_id = 1`)).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "urn", "namespace_id", "receiver", "match"}).
+				AddRow(1, "foo", 1, json.RawMessage(`[{"id":1 ,"configuration": {"channel_name": "test"}}]`),
+					json.RawMessage(`{"foo": "bar"}`)))
+		dbmock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "inhibition_rules" WHERE namespace_id = 1`)).
+			WillReturnRows(sqlmock.NewRows(nil))
+		dbmock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "time_intervals" WHERE namespace_id = 1`)).
+			WillReturnRows(sqlmock.NewRows(nil))
+
+		err := syncer.SyncNamespace(db, namespace)
+		assert.Nil(t, err)
+		assert.Nil(t, dbmock.ExpectationsWereMet())
+		providerMock.AssertExpectations(t)
+		amClientMock.AssertExpectations(t)
+	})
+
+	t.Run("should not sync a namespace without subscriptions, inhibition rules or time intervals", func(t *testing.T) {
+		db, dbmock, _ := mocks.NewStore()
+		providerMock := &mocks.ProviderService{}
+		receiverMock := &mocks.ReceiverService{}
+		syncer := NamespaceSyncer{NewRepository(db, adapter.NewRegistry()), providerMock, receiverMock}
+
+		for _, query := range []string{countSubscriptionsQuery, countInhibitionRulesQuery, countTimeIntervalsQuery} {
+			dbmock.ExpectQuery(query).WithArgs(1).WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(0))
+		}
+
+		err := syncer.SyncNamespace(db, namespace)
+		assert.Nil(t, err)
+		assert.Nil(t, dbmock.ExpectationsWereMet())
+		providerMock.AssertNotCalled(t, "GetProvider", mock.Anything)
+	})
+}
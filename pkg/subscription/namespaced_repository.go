@@ -0,0 +1,141 @@
+package subscription
+
+import (
+	"fmt"
+	"github.com/odpf/siren/domain"
+	"github.com/pkg/errors"
+	"gorm.io/gorm"
+)
+
+// namespacedResource is a resource of a namespace rendered into the
+// alertmanager config of the namespace, as inhibition rules and time intervals
+type namespacedResource interface {
+	getId() uint64
+	getNamespaceId() uint64
+}
+
+// namespacedRepository talks to the store to read or insert the resources of
+// namespaces, syncing the alertmanager config of their namespace along with
+// its subscriptions
+type namespacedRepository struct {
+	db            *gorm.DB
+	subscriptions Repository
+	// name is the name of the resource in errors
+	name string
+	// columns are the columns replaced by an update
+	columns []string
+	// newResource returns an empty resource to read into
+	newResource func() namespacedResource
+}
+
+// list reads the resources of a namespace, or of all namespaces if zero,
+// into the slice pointed to by resources
+func (r namespacedRepository) list(namespaceId uint64, resources interface{}) error {
+	query := r.db
+	if namespaceId != 0 {
+		query = query.Where(fmt.Sprintf("namespace_id = %d", namespaceId))
+	}
+	result := query.Find(resources)
+	return result.Error
+}
+
+func (r namespacedRepository) create(resource namespacedResource, namespaceService domain.NamespaceService,
+	providerService domain.ProviderService, receiverService domain.ReceiverService) (namespacedResource, error) {
+	newResource := r.newResource()
+	createError := r.db.Transaction(func(tx *gorm.DB) error {
+		result := tx.Create(resource)
+		if result.Error != nil {
+			return errors.Wrapf(result.Error, "failed to insert %s", r.name)
+		}
+		result = tx.Where(fmt.Sprintf("id = %d", resource.getId())).Find(newResource)
+		if result.Error != nil {
+			return errors.Wrapf(result.Error, "failed to get newly inserted %s", r.name)
+		}
+		return r.subscriptions.syncInUpstreamCurrentSubscriptionsOfNamespace(tx, newResource.getNamespaceId(),
+			namespaceService, providerService, receiverService)
+	})
+	if createError != nil {
+		return nil, createError
+	}
+	return newResource, nil
+}
+
+// get returns nil if the resource does not exist
+func (r namespacedRepository) get(id uint64) (namespacedResource, error) {
+	resource := r.newResource()
+	result := r.db.Where(fmt.Sprintf("id = %d", id)).Find(resource)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	if result.RowsAffected == 0 {
+		return nil, nil
+	}
+	return resource, nil
+}
+
+// update replaces the columns of a resource, syncing the namespace it is
+// moved out of as well
+func (r namespacedRepository) update(resource namespacedResource, namespaceService domain.NamespaceService,
+	providerService domain.ProviderService, receiverService domain.ReceiverService) (namespacedResource, error) {
+	existingResource, newResource := r.newResource(), r.newResource()
+	updateError := r.db.Transaction(func(tx *gorm.DB) error {
+		result := tx.Where(fmt.Sprintf("id = %d", resource.getId())).Find(existingResource)
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return errors.Errorf("%s doesn't exist", r.name)
+		}
+		result = tx.Where("id = ?", resource.getId()).
+			Select(r.columns).
+			Updates(resource)
+		if result.Error != nil {
+			return result.Error
+		}
+		result = tx.Where(fmt.Sprintf("id = %d", resource.getId())).Find(newResource)
+		if result.Error != nil {
+			return result.Error
+		}
+		if existingResource.getNamespaceId() != newResource.getNamespaceId() {
+			err := r.subscriptions.syncInUpstreamCurrentSubscriptionsOfNamespace(tx, existingResource.getNamespaceId(),
+				namespaceService, providerService, receiverService)
+			if err != nil {
+				return err
+			}
+		}
+		return r.subscriptions.syncInUpstreamCurrentSubscriptionsOfNamespace(tx, newResource.getNamespaceId(),
+			namespaceService, providerService, receiverService)
+	})
+	if updateError != nil {
+		return nil, updateError
+	}
+	return newResource, nil
+}
+
+func (r namespacedRepository) delete(id uint64, namespaceService domain.NamespaceService,
+	providerService domain.ProviderService, receiverService domain.ReceiverService) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		resource := r.newResource()
+		result := tx.Where(fmt.Sprintf("id = %d", id)).Find(resource)
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return nil
+		}
+		result = tx.Delete(r.newResource(), id)
+		if result.Error != nil {
+			return errors.Wrapf(result.Error, "failed to delete %s", r.name)
+		}
+		return r.subscriptions.syncInUpstreamCurrentSubscriptionsOfNamespace(tx, resource.getNamespaceId(),
+			namespaceService, providerService, receiverService)
+	})
+}
+
+func (r namespacedRepository) migrate() error {
+	err := r.db.AutoMigrate(r.newResource())
+	if err != nil {
+		return err
+	}
+	return nil
+}
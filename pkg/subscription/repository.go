@@ -1,9 +1,10 @@
 package subscription
 
 import (
+	"context"
 	"fmt"
 	"github.com/odpf/siren/domain"
-	"github.com/odpf/siren/pkg/subscription/alertmanager"
+	"github.com/odpf/siren/pkg/adapter"
 	"github.com/pkg/errors"
 	"gorm.io/gorm"
 	"sort"
@@ -12,12 +13,12 @@ import (
 // Repository talks to the store to read or insert data
 type Repository struct {
 	db       *gorm.DB
-	amClient alertmanager.Client
+	adapters *adapter.Registry
 }
 
 // NewRepository returns repository struct
-func NewRepository(db *gorm.DB) *Repository {
-	return &Repository{db, nil}
+func NewRepository(db *gorm.DB, adapters *adapter.Registry) *Repository {
+	return &Repository{db, adapters}
 }
 
 func (r Repository) List() ([]*Subscription, error) {
@@ -66,7 +67,7 @@ func (r Repository) Update(sub *Subscription, namespaceService domain.NamespaceS
 	providerService domain.ProviderService, receiverService domain.ReceiverService) (*Subscription, error) {
 	var newSubscription, existingSubscription Subscription
 	updateError := r.db.Transaction(func(tx *gorm.DB) error {
-		result := r.db.Where(fmt.Sprintf("id = %d", sub.Id)).Find(&existingSubscription)
+		result := tx.Where(fmt.Sprintf("id = %d", sub.Id)).Find(&existingSubscription)
 		if result.Error != nil {
 			return result.Error
 		}
@@ -74,16 +75,27 @@ func (r Repository) Update(sub *Subscription, namespaceService domain.NamespaceS
 			return errors.New("subscription doesn't exist")
 		} else {
 			sortReceivers(sub)
-			result = r.db.Where("id = ?", sub.Id).Updates(sub)
+			result = tx.Where("id = ?", sub.Id).
+				Select("namespace_id", "urn", "receiver", "match", "matchers",
+					"group_by", "group_wait", "group_interval", "repeat_interval",
+					"mute_time_intervals", "updated_at").
+				Updates(sub)
 			if result.Error != nil {
 				return result.Error
 			}
 		}
-		result = r.db.Where(fmt.Sprintf("id = %d", sub.Id)).Find(&newSubscription)
+		result = tx.Where(fmt.Sprintf("id = %d", sub.Id)).Find(&newSubscription)
 		if result.Error != nil {
 			return result.Error
 		}
-		return r.syncInUpstreamCurrentSubscriptionsOfNamespace(tx, sub.NamespaceId, namespaceService,
+		if existingSubscription.NamespaceId != newSubscription.NamespaceId {
+			err := r.syncInUpstreamCurrentSubscriptionsOfNamespace(tx, existingSubscription.NamespaceId,
+				namespaceService, providerService, receiverService)
+			if err != nil {
+				return err
+			}
+		}
+		return r.syncInUpstreamCurrentSubscriptionsOfNamespace(tx, newSubscription.NamespaceId, namespaceService,
 			providerService, receiverService)
 	})
 	if updateError != nil {
@@ -121,17 +133,35 @@ func (r Repository) Migrate() error {
 	return nil
 }
 
-var alertmanagerClientCreator = alertmanager.NewClient
-
 func (r Repository) syncInUpstreamCurrentSubscriptionsOfNamespace(tx *gorm.DB, namespaceId uint64, namespaceService domain.NamespaceService,
 	providerService domain.ProviderService, receiverService domain.ReceiverService) error {
+	return r.syncInUpstreamSubscriptionsOfNamespace(tx, namespaceId, namespaceService.GetNamespace,
+		providerService, receiverService)
+}
+
+// syncInUpstreamSubscriptionsOfNamespace syncs the alertmanager config of the
+// namespace returned by getNamespace
+func (r Repository) syncInUpstreamSubscriptionsOfNamespace(tx *gorm.DB, namespaceId uint64,
+	getNamespace func(uint64) (*domain.Namespace, error), providerService domain.ProviderService,
+	receiverService domain.ReceiverService) error {
 	// fetch all subscriptions in this namespace.
 	subscriptionsInNamespace, err := r.getAllSubscriptionsWithinNamespace(tx, namespaceId)
 	if err != nil {
 		return errors.Wrap(err, "r.getAllSubscriptionsWithinNamespace")
 	}
+	inhibitionRulesInNamespace, err := r.getAllInhibitionRulesWithinNamespace(tx, namespaceId)
+	if err != nil {
+		return errors.Wrap(err, "r.getAllInhibitionRulesWithinNamespace")
+	}
+	timeIntervalsInNamespace, err := r.getAllTimeIntervalsWithinNamespace(tx, namespaceId)
+	if err != nil {
+		return errors.Wrap(err, "r.getAllTimeIntervalsWithinNamespace")
+	}
+	if err := checkTimeIntervalReferences(subscriptionsInNamespace, timeIntervalsInNamespace); err != nil {
+		return err
+	}
 	// check provider type of the namespace
-	providerInfo, namespaceInfo, err := r.getProviderAndNamespaceInfoFromNamespaceId(namespaceId, namespaceService, providerService)
+	providerInfo, namespaceInfo, err := r.getProviderAndNamespaceInfoFromNamespaceId(namespaceId, getNamespace, providerService)
 	if err != nil {
 		return errors.Wrap(err, "r.getProviderAndNamespaceInfoFromNamespaceId")
 	}
@@ -140,27 +170,26 @@ func (r Repository) syncInUpstreamCurrentSubscriptionsOfNamespace(tx *gorm.DB, n
 		return errors.Wrap(err, "r.addReceiversConfiguration")
 	}
 	// do upstream call to create subscriptions as per provider type
-	switch providerInfo.Type {
-	case "cortex":
-		amConfig := getAmConfigFromSubscriptions(subscriptionsInNamespaceEnrichedWithReceivers)
-		newAMClient, err := alertmanagerClientCreator(domain.CortexConfig{Address: providerInfo.Host})
-		if err != nil {
-			return errors.Wrap(err, "alertmanagerClientCreator: ")
-		}
-		r.amClient = newAMClient
-		err = r.amClient.SyncConfig(amConfig, namespaceInfo.Urn)
-		if err != nil {
-			return errors.Wrap(err, "r.amClient.SyncConfig")
-		}
-	default:
+	newAdapter, ok := r.adapters.Get(providerInfo.Type)
+	if !ok {
 		return errors.New(fmt.Sprintf("subscriptions for provider type '%s' not supported", providerInfo.Type))
 	}
+	amConfig := getAmConfigFromSubscriptions(subscriptionsInNamespaceEnrichedWithReceivers, namespaceInfo.Routing,
+		inhibitionRulesInNamespace, timeIntervalsInNamespace)
+	providerAdapter, err := newAdapter(providerInfo.Urn, providerInfo.Host)
+	if err != nil {
+		return errors.Wrap(err, "newAdapter")
+	}
+	err = providerAdapter.SyncAlertmanagerConfig(context.Background(), namespaceInfo.Urn, amConfig)
+	if err != nil {
+		return errors.Wrap(err, "providerAdapter.SyncAlertmanagerConfig")
+	}
 	return nil
 }
 
-func (r Repository) getProviderAndNamespaceInfoFromNamespaceId(id uint64, namespaceService domain.NamespaceService,
+func (r Repository) getProviderAndNamespaceInfoFromNamespaceId(id uint64, getNamespace func(uint64) (*domain.Namespace, error),
 	providerService domain.ProviderService) (*domain.Provider, *domain.Namespace, error) {
-	namespaceInfo, err := namespaceService.GetNamespace(id)
+	namespaceInfo, err := getNamespace(id)
 	if err != nil {
 		return nil, nil, errors.Wrap(err, "failed to get namespace details")
 	}
@@ -201,6 +230,24 @@ func (r Repository) getAllSubscriptionsWithinNamespace(tx *gorm.DB, id uint64) (
 	return subscriptionsInNamespace, nil
 }
 
+func (r Repository) getAllInhibitionRulesWithinNamespace(tx *gorm.DB, id uint64) ([]InhibitionRule, error) {
+	var inhibitionRulesInNamespace []InhibitionRule
+	result := tx.Where(fmt.Sprintf("namespace_id = %d", id)).Find(&inhibitionRulesInNamespace)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	return inhibitionRulesInNamespace, nil
+}
+
+func (r Repository) getAllTimeIntervalsWithinNamespace(tx *gorm.DB, id uint64) ([]TimeInterval, error) {
+	var timeIntervalsInNamespace []TimeInterval
+	result := tx.Where(fmt.Sprintf("namespace_id = %d", id)).Find(&timeIntervalsInNamespace)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	return timeIntervalsInNamespace, nil
+}
+
 func (r Repository) deleteSubscriptionFromDB(tx *gorm.DB, id uint64) error {
 	result := tx.Delete(Subscription{}, id)
 	return result.Error
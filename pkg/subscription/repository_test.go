@@ -8,6 +8,7 @@ import (
 	"github.com/DATA-DOG/go-sqlmock"
 	"github.com/odpf/siren/domain"
 	"github.com/odpf/siren/mocks"
+	"github.com/odpf/siren/pkg/adapter"
 	"github.com/odpf/siren/pkg/subscription/alertmanager"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/suite"
@@ -30,13 +31,15 @@ type RepositoryTestSuite struct {
 	sqldb      *sql.DB
 	dbmock     sqlmock.Sqlmock
 	repository SubscriptionRepository
+	adapters   *adapter.Registry
 }
 
 func (s *RepositoryTestSuite) SetupTest() {
 	db, mock, _ := mocks.NewStore()
 	s.sqldb, _ = db.DB()
 	s.dbmock = mock
-	s.repository = NewRepository(db)
+	s.adapters = adapter.NewRegistry()
+	s.repository = NewRepository(db, s.adapters)
 }
 
 func (s *RepositoryTestSuite) TearDownTest() {
@@ -69,15 +72,17 @@ func (s *RepositoryTestSuite) TestCreate() {
 	dummyNamespace := &domain.Namespace{Id: 1, Provider: 1, Urn: "dummy"}
 	dummyProvider := &domain.Provider{Id: 1, Urn: "test", Type: "cortex", Host: "http://localhost:8080"}
 
-	insertQuery := regexp.QuoteMeta(`INSERT INTO "subscriptions" ("namespace_id","urn","receiver","match","created_at","updated_at","id") VALUES ($1,$2,$3,$4,$5,$6,$7) RETURNING "id"`)
+	insertQuery := regexp.QuoteMeta(`INSERT INTO "subscriptions" ("namespace_id","urn","receiver","match","matchers","group_by","group_wait","group_interval","repeat_interval","mute_time_intervals","created_at","updated_at","id") VALUES ($1,$2,$3,$4,$5,$6,$7,$8,$9,$10,$11,$12,$13) RETURNING "id"`)
 	fetchLastInsertedQuery := regexp.QuoteMeta(`SELECT * FROM "subscriptions" WHERE id = 1`)
 	fetchSubscriptionsWithinNamespaceQuery := regexp.QuoteMeta(`SELECT * FROM "subscriptions" WHERE namespace_id = 1`)
+	fetchInhibitionRulesWithinNamespaceQuery := regexp.QuoteMeta(`SELECT * FROM "inhibition_rules" WHERE namespace_id = 1`)
+	fetchTimeIntervalsWithinNamespaceQuery := regexp.QuoteMeta(`SELECT * FROM "time_intervals" WHERE namespace_id = 1`)
 
 	s.Run("should create a subscription", func() {
 		providerMock := &mocks.ProviderService{}
 		namespaceMock := &mocks.NamespaceService{}
 		receiverMock := &mocks.ReceiverService{}
-		amClientMock := &ClientMock{}
+		amClientMock := &adapter.AdapterMock{}
 		dummySlackReceivers := &domain.Receiver{Id: 1, Type: "slack", Configurations: randomSlackReceiverConfig}
 		dummyPagerdutyReceivers := &domain.Receiver{Id: 2, Type: "pagerduty", Configurations: randomPagerdutyReceiverConfig}
 		dummyHTTPReceivers := &domain.Receiver{Id: 3, Type: "http", Configurations: randomHTTPReceiverConfig}
@@ -85,8 +90,8 @@ func (s *RepositoryTestSuite) TestCreate() {
 		namespaceMock.On("GetNamespace", uint64(1)).Return(dummyNamespace, nil).Once()
 		receiverMock.On("ListReceivers").Return(
 			[]*domain.Receiver{dummySlackReceivers, dummyPagerdutyReceivers, dummyHTTPReceivers}, nil).Once()
-		amClientMock.On("SyncConfig", mock.AnythingOfType("alertmanager.AMConfig"), "dummy").Run(func(args mock.Arguments) {
-			rarg := args.Get(0)
+		amClientMock.On("SyncAlertmanagerConfig", mock.Anything, "dummy", mock.AnythingOfType("alertmanager.AMConfig")).Run(func(args mock.Arguments) {
+			rarg := args.Get(2)
 			s.Require().IsType(alertmanager.AMConfig{}, rarg)
 			r := rarg.(alertmanager.AMConfig)
 			s.Equal(3, len(r.Receivers))
@@ -95,15 +100,15 @@ func (s *RepositoryTestSuite) TestCreate() {
 			s.Equal("baz_receiverId_3_idx_0", r.Receivers[2].Receiver)
 		}).Return(nil).Once()
 
-		oldAMClientCreator := alertmanagerClientCreator
-		defer func() { alertmanagerClientCreator = oldAMClientCreator }()
-		alertmanagerClientCreator = func(c domain.CortexConfig) (alertmanager.Client, error) {
+		s.adapters.Register("cortex", func(string, string) (adapter.Adapter, error) {
 			return amClientMock, nil
-		}
+		})
 
 		s.dbmock.ExpectBegin()
 		s.dbmock.ExpectQuery(insertQuery).WithArgs(expectedSubscription.NamespaceId, expectedSubscription.Urn,
-			expectedSubscription.Receiver, expectedSubscription.Match, expectedSubscription.CreatedAt,
+			expectedSubscription.Receiver, expectedSubscription.Match, expectedSubscription.Matchers, expectedSubscription.GroupBy,
+			expectedSubscription.GroupWait, expectedSubscription.GroupInterval, expectedSubscription.RepeatInterval,
+			expectedSubscription.MuteTimeIntervals, expectedSubscription.CreatedAt,
 			expectedSubscription.UpdatedAt, expectedSubscription.Id).WillReturnRows(sqlmock.NewRows(nil))
 
 		expectedRows := sqlmock.
@@ -126,6 +131,8 @@ func (s *RepositoryTestSuite) TestCreate() {
 
 		s.dbmock.ExpectQuery(fetchLastInsertedQuery).WillReturnRows(expectedRows)
 		s.dbmock.ExpectQuery(fetchSubscriptionsWithinNamespaceQuery).WillReturnRows(expectedRowsInNamespace)
+		s.dbmock.ExpectQuery(fetchInhibitionRulesWithinNamespaceQuery).WillReturnRows(sqlmock.NewRows(nil))
+		s.dbmock.ExpectQuery(fetchTimeIntervalsWithinNamespaceQuery).WillReturnRows(sqlmock.NewRows(nil))
 		s.dbmock.ExpectCommit()
 
 		actualSubscription, err := s.repository.Create(expectedSubscription, namespaceMock, providerMock, receiverMock)
@@ -140,7 +147,9 @@ func (s *RepositoryTestSuite) TestCreate() {
 
 		s.dbmock.ExpectBegin()
 		s.dbmock.ExpectQuery(insertQuery).WithArgs(expectedSubscription.NamespaceId, expectedSubscription.Urn,
-			expectedSubscription.Receiver, expectedSubscription.Match, expectedSubscription.CreatedAt,
+			expectedSubscription.Receiver, expectedSubscription.Match, expectedSubscription.Matchers, expectedSubscription.GroupBy,
+			expectedSubscription.GroupWait, expectedSubscription.GroupInterval, expectedSubscription.RepeatInterval,
+			expectedSubscription.MuteTimeIntervals, expectedSubscription.CreatedAt,
 			expectedSubscription.UpdatedAt, expectedSubscription.Id).WillReturnError(errors.New("random error"))
 		s.dbmock.ExpectRollback()
 
@@ -156,7 +165,9 @@ func (s *RepositoryTestSuite) TestCreate() {
 
 		s.dbmock.ExpectBegin()
 		s.dbmock.ExpectQuery(insertQuery).WithArgs(expectedSubscription.NamespaceId, expectedSubscription.Urn,
-			expectedSubscription.Receiver, expectedSubscription.Match, expectedSubscription.CreatedAt,
+			expectedSubscription.Receiver, expectedSubscription.Match, expectedSubscription.Matchers, expectedSubscription.GroupBy,
+			expectedSubscription.GroupWait, expectedSubscription.GroupInterval, expectedSubscription.RepeatInterval,
+			expectedSubscription.MuteTimeIntervals, expectedSubscription.CreatedAt,
 			expectedSubscription.UpdatedAt, expectedSubscription.Id).WillReturnRows(sqlmock.NewRows(nil))
 		s.dbmock.ExpectQuery(fetchLastInsertedQuery).WillReturnError(errors.New("random error"))
 		s.dbmock.ExpectRollback()
@@ -173,7 +184,9 @@ func (s *RepositoryTestSuite) TestCreate() {
 
 		s.dbmock.ExpectBegin()
 		s.dbmock.ExpectQuery(insertQuery).WithArgs(expectedSubscription.NamespaceId, expectedSubscription.Urn,
-			expectedSubscription.Receiver, expectedSubscription.Match, expectedSubscription.CreatedAt,
+			expectedSubscription.Receiver, expectedSubscription.Match, expectedSubscription.Matchers, expectedSubscription.GroupBy,
+			expectedSubscription.GroupWait, expectedSubscription.GroupInterval, expectedSubscription.RepeatInterval,
+			expectedSubscription.MuteTimeIntervals, expectedSubscription.CreatedAt,
 			expectedSubscription.UpdatedAt, expectedSubscription.Id).WillReturnRows(sqlmock.NewRows(nil))
 		expectedRows := sqlmock.
 			NewRows([]string{"urn", "namespace_id", "receiver", "match", "created_at", "updated_at", "id"}).
@@ -189,6 +202,155 @@ func (s *RepositoryTestSuite) TestCreate() {
 		s.Nil(actualSubscription)
 	})
 
+	s.Run("should return error in fetching all inhibition rules within given namespace", func() {
+		providerMock := &mocks.ProviderService{}
+		namespaceMock := &mocks.NamespaceService{}
+		receiverMock := &mocks.ReceiverService{}
+
+		s.dbmock.ExpectBegin()
+		s.dbmock.ExpectQuery(insertQuery).WithArgs(expectedSubscription.NamespaceId, expectedSubscription.Urn,
+			expectedSubscription.Receiver, expectedSubscription.Match, expectedSubscription.Matchers, expectedSubscription.GroupBy,
+			expectedSubscription.GroupWait, expectedSubscription.GroupInterval, expectedSubscription.RepeatInterval,
+			expectedSubscription.MuteTimeIntervals, expectedSubscription.CreatedAt,
+			expectedSubscription.UpdatedAt, expectedSubscription.Id).WillReturnRows(sqlmock.NewRows(nil))
+		expectedRows := sqlmock.
+			NewRows([]string{"urn", "namespace_id", "receiver", "match", "created_at", "updated_at", "id"}).
+			AddRow(expectedSubscription.Urn, expectedSubscription.NamespaceId,
+				json.RawMessage(`[{"id":1 ,"configuration": {"channel_name": "test"}}, {"id":2 ,"configuration": {}}]`),
+				json.RawMessage(`{"foo": "bar"}`), expectedSubscription.CreatedAt, expectedSubscription.UpdatedAt, expectedSubscription.Id)
+		s.dbmock.ExpectQuery(fetchLastInsertedQuery).WillReturnRows(expectedRows)
+		s.dbmock.ExpectQuery(fetchSubscriptionsWithinNamespaceQuery).WillReturnRows(sqlmock.NewRows(nil))
+		s.dbmock.ExpectQuery(fetchInhibitionRulesWithinNamespaceQuery).WillReturnError(errors.New("random error"))
+		s.dbmock.ExpectRollback()
+
+		actualSubscription, err := s.repository.Create(expectedSubscription, namespaceMock, providerMock, receiverMock)
+		s.EqualError(err, "r.getAllInhibitionRulesWithinNamespace: random error")
+		s.Nil(actualSubscription)
+	})
+
+	s.Run("should return error if a subscription refers to a time interval missing from the namespace", func() {
+		providerMock := &mocks.ProviderService{}
+		namespaceMock := &mocks.NamespaceService{}
+		receiverMock := &mocks.ReceiverService{}
+
+		s.dbmock.ExpectBegin()
+		s.dbmock.ExpectQuery(insertQuery).WillReturnRows(sqlmock.NewRows(nil))
+		expectedRows := func() *sqlmock.Rows {
+			return sqlmock.NewRows([]string{"urn", "namespace_id", "mute_time_intervals", "id"}).
+				AddRow(expectedSubscription.Urn, expectedSubscription.NamespaceId, json.RawMessage(`["weekends"]`),
+					expectedSubscription.Id)
+		}
+		s.dbmock.ExpectQuery(fetchLastInsertedQuery).WillReturnRows(expectedRows())
+		s.dbmock.ExpectQuery(fetchSubscriptionsWithinNamespaceQuery).WillReturnRows(expectedRows())
+		s.dbmock.ExpectQuery(fetchInhibitionRulesWithinNamespaceQuery).WillReturnRows(sqlmock.NewRows(nil))
+		s.dbmock.ExpectQuery(fetchTimeIntervalsWithinNamespaceQuery).WillReturnRows(
+			sqlmock.NewRows([]string{"id", "namespace_id", "urn"}).AddRow(1, 1, "business-hours"))
+		s.dbmock.ExpectRollback()
+
+		actualSubscription, err := s.repository.Create(expectedSubscription, namespaceMock, providerMock, receiverMock)
+		var invalidTimeIntervalErr *domain.InvalidTimeIntervalError
+		s.True(errors.As(err, &invalidTimeIntervalErr))
+		s.EqualError(err, "invalid time interval: subscription foo refers to time interval weekends missing from namespace 1")
+		s.Nil(actualSubscription)
+	})
+
+	s.Run("should mute subscriptions in their time intervals and the ones muting the namespace", func() {
+		providerMock := &mocks.ProviderService{}
+		namespaceMock := &mocks.NamespaceService{}
+		receiverMock := &mocks.ReceiverService{}
+		amClientMock := &adapter.AdapterMock{}
+		dummySlackReceivers := &domain.Receiver{Id: 1, Type: "slack", Configurations: randomSlackReceiverConfig}
+		providerMock.On("GetProvider", uint64(1)).Return(dummyProvider, nil).Once()
+		namespaceMock.On("GetNamespace", uint64(1)).Return(dummyNamespace, nil).Once()
+		receiverMock.On("ListReceivers").Return([]*domain.Receiver{dummySlackReceivers}, nil).Once()
+		amClientMock.On("SyncAlertmanagerConfig", mock.Anything, "dummy", mock.AnythingOfType("alertmanager.AMConfig")).Run(func(args mock.Arguments) {
+			r := args.Get(2).(alertmanager.AMConfig)
+			s.Equal(1, len(r.Receivers))
+			s.Equal([]string{"business-hours", "weekends"}, r.Receivers[0].MuteTimeIntervals)
+			s.Equal(2, len(r.TimeIntervals))
+		}).Return(nil).Once()
+		s.adapters.Register("cortex", func(string, string) (adapter.Adapter, error) {
+			return amClientMock, nil
+		})
+
+		s.dbmock.ExpectBegin()
+		s.dbmock.ExpectQuery(insertQuery).WillReturnRows(sqlmock.NewRows(nil))
+		expectedRows := func() *sqlmock.Rows {
+			return sqlmock.NewRows([]string{"urn", "namespace_id", "receiver", "mute_time_intervals", "id"}).
+				AddRow(expectedSubscription.Urn, expectedSubscription.NamespaceId,
+					json.RawMessage(`[{"id":1 ,"configuration": {"channel_name": "test"}}]`),
+					json.RawMessage(`["business-hours"]`), expectedSubscription.Id)
+		}
+		s.dbmock.ExpectQuery(fetchLastInsertedQuery).WillReturnRows(expectedRows())
+		s.dbmock.ExpectQuery(fetchSubscriptionsWithinNamespaceQuery).WillReturnRows(expectedRows())
+		s.dbmock.ExpectQuery(fetchInhibitionRulesWithinNamespaceQuery).WillReturnRows(sqlmock.NewRows(nil))
+		s.dbmock.ExpectQuery(fetchTimeIntervalsWithinNamespaceQuery).WillReturnRows(
+			sqlmock.NewRows([]string{"id", "namespace_id", "urn", "periods", "mute_namespace"}).
+				AddRow(1, 1, "business-hours", json.RawMessage(`[{"weekdays":["monday:friday"]}]`), false).
+				AddRow(2, 1, "weekends", json.RawMessage(`[{"weekdays":["saturday","sunday"]}]`), true))
+		s.dbmock.ExpectCommit()
+
+		actualSubscription, err := s.repository.Create(expectedSubscription, namespaceMock, providerMock, receiverMock)
+		s.Nil(err)
+		s.Equal(StringList{"business-hours"}, actualSubscription.MuteTimeIntervals)
+		amClientMock.AssertExpectations(s.T())
+	})
+
+	s.Run("should sync the inhibition rules of the namespace with the subscriptions", func() {
+		providerMock := &mocks.ProviderService{}
+		namespaceMock := &mocks.NamespaceService{}
+		receiverMock := &mocks.ReceiverService{}
+		amClientMock := &adapter.AdapterMock{}
+		dummySlackReceivers := &domain.Receiver{Id: 1, Type: "slack", Configurations: randomSlackReceiverConfig}
+		providerMock.On("GetProvider", uint64(1)).Return(dummyProvider, nil).Once()
+		namespaceMock.On("GetNamespace", uint64(1)).Return(dummyNamespace, nil).Once()
+		receiverMock.On("ListReceivers").Return([]*domain.Receiver{dummySlackReceivers}, nil).Once()
+		amClientMock.On("SyncAlertmanagerConfig", mock.Anything, "dummy", mock.AnythingOfType("alertmanager.AMConfig")).Run(func(args mock.Arguments) {
+			r := args.Get(2).(alertmanager.AMConfig)
+			s.Equal(1, len(r.Receivers))
+			s.Equal([]alertmanager.AMInhibitRule{{
+				SourceMatchers: []alertmanager.AMMatcher{{Name: "alertname", Operator: "=", Value: "ClusterDown"}},
+				TargetMatchers: []alertmanager.AMMatcher{{Name: "alertname", Operator: "=~", Value: "Pod.*"}},
+				Equal:          []string{"cluster"},
+			}}, r.InhibitRules)
+		}).Return(nil).Once()
+		s.adapters.Register("cortex", func(string, string) (adapter.Adapter, error) {
+			return amClientMock, nil
+		})
+
+		s.dbmock.ExpectBegin()
+		s.dbmock.ExpectQuery(insertQuery).WithArgs(expectedSubscription.NamespaceId, expectedSubscription.Urn,
+			expectedSubscription.Receiver, expectedSubscription.Match, expectedSubscription.Matchers, expectedSubscription.GroupBy,
+			expectedSubscription.GroupWait, expectedSubscription.GroupInterval, expectedSubscription.RepeatInterval,
+			expectedSubscription.MuteTimeIntervals, expectedSubscription.CreatedAt,
+			expectedSubscription.UpdatedAt, expectedSubscription.Id).WillReturnRows(sqlmock.NewRows(nil))
+		expectedRows := sqlmock.
+			NewRows([]string{"urn", "namespace_id", "receiver", "match", "created_at", "updated_at", "id"}).
+			AddRow(expectedSubscription.Urn, expectedSubscription.NamespaceId,
+				json.RawMessage(`[{"id":1 ,"configuration": {"channel_name": "test"}}]`),
+				json.RawMessage(`{"foo": "bar"}`), expectedSubscription.CreatedAt, expectedSubscription.UpdatedAt, expectedSubscription.Id)
+		expectedRowsInNamespace := sqlmock.
+			NewRows([]string{"urn", "namespace_id", "receiver", "match", "created_at", "updated_at", "id"}).
+			AddRow(expectedSubscription.Urn, expectedSubscription.NamespaceId,
+				json.RawMessage(`[{"id":1 ,"configuration": {"channel_name": "test"}}]`),
+				json.RawMessage(`{"foo": "bar"}`), expectedSubscription.CreatedAt, expectedSubscription.UpdatedAt, expectedSubscription.Id)
+		expectedInhibitionRulesInNamespace := sqlmock.
+			NewRows([]string{"id", "namespace_id", "urn", "source_matchers", "target_matchers", "equal"}).
+			AddRow(1, 1, "cluster-down",
+				json.RawMessage(`[{"name":"alertname","operator":"=","value":"ClusterDown"}]`),
+				json.RawMessage(`[{"name":"alertname","operator":"=~","value":"Pod.*"}]`),
+				json.RawMessage(`["cluster"]`))
+		s.dbmock.ExpectQuery(fetchLastInsertedQuery).WillReturnRows(expectedRows)
+		s.dbmock.ExpectQuery(fetchSubscriptionsWithinNamespaceQuery).WillReturnRows(expectedRowsInNamespace)
+		s.dbmock.ExpectQuery(fetchInhibitionRulesWithinNamespaceQuery).WillReturnRows(expectedInhibitionRulesInNamespace)
+		s.dbmock.ExpectQuery(fetchTimeIntervalsWithinNamespaceQuery).WillReturnRows(sqlmock.NewRows(nil))
+		s.dbmock.ExpectCommit()
+
+		_, err := s.repository.Create(expectedSubscription, namespaceMock, providerMock, receiverMock)
+		s.Nil(err)
+		amClientMock.AssertExpectations(s.T())
+	})
+
 	s.Run("should return error in fetching namespace details", func() {
 		providerMock := &mocks.ProviderService{}
 		namespaceMock := &mocks.NamespaceService{}
@@ -198,7 +360,9 @@ func (s *RepositoryTestSuite) TestCreate() {
 
 		s.dbmock.ExpectBegin()
 		s.dbmock.ExpectQuery(insertQuery).WithArgs(expectedSubscription.NamespaceId, expectedSubscription.Urn,
-			expectedSubscription.Receiver, expectedSubscription.Match, expectedSubscription.CreatedAt,
+			expectedSubscription.Receiver, expectedSubscription.Match, expectedSubscription.Matchers, expectedSubscription.GroupBy,
+			expectedSubscription.GroupWait, expectedSubscription.GroupInterval, expectedSubscription.RepeatInterval,
+			expectedSubscription.MuteTimeIntervals, expectedSubscription.CreatedAt,
 			expectedSubscription.UpdatedAt, expectedSubscription.Id).WillReturnRows(sqlmock.NewRows(nil))
 
 		expectedRows := sqlmock.
@@ -221,6 +385,8 @@ func (s *RepositoryTestSuite) TestCreate() {
 
 		s.dbmock.ExpectQuery(fetchLastInsertedQuery).WillReturnRows(expectedRows)
 		s.dbmock.ExpectQuery(fetchSubscriptionsWithinNamespaceQuery).WillReturnRows(expectedRowsInNamespace)
+		s.dbmock.ExpectQuery(fetchInhibitionRulesWithinNamespaceQuery).WillReturnRows(sqlmock.NewRows(nil))
+		s.dbmock.ExpectQuery(fetchTimeIntervalsWithinNamespaceQuery).WillReturnRows(sqlmock.NewRows(nil))
 		s.dbmock.ExpectRollback()
 
 		actualSubscription, err := s.repository.Create(expectedSubscription, namespaceMock, providerMock, receiverMock)
@@ -238,7 +404,9 @@ func (s *RepositoryTestSuite) TestCreate() {
 
 		s.dbmock.ExpectBegin()
 		s.dbmock.ExpectQuery(insertQuery).WithArgs(expectedSubscription.NamespaceId, expectedSubscription.Urn,
-			expectedSubscription.Receiver, expectedSubscription.Match, expectedSubscription.CreatedAt,
+			expectedSubscription.Receiver, expectedSubscription.Match, expectedSubscription.Matchers, expectedSubscription.GroupBy,
+			expectedSubscription.GroupWait, expectedSubscription.GroupInterval, expectedSubscription.RepeatInterval,
+			expectedSubscription.MuteTimeIntervals, expectedSubscription.CreatedAt,
 			expectedSubscription.UpdatedAt, expectedSubscription.Id).WillReturnRows(sqlmock.NewRows(nil))
 
 		expectedRows := sqlmock.
@@ -261,6 +429,8 @@ func (s *RepositoryTestSuite) TestCreate() {
 
 		s.dbmock.ExpectQuery(fetchLastInsertedQuery).WillReturnRows(expectedRows)
 		s.dbmock.ExpectQuery(fetchSubscriptionsWithinNamespaceQuery).WillReturnRows(expectedRowsInNamespace)
+		s.dbmock.ExpectQuery(fetchInhibitionRulesWithinNamespaceQuery).WillReturnRows(sqlmock.NewRows(nil))
+		s.dbmock.ExpectQuery(fetchTimeIntervalsWithinNamespaceQuery).WillReturnRows(sqlmock.NewRows(nil))
 		s.dbmock.ExpectRollback()
 
 		actualSubscription, err := s.repository.Create(expectedSubscription, namespaceMock, providerMock, receiverMock)
@@ -279,7 +449,9 @@ func (s *RepositoryTestSuite) TestCreate() {
 
 		s.dbmock.ExpectBegin()
 		s.dbmock.ExpectQuery(insertQuery).WithArgs(expectedSubscription.NamespaceId, expectedSubscription.Urn,
-			expectedSubscription.Receiver, expectedSubscription.Match, expectedSubscription.CreatedAt,
+			expectedSubscription.Receiver, expectedSubscription.Match, expectedSubscription.Matchers, expectedSubscription.GroupBy,
+			expectedSubscription.GroupWait, expectedSubscription.GroupInterval, expectedSubscription.RepeatInterval,
+			expectedSubscription.MuteTimeIntervals, expectedSubscription.CreatedAt,
 			expectedSubscription.UpdatedAt, expectedSubscription.Id).WillReturnRows(sqlmock.NewRows(nil))
 
 		expectedRows := sqlmock.
@@ -302,6 +474,8 @@ func (s *RepositoryTestSuite) TestCreate() {
 
 		s.dbmock.ExpectQuery(fetchLastInsertedQuery).WillReturnRows(expectedRows)
 		s.dbmock.ExpectQuery(fetchSubscriptionsWithinNamespaceQuery).WillReturnRows(expectedRowsInNamespace)
+		s.dbmock.ExpectQuery(fetchInhibitionRulesWithinNamespaceQuery).WillReturnRows(sqlmock.NewRows(nil))
+		s.dbmock.ExpectQuery(fetchTimeIntervalsWithinNamespaceQuery).WillReturnRows(sqlmock.NewRows(nil))
 		s.dbmock.ExpectRollback()
 
 		actualSubscription, err := s.repository.Create(expectedSubscription, namespaceMock, providerMock, receiverMock)
@@ -321,7 +495,9 @@ func (s *RepositoryTestSuite) TestCreate() {
 
 		s.dbmock.ExpectBegin()
 		s.dbmock.ExpectQuery(insertQuery).WithArgs(expectedSubscription.NamespaceId, expectedSubscription.Urn,
-			expectedSubscription.Receiver, expectedSubscription.Match, expectedSubscription.CreatedAt,
+			expectedSubscription.Receiver, expectedSubscription.Match, expectedSubscription.Matchers, expectedSubscription.GroupBy,
+			expectedSubscription.GroupWait, expectedSubscription.GroupInterval, expectedSubscription.RepeatInterval,
+			expectedSubscription.MuteTimeIntervals, expectedSubscription.CreatedAt,
 			expectedSubscription.UpdatedAt, expectedSubscription.Id).WillReturnRows(sqlmock.NewRows(nil))
 
 		expectedRows := sqlmock.
@@ -344,6 +520,8 @@ func (s *RepositoryTestSuite) TestCreate() {
 
 		s.dbmock.ExpectQuery(fetchLastInsertedQuery).WillReturnRows(expectedRows)
 		s.dbmock.ExpectQuery(fetchSubscriptionsWithinNamespaceQuery).WillReturnRows(expectedRowsInNamespace)
+		s.dbmock.ExpectQuery(fetchInhibitionRulesWithinNamespaceQuery).WillReturnRows(sqlmock.NewRows(nil))
+		s.dbmock.ExpectQuery(fetchTimeIntervalsWithinNamespaceQuery).WillReturnRows(sqlmock.NewRows(nil))
 		s.dbmock.ExpectRollback()
 
 		actualSubscription, err := s.repository.Create(expectedSubscription, namespaceMock, providerMock, receiverMock)
@@ -376,7 +554,9 @@ func (s *RepositoryTestSuite) TestCreate() {
 
 		s.dbmock.ExpectBegin()
 		s.dbmock.ExpectQuery(insertQuery).WithArgs(expectedSubscription.NamespaceId, expectedSubscription.Urn,
-			expectedSubscription.Receiver, expectedSubscription.Match, expectedSubscription.CreatedAt,
+			expectedSubscription.Receiver, expectedSubscription.Match, expectedSubscription.Matchers, expectedSubscription.GroupBy,
+			expectedSubscription.GroupWait, expectedSubscription.GroupInterval, expectedSubscription.RepeatInterval,
+			expectedSubscription.MuteTimeIntervals, expectedSubscription.CreatedAt,
 			expectedSubscription.UpdatedAt, expectedSubscription.Id).WillReturnRows(sqlmock.NewRows(nil))
 
 		expectedRows := sqlmock.
@@ -399,6 +579,8 @@ func (s *RepositoryTestSuite) TestCreate() {
 
 		s.dbmock.ExpectQuery(fetchLastInsertedQuery).WillReturnRows(expectedRows)
 		s.dbmock.ExpectQuery(fetchSubscriptionsWithinNamespaceQuery).WillReturnRows(expectedRowsInNamespace)
+		s.dbmock.ExpectQuery(fetchInhibitionRulesWithinNamespaceQuery).WillReturnRows(sqlmock.NewRows(nil))
+		s.dbmock.ExpectQuery(fetchTimeIntervalsWithinNamespaceQuery).WillReturnRows(sqlmock.NewRows(nil))
 		s.dbmock.ExpectRollback()
 
 		actualSubscription, err := s.repository.Create(expectedSubscription, namespaceMock, providerMock, receiverMock)
@@ -419,7 +601,9 @@ func (s *RepositoryTestSuite) TestCreate() {
 
 		s.dbmock.ExpectBegin()
 		s.dbmock.ExpectQuery(insertQuery).WithArgs(expectedSubscription.NamespaceId, expectedSubscription.Urn,
-			expectedSubscription.Receiver, expectedSubscription.Match, expectedSubscription.CreatedAt,
+			expectedSubscription.Receiver, expectedSubscription.Match, expectedSubscription.Matchers, expectedSubscription.GroupBy,
+			expectedSubscription.GroupWait, expectedSubscription.GroupInterval, expectedSubscription.RepeatInterval,
+			expectedSubscription.MuteTimeIntervals, expectedSubscription.CreatedAt,
 			expectedSubscription.UpdatedAt, expectedSubscription.Id).WillReturnRows(sqlmock.NewRows(nil))
 
 		expectedRows := sqlmock.
@@ -442,6 +626,8 @@ func (s *RepositoryTestSuite) TestCreate() {
 
 		s.dbmock.ExpectQuery(fetchLastInsertedQuery).WillReturnRows(expectedRows)
 		s.dbmock.ExpectQuery(fetchSubscriptionsWithinNamespaceQuery).WillReturnRows(expectedRowsInNamespace)
+		s.dbmock.ExpectQuery(fetchInhibitionRulesWithinNamespaceQuery).WillReturnRows(sqlmock.NewRows(nil))
+		s.dbmock.ExpectQuery(fetchTimeIntervalsWithinNamespaceQuery).WillReturnRows(sqlmock.NewRows(nil))
 		s.dbmock.ExpectRollback()
 
 		actualSubscription, err := s.repository.Create(expectedSubscription, namespaceMock, providerMock, receiverMock)
@@ -453,7 +639,7 @@ func (s *RepositoryTestSuite) TestCreate() {
 		providerMock := &mocks.ProviderService{}
 		namespaceMock := &mocks.NamespaceService{}
 		receiverMock := &mocks.ReceiverService{}
-		amClientMock := &ClientMock{}
+		amClientMock := &adapter.AdapterMock{}
 		dummySlackReceivers := &domain.Receiver{Id: 1, Type: "slack", Configurations: randomSlackReceiverConfig}
 		dummyPagerdutyReceivers := &domain.Receiver{Id: 2, Type: "pagerduty", Configurations: randomPagerdutyReceiverConfig}
 		dummyHTTPReceivers := &domain.Receiver{Id: 3, Type: "http", Configurations: randomHTTPReceiverConfig}
@@ -461,8 +647,8 @@ func (s *RepositoryTestSuite) TestCreate() {
 		namespaceMock.On("GetNamespace", uint64(1)).Return(dummyNamespace, nil).Once()
 		receiverMock.On("ListReceivers").Return(
 			[]*domain.Receiver{dummySlackReceivers, dummyPagerdutyReceivers, dummyHTTPReceivers}, nil).Once()
-		amClientMock.On("SyncConfig", mock.AnythingOfType("alertmanager.AMConfig"), "dummy").Run(func(args mock.Arguments) {
-			rarg := args.Get(0)
+		amClientMock.On("SyncAlertmanagerConfig", mock.Anything, "dummy", mock.AnythingOfType("alertmanager.AMConfig")).Run(func(args mock.Arguments) {
+			rarg := args.Get(2)
 			s.Require().IsType(alertmanager.AMConfig{}, rarg)
 			r := rarg.(alertmanager.AMConfig)
 			s.Equal(3, len(r.Receivers))
@@ -471,15 +657,15 @@ func (s *RepositoryTestSuite) TestCreate() {
 			s.Equal("baz_receiverId_3_idx_0", r.Receivers[2].Receiver)
 		}).Return(nil).Once()
 
-		oldAMClientCreator := alertmanagerClientCreator
-		defer func() { alertmanagerClientCreator = oldAMClientCreator }()
-		alertmanagerClientCreator = func(c domain.CortexConfig) (alertmanager.Client, error) {
+		s.adapters.Register("cortex", func(string, string) (adapter.Adapter, error) {
 			return nil, errors.New("random error")
-		}
+		})
 
 		s.dbmock.ExpectBegin()
 		s.dbmock.ExpectQuery(insertQuery).WithArgs(expectedSubscription.NamespaceId, expectedSubscription.Urn,
-			expectedSubscription.Receiver, expectedSubscription.Match, expectedSubscription.CreatedAt,
+			expectedSubscription.Receiver, expectedSubscription.Match, expectedSubscription.Matchers, expectedSubscription.GroupBy,
+			expectedSubscription.GroupWait, expectedSubscription.GroupInterval, expectedSubscription.RepeatInterval,
+			expectedSubscription.MuteTimeIntervals, expectedSubscription.CreatedAt,
 			expectedSubscription.UpdatedAt, expectedSubscription.Id).WillReturnRows(sqlmock.NewRows(nil))
 
 		expectedRows := sqlmock.
@@ -502,10 +688,12 @@ func (s *RepositoryTestSuite) TestCreate() {
 
 		s.dbmock.ExpectQuery(fetchLastInsertedQuery).WillReturnRows(expectedRows)
 		s.dbmock.ExpectQuery(fetchSubscriptionsWithinNamespaceQuery).WillReturnRows(expectedRowsInNamespace)
+		s.dbmock.ExpectQuery(fetchInhibitionRulesWithinNamespaceQuery).WillReturnRows(sqlmock.NewRows(nil))
+		s.dbmock.ExpectQuery(fetchTimeIntervalsWithinNamespaceQuery).WillReturnRows(sqlmock.NewRows(nil))
 		s.dbmock.ExpectRollback()
 
 		actualSubscription, err := s.repository.Create(expectedSubscription, namespaceMock, providerMock, receiverMock)
-		s.EqualError(err, "alertmanagerClientCreator: : random error")
+		s.EqualError(err, "newAdapter: random error")
 		s.Nil(actualSubscription)
 	})
 
@@ -513,7 +701,7 @@ func (s *RepositoryTestSuite) TestCreate() {
 		providerMock := &mocks.ProviderService{}
 		namespaceMock := &mocks.NamespaceService{}
 		receiverMock := &mocks.ReceiverService{}
-		amClientMock := &ClientMock{}
+		amClientMock := &adapter.AdapterMock{}
 
 		dummySlackReceivers := &domain.Receiver{Id: 1, Type: "slack", Configurations: randomSlackReceiverConfig}
 		dummyPagerdutyReceivers := &domain.Receiver{Id: 2, Type: "pagerduty", Configurations: randomPagerdutyReceiverConfig}
@@ -523,18 +711,18 @@ func (s *RepositoryTestSuite) TestCreate() {
 		namespaceMock.On("GetNamespace", uint64(1)).Return(dummyNamespace, nil).Once()
 		receiverMock.On("ListReceivers").Return(
 			[]*domain.Receiver{dummySlackReceivers, dummyPagerdutyReceivers, dummyHTTPReceivers}, nil).Once()
-		amClientMock.On("SyncConfig", mock.AnythingOfType("alertmanager.AMConfig"), "dummy").
+		amClientMock.On("SyncAlertmanagerConfig", mock.Anything, "dummy", mock.AnythingOfType("alertmanager.AMConfig")).
 			Return(errors.New("random error")).Once()
 
-		oldAMClientCreator := alertmanagerClientCreator
-		defer func() { alertmanagerClientCreator = oldAMClientCreator }()
-		alertmanagerClientCreator = func(c domain.CortexConfig) (alertmanager.Client, error) {
+		s.adapters.Register("cortex", func(string, string) (adapter.Adapter, error) {
 			return amClientMock, nil
-		}
+		})
 
 		s.dbmock.ExpectBegin()
 		s.dbmock.ExpectQuery(insertQuery).WithArgs(expectedSubscription.NamespaceId, expectedSubscription.Urn,
-			expectedSubscription.Receiver, expectedSubscription.Match, expectedSubscription.CreatedAt,
+			expectedSubscription.Receiver, expectedSubscription.Match, expectedSubscription.Matchers, expectedSubscription.GroupBy,
+			expectedSubscription.GroupWait, expectedSubscription.GroupInterval, expectedSubscription.RepeatInterval,
+			expectedSubscription.MuteTimeIntervals, expectedSubscription.CreatedAt,
 			expectedSubscription.UpdatedAt, expectedSubscription.Id).WillReturnRows(sqlmock.NewRows(nil))
 
 		expectedRows := sqlmock.
@@ -557,10 +745,12 @@ func (s *RepositoryTestSuite) TestCreate() {
 
 		s.dbmock.ExpectQuery(fetchLastInsertedQuery).WillReturnRows(expectedRows)
 		s.dbmock.ExpectQuery(fetchSubscriptionsWithinNamespaceQuery).WillReturnRows(expectedRowsInNamespace)
+		s.dbmock.ExpectQuery(fetchInhibitionRulesWithinNamespaceQuery).WillReturnRows(sqlmock.NewRows(nil))
+		s.dbmock.ExpectQuery(fetchTimeIntervalsWithinNamespaceQuery).WillReturnRows(sqlmock.NewRows(nil))
 		s.dbmock.ExpectRollback()
 
 		actualSubscription, err := s.repository.Create(expectedSubscription, namespaceMock, providerMock, receiverMock)
-		s.EqualError(err, "r.amClient.SyncConfig: random error")
+		s.EqualError(err, "providerAdapter.SyncAlertmanagerConfig: random error")
 		s.Nil(actualSubscription)
 	})
 
@@ -578,7 +768,9 @@ func (s *RepositoryTestSuite) TestCreate() {
 
 		s.dbmock.ExpectBegin()
 		s.dbmock.ExpectQuery(insertQuery).WithArgs(expectedSubscription.NamespaceId, expectedSubscription.Urn,
-			expectedSubscription.Receiver, expectedSubscription.Match, expectedSubscription.CreatedAt,
+			expectedSubscription.Receiver, expectedSubscription.Match, expectedSubscription.Matchers, expectedSubscription.GroupBy,
+			expectedSubscription.GroupWait, expectedSubscription.GroupInterval, expectedSubscription.RepeatInterval,
+			expectedSubscription.MuteTimeIntervals, expectedSubscription.CreatedAt,
 			expectedSubscription.UpdatedAt, expectedSubscription.Id).WillReturnRows(sqlmock.NewRows(nil))
 
 		expectedRows := sqlmock.
@@ -601,6 +793,8 @@ func (s *RepositoryTestSuite) TestCreate() {
 
 		s.dbmock.ExpectQuery(fetchLastInsertedQuery).WillReturnRows(expectedRows)
 		s.dbmock.ExpectQuery(fetchSubscriptionsWithinNamespaceQuery).WillReturnRows(expectedRowsInNamespace)
+		s.dbmock.ExpectQuery(fetchInhibitionRulesWithinNamespaceQuery).WillReturnRows(sqlmock.NewRows(nil))
+		s.dbmock.ExpectQuery(fetchTimeIntervalsWithinNamespaceQuery).WillReturnRows(sqlmock.NewRows(nil))
 		s.dbmock.ExpectRollback()
 
 		actualSubscription, err := s.repository.Create(expectedSubscription, namespaceMock, providerMock, receiverMock)
@@ -733,14 +927,16 @@ func (s *RepositoryTestSuite) TestUpdate() {
 
 	firstSelectQuery := regexp.QuoteMeta(`SELECT * FROM "subscriptions" WHERE id = 1`)
 	secondSelectQuery := regexp.QuoteMeta(`SELECT * FROM "subscriptions" WHERE id = 1`)
-	updateQuery := regexp.QuoteMeta(`UPDATE "subscriptions" SET "namespace_id"=$1,"urn"=$2,"receiver"=$3,"match"=$4,"created_at"=$5,"updated_at"=$6 WHERE id = $7 AND "id" = $8`)
+	updateQuery := regexp.QuoteMeta(`UPDATE "subscriptions" SET "namespace_id"=$1,"urn"=$2,"receiver"=$3,"match"=$4,"matchers"=$5,"group_by"=$6,"group_wait"=$7,"group_interval"=$8,"repeat_interval"=$9,"mute_time_intervals"=$10,"updated_at"=$11 WHERE id = $12 AND "id" = $13`)
 	fetchSubscriptionsWithinNamespaceQuery := regexp.QuoteMeta(`SELECT * FROM "subscriptions" WHERE namespace_id = 1`)
+	fetchInhibitionRulesWithinNamespaceQuery := regexp.QuoteMeta(`SELECT * FROM "inhibition_rules" WHERE namespace_id = 1`)
+	fetchTimeIntervalsWithinNamespaceQuery := regexp.QuoteMeta(`SELECT * FROM "time_intervals" WHERE namespace_id = 1`)
 
 	s.Run("should update a subscription", func() {
 		providerMock := &mocks.ProviderService{}
 		namespaceMock := &mocks.NamespaceService{}
 		receiverMock := &mocks.ReceiverService{}
-		amClientMock := &ClientMock{}
+		amClientMock := &adapter.AdapterMock{}
 		dummySlackReceivers := &domain.Receiver{Id: 1, Type: "slack", Configurations: randomSlackReceiverConfig}
 		dummyPagerdutyReceivers := &domain.Receiver{Id: 2, Type: "pagerduty", Configurations: randomPagerdutyReceiverConfig}
 		dummyHTTPReceivers := &domain.Receiver{Id: 3, Type: "http", Configurations: randomHTTPReceiverConfig}
@@ -748,8 +944,8 @@ func (s *RepositoryTestSuite) TestUpdate() {
 		namespaceMock.On("GetNamespace", uint64(1)).Return(dummyNamespace, nil).Once()
 		receiverMock.On("ListReceivers").Return(
 			[]*domain.Receiver{dummySlackReceivers, dummyPagerdutyReceivers, dummyHTTPReceivers}, nil).Once()
-		amClientMock.On("SyncConfig", mock.AnythingOfType("alertmanager.AMConfig"), "dummy").Run(func(args mock.Arguments) {
-			rarg := args.Get(0)
+		amClientMock.On("SyncAlertmanagerConfig", mock.Anything, "dummy", mock.AnythingOfType("alertmanager.AMConfig")).Run(func(args mock.Arguments) {
+			rarg := args.Get(2)
 			s.Require().IsType(alertmanager.AMConfig{}, rarg)
 			r := rarg.(alertmanager.AMConfig)
 			s.Equal(3, len(r.Receivers))
@@ -759,21 +955,20 @@ func (s *RepositoryTestSuite) TestUpdate() {
 			s.Equal("baz_receiverId_3_idx_0", r.Receivers[2].Receiver)
 		}).Return(nil).Once()
 
-		oldAMClientCreator := alertmanagerClientCreator
-		defer func() { alertmanagerClientCreator = oldAMClientCreator }()
-		alertmanagerClientCreator = func(c domain.CortexConfig) (alertmanager.Client, error) {
+		s.adapters.Register("cortex", func(string, string) (adapter.Adapter, error) {
 			return amClientMock, nil
-		}
+		})
 
 		s.dbmock.ExpectBegin()
 		expectedRowsBeforeUpdate := sqlmock.
-			NewRows([]string{"urn", "namespace_id", "receiver", "match", "created_at", "updated_at", "id"}).
+			NewRows([]string{"urn", "namespace_id", "receiver", "match", "matchers", "group_wait", "mute_time_intervals", "created_at", "updated_at", "id"}).
 			AddRow(subscription.Urn, subscription.NamespaceId,
 				json.RawMessage(`[{"id":1 ,"configuration": {"channel_name":"test"}}]`), json.RawMessage(`{"foo":"bar"}`),
+				json.RawMessage(`[{"name":"env","operator":"=~","value":"prod.*"}]`), "30s", json.RawMessage(`["weekends"]`),
 				subscription.CreatedAt, subscription.UpdatedAt, subscription.Id)
 		s.dbmock.ExpectQuery(firstSelectQuery).WillReturnRows(expectedRowsBeforeUpdate)
 		s.dbmock.ExpectExec(updateQuery).WithArgs(subscription.NamespaceId, subscription.Urn,
-			subscription.Receiver, subscription.Match, AnyTime{}, AnyTime{}, subscription.Id, subscription.Id).
+			subscription.Receiver, subscription.Match, nil, nil, "", "", "", nil, AnyTime{}, subscription.Id, subscription.Id).
 			WillReturnResult(sqlmock.NewResult(1, 1))
 
 		expectedRowsAfterUpdate := sqlmock.
@@ -797,6 +992,9 @@ func (s *RepositoryTestSuite) TestUpdate() {
 				json.RawMessage(`{"foo": "bar"}`), subscription.CreatedAt, subscription.UpdatedAt, 3)
 
 		s.dbmock.ExpectQuery(fetchSubscriptionsWithinNamespaceQuery).WillReturnRows(expectedRowsInNamespace)
+
+		s.dbmock.ExpectQuery(fetchInhibitionRulesWithinNamespaceQuery).WillReturnRows(sqlmock.NewRows(nil))
+		s.dbmock.ExpectQuery(fetchTimeIntervalsWithinNamespaceQuery).WillReturnRows(sqlmock.NewRows(nil))
 		s.dbmock.ExpectCommit()
 
 		actualSubscription, err := s.repository.Update(input, namespaceMock, providerMock, receiverMock)
@@ -804,6 +1002,69 @@ func (s *RepositoryTestSuite) TestUpdate() {
 		s.Nil(err)
 	})
 
+	s.Run("should sync the namespace the subscription is moved out of", func() {
+		providerMock := &mocks.ProviderService{}
+		namespaceMock := &mocks.NamespaceService{}
+		receiverMock := &mocks.ReceiverService{}
+		amClientMock := &adapter.AdapterMock{}
+		dummySlackReceivers := &domain.Receiver{Id: 1, Type: "slack", Configurations: randomSlackReceiverConfig}
+		providerMock.On("GetProvider", uint64(1)).Return(dummyProvider, nil).Twice()
+		namespaceMock.On("GetNamespace", uint64(2)).Return(&domain.Namespace{Id: 2, Provider: 1, Urn: "old"}, nil).Once()
+		namespaceMock.On("GetNamespace", uint64(1)).Return(dummyNamespace, nil).Once()
+		receiverMock.On("ListReceivers").Return([]*domain.Receiver{dummySlackReceivers}, nil)
+		amClientMock.On("SyncAlertmanagerConfig", mock.Anything, "old", mock.AnythingOfType("alertmanager.AMConfig")).
+			Run(func(args mock.Arguments) {
+				s.Empty(args.Get(2).(alertmanager.AMConfig).Receivers)
+			}).Return(nil).Once()
+		amClientMock.On("SyncAlertmanagerConfig", mock.Anything, "dummy", mock.AnythingOfType("alertmanager.AMConfig")).
+			Run(func(args mock.Arguments) {
+				s.Equal(1, len(args.Get(2).(alertmanager.AMConfig).Receivers))
+			}).Return(nil).Once()
+
+		s.adapters.Register("cortex", func(string, string) (adapter.Adapter, error) {
+			return amClientMock, nil
+		})
+
+		s.dbmock.ExpectBegin()
+		s.dbmock.ExpectQuery(firstSelectQuery).WillReturnRows(sqlmock.
+			NewRows([]string{"urn", "namespace_id", "receiver", "match", "created_at", "updated_at", "id"}).
+			AddRow(subscription.Urn, 2,
+				json.RawMessage(`[{"id":1 ,"configuration": {"channel_name":"test"}}]`), json.RawMessage(`{"foo":"bar"}`),
+				subscription.CreatedAt, subscription.UpdatedAt, subscription.Id))
+		s.dbmock.ExpectExec(updateQuery).WithArgs(subscription.NamespaceId, subscription.Urn,
+			subscription.Receiver, subscription.Match, nil, nil, "", "", "", nil, AnyTime{}, subscription.Id, subscription.Id).
+			WillReturnResult(sqlmock.NewResult(1, 1))
+		rowsAfterUpdate := sqlmock.
+			NewRows([]string{"urn", "namespace_id", "receiver", "match", "created_at", "updated_at", "id"}).
+			AddRow(subscription.Urn, subscription.NamespaceId,
+				json.RawMessage(`[{"id":1 ,"configuration": {"channel_name":"updated_channel"}}]`), json.RawMessage(`{"foo":"bar"}`),
+				subscription.CreatedAt, subscription.UpdatedAt, subscription.Id)
+		s.dbmock.ExpectQuery(secondSelectQuery).WillReturnRows(rowsAfterUpdate)
+		s.dbmock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "subscriptions" WHERE namespace_id = 2`)).
+			WillReturnRows(sqlmock.NewRows(nil))
+		s.dbmock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "inhibition_rules" WHERE namespace_id = 2`)).
+			WillReturnRows(sqlmock.NewRows(nil))
+		s.dbmock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "time_intervals" WHERE namespace_id = 2`)).
+			WillReturnRows(sqlmock.NewRows(nil))
+		s.dbmock.ExpectQuery(fetchSubscriptionsWithinNamespaceQuery).WillReturnRows(sqlmock.
+			NewRows([]string{"urn", "namespace_id", "receiver", "match", "created_at", "updated_at", "id"}).
+			AddRow(subscription.Urn, subscription.NamespaceId,
+				json.RawMessage(`[{"id":1 ,"configuration": {"channel_name": "updated_channel"}}]`),
+				json.RawMessage(`{"foo": "bar"}`), subscription.CreatedAt, subscription.UpdatedAt, subscription.Id))
+		s.dbmock.ExpectQuery(fetchInhibitionRulesWithinNamespaceQuery).WillReturnRows(sqlmock.NewRows(nil))
+		s.dbmock.ExpectQuery(fetchTimeIntervalsWithinNamespaceQuery).WillReturnRows(sqlmock.NewRows(nil))
+		s.dbmock.ExpectCommit()
+
+		actualSubscription, err := s.repository.Update(input, namespaceMock, providerMock, receiverMock)
+		s.Nil(err)
+		s.Equal(subscription.NamespaceId, actualSubscription.NamespaceId)
+		namespaceMock.AssertExpectations(s.T())
+		amClientMock.AssertExpectations(s.T())
+		if err := s.dbmock.ExpectationsWereMet(); err != nil {
+			s.T().Errorf("there were unfulfilled expectations: %s", err)
+		}
+	})
+
 	s.Run("should return error in fetching subscription before update", func() {
 		providerMock := &mocks.ProviderService{}
 		namespaceMock := &mocks.NamespaceService{}
@@ -843,7 +1104,7 @@ func (s *RepositoryTestSuite) TestUpdate() {
 				subscription.CreatedAt, subscription.UpdatedAt, subscription.Id)
 		s.dbmock.ExpectQuery(firstSelectQuery).WillReturnRows(expectedRowsBeforeUpdate)
 		s.dbmock.ExpectExec(updateQuery).WithArgs(subscription.NamespaceId, subscription.Urn,
-			subscription.Receiver, subscription.Match, AnyTime{}, AnyTime{}, subscription.Id, subscription.Id).
+			subscription.Receiver, subscription.Match, nil, nil, "", "", "", nil, AnyTime{}, subscription.Id, subscription.Id).
 			WillReturnError(errors.New("random error"))
 		s.dbmock.ExpectRollback()
 
@@ -865,7 +1126,7 @@ func (s *RepositoryTestSuite) TestUpdate() {
 				subscription.CreatedAt, subscription.UpdatedAt, subscription.Id)
 		s.dbmock.ExpectQuery(firstSelectQuery).WillReturnRows(expectedRowsBeforeUpdate)
 		s.dbmock.ExpectExec(updateQuery).WithArgs(subscription.NamespaceId, subscription.Urn,
-			subscription.Receiver, subscription.Match, AnyTime{}, AnyTime{}, subscription.Id, subscription.Id).
+			subscription.Receiver, subscription.Match, nil, nil, "", "", "", nil, AnyTime{}, subscription.Id, subscription.Id).
 			WillReturnResult(sqlmock.NewResult(1, 1))
 
 		s.dbmock.ExpectQuery(secondSelectQuery).WillReturnError(errors.New("random error"))
@@ -880,7 +1141,7 @@ func (s *RepositoryTestSuite) TestUpdate() {
 		providerMock := &mocks.ProviderService{}
 		namespaceMock := &mocks.NamespaceService{}
 		receiverMock := &mocks.ReceiverService{}
-		amClientMock := &ClientMock{}
+		amClientMock := &adapter.AdapterMock{}
 		dummySlackReceivers := &domain.Receiver{Id: 1, Type: "slack", Configurations: randomSlackReceiverConfig}
 		dummyPagerdutyReceivers := &domain.Receiver{Id: 2, Type: "pagerduty", Configurations: randomPagerdutyReceiverConfig}
 		dummyHTTPReceivers := &domain.Receiver{Id: 3, Type: "http", Configurations: randomHTTPReceiverConfig}
@@ -888,14 +1149,12 @@ func (s *RepositoryTestSuite) TestUpdate() {
 		namespaceMock.On("GetNamespace", uint64(1)).Return(dummyNamespace, nil).Once()
 		receiverMock.On("ListReceivers").Return(
 			[]*domain.Receiver{dummySlackReceivers, dummyPagerdutyReceivers, dummyHTTPReceivers}, nil).Once()
-		amClientMock.On("SyncConfig", mock.AnythingOfType("alertmanager.AMConfig"), "dummy").
+		amClientMock.On("SyncAlertmanagerConfig", mock.Anything, "dummy", mock.AnythingOfType("alertmanager.AMConfig")).
 			Return(errors.New("random error")).Once()
 
-		oldAMClientCreator := alertmanagerClientCreator
-		defer func() { alertmanagerClientCreator = oldAMClientCreator }()
-		alertmanagerClientCreator = func(c domain.CortexConfig) (alertmanager.Client, error) {
+		s.adapters.Register("cortex", func(string, string) (adapter.Adapter, error) {
 			return amClientMock, nil
-		}
+		})
 
 		s.dbmock.ExpectBegin()
 		expectedRowsBeforeUpdate := sqlmock.
@@ -905,7 +1164,7 @@ func (s *RepositoryTestSuite) TestUpdate() {
 				subscription.CreatedAt, subscription.UpdatedAt, subscription.Id)
 		s.dbmock.ExpectQuery(firstSelectQuery).WillReturnRows(expectedRowsBeforeUpdate)
 		s.dbmock.ExpectExec(updateQuery).WithArgs(subscription.NamespaceId, subscription.Urn,
-			subscription.Receiver, subscription.Match, AnyTime{}, AnyTime{}, subscription.Id, subscription.Id).
+			subscription.Receiver, subscription.Match, nil, nil, "", "", "", nil, AnyTime{}, subscription.Id, subscription.Id).
 			WillReturnResult(sqlmock.NewResult(1, 1))
 
 		expectedRowsAfterUpdate := sqlmock.
@@ -929,10 +1188,13 @@ func (s *RepositoryTestSuite) TestUpdate() {
 				json.RawMessage(`{"foo": "bar"}`), subscription.CreatedAt, subscription.UpdatedAt, 3)
 
 		s.dbmock.ExpectQuery(fetchSubscriptionsWithinNamespaceQuery).WillReturnRows(expectedRowsInNamespace)
+
+		s.dbmock.ExpectQuery(fetchInhibitionRulesWithinNamespaceQuery).WillReturnRows(sqlmock.NewRows(nil))
+		s.dbmock.ExpectQuery(fetchTimeIntervalsWithinNamespaceQuery).WillReturnRows(sqlmock.NewRows(nil))
 		s.dbmock.ExpectCommit()
 
 		actualSubscription, err := s.repository.Update(input, namespaceMock, providerMock, receiverMock)
-		s.EqualError(err, "r.amClient.SyncConfig: random error")
+		s.EqualError(err, "providerAdapter.SyncAlertmanagerConfig: random error")
 		s.Nil(actualSubscription)
 	})
 }
@@ -966,12 +1228,14 @@ func (s *RepositoryTestSuite) TestDelete() {
 	selectQuery := regexp.QuoteMeta(`SELECT * FROM "subscriptions" WHERE id = 1`)
 	deleteQuery := regexp.QuoteMeta(`DELETE FROM "subscriptions" WHERE "subscriptions"."id" = $1`)
 	fetchSubscriptionsWithinNamespaceQuery := regexp.QuoteMeta(`SELECT * FROM "subscriptions" WHERE namespace_id = 1`)
+	fetchInhibitionRulesWithinNamespaceQuery := regexp.QuoteMeta(`SELECT * FROM "inhibition_rules" WHERE namespace_id = 1`)
+	fetchTimeIntervalsWithinNamespaceQuery := regexp.QuoteMeta(`SELECT * FROM "time_intervals" WHERE namespace_id = 1`)
 
 	s.Run("should delete a subscription", func() {
 		providerMock := &mocks.ProviderService{}
 		namespaceMock := &mocks.NamespaceService{}
 		receiverMock := &mocks.ReceiverService{}
-		amClientMock := &ClientMock{}
+		amClientMock := &adapter.AdapterMock{}
 		dummySlackReceivers := &domain.Receiver{Id: 1, Type: "slack", Configurations: randomSlackReceiverConfig}
 		dummyPagerdutyReceivers := &domain.Receiver{Id: 2, Type: "pagerduty", Configurations: randomPagerdutyReceiverConfig}
 		dummyHTTPReceivers := &domain.Receiver{Id: 3, Type: "http", Configurations: randomHTTPReceiverConfig}
@@ -979,8 +1243,8 @@ func (s *RepositoryTestSuite) TestDelete() {
 		namespaceMock.On("GetNamespace", uint64(1)).Return(dummyNamespace, nil).Once()
 		receiverMock.On("ListReceivers").Return(
 			[]*domain.Receiver{dummySlackReceivers, dummyPagerdutyReceivers, dummyHTTPReceivers}, nil).Once()
-		amClientMock.On("SyncConfig", mock.AnythingOfType("alertmanager.AMConfig"), "dummy").Run(func(args mock.Arguments) {
-			rarg := args.Get(0)
+		amClientMock.On("SyncAlertmanagerConfig", mock.Anything, "dummy", mock.AnythingOfType("alertmanager.AMConfig")).Run(func(args mock.Arguments) {
+			rarg := args.Get(2)
 			s.Require().IsType(alertmanager.AMConfig{}, rarg)
 			r := rarg.(alertmanager.AMConfig)
 			s.Equal(2, len(r.Receivers))
@@ -988,11 +1252,9 @@ func (s *RepositoryTestSuite) TestDelete() {
 			s.Equal("baz_receiverId_3_idx_0", r.Receivers[1].Receiver)
 		}).Return(nil).Once()
 
-		oldAMClientCreator := alertmanagerClientCreator
-		defer func() { alertmanagerClientCreator = oldAMClientCreator }()
-		alertmanagerClientCreator = func(c domain.CortexConfig) (alertmanager.Client, error) {
+		s.adapters.Register("cortex", func(string, string) (adapter.Adapter, error) {
 			return amClientMock, nil
-		}
+		})
 
 		s.dbmock.ExpectBegin()
 		expectedRows := sqlmock.
@@ -1013,6 +1275,9 @@ func (s *RepositoryTestSuite) TestDelete() {
 				json.RawMessage(`{"foo": "bar"}`), subscription.CreatedAt, subscription.UpdatedAt, 3)
 
 		s.dbmock.ExpectQuery(fetchSubscriptionsWithinNamespaceQuery).WillReturnRows(expectedRowsInNamespace)
+
+		s.dbmock.ExpectQuery(fetchInhibitionRulesWithinNamespaceQuery).WillReturnRows(sqlmock.NewRows(nil))
+		s.dbmock.ExpectQuery(fetchTimeIntervalsWithinNamespaceQuery).WillReturnRows(sqlmock.NewRows(nil))
 		s.dbmock.ExpectCommit()
 
 		err := s.repository.Delete(1, namespaceMock, providerMock, receiverMock)
@@ -1069,7 +1334,7 @@ func (s *RepositoryTestSuite) TestDelete() {
 		providerMock := &mocks.ProviderService{}
 		namespaceMock := &mocks.NamespaceService{}
 		receiverMock := &mocks.ReceiverService{}
-		amClientMock := &ClientMock{}
+		amClientMock := &adapter.AdapterMock{}
 		dummySlackReceivers := &domain.Receiver{Id: 1, Type: "slack", Configurations: randomSlackReceiverConfig}
 		dummyPagerdutyReceivers := &domain.Receiver{Id: 2, Type: "pagerduty", Configurations: randomPagerdutyReceiverConfig}
 		dummyHTTPReceivers := &domain.Receiver{Id: 3, Type: "http", Configurations: randomHTTPReceiverConfig}
@@ -1077,14 +1342,12 @@ func (s *RepositoryTestSuite) TestDelete() {
 		namespaceMock.On("GetNamespace", uint64(1)).Return(dummyNamespace, nil).Once()
 		receiverMock.On("ListReceivers").Return(
 			[]*domain.Receiver{dummySlackReceivers, dummyPagerdutyReceivers, dummyHTTPReceivers}, nil).Once()
-		amClientMock.On("SyncConfig", mock.AnythingOfType("alertmanager.AMConfig"), "dummy").
+		amClientMock.On("SyncAlertmanagerConfig", mock.Anything, "dummy", mock.AnythingOfType("alertmanager.AMConfig")).
 			Return(errors.New("random error")).Once()
 
-		oldAMClientCreator := alertmanagerClientCreator
-		defer func() { alertmanagerClientCreator = oldAMClientCreator }()
-		alertmanagerClientCreator = func(c domain.CortexConfig) (alertmanager.Client, error) {
+		s.adapters.Register("cortex", func(string, string) (adapter.Adapter, error) {
 			return amClientMock, nil
-		}
+		})
 
 		s.dbmock.ExpectBegin()
 		expectedRows := sqlmock.
@@ -1105,10 +1368,13 @@ func (s *RepositoryTestSuite) TestDelete() {
 				json.RawMessage(`{"foo": "bar"}`), subscription.CreatedAt, subscription.UpdatedAt, 3)
 
 		s.dbmock.ExpectQuery(fetchSubscriptionsWithinNamespaceQuery).WillReturnRows(expectedRowsInNamespace)
+
+		s.dbmock.ExpectQuery(fetchInhibitionRulesWithinNamespaceQuery).WillReturnRows(sqlmock.NewRows(nil))
+		s.dbmock.ExpectQuery(fetchTimeIntervalsWithinNamespaceQuery).WillReturnRows(sqlmock.NewRows(nil))
 		s.dbmock.ExpectRollback()
 
 		err := s.repository.Delete(1, namespaceMock, providerMock, receiverMock)
-		s.EqualError(err, "r.amClient.SyncConfig: random error")
+		s.EqualError(err, "providerAdapter.SyncAlertmanagerConfig: random error")
 	})
 }
 
@@ -2,6 +2,7 @@ package subscription
 
 import (
 	"github.com/odpf/siren/domain"
+	"github.com/odpf/siren/pkg/adapter"
 	"github.com/odpf/siren/pkg/namespace"
 	"github.com/odpf/siren/pkg/provider"
 	"github.com/odpf/siren/pkg/receiver"
@@ -11,24 +12,26 @@ import (
 
 // Service handles business logic
 type Service struct {
-	repository      SubscriptionRepository
-	providerService domain.ProviderService
+	repository       SubscriptionRepository
+	providerService  domain.ProviderService
 	namespaceService domain.NamespaceService
 	receiverService  domain.ReceiverService
 }
 
 // NewService returns service struct
-func NewService(db *gorm.DB, key string) (domain.SubscriptionService, error) {
-	repository := NewRepository(db)
-	namespaceService, err := namespace.NewService(db, key)
-	if err != nil {
-		return nil, errors.Wrap(err, "failed to create namespace service")
-	}
+func NewService(db *gorm.DB, adapters *adapter.Registry, key string) (domain.SubscriptionService, error) {
+	repository := NewRepository(db, adapters)
+	providerService := provider.NewService(db)
 	receiverService, err := receiver.NewService(db, nil, key)
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to create receiver service")
 	}
-	return &Service{repository, provider.NewService(db),
+	namespaceService, err := namespace.NewService(db, key,
+		&NamespaceSyncer{repository, providerService, receiverService})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create namespace service")
+	}
+	return &Service{repository, providerService,
 		namespaceService, receiverService}, nil
 }
 
@@ -46,6 +49,12 @@ func (s Service) ListSubscriptions() ([]*domain.Subscription, error) {
 }
 
 func (s Service) CreateSubscription(domainSubscription *domain.Subscription) (*domain.Subscription, error) {
+	if err := validateMatchers(domainSubscription.Matchers); err != nil {
+		return nil, err
+	}
+	if err := domainSubscription.Routing.Validate(); err != nil {
+		return nil, err
+	}
 	sub := &Subscription{}
 	sub.fromDomain(domainSubscription)
 	newSubscription, err := s.repository.Create(sub, s.namespaceService, s.providerService, s.receiverService)
@@ -67,6 +76,12 @@ func (s Service) GetSubscription(id uint64) (*domain.Subscription, error) {
 }
 
 func (s Service) UpdateSubscription(domainSubscription *domain.Subscription) (*domain.Subscription, error) {
+	if err := validateMatchers(domainSubscription.Matchers); err != nil {
+		return nil, err
+	}
+	if err := domainSubscription.Routing.Validate(); err != nil {
+		return nil, err
+	}
 	subscription := &Subscription{}
 	subscription.fromDomain(domainSubscription)
 	updatedSubscription, err := s.repository.Update(subscription, s.namespaceService, s.providerService, s.receiverService)
@@ -83,3 +98,13 @@ func (s Service) DeleteSubscription(id uint64) error {
 func (s Service) Migrate() error {
 	return s.repository.Migrate()
 }
+
+// validateMatchers checks matchers the way alertmanager loads them
+func validateMatchers(matchers []domain.Matcher) error {
+	for _, matcher := range matchers {
+		if err := matcher.Validate(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -40,6 +40,63 @@ func TestService_CreateSubscription(t *testing.T) {
 		assert.EqualError(t, err, "s.repository.Create: random error")
 		assert.Nil(t, result)
 	})
+
+	t.Run("should create subscription with matchers", func(t *testing.T) {
+		repositoryMock := &SubscriptionRepositoryMock{}
+		matchers := []domain.Matcher{
+			{Name: "service", Operator: domain.MatchRegexp, Value: "payments-.*"},
+			{Name: "severity", Operator: domain.MatchNotEqual, Value: "INFO"},
+		}
+		subscription := &Subscription{Urn: "test", Id: 1, NamespaceId: 1, Match: match,
+			Matchers: MatcherList{
+				{Name: "service", Operator: "=~", Value: "payments-.*"},
+				{Name: "severity", Operator: "!=", Value: "INFO"},
+			},
+			Receiver: []ReceiverMetadata{{Id: 1, Configuration: make(map[string]string)}}, CreatedAt: timeNow, UpdatedAt: timeNow}
+		repositoryMock.On("Create", subscription, nil, nil, nil).Return(subscription, nil).Once()
+		dummyService := Service{repositoryMock, nil, nil, nil}
+
+		result, err := dummyService.CreateSubscription(&domain.Subscription{Id: 1, Urn: "test", Namespace: 1,
+			Receivers: receivers, Match: match, Matchers: matchers, CreatedAt: timeNow, UpdatedAt: timeNow})
+		assert.Nil(t, err)
+		assert.Equal(t, matchers, result.Matchers)
+		repositoryMock.AssertExpectations(t)
+	})
+
+	t.Run("should return error if a matcher is invalid", func(t *testing.T) {
+		for _, matcher := range []domain.Matcher{
+			{Name: "1service", Operator: domain.MatchEqual, Value: "payments"},
+			{Name: "service", Operator: "==", Value: "payments"},
+			{Name: "service", Operator: domain.MatchNotRegexp, Value: "payments-(.*"},
+		} {
+			repositoryMock := &SubscriptionRepositoryMock{}
+			dummyService := Service{repositoryMock, nil, nil, nil}
+
+			result, err := dummyService.CreateSubscription(&domain.Subscription{Urn: "test", Namespace: 1,
+				Receivers: receivers, Matchers: []domain.Matcher{matcher}})
+			var invalidMatcherErr *domain.InvalidMatcherError
+			assert.True(t, errors.As(err, &invalidMatcherErr))
+			assert.Equal(t, matcher, invalidMatcherErr.Matcher)
+			assert.Nil(t, result)
+		}
+	})
+	t.Run("should return error if the routing is invalid", func(t *testing.T) {
+		for routing, expectedErr := range map[*domain.Routing]string{
+			{GroupBy: []string{"service-name"}}:              `invalid routing: invalid label "service-name" to group by`,
+			{GroupBy: []string{"...", "service"}}:            "invalid routing: cannot group by ... and other labels",
+			{GroupWait: "30"}:                                `invalid routing: group_wait: not a valid duration string: "30"`,
+			{GroupInterval: "0s"}:                            "invalid routing: group_interval cannot be zero",
+			{GroupBy: []string{"..."}, RepeatInterval: "1x"}: `invalid routing: repeat_interval: not a valid duration string: "1x"`,
+		} {
+			repositoryMock := &SubscriptionRepositoryMock{}
+			dummyService := Service{repositoryMock, nil, nil, nil}
+
+			result, err := dummyService.CreateSubscription(&domain.Subscription{Urn: "test", Namespace: 1,
+				Receivers: receivers, Routing: *routing})
+			assert.EqualError(t, err, expectedErr)
+			assert.Nil(t, result)
+		}
+	})
 }
 
 func TestService_GetSubscription(t *testing.T) {
@@ -143,6 +200,16 @@ func TestService_UpdateSubscription(t *testing.T) {
 		assert.EqualError(t, err, "s.repository.Update: random error")
 		assert.Nil(t, result)
 	})
+
+	t.Run("should return error if a matcher is invalid", func(t *testing.T) {
+		repositoryMock := &SubscriptionRepositoryMock{}
+		dummyService := Service{repositoryMock, nil, nil, nil}
+
+		result, err := dummyService.UpdateSubscription(&domain.Subscription{Id: 1, Urn: "test", Namespace: 1,
+			Receivers: receivers, Matchers: []domain.Matcher{{Name: "service", Operator: "~=", Value: "payments"}}})
+		assert.EqualError(t, err, `invalid matcher service~="payments": unknown operator "~="`)
+		assert.Nil(t, result)
+	})
 }
 
 func TestService_DeleteSubscription(t *testing.T) {
@@ -0,0 +1,69 @@
+package subscription
+
+import (
+	"github.com/odpf/siren/domain"
+	"github.com/odpf/siren/pkg/adapter"
+	"gorm.io/gorm"
+)
+
+// timeIntervalRepository talks to the store to read or insert time intervals,
+// syncing the alertmanager config of their namespace along with its subscriptions
+type timeIntervalRepository struct {
+	namespacedRepository
+}
+
+// NewTimeIntervalRepository returns repository struct
+func NewTimeIntervalRepository(db *gorm.DB, adapters *adapter.Registry) TimeIntervalRepository {
+	return &timeIntervalRepository{namespacedRepository{
+		db:            db,
+		subscriptions: *NewRepository(db, adapters),
+		name:          "time interval",
+		columns:       []string{"namespace_id", "urn", "periods", "mute_namespace"},
+		newResource:   func() namespacedResource { return &TimeInterval{} },
+	}}
+}
+
+func (r timeIntervalRepository) List(namespaceId uint64) ([]*TimeInterval, error) {
+	var timeIntervals []*TimeInterval
+	if err := r.list(namespaceId, &timeIntervals); err != nil {
+		return nil, err
+	}
+	return timeIntervals, nil
+}
+
+func (r timeIntervalRepository) Create(timeInterval *TimeInterval, namespaceService domain.NamespaceService,
+	providerService domain.ProviderService, receiverService domain.ReceiverService) (*TimeInterval, error) {
+	newTimeInterval, err := r.create(timeInterval, namespaceService, providerService, receiverService)
+	if err != nil {
+		return nil, err
+	}
+	return newTimeInterval.(*TimeInterval), nil
+}
+
+func (r timeIntervalRepository) Get(id uint64) (*TimeInterval, error) {
+	timeInterval, err := r.get(id)
+	if err != nil || timeInterval == nil {
+		return nil, err
+	}
+	return timeInterval.(*TimeInterval), nil
+}
+
+// Update replaces the urn, namespace, periods and namespace muting of a
+// time interval, syncing the namespace it is moved out of as well
+func (r timeIntervalRepository) Update(timeInterval *TimeInterval, namespaceService domain.NamespaceService,
+	providerService domain.ProviderService, receiverService domain.ReceiverService) (*TimeInterval, error) {
+	newTimeInterval, err := r.update(timeInterval, namespaceService, providerService, receiverService)
+	if err != nil {
+		return nil, err
+	}
+	return newTimeInterval.(*TimeInterval), nil
+}
+
+func (r timeIntervalRepository) Delete(id uint64, namespaceService domain.NamespaceService,
+	providerService domain.ProviderService, receiverService domain.ReceiverService) error {
+	return r.delete(id, namespaceService, providerService, receiverService)
+}
+
+func (r timeIntervalRepository) Migrate() error {
+	return r.migrate()
+}
@@ -0,0 +1,394 @@
+package subscription
+
+import (
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/odpf/siren/domain"
+	"github.com/odpf/siren/mocks"
+	"github.com/odpf/siren/pkg/adapter"
+	"github.com/odpf/siren/pkg/subscription/alertmanager"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+	"regexp"
+	"testing"
+	"time"
+)
+
+type TimeIntervalRepositoryTestSuite struct {
+	suite.Suite
+	sqldb      *sql.DB
+	dbmock     sqlmock.Sqlmock
+	repository TimeIntervalRepository
+	adapters   *adapter.Registry
+}
+
+func (s *TimeIntervalRepositoryTestSuite) SetupTest() {
+	db, mock, _ := mocks.NewStore()
+	s.sqldb, _ = db.DB()
+	s.dbmock = mock
+	s.adapters = adapter.NewRegistry()
+	s.repository = NewTimeIntervalRepository(db, s.adapters)
+}
+
+func (s *TimeIntervalRepositoryTestSuite) TearDownTest() {
+	s.sqldb.Close()
+}
+
+var timeIntervalColumns = []string{"id", "namespace_id", "urn", "periods", "mute_namespace", "created_at", "updated_at"}
+
+func (s *TimeIntervalRepositoryTestSuite) TestCreate() {
+	timenow := time.Now()
+	timeInterval := &TimeInterval{
+		Id:          1,
+		NamespaceId: 1,
+		Urn:         "business-hours",
+		Periods: TimePeriodList{{
+			Times:    []domain.TimeRange{{StartTime: "09:00", EndTime: "17:00"}},
+			Weekdays: []string{"monday:friday"},
+		}},
+		CreatedAt: timenow,
+		UpdatedAt: timenow,
+	}
+	dummyNamespace := &domain.Namespace{Id: 1, Provider: 1, Urn: "dummy"}
+	dummyProvider := &domain.Provider{Id: 1, Urn: "test", Type: "cortex", Host: "http://localhost:8080"}
+
+	insertQuery := regexp.QuoteMeta(`INSERT INTO "time_intervals" ("namespace_id","urn","periods","mute_namespace","created_at","updated_at","id") VALUES ($1,$2,$3,$4,$5,$6,$7) RETURNING "id"`)
+	fetchLastInsertedQuery := regexp.QuoteMeta(`SELECT * FROM "time_intervals" WHERE id = 1`)
+	fetchSubscriptionsWithinNamespaceQuery := regexp.QuoteMeta(`SELECT * FROM "subscriptions" WHERE namespace_id = 1`)
+	fetchInhibitionRulesWithinNamespaceQuery := regexp.QuoteMeta(`SELECT * FROM "inhibition_rules" WHERE namespace_id = 1`)
+	fetchTimeIntervalsWithinNamespaceQuery := regexp.QuoteMeta(`SELECT * FROM "time_intervals" WHERE namespace_id = 1`)
+	expectedRows := func() *sqlmock.Rows {
+		return sqlmock.NewRows(timeIntervalColumns).
+			AddRow(timeInterval.Id, timeInterval.NamespaceId, timeInterval.Urn,
+				json.RawMessage(`[{"times":[{"start_time":"09:00","end_time":"17:00"}],"weekdays":["monday:friday"]}]`),
+				false, timeInterval.CreatedAt, timeInterval.UpdatedAt)
+	}
+
+	s.Run("should create a time interval and sync its namespace", func() {
+		providerMock := &mocks.ProviderService{}
+		namespaceMock := &mocks.NamespaceService{}
+		receiverMock := &mocks.ReceiverService{}
+		amClientMock := &adapter.AdapterMock{}
+		providerMock.On("GetProvider", uint64(1)).Return(dummyProvider, nil).Once()
+		namespaceMock.On("GetNamespace", uint64(1)).Return(dummyNamespace, nil).Once()
+		receiverMock.On("ListReceivers").Return([]*domain.Receiver{}, nil).Once()
+		amClientMock.On("SyncAlertmanagerConfig", mock.Anything, "dummy", mock.AnythingOfType("alertmanager.AMConfig")).Run(func(args mock.Arguments) {
+			r := args.Get(2).(alertmanager.AMConfig)
+			s.Equal([]alertmanager.AMTimeInterval{{
+				Name: "business-hours",
+				Periods: []alertmanager.AMTimePeriod{{
+					Times:    []alertmanager.AMTimeRange{{StartTime: "09:00", EndTime: "17:00"}},
+					Weekdays: []string{"monday:friday"},
+				}},
+			}}, r.TimeIntervals)
+		}).Return(nil).Once()
+		s.adapters.Register("cortex", func(string, string) (adapter.Adapter, error) {
+			return amClientMock, nil
+		})
+
+		s.dbmock.ExpectBegin()
+		s.dbmock.ExpectQuery(insertQuery).WithArgs(timeInterval.NamespaceId, timeInterval.Urn, timeInterval.Periods,
+			timeInterval.MuteNamespace, timeInterval.CreatedAt, timeInterval.UpdatedAt, timeInterval.Id).
+			WillReturnRows(sqlmock.NewRows(nil))
+		s.dbmock.ExpectQuery(fetchLastInsertedQuery).WillReturnRows(expectedRows())
+		s.dbmock.ExpectQuery(fetchSubscriptionsWithinNamespaceQuery).WillReturnRows(sqlmock.NewRows(nil))
+		s.dbmock.ExpectQuery(fetchInhibitionRulesWithinNamespaceQuery).WillReturnRows(sqlmock.NewRows(nil))
+		s.dbmock.ExpectQuery(fetchTimeIntervalsWithinNamespaceQuery).WillReturnRows(expectedRows())
+		s.dbmock.ExpectCommit()
+
+		actualTimeInterval, err := s.repository.Create(timeInterval, namespaceMock, providerMock, receiverMock)
+		s.Equal(timeInterval, actualTimeInterval)
+		s.Nil(err)
+		amClientMock.AssertExpectations(s.T())
+	})
+
+	s.Run("should return error in creating time interval", func() {
+		s.dbmock.ExpectBegin()
+		s.dbmock.ExpectQuery(insertQuery).WillReturnError(errors.New("random error"))
+		s.dbmock.ExpectRollback()
+
+		actualTimeInterval, err := s.repository.Create(timeInterval, &mocks.NamespaceService{},
+			&mocks.ProviderService{}, &mocks.ReceiverService{})
+		s.EqualError(err, "failed to insert time interval: random error")
+		s.Nil(actualTimeInterval)
+	})
+
+	s.Run("should return error in fetching newly inserted time interval", func() {
+		s.dbmock.ExpectBegin()
+		s.dbmock.ExpectQuery(insertQuery).WillReturnRows(sqlmock.NewRows(nil))
+		s.dbmock.ExpectQuery(fetchLastInsertedQuery).WillReturnError(errors.New("random error"))
+		s.dbmock.ExpectRollback()
+
+		actualTimeInterval, err := s.repository.Create(timeInterval, &mocks.NamespaceService{},
+			&mocks.ProviderService{}, &mocks.ReceiverService{})
+		s.EqualError(err, "failed to get newly inserted time interval: random error")
+		s.Nil(actualTimeInterval)
+	})
+
+	s.Run("should return error in fetching time intervals within namespace", func() {
+		s.dbmock.ExpectBegin()
+		s.dbmock.ExpectQuery(insertQuery).WillReturnRows(sqlmock.NewRows(nil))
+		s.dbmock.ExpectQuery(fetchLastInsertedQuery).WillReturnRows(expectedRows())
+		s.dbmock.ExpectQuery(fetchSubscriptionsWithinNamespaceQuery).WillReturnRows(sqlmock.NewRows(nil))
+		s.dbmock.ExpectQuery(fetchInhibitionRulesWithinNamespaceQuery).WillReturnRows(sqlmock.NewRows(nil))
+		s.dbmock.ExpectQuery(fetchTimeIntervalsWithinNamespaceQuery).WillReturnError(errors.New("random error"))
+		s.dbmock.ExpectRollback()
+
+		actualTimeInterval, err := s.repository.Create(timeInterval, &mocks.NamespaceService{},
+			&mocks.ProviderService{}, &mocks.ReceiverService{})
+		s.EqualError(err, "r.getAllTimeIntervalsWithinNamespace: random error")
+		s.Nil(actualTimeInterval)
+	})
+}
+
+func (s *TimeIntervalRepositoryTestSuite) TestGet() {
+	selectQuery := regexp.QuoteMeta(`SELECT * FROM "time_intervals" WHERE id = 1`)
+
+	s.Run("should get time interval by id", func() {
+		s.dbmock.ExpectQuery(selectQuery).WillReturnRows(sqlmock.NewRows(timeIntervalColumns).
+			AddRow(1, 1, "weekends", json.RawMessage(`[{"weekdays":["saturday","sunday"]}]`), true, time.Now(), time.Now()))
+		actualTimeInterval, err := s.repository.Get(1)
+		s.Equal(uint64(1), actualTimeInterval.Id)
+		s.Equal("weekends", actualTimeInterval.Urn)
+		s.Equal(TimePeriodList{{Weekdays: []string{"saturday", "sunday"}}}, actualTimeInterval.Periods)
+		s.True(actualTimeInterval.MuteNamespace)
+		s.Nil(err)
+	})
+
+	s.Run("should return error in finding time interval", func() {
+		s.dbmock.ExpectQuery(selectQuery).WillReturnError(errors.New("random error"))
+		actualTimeInterval, err := s.repository.Get(1)
+		s.EqualError(err, "random error")
+		s.Nil(actualTimeInterval)
+	})
+
+	s.Run("should return nil if time interval not found", func() {
+		s.dbmock.ExpectQuery(selectQuery).WillReturnRows(sqlmock.NewRows(timeIntervalColumns))
+		actualTimeInterval, err := s.repository.Get(1)
+		s.Nil(actualTimeInterval)
+		s.Nil(err)
+	})
+}
+
+func (s *TimeIntervalRepositoryTestSuite) TestList() {
+	s.Run("should get all time intervals", func() {
+		selectQuery := regexp.QuoteMeta(`SELECT * FROM "time_intervals"`)
+		s.dbmock.ExpectQuery(selectQuery).WillReturnRows(sqlmock.NewRows(timeIntervalColumns).
+			AddRow(1, 1, "weekends", json.RawMessage(`[]`), false, time.Now(), time.Now()).
+			AddRow(2, 2, "weekends", json.RawMessage(`[]`), false, time.Now(), time.Now()))
+		actualTimeIntervals, err := s.repository.List(0)
+		s.Equal(2, len(actualTimeIntervals))
+		s.Nil(err)
+	})
+
+	s.Run("should get time intervals of a namespace", func() {
+		selectQuery := regexp.QuoteMeta(`SELECT * FROM "time_intervals" WHERE namespace_id = 2`)
+		s.dbmock.ExpectQuery(selectQuery).WillReturnRows(sqlmock.NewRows(timeIntervalColumns).
+			AddRow(2, 2, "weekends", json.RawMessage(`[]`), false, time.Now(), time.Now()))
+		actualTimeIntervals, err := s.repository.List(2)
+		s.Equal(1, len(actualTimeIntervals))
+		s.Equal(uint64(2), actualTimeIntervals[0].NamespaceId)
+		s.Nil(err)
+	})
+
+	s.Run("should return error in fetching time intervals", func() {
+		selectQuery := regexp.QuoteMeta(`SELECT * FROM "time_intervals"`)
+		s.dbmock.ExpectQuery(selectQuery).WillReturnError(errors.New("random error"))
+		actualTimeIntervals, err := s.repository.List(0)
+		s.EqualError(err, "random error")
+		s.Nil(actualTimeIntervals)
+	})
+}
+
+func (s *TimeIntervalRepositoryTestSuite) TestUpdate() {
+	timenow := time.Now()
+	input := &TimeInterval{
+		Id:            1,
+		NamespaceId:   2,
+		Urn:           "weekends",
+		Periods:       TimePeriodList{{Weekdays: []string{"saturday", "sunday"}}},
+		MuteNamespace: true,
+	}
+	dummyProvider := &domain.Provider{Id: 1, Urn: "test", Type: "cortex", Host: "http://localhost:8080"}
+
+	selectQuery := regexp.QuoteMeta(`SELECT * FROM "time_intervals" WHERE id = 1`)
+	updateQuery := regexp.QuoteMeta(`UPDATE "time_intervals" SET "namespace_id"=$1,"urn"=$2,"periods"=$3,"mute_namespace"=$4,"updated_at"=$5 WHERE id = $6 AND "id" = $7`)
+	fetchSubscriptionsWithinNamespaceQuery := func(namespaceId int) string {
+		return regexp.QuoteMeta(fmt.Sprintf(`SELECT * FROM "subscriptions" WHERE namespace_id = %d`, namespaceId))
+	}
+	fetchInhibitionRulesWithinNamespaceQuery := func(namespaceId int) string {
+		return regexp.QuoteMeta(fmt.Sprintf(`SELECT * FROM "inhibition_rules" WHERE namespace_id = %d`, namespaceId))
+	}
+	fetchTimeIntervalsWithinNamespaceQuery := func(namespaceId int) string {
+		return regexp.QuoteMeta(fmt.Sprintf(`SELECT * FROM "time_intervals" WHERE namespace_id = %d`, namespaceId))
+	}
+	rowsInNamespace := func(namespaceId uint64) *sqlmock.Rows {
+		return sqlmock.NewRows(timeIntervalColumns).
+			AddRow(1, namespaceId, "weekends", json.RawMessage(`[{"weekdays":["saturday","sunday"]}]`), true, timenow, timenow)
+	}
+
+	s.Run("should update a time interval and sync the old and new namespaces", func() {
+		providerMock := &mocks.ProviderService{}
+		namespaceMock := &mocks.NamespaceService{}
+		receiverMock := &mocks.ReceiverService{}
+		amClientMock := &adapter.AdapterMock{}
+		providerMock.On("GetProvider", uint64(1)).Return(dummyProvider, nil).Twice()
+		namespaceMock.On("GetNamespace", uint64(1)).Return(&domain.Namespace{Id: 1, Provider: 1, Urn: "old"}, nil).Once()
+		namespaceMock.On("GetNamespace", uint64(2)).Return(&domain.Namespace{Id: 2, Provider: 1, Urn: "new"}, nil).Once()
+		receiverMock.On("ListReceivers").Return([]*domain.Receiver{}, nil).Twice()
+		amClientMock.On("SyncAlertmanagerConfig", mock.Anything, "old", mock.AnythingOfType("alertmanager.AMConfig")).Run(func(args mock.Arguments) {
+			s.Empty(args.Get(2).(alertmanager.AMConfig).TimeIntervals)
+		}).Return(nil).Once()
+		amClientMock.On("SyncAlertmanagerConfig", mock.Anything, "new", mock.AnythingOfType("alertmanager.AMConfig")).Run(func(args mock.Arguments) {
+			s.Equal(1, len(args.Get(2).(alertmanager.AMConfig).TimeIntervals))
+		}).Return(nil).Once()
+		s.adapters.Register("cortex", func(string, string) (adapter.Adapter, error) {
+			return amClientMock, nil
+		})
+
+		s.dbmock.ExpectBegin()
+		s.dbmock.ExpectQuery(selectQuery).WillReturnRows(rowsInNamespace(1))
+		s.dbmock.ExpectExec(updateQuery).WithArgs(input.NamespaceId, input.Urn, input.Periods, input.MuteNamespace,
+			AnyTime{}, input.Id, input.Id).WillReturnResult(sqlmock.NewResult(1, 1))
+		s.dbmock.ExpectQuery(selectQuery).WillReturnRows(rowsInNamespace(2))
+		s.dbmock.ExpectQuery(fetchSubscriptionsWithinNamespaceQuery(1)).WillReturnRows(sqlmock.NewRows(nil))
+		s.dbmock.ExpectQuery(fetchInhibitionRulesWithinNamespaceQuery(1)).WillReturnRows(sqlmock.NewRows(nil))
+		s.dbmock.ExpectQuery(fetchTimeIntervalsWithinNamespaceQuery(1)).WillReturnRows(sqlmock.NewRows(nil))
+		s.dbmock.ExpectQuery(fetchSubscriptionsWithinNamespaceQuery(2)).WillReturnRows(sqlmock.NewRows(nil))
+		s.dbmock.ExpectQuery(fetchInhibitionRulesWithinNamespaceQuery(2)).WillReturnRows(sqlmock.NewRows(nil))
+		s.dbmock.ExpectQuery(fetchTimeIntervalsWithinNamespaceQuery(2)).WillReturnRows(rowsInNamespace(2))
+		s.dbmock.ExpectCommit()
+
+		actualTimeInterval, err := s.repository.Update(input, namespaceMock, providerMock, receiverMock)
+		s.Nil(err)
+		s.Equal(uint64(2), actualTimeInterval.NamespaceId)
+		s.Equal(input.Periods, actualTimeInterval.Periods)
+		amClientMock.AssertExpectations(s.T())
+	})
+
+	s.Run("should return error if a subscription of the old namespace still refers to the time interval", func() {
+		providerMock := &mocks.ProviderService{}
+		namespaceMock := &mocks.NamespaceService{}
+		receiverMock := &mocks.ReceiverService{}
+
+		s.dbmock.ExpectBegin()
+		s.dbmock.ExpectQuery(selectQuery).WillReturnRows(rowsInNamespace(1))
+		s.dbmock.ExpectExec(updateQuery).WillReturnResult(sqlmock.NewResult(1, 1))
+		s.dbmock.ExpectQuery(selectQuery).WillReturnRows(rowsInNamespace(2))
+		s.dbmock.ExpectQuery(fetchSubscriptionsWithinNamespaceQuery(1)).WillReturnRows(
+			sqlmock.NewRows([]string{"id", "urn", "namespace_id", "mute_time_intervals"}).
+				AddRow(1, "foo", 1, json.RawMessage(`["weekends"]`)))
+		s.dbmock.ExpectQuery(fetchInhibitionRulesWithinNamespaceQuery(1)).WillReturnRows(sqlmock.NewRows(nil))
+		s.dbmock.ExpectQuery(fetchTimeIntervalsWithinNamespaceQuery(1)).WillReturnRows(sqlmock.NewRows(nil))
+		s.dbmock.ExpectRollback()
+
+		actualTimeInterval, err := s.repository.Update(input, namespaceMock, providerMock, receiverMock)
+		var invalidTimeIntervalErr *domain.InvalidTimeIntervalError
+		s.True(errors.As(err, &invalidTimeIntervalErr))
+		s.EqualError(err, "invalid time interval: subscription foo refers to time interval weekends missing from namespace 1")
+		s.Nil(actualTimeInterval)
+	})
+
+	s.Run("should return error in fetching time interval before update", func() {
+		s.dbmock.ExpectBegin()
+		s.dbmock.ExpectQuery(selectQuery).WillReturnError(errors.New("random error"))
+		s.dbmock.ExpectRollback()
+
+		actualTimeInterval, err := s.repository.Update(input, &mocks.NamespaceService{},
+			&mocks.ProviderService{}, &mocks.ReceiverService{})
+		s.EqualError(err, "random error")
+		s.Nil(actualTimeInterval)
+	})
+
+	s.Run("should return error if time interval does not exist", func() {
+		s.dbmock.ExpectBegin()
+		s.dbmock.ExpectQuery(selectQuery).WillReturnRows(sqlmock.NewRows(timeIntervalColumns))
+		s.dbmock.ExpectRollback()
+
+		actualTimeInterval, err := s.repository.Update(input, &mocks.NamespaceService{},
+			&mocks.ProviderService{}, &mocks.ReceiverService{})
+		s.EqualError(err, "time interval doesn't exist")
+		s.Nil(actualTimeInterval)
+	})
+
+	s.Run("should return error if updating time interval fails", func() {
+		s.dbmock.ExpectBegin()
+		s.dbmock.ExpectQuery(selectQuery).WillReturnRows(rowsInNamespace(1))
+		s.dbmock.ExpectExec(updateQuery).WillReturnError(errors.New("random error"))
+		s.dbmock.ExpectRollback()
+
+		actualTimeInterval, err := s.repository.Update(input, &mocks.NamespaceService{},
+			&mocks.ProviderService{}, &mocks.ReceiverService{})
+		s.EqualError(err, "random error")
+		s.Nil(actualTimeInterval)
+	})
+}
+
+func (s *TimeIntervalRepositoryTestSuite) TestDelete() {
+	dummyNamespace := &domain.Namespace{Id: 1, Provider: 1, Urn: "dummy"}
+	dummyProvider := &domain.Provider{Id: 1, Urn: "test", Type: "cortex", Host: "http://localhost:8080"}
+
+	selectQuery := regexp.QuoteMeta(`SELECT * FROM "time_intervals" WHERE id = 1`)
+	deleteQuery := regexp.QuoteMeta(`DELETE FROM "time_intervals" WHERE "time_intervals"."id" = $1`)
+	fetchSubscriptionsWithinNamespaceQuery := regexp.QuoteMeta(`SELECT * FROM "subscriptions" WHERE namespace_id = 1`)
+	fetchInhibitionRulesWithinNamespaceQuery := regexp.QuoteMeta(`SELECT * FROM "inhibition_rules" WHERE namespace_id = 1`)
+	fetchTimeIntervalsWithinNamespaceQuery := regexp.QuoteMeta(`SELECT * FROM "time_intervals" WHERE namespace_id = 1`)
+	expectedRows := func() *sqlmock.Rows {
+		return sqlmock.NewRows(timeIntervalColumns).
+			AddRow(1, 1, "weekends", json.RawMessage(`[]`), false, time.Now(), time.Now())
+	}
+
+	s.Run("should delete a time interval and sync its namespace", func() {
+		providerMock := &mocks.ProviderService{}
+		namespaceMock := &mocks.NamespaceService{}
+		receiverMock := &mocks.ReceiverService{}
+		amClientMock := &adapter.AdapterMock{}
+		providerMock.On("GetProvider", uint64(1)).Return(dummyProvider, nil).Once()
+		namespaceMock.On("GetNamespace", uint64(1)).Return(dummyNamespace, nil).Once()
+		receiverMock.On("ListReceivers").Return([]*domain.Receiver{}, nil).Once()
+		amClientMock.On("SyncAlertmanagerConfig", mock.Anything, "dummy", mock.AnythingOfType("alertmanager.AMConfig")).Run(func(args mock.Arguments) {
+			s.Empty(args.Get(2).(alertmanager.AMConfig).TimeIntervals)
+		}).Return(nil).Once()
+		s.adapters.Register("cortex", func(string, string) (adapter.Adapter, error) {
+			return amClientMock, nil
+		})
+
+		s.dbmock.ExpectBegin()
+		s.dbmock.ExpectQuery(selectQuery).WillReturnRows(expectedRows())
+		s.dbmock.ExpectExec(deleteQuery).WithArgs(1).WillReturnResult(sqlmock.NewResult(0, 1))
+		s.dbmock.ExpectQuery(fetchSubscriptionsWithinNamespaceQuery).WillReturnRows(sqlmock.NewRows(nil))
+		s.dbmock.ExpectQuery(fetchInhibitionRulesWithinNamespaceQuery).WillReturnRows(sqlmock.NewRows(nil))
+		s.dbmock.ExpectQuery(fetchTimeIntervalsWithinNamespaceQuery).WillReturnRows(sqlmock.NewRows(nil))
+		s.dbmock.ExpectCommit()
+
+		err := s.repository.Delete(1, namespaceMock, providerMock, receiverMock)
+		s.Nil(err)
+		amClientMock.AssertExpectations(s.T())
+	})
+
+	s.Run("should return no error if time interval does not exist", func() {
+		s.dbmock.ExpectBegin()
+		s.dbmock.ExpectQuery(selectQuery).WillReturnRows(sqlmock.NewRows(timeIntervalColumns))
+		s.dbmock.ExpectCommit()
+
+		err := s.repository.Delete(1, &mocks.NamespaceService{}, &mocks.ProviderService{}, &mocks.ReceiverService{})
+		s.Nil(err)
+	})
+
+	s.Run("should return error in deleting time interval", func() {
+		s.dbmock.ExpectBegin()
+		s.dbmock.ExpectQuery(selectQuery).WillReturnRows(expectedRows())
+		s.dbmock.ExpectExec(deleteQuery).WithArgs(1).WillReturnError(errors.New("random error"))
+		s.dbmock.ExpectRollback()
+
+		err := s.repository.Delete(1, &mocks.NamespaceService{}, &mocks.ProviderService{}, &mocks.ReceiverService{})
+		s.EqualError(err, "failed to delete time interval: random error")
+	})
+}
+
+func TestTimeIntervalRepository(t *testing.T) {
+	suite.Run(t, new(TimeIntervalRepositoryTestSuite))
+}
@@ -0,0 +1,116 @@
+package subscription
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/odpf/siren/domain"
+	"github.com/odpf/siren/pkg/adapter"
+	"github.com/odpf/siren/pkg/namespace"
+	"github.com/odpf/siren/pkg/provider"
+	"github.com/odpf/siren/pkg/receiver"
+	"github.com/pkg/errors"
+	"github.com/prometheus/alertmanager/timeinterval"
+	"gorm.io/gorm"
+)
+
+// TimeIntervalService handles business logic of time intervals
+type TimeIntervalService struct {
+	repository       TimeIntervalRepository
+	providerService  domain.ProviderService
+	namespaceService domain.NamespaceService
+	receiverService  domain.ReceiverService
+}
+
+// NewTimeIntervalService returns service struct
+func NewTimeIntervalService(db *gorm.DB, adapters *adapter.Registry, key string) (domain.TimeIntervalService, error) {
+	repository := NewTimeIntervalRepository(db, adapters)
+	providerService := provider.NewService(db)
+	receiverService, err := receiver.NewService(db, nil, key)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create receiver service")
+	}
+	namespaceService, err := namespace.NewService(db, key,
+		&NamespaceSyncer{NewRepository(db, adapters), providerService, receiverService})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create namespace service")
+	}
+	return &TimeIntervalService{repository, providerService,
+		namespaceService, receiverService}, nil
+}
+
+func (s TimeIntervalService) ListTimeIntervals(namespaceId uint64) ([]*domain.TimeInterval, error) {
+	timeIntervals, err := s.repository.List(namespaceId)
+	if err != nil {
+		return nil, errors.Wrap(err, "s.repository.List")
+	}
+	domainTimeIntervals := make([]*domain.TimeInterval, 0, len(timeIntervals))
+	for _, timeInterval := range timeIntervals {
+		domainTimeIntervals = append(domainTimeIntervals, timeInterval.toDomain())
+	}
+	return domainTimeIntervals, nil
+}
+
+func (s TimeIntervalService) CreateTimeInterval(domainTimeInterval *domain.TimeInterval) (*domain.TimeInterval, error) {
+	if err := validateTimeInterval(domainTimeInterval); err != nil {
+		return nil, err
+	}
+	timeInterval := &TimeInterval{}
+	timeInterval.fromDomain(domainTimeInterval)
+	newTimeInterval, err := s.repository.Create(timeInterval, s.namespaceService, s.providerService, s.receiverService)
+	if err != nil {
+		return nil, errors.Wrap(err, "s.repository.Create")
+	}
+	return newTimeInterval.toDomain(), nil
+}
+
+func (s TimeIntervalService) GetTimeInterval(id uint64) (*domain.TimeInterval, error) {
+	timeInterval, err := s.repository.Get(id)
+	if err != nil {
+		return nil, errors.Wrap(err, "s.repository.Get")
+	}
+	if timeInterval == nil {
+		return nil, nil
+	}
+	return timeInterval.toDomain(), nil
+}
+
+func (s TimeIntervalService) UpdateTimeInterval(domainTimeInterval *domain.TimeInterval) (*domain.TimeInterval, error) {
+	if err := validateTimeInterval(domainTimeInterval); err != nil {
+		return nil, err
+	}
+	timeInterval := &TimeInterval{}
+	timeInterval.fromDomain(domainTimeInterval)
+	updatedTimeInterval, err := s.repository.Update(timeInterval, s.namespaceService, s.providerService, s.receiverService)
+	if err != nil {
+		return nil, errors.Wrap(err, "s.repository.Update")
+	}
+	return updatedTimeInterval.toDomain(), nil
+}
+
+func (s TimeIntervalService) DeleteTimeInterval(id uint64) error {
+	return s.repository.Delete(id, s.namespaceService, s.providerService, s.receiverService)
+}
+
+func (s TimeIntervalService) Migrate() error {
+	return s.repository.Migrate()
+}
+
+// validateTimeInterval requires at least one period and parses each of them
+// like alertmanager does. Periods are in UTC, alertmanager v0.22 predating
+// the location of time intervals.
+func validateTimeInterval(timeInterval *domain.TimeInterval) error {
+	if len(timeInterval.Periods) == 0 {
+		return &domain.InvalidTimeIntervalError{Reason: "periods cannot be empty"}
+	}
+	for idx, period := range timeInterval.Periods {
+		periodJSON, err := json.Marshal(period)
+		if err != nil {
+			return errors.Wrap(err, "failed to marshal time period")
+		}
+		var amPeriod timeinterval.TimeInterval
+		if err := json.Unmarshal(periodJSON, &amPeriod); err != nil {
+			return &domain.InvalidTimeIntervalError{Reason: fmt.Sprintf("period %d: %s", idx, err)}
+		}
+	}
+	return nil
+}
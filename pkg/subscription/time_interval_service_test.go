@@ -0,0 +1,182 @@
+package subscription
+
+import (
+	"errors"
+	"github.com/odpf/siren/domain"
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+func TestTimeIntervalService_CreateTimeInterval(t *testing.T) {
+	timeNow := time.Now()
+	periods := []domain.TimePeriod{{
+		Times:    []domain.TimeRange{{StartTime: "09:00", EndTime: "17:00"}},
+		Weekdays: []string{"monday:friday"},
+	}}
+	input := &domain.TimeInterval{Id: 1, Urn: "business-hours", Namespace: 1, Periods: periods,
+		CreatedAt: timeNow, UpdatedAt: timeNow}
+	timeInterval := &TimeInterval{Id: 1, Urn: "business-hours", NamespaceId: 1, Periods: periods,
+		CreatedAt: timeNow, UpdatedAt: timeNow}
+
+	t.Run("should call repository create method and return result in domain's type", func(t *testing.T) {
+		repositoryMock := &TimeIntervalRepositoryMock{}
+		repositoryMock.On("Create", timeInterval, nil, nil, nil).Return(timeInterval, nil).Once()
+		dummyService := TimeIntervalService{repositoryMock, nil, nil, nil}
+
+		result, err := dummyService.CreateTimeInterval(input)
+		assert.Nil(t, err)
+		assert.Equal(t, input, result)
+		repositoryMock.AssertExpectations(t)
+	})
+
+	t.Run("should return error in time interval creation", func(t *testing.T) {
+		repositoryMock := &TimeIntervalRepositoryMock{}
+		repositoryMock.On("Create", timeInterval, nil, nil, nil).Return(nil, errors.New("random error")).Once()
+		dummyService := TimeIntervalService{repositoryMock, nil, nil, nil}
+
+		result, err := dummyService.CreateTimeInterval(input)
+		assert.EqualError(t, err, "s.repository.Create: random error")
+		assert.Nil(t, result)
+	})
+
+	t.Run("should return error if the time interval is invalid", func(t *testing.T) {
+		for _, testCase := range []struct {
+			periods []domain.TimePeriod
+			reason  string
+		}{
+			{nil, "periods cannot be empty"},
+			{[]domain.TimePeriod{{Times: []domain.TimeRange{{StartTime: "17:00", EndTime: "09:00"}}}},
+				"period 0: start time cannot be equal or greater than end time"},
+			{[]domain.TimePeriod{{}, {Weekdays: []string{"funday"}}}, "period 1: funday is not a valid weekday"},
+		} {
+			dummyService := TimeIntervalService{&TimeIntervalRepositoryMock{}, nil, nil, nil}
+
+			result, err := dummyService.CreateTimeInterval(&domain.TimeInterval{Urn: "foo", Periods: testCase.periods})
+			var invalidTimeIntervalErr *domain.InvalidTimeIntervalError
+			assert.True(t, errors.As(err, &invalidTimeIntervalErr))
+			assert.Equal(t, testCase.reason, invalidTimeIntervalErr.Reason)
+			assert.Nil(t, result)
+		}
+	})
+}
+
+func TestTimeIntervalService_GetTimeInterval(t *testing.T) {
+	t.Run("should call repository get method and return result in domain's type", func(t *testing.T) {
+		repositoryMock := &TimeIntervalRepositoryMock{}
+		repositoryMock.On("Get", uint64(1)).Return(&TimeInterval{Id: 1, Urn: "business-hours", NamespaceId: 1}, nil).Once()
+		dummyService := TimeIntervalService{repositoryMock, nil, nil, nil}
+
+		result, err := dummyService.GetTimeInterval(1)
+		assert.Nil(t, err)
+		assert.Equal(t, &domain.TimeInterval{Id: 1, Urn: "business-hours", Namespace: 1}, result)
+	})
+
+	t.Run("should not return error if time interval doesn't exist", func(t *testing.T) {
+		repositoryMock := &TimeIntervalRepositoryMock{}
+		repositoryMock.On("Get", uint64(1)).Return(nil, nil).Once()
+		dummyService := TimeIntervalService{repositoryMock, nil, nil, nil}
+
+		result, err := dummyService.GetTimeInterval(1)
+		assert.Nil(t, err)
+		assert.Nil(t, result)
+	})
+
+	t.Run("should call repository get method and return error if any", func(t *testing.T) {
+		repositoryMock := &TimeIntervalRepositoryMock{}
+		repositoryMock.On("Get", uint64(1)).Return(nil, errors.New("random error")).Once()
+		dummyService := TimeIntervalService{repositoryMock, nil, nil, nil}
+
+		result, err := dummyService.GetTimeInterval(1)
+		assert.EqualError(t, err, "s.repository.Get: random error")
+		assert.Nil(t, result)
+	})
+}
+
+func TestTimeIntervalService_ListTimeIntervals(t *testing.T) {
+	t.Run("should call repository list method and return result in domain's type", func(t *testing.T) {
+		repositoryMock := &TimeIntervalRepositoryMock{}
+		repositoryMock.On("List", uint64(1)).Return([]*TimeInterval{{Id: 1, Urn: "business-hours", NamespaceId: 1}}, nil).Once()
+		dummyService := TimeIntervalService{repositoryMock, nil, nil, nil}
+
+		result, err := dummyService.ListTimeIntervals(1)
+		assert.Nil(t, err)
+		assert.Equal(t, []*domain.TimeInterval{{Id: 1, Urn: "business-hours", Namespace: 1}}, result)
+	})
+
+	t.Run("should call repository list method and return error if any", func(t *testing.T) {
+		repositoryMock := &TimeIntervalRepositoryMock{}
+		repositoryMock.On("List", uint64(0)).Return(nil, errors.New("random error")).Once()
+		dummyService := TimeIntervalService{repositoryMock, nil, nil, nil}
+
+		result, err := dummyService.ListTimeIntervals(0)
+		assert.EqualError(t, err, "s.repository.List: random error")
+		assert.Nil(t, result)
+	})
+}
+
+func TestTimeIntervalService_UpdateTimeInterval(t *testing.T) {
+	periods := []domain.TimePeriod{{Weekdays: []string{"saturday", "sunday"}}}
+	input := &domain.TimeInterval{Id: 1, Urn: "weekends", Namespace: 1, Periods: periods, MuteNamespace: true}
+	timeInterval := &TimeInterval{Id: 1, Urn: "weekends", NamespaceId: 1, Periods: periods, MuteNamespace: true}
+
+	t.Run("should call repository update method and return result in domain's type", func(t *testing.T) {
+		repositoryMock := &TimeIntervalRepositoryMock{}
+		repositoryMock.On("Update", timeInterval, nil, nil, nil).Return(timeInterval, nil).Once()
+		dummyService := TimeIntervalService{repositoryMock, nil, nil, nil}
+
+		result, err := dummyService.UpdateTimeInterval(input)
+		assert.Nil(t, err)
+		assert.Equal(t, input, result)
+	})
+
+	t.Run("should return error in time interval update", func(t *testing.T) {
+		repositoryMock := &TimeIntervalRepositoryMock{}
+		repositoryMock.On("Update", timeInterval, nil, nil, nil).Return(nil, errors.New("random error")).Once()
+		dummyService := TimeIntervalService{repositoryMock, nil, nil, nil}
+
+		result, err := dummyService.UpdateTimeInterval(input)
+		assert.EqualError(t, err, "s.repository.Update: random error")
+		assert.Nil(t, result)
+	})
+
+	t.Run("should return error if the time interval is invalid", func(t *testing.T) {
+		dummyService := TimeIntervalService{&TimeIntervalRepositoryMock{}, nil, nil, nil}
+
+		result, err := dummyService.UpdateTimeInterval(&domain.TimeInterval{Id: 1, Urn: "weekends"})
+		assert.EqualError(t, err, "invalid time interval: periods cannot be empty")
+		assert.Nil(t, result)
+	})
+}
+
+func TestTimeIntervalService_DeleteTimeInterval(t *testing.T) {
+	t.Run("should call repository delete method and return result", func(t *testing.T) {
+		repositoryMock := &TimeIntervalRepositoryMock{}
+		repositoryMock.On("Delete", uint64(1), nil, nil, nil).Return(nil).Once()
+		dummyService := TimeIntervalService{repositoryMock, nil, nil, nil}
+
+		err := dummyService.DeleteTimeInterval(1)
+		assert.Nil(t, err)
+	})
+
+	t.Run("should return error in time interval delete", func(t *testing.T) {
+		repositoryMock := &TimeIntervalRepositoryMock{}
+		repositoryMock.On("Delete", uint64(1), nil, nil, nil).Return(errors.New("random error")).Once()
+		dummyService := TimeIntervalService{repositoryMock, nil, nil, nil}
+
+		err := dummyService.DeleteTimeInterval(1)
+		assert.EqualError(t, err, "random error")
+	})
+}
+
+func TestTimeIntervalService_Migrate(t *testing.T) {
+	t.Run("should call repository Migrate method and return result", func(t *testing.T) {
+		repositoryMock := &TimeIntervalRepositoryMock{}
+		repositoryMock.On("Migrate").Return(nil).Once()
+		dummyService := TimeIntervalService{repositoryMock, nil, nil, nil}
+
+		err := dummyService.Migrate()
+		assert.Nil(t, err)
+		repositoryMock.AssertExpectations(t)
+	})
+}
@@ -0,0 +1,238 @@
+package templates
+
+import (
+	"errors"
+	"fmt"
+	"github.com/odpf/siren/domain"
+	"github.com/prometheus/prometheus/pkg/rulefmt"
+	"github.com/prometheus/prometheus/promql/parser"
+	"gopkg.in/yaml.v3"
+	"math"
+	"reflect"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+var invalidNameRegex = regexp.MustCompile(`[^A-Za-z0-9_-]`)
+
+// convertedRule is a rule of a converted template, with a templatized for
+type convertedRule struct {
+	Record      string            `yaml:"record,omitempty"`
+	Alert       string            `yaml:"alert,omitempty"`
+	Expr        string            `yaml:"expr"`
+	For         string            `yaml:"for,omitempty"`
+	Labels      map[string]string `yaml:"labels,omitempty"`
+	Annotations map[string]string `yaml:"annotations,omitempty"`
+}
+
+// threshold is a number literal compared against in an expression
+type threshold struct {
+	start, end int
+	text       string
+}
+
+// ConvertRuleFile turns every rule of a Prometheus rule file into a template
+// tagged with the name of its group. The numeric thresholds of comparisons in
+// the expression, the for duration and the values of the given labels become
+// variables defaulting to their values, so that every template renders its
+// rule unchanged by default.
+func ConvertRuleFile(content []byte, labelVariables []string) ([]domain.Template, error) {
+	ruleGroups, errs := rulefmt.Parse(content)
+	if len(errs) != 0 {
+		messages := make([]string, 0, len(errs))
+		for _, err := range errs {
+			messages = append(messages, err.Error())
+		}
+		return nil, errors.New(strings.Join(messages, "; "))
+	}
+
+	converted := make([]domain.Template, 0)
+	names := make(map[string]bool)
+	for _, group := range ruleGroups.Groups {
+		for _, ruleNode := range group.Rules {
+			rule := rulefmt.Rule{
+				Record:      ruleNode.Record.Value,
+				Alert:       ruleNode.Alert.Value,
+				Expr:        ruleNode.Expr.Value,
+				For:         ruleNode.For,
+				Labels:      ruleNode.Labels,
+				Annotations: ruleNode.Annotations,
+			}
+			template, err := convertRule(rule, labelVariables)
+			if err != nil {
+				return nil, err
+			}
+			template.Name = uniqueName(template.Name, names)
+			template.Tags = []string{group.Name}
+			converted = append(converted, *template)
+		}
+	}
+	return converted, nil
+}
+
+func uniqueName(name string, names map[string]bool) string {
+	unique := name
+	for i := 2; names[unique]; i++ {
+		unique = fmt.Sprintf("%s_%d", name, i)
+	}
+	names[unique] = true
+	return unique
+}
+
+func convertRule(rule rulefmt.Rule, labelVariables []string) (*domain.Template, error) {
+	name := rule.Alert
+	if name == "" {
+		name = rule.Record
+	}
+	expr, variables, err := templatizeExpr(rule.Expr)
+	if err != nil {
+		return nil, fmt.Errorf("rule %s: %w", name, err)
+	}
+	converted := convertedRule{
+		Record:      rule.Record,
+		Alert:       rule.Alert,
+		Expr:        expr,
+		Annotations: rule.Annotations,
+	}
+	if rule.For != 0 {
+		converted.For = "[[ .for ]]"
+		variables = append(variables, domain.Variable{
+			Name:        "for",
+			Type:        variableTypeDuration,
+			Default:     rule.For.String(),
+			Description: "Duration the condition has to hold before the alert fires",
+		})
+	}
+	if len(rule.Labels) != 0 {
+		converted.Labels = make(map[string]string, len(rule.Labels))
+		for label, value := range rule.Labels {
+			converted.Labels[label] = value
+		}
+	}
+	used := make(map[string]bool)
+	for _, variable := range variables {
+		used[variable.Name] = true
+	}
+	for _, label := range labelVariables {
+		value, ok := rule.Labels[label]
+		if !ok {
+			continue
+		}
+		variableName := invalidNameRegex.ReplaceAllString(label, "_")
+		if used[variableName] {
+			variableName += "_label"
+		}
+		used[variableName] = true
+		converted.Labels[label] = fmt.Sprintf("[[ .%s ]]", variableName)
+		variables = append(variables, domain.Variable{
+			Name:        variableName,
+			Type:        variableTypeString,
+			Default:     value,
+			Description: fmt.Sprintf("Value of the %s label", label),
+		})
+	}
+
+	body, err := yaml.Marshal([]convertedRule{converted})
+	if err != nil {
+		return nil, err
+	}
+	template := &domain.Template{
+		Name:      invalidNameRegex.ReplaceAllString(name, "_"),
+		Body:      string(body),
+		Variables: variables,
+	}
+	if err := ValidateVariables(template.Variables); err != nil {
+		return nil, fmt.Errorf("rule %s: %w", name, err)
+	}
+	if err := checkConvertedRule(template, rule); err != nil {
+		return nil, fmt.Errorf("rule %s cannot be converted to a template: %w", name, err)
+	}
+	return template, nil
+}
+
+// templatizeExpr replaces the number literals compared against in an
+// expression with variables named threshold, threshold_2 and so on
+func templatizeExpr(expr string) (string, []domain.Variable, error) {
+	parsed, err := parser.ParseExpr(expr)
+	if err != nil {
+		return "", nil, err
+	}
+	var thresholds []threshold
+	parser.Inspect(parsed, func(node parser.Node, _ []parser.Node) error {
+		binaryExpr, ok := node.(*parser.BinaryExpr)
+		if !ok || !binaryExpr.Op.IsComparisonOperator() {
+			return nil
+		}
+		for _, operand := range []parser.Expr{binaryExpr.LHS, binaryExpr.RHS} {
+			number, ok := operand.(*parser.NumberLiteral)
+			if !ok || math.IsInf(number.Val, 0) || math.IsNaN(number.Val) {
+				continue
+			}
+			start, end := int(number.PosRange.Start), int(number.PosRange.End)
+			if start < 0 || end > len(expr) || start >= end {
+				continue
+			}
+			thresholds = append(thresholds, threshold{start: start, end: end, text: expr[start:end]})
+		}
+		return nil
+	})
+	sort.Slice(thresholds, func(i, j int) bool {
+		return thresholds[i].start < thresholds[j].start
+	})
+
+	var variables []domain.Variable
+	var templatized strings.Builder
+	last := 0
+	for _, t := range thresholds {
+		variableType := variableTypeFloat
+		if _, err := strconv.ParseInt(t.text, 10, 64); err == nil {
+			variableType = variableTypeInt
+		} else if _, err := strconv.ParseFloat(t.text, 64); err != nil {
+			// literals like hexadecimals are kept as they are
+			continue
+		}
+		name := "threshold"
+		if len(variables) != 0 {
+			name = fmt.Sprintf("threshold_%d", len(variables)+1)
+		}
+		variables = append(variables, domain.Variable{
+			Name:        name,
+			Type:        variableType,
+			Default:     t.text,
+			Description: "Threshold of the condition of the rule",
+		})
+		templatized.WriteString(expr[last:t.start])
+		templatized.WriteString(fmt.Sprintf("[[ .%s ]]", name))
+		last = t.end
+	}
+	templatized.WriteString(expr[last:])
+	return templatized.String(), variables, nil
+}
+
+// checkConvertedRule checks that a converted template renders its rule
+// unchanged with the default values of its variables
+func checkConvertedRule(template *domain.Template, rule rulefmt.Rule) error {
+	rendered, err := RenderBody(template, nil)
+	if err != nil {
+		return err
+	}
+	var renderedRules []rulefmt.Rule
+	if err := yaml.Unmarshal([]byte(rendered), &renderedRules); err != nil {
+		return err
+	}
+	if len(renderedRules) != 1 || !sameRule(renderedRules[0], rule) {
+		return errors.New("the rendered rule differs from the original rule")
+	}
+	return nil
+}
+
+// sameRule compares rules, treating empty and missing labels or annotations alike
+func sameRule(a, b rulefmt.Rule) bool {
+	sameMap := func(x, y map[string]string) bool {
+		return (len(x) == 0 && len(y) == 0) || reflect.DeepEqual(x, y)
+	}
+	return a.Record == b.Record && a.Alert == b.Alert && a.Expr == b.Expr && a.For == b.For &&
+		sameMap(a.Labels, b.Labels) && sameMap(a.Annotations, b.Annotations)
+}
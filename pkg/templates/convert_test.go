@@ -0,0 +1,106 @@
+package templates
+
+import (
+	"github.com/odpf/siren/domain"
+	"github.com/prometheus/prometheus/pkg/rulefmt"
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/yaml.v3"
+	"testing"
+	"time"
+)
+
+const dummyRuleFile = `
+groups:
+  - name: cpu
+    rules:
+      - alert: CPUHigh
+        expr: avg by (host) (cpu_usage_user) > 80 and avg by (host) (cpu_usage_user) < 99.5
+        for: 5m
+        labels:
+          severity: WARNING
+          team: odpf
+        annotations:
+          summary: CPU of {{ $labels.host }} is high
+      - record: job:cpu:avg
+        expr: avg by (job) (cpu_usage_user)
+  - name: cpu-staging
+    rules:
+      - alert: CPUHigh
+        expr: avg by (host) (cpu_usage_user) > 95
+`
+
+func renderConverted(t *testing.T, template domain.Template, variables map[string]string) rulefmt.Rule {
+	rendered, err := RenderBody(&template, variables)
+	assert.Nil(t, err)
+	var rules []rulefmt.Rule
+	assert.Nil(t, yaml.Unmarshal([]byte(rendered), &rules))
+	assert.Equal(t, 1, len(rules))
+	return rules[0]
+}
+
+func TestConvertRuleFile(t *testing.T) {
+	t.Run("should turn thresholds, for and the given labels into variables", func(t *testing.T) {
+		converted, err := ConvertRuleFile([]byte(dummyRuleFile), []string{"severity", "cluster"})
+		assert.Nil(t, err)
+		assert.Equal(t, 3, len(converted))
+
+		template := converted[0]
+		assert.Equal(t, "CPUHigh", template.Name)
+		assert.Equal(t, []string{"cpu"}, template.Tags)
+		assert.Equal(t, []domain.Variable{
+			{Name: "threshold", Type: "int", Default: "80", Description: "Threshold of the condition of the rule"},
+			{Name: "threshold_2", Type: "float", Default: "99.5", Description: "Threshold of the condition of the rule"},
+			{Name: "for", Type: "duration", Default: "5m", Description: "Duration the condition has to hold before the alert fires"},
+			{Name: "severity", Type: "string", Default: "WARNING", Description: "Value of the severity label"},
+		}, template.Variables)
+
+		rule := renderConverted(t, template, map[string]string{"threshold": "90", "for": "10m", "severity": "CRITICAL"})
+		assert.Equal(t, "CPUHigh", rule.Alert)
+		assert.Equal(t, "avg by (host) (cpu_usage_user) > 90 and avg by (host) (cpu_usage_user) < 99.5", rule.Expr)
+		assert.Equal(t, 10*time.Minute, time.Duration(rule.For))
+		assert.Equal(t, map[string]string{"severity": "CRITICAL", "team": "odpf"}, rule.Labels)
+		assert.Equal(t, map[string]string{"summary": "CPU of {{ $labels.host }} is high"}, rule.Annotations)
+	})
+
+	t.Run("should keep rules without thresholds as they are", func(t *testing.T) {
+		converted, err := ConvertRuleFile([]byte(dummyRuleFile), nil)
+		assert.Nil(t, err)
+
+		template := converted[1]
+		assert.Equal(t, "job_cpu_avg", template.Name)
+		assert.Empty(t, template.Variables)
+		rule := renderConverted(t, template, nil)
+		assert.Equal(t, "job:cpu:avg", rule.Record)
+		assert.Equal(t, "avg by (job) (cpu_usage_user)", rule.Expr)
+	})
+
+	t.Run("should give unique names to rules of the same name", func(t *testing.T) {
+		converted, err := ConvertRuleFile([]byte(dummyRuleFile), nil)
+		assert.Nil(t, err)
+		assert.Equal(t, "CPUHigh_2", converted[2].Name)
+		assert.Equal(t, []string{"cpu-staging"}, converted[2].Tags)
+		assert.Equal(t, "95", converted[2].Variables[0].Default)
+	})
+
+	t.Run("should return error if the rule file is invalid", func(t *testing.T) {
+		converted, err := ConvertRuleFile([]byte("groups:\n  - name: cpu\n    rules:\n      - alert: CPUHigh\n        expr: avg(\n"), nil)
+		assert.Error(t, err)
+		assert.Nil(t, converted)
+	})
+}
+
+func TestTemplatizeExpr(t *testing.T) {
+	t.Run("should templatize thresholds on the left hand side", func(t *testing.T) {
+		expr, variables, err := templatizeExpr("5 >= delta(temperature[1h])")
+		assert.Nil(t, err)
+		assert.Equal(t, "[[ .threshold ]] >= delta(temperature[1h])", expr)
+		assert.Equal(t, "5", variables[0].Default)
+	})
+
+	t.Run("should leave numbers that are not compared against", func(t *testing.T) {
+		expr, variables, err := templatizeExpr("rate(errors_total[5m]) * 100 > 1")
+		assert.Nil(t, err)
+		assert.Equal(t, "rate(errors_total[5m]) * 100 > [[ .threshold ]]", expr)
+		assert.Equal(t, 1, len(variables))
+	})
+}
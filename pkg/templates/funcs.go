@@ -0,0 +1,182 @@
+package templates
+
+import (
+	"fmt"
+	"github.com/prometheus/common/model"
+	"gopkg.in/yaml.v3"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// funcMap returns the functions available in template bodies besides the
+// builtin ones. include is a placeholder until a template is executed, when
+// it is bound to the partials of the template.
+func funcMap() map[string]interface{} {
+	return map[string]interface{}{
+		"include":         includeUnavailable,
+		"default":         defaultValue,
+		"join":            join,
+		"split":           split,
+		"quote":           quote,
+		"toYaml":          toYaml,
+		"indent":          indent,
+		"nindent":         nindent,
+		"durationAdd":     durationAdd,
+		"durationMul":     durationMul,
+		"durationSeconds": durationSeconds,
+		"labelMatcher":    labelMatcher,
+		"regexMatcher":    regexMatcher,
+	}
+}
+
+func includeUnavailable(name string, data interface{}) (string, error) {
+	return "", fmt.Errorf("cannot include %s outside of a rendered template", name)
+}
+
+// defaultValue returns value, or fallback when value is empty
+func defaultValue(fallback, value interface{}) interface{} {
+	switch v := value.(type) {
+	case nil:
+		return fallback
+	case string:
+		if v == "" {
+			return fallback
+		}
+	case []string:
+		if len(v) == 0 {
+			return fallback
+		}
+	case []interface{}:
+		if len(v) == 0 {
+			return fallback
+		}
+	}
+	return value
+}
+
+func toStrings(values interface{}) []string {
+	switch v := values.(type) {
+	case nil:
+		return nil
+	case []string:
+		return v
+	case []interface{}:
+		result := make([]string, 0, len(v))
+		for _, value := range v {
+			result = append(result, fmt.Sprint(value))
+		}
+		return result
+	default:
+		return []string{fmt.Sprint(v)}
+	}
+}
+
+func join(separator string, values interface{}) string {
+	return strings.Join(toStrings(values), separator)
+}
+
+// split splits s around separator, an empty s gives no values
+func split(separator, s string) []string {
+	if s == "" {
+		return []string{}
+	}
+	return strings.Split(s, separator)
+}
+
+func quote(value interface{}) string {
+	return strconv.Quote(fmt.Sprint(value))
+}
+
+func toYaml(value interface{}) (string, error) {
+	out, err := yaml.Marshal(value)
+	if err != nil {
+		return "", err
+	}
+	return strings.TrimSuffix(string(out), "\n"), nil
+}
+
+func indent(spaces int, s string) string {
+	padding := strings.Repeat(" ", spaces)
+	return padding + strings.ReplaceAll(s, "\n", "\n"+padding)
+}
+
+func nindent(spaces int, s string) string {
+	return "\n" + indent(spaces, s)
+}
+
+func parseDurations(durations ...string) ([]time.Duration, error) {
+	result := make([]time.Duration, 0, len(durations))
+	for _, d := range durations {
+		parsed, err := model.ParseDuration(d)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, time.Duration(parsed))
+	}
+	return result, nil
+}
+
+// durationAdd adds prometheus durations, like 1h and 30m to 1h30m
+func durationAdd(durations ...string) (string, error) {
+	parsed, err := parseDurations(durations...)
+	if err != nil {
+		return "", err
+	}
+	var sum time.Duration
+	for _, d := range parsed {
+		sum += d
+	}
+	return model.Duration(sum).String(), nil
+}
+
+// durationMul multiplies a prometheus duration by a number or a numeric string
+func durationMul(duration string, factor interface{}) (string, error) {
+	parsed, err := parseDurations(duration)
+	if err != nil {
+		return "", err
+	}
+	var f float64
+	switch v := factor.(type) {
+	case int:
+		f = float64(v)
+	case int64:
+		f = float64(v)
+	case float64:
+		f = v
+	case string:
+		f, err = strconv.ParseFloat(v, 64)
+		if err != nil {
+			return "", fmt.Errorf("%q is not a number", v)
+		}
+	default:
+		return "", fmt.Errorf("%v is not a number", factor)
+	}
+	return model.Duration(time.Duration(float64(parsed[0]) * f)).String(), nil
+}
+
+// durationSeconds returns the number of seconds of a prometheus duration
+func durationSeconds(duration string) (float64, error) {
+	parsed, err := parseDurations(duration)
+	if err != nil {
+		return 0, err
+	}
+	return parsed[0].Seconds(), nil
+}
+
+// labelMatcher builds an equality label matcher, like team="odpf"
+func labelMatcher(name string, value interface{}) string {
+	return name + "=" + quote(value)
+}
+
+// regexMatcher builds a regex label matcher matching any of the given
+// values literally, like team=~"odpf|gotocompany"
+func regexMatcher(name string, values interface{}) string {
+	list := toStrings(values)
+	escaped := make([]string, 0, len(list))
+	for _, value := range list {
+		escaped = append(escaped, regexp.QuoteMeta(value))
+	}
+	return name + "=~" + quote(strings.Join(escaped, "|"))
+}
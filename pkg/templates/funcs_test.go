@@ -0,0 +1,138 @@
+package templates
+
+import (
+	"github.com/odpf/siren/domain"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestHelperFunctions(t *testing.T) {
+	tests := []struct {
+		name      string
+		body      string
+		variables map[string]string
+		expected  string
+		err       string
+	}{
+		{
+			name:      "default should return the fallback of an empty value",
+			body:      `[[ default "odpf" .team ]]`,
+			variables: map[string]string{"team": ""},
+			expected:  "odpf",
+		},
+		{
+			name:      "default should return a value that is not empty",
+			body:      `[[ default "odpf" .team ]]`,
+			variables: map[string]string{"team": "gojek"},
+			expected:  "gojek",
+		},
+		{
+			name:      "join should join split values",
+			body:      `[[ split "," .teams | join " or " ]]`,
+			variables: map[string]string{"teams": "odpf,gojek"},
+			expected:  "odpf or gojek",
+		},
+		{
+			name:      "quote should quote a value",
+			body:      `summary: [[ quote .summary ]]`,
+			variables: map[string]string{"summary": `cpu "high"`},
+			expected:  `summary: "cpu \"high\""`,
+		},
+		{
+			name:     "toYaml should marshal split values",
+			body:     `[[ split "," "a,b" | toYaml ]]`,
+			expected: "- a\n- b",
+		},
+		{
+			name:     "nindent should indent every line on a new line",
+			body:     `labels:[[ nindent 2 "team: odpf\nseverity: WARNING" ]]`,
+			expected: "labels:\n  team: odpf\n  severity: WARNING",
+		},
+		{
+			name:      "durationAdd should add durations",
+			body:      `[[ durationAdd .for "30m" ]]`,
+			variables: map[string]string{"for": "1h"},
+			expected:  "1h30m",
+		},
+		{
+			name:      "durationMul should multiply a duration by a numeric string",
+			body:      `[[ durationMul .for .factor ]]`,
+			variables: map[string]string{"for": "5m", "factor": "1.5"},
+			expected:  "7m30s",
+		},
+		{
+			name:     "durationMul should multiply a duration by a number",
+			body:     `[[ durationMul "5m" 3 ]]`,
+			expected: "15m",
+		},
+		{
+			name:     "durationSeconds should return the seconds of a duration",
+			body:     `[[ durationSeconds "5m" ]]`,
+			expected: "300",
+		},
+		{
+			name: "durationAdd should return error for an invalid duration",
+			body: `[[ durationAdd "5m" "abc" ]]`,
+			err:  `error calling durationAdd: not a valid duration string: "abc"`,
+		},
+		{
+			name:      "labelMatcher should build an equality matcher",
+			body:      `cpu{[[ labelMatcher "team" .team ]]}`,
+			variables: map[string]string{"team": "odpf"},
+			expected:  `cpu{team="odpf"}`,
+		},
+		{
+			name:      "regexMatcher should build a regex matcher of literal values",
+			body:      `cpu{[[ split "," .hosts | regexMatcher "host" ]]}`,
+			variables: map[string]string{"hosts": "a.odpf.io,b.odpf.io"},
+			expected:  `cpu{host=~"a\\.odpf\\.io|b\\.odpf\\.io"}`,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			rendered, err := renderBody(tc.body, nil, tc.variables)
+			if tc.err != "" {
+				assert.Error(t, err)
+				assert.Contains(t, err.Error(), tc.err)
+				return
+			}
+			assert.Nil(t, err)
+			assert.Equal(t, tc.expected, rendered)
+		})
+	}
+}
+
+func TestRenderBodyWithPartials(t *testing.T) {
+	partials := []domain.Partial{
+		{Name: "labels", Body: `team: [[ .team ]][[ include "severity" . | nindent 0 ]]`},
+		{Name: "severity", Body: `severity: [[ default "WARNING" .severity ]]`},
+	}
+
+	t.Run("should render included partials with the given data", func(t *testing.T) {
+		rendered, err := RenderBody(&domain.Template{
+			Body:      `labels:[[ include "labels" . | nindent 2 ]]`,
+			Variables: []domain.Variable{{Name: "team", Type: "string", Default: "odpf"}},
+			Partials:  partials,
+		}, map[string]string{})
+		assert.Nil(t, err)
+		assert.Equal(t, "labels:\n  team: odpf\n  severity: WARNING", rendered)
+	})
+
+	t.Run("should prefer templates defined in the body over partials", func(t *testing.T) {
+		rendered, err := RenderBody(&domain.Template{
+			Body:     `[[ define "severity" ]]severity: CRITICAL[[ end ]][[ template "severity" . ]]`,
+			Partials: partials,
+		}, map[string]string{})
+		assert.Nil(t, err)
+		assert.Equal(t, "severity: CRITICAL", rendered)
+	})
+
+	t.Run("should stop including partials that include themselves", func(t *testing.T) {
+		_, err := RenderBody(&domain.Template{
+			Body:     `[[ include "loop" . ]]`,
+			Partials: []domain.Partial{{Name: "loop", Body: `[[ include "loop" . ]]`}},
+		}, map[string]string{})
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "exceeded maximum include depth 100 including loop")
+	})
+}
@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"github.com/lib/pq"
 	"github.com/odpf/siren/domain"
+	"gorm.io/gorm"
 	"time"
 )
 
@@ -15,18 +16,82 @@ type Template struct {
 	Body      string
 	Tags      pq.StringArray `gorm:"type:text[];index:idx_tags,type:gin"`
 	Variables string         `gorm:"type:jsonb" sql:"type:jsonb" `
+	Version   uint
+}
+
+// TemplateVersion is the stored snapshot of a template at an upsert
+type TemplateVersion struct {
+	ID         uint `gorm:"primarykey"`
+	CreatedAt  time.Time
+	TemplateID uint `gorm:"uniqueIndex:unique_template_version"`
+	Version    uint `gorm:"uniqueIndex:unique_template_version"`
+	Body       string
+	Tags       pq.StringArray `gorm:"type:text[]"`
+	Variables  string         `gorm:"type:jsonb" sql:"type:jsonb" `
+}
+
+// Partial is a named template body included by templates
+type Partial struct {
+	ID        uint `gorm:"primarykey"`
+	CreatedAt time.Time
+	UpdatedAt time.Time
+	Name      string `gorm:"index:idx_partial_name,unique"`
+	Body      string
+}
+
+func (partial *Partial) fromDomain(p *domain.Partial) *Partial {
+	partial.ID = p.ID
+	partial.CreatedAt = p.CreatedAt
+	partial.UpdatedAt = p.UpdatedAt
+	partial.Name = p.Name
+	partial.Body = p.Body
+	return partial
+}
+
+func (partial *Partial) toDomain() *domain.Partial {
+	return &domain.Partial{
+		ID:        partial.ID,
+		CreatedAt: partial.CreatedAt,
+		UpdatedAt: partial.UpdatedAt,
+		Name:      partial.Name,
+		Body:      partial.Body,
+	}
 }
 
 // TemplatesRepositoryMock interface
 type TemplatesRepository interface {
 	Upsert(*Template) (*Template, error)
 	Index(string) ([]Template, error)
+	List(*domain.TemplateFilter, int, int) ([]Template, error)
 	GetByName(string) (*Template, error)
-	Delete(string) error
+	Delete(string, bool, TemplateRulesDeleter, domain.TemplatesService) ([]domain.Rule, error)
 	Render(string, map[string]string) (string, error)
+	ListVersions(string) ([]TemplateVersion, error)
+	GetVersion(string, uint) (*TemplateVersion, error)
+	UpsertPartial(*Partial) (*Partial, error)
+	ListPartials() ([]Partial, error)
+	DeletePartial(string) error
 	Migrate() error
 }
 
+// TemplateRulesDeleter deletes the rules of a template in the transaction of
+// the template deletion and pushes their rule groups again without them
+type TemplateRulesDeleter interface {
+	DeleteTemplateRules(*gorm.DB, string, domain.TemplatesService) ([]domain.Rule, error)
+}
+
+// TemplateRulesSyncer pushes again the rule groups with rules of a stored
+// template, or reports their diff in a dry run
+type TemplateRulesSyncer interface {
+	SyncTemplate(*domain.Template, bool, domain.TemplatesService) ([]domain.RuleGroupSync, error)
+}
+
+// TemplateRules deletes and syncs the rules of templates
+type TemplateRules interface {
+	TemplateRulesDeleter
+	TemplateRulesSyncer
+}
+
 func (template *Template) fromDomain(t *domain.Template) (*Template, error) {
 	template.ID = t.ID
 	template.CreatedAt = t.CreatedAt
@@ -57,5 +122,24 @@ func (template *Template) toDomain() (*domain.Template, error) {
 		CreatedAt: template.CreatedAt,
 		UpdatedAt: template.UpdatedAt,
 		Variables: variables,
+		Version:   template.Version,
+	}, nil
+}
+
+func (version *TemplateVersion) toDomain(name string) (*domain.Template, error) {
+	var variables []domain.Variable
+	err := json.Unmarshal([]byte(version.Variables), &variables)
+	if err != nil {
+		return nil, err
+	}
+	return &domain.Template{
+		ID:        version.TemplateID,
+		Name:      name,
+		Body:      version.Body,
+		Tags:      version.Tags,
+		CreatedAt: version.CreatedAt,
+		UpdatedAt: version.CreatedAt,
+		Variables: variables,
+		Version:   version.Version,
 	}, nil
 }
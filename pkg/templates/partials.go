@@ -0,0 +1,176 @@
+package templates
+
+import (
+	"bytes"
+	"fmt"
+	"github.com/odpf/siren/domain"
+	"sort"
+	"strings"
+	"text/template"
+	"text/template/parse"
+)
+
+// maxIncludeDepth bounds nested includes, which unlike the template action
+// are not bounded by text/template
+const maxIncludeDepth = 100
+
+func newParser() *template.Template {
+	return template.New("parser").Delims(leftDelim, rightDelim).Funcs(funcMap())
+}
+
+// IncludedPartials returns the names of the partials a body includes with
+// the template action or the include function. Names of templates defined
+// in the body itself are left out.
+func IncludedPartials(body string) ([]string, error) {
+	tmpl, err := newParser().Parse(body)
+	if err != nil {
+		return nil, err
+	}
+	defined := make(map[string]bool)
+	included := make(map[string]bool)
+	for _, t := range tmpl.Templates() {
+		defined[t.Name()] = true
+		if t.Tree != nil {
+			collectIncludes(t.Tree.Root, included)
+		}
+	}
+	names := make([]string, 0, len(included))
+	for name := range included {
+		if !defined[name] {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+	return names, nil
+}
+
+// includesPartials tells if a body needs partials to render. Bodies that do
+// not parse need none, rendering reports the parse error.
+func includesPartials(body string) bool {
+	names, err := IncludedPartials(body)
+	return err == nil && len(names) != 0
+}
+
+func collectIncludes(node parse.Node, included map[string]bool) {
+	switch n := node.(type) {
+	case *parse.ListNode:
+		if n == nil {
+			return
+		}
+		for _, child := range n.Nodes {
+			collectIncludes(child, included)
+		}
+	case *parse.ActionNode:
+		collectIncludes(n.Pipe, included)
+	case *parse.PipeNode:
+		if n == nil {
+			return
+		}
+		for _, cmd := range n.Cmds {
+			collectIncludes(cmd, included)
+		}
+	case *parse.CommandNode:
+		if len(n.Args) > 1 {
+			identifier, isIdentifier := n.Args[0].(*parse.IdentifierNode)
+			name, isString := n.Args[1].(*parse.StringNode)
+			if isIdentifier && isString && identifier.Ident == "include" {
+				included[name.Text] = true
+			}
+		}
+		for _, arg := range n.Args {
+			collectIncludes(arg, included)
+		}
+	case *parse.IfNode:
+		collectBranchIncludes(&n.BranchNode, included)
+	case *parse.RangeNode:
+		collectBranchIncludes(&n.BranchNode, included)
+	case *parse.WithNode:
+		collectBranchIncludes(&n.BranchNode, included)
+	case *parse.TemplateNode:
+		included[n.Name] = true
+		collectIncludes(n.Pipe, included)
+	}
+}
+
+func collectBranchIncludes(branch *parse.BranchNode, included map[string]bool) {
+	collectIncludes(branch.Pipe, included)
+	collectIncludes(branch.List, included)
+	collectIncludes(branch.ElseList, included)
+}
+
+// checkPartial checks that a partial parses and does not include itself,
+// directly or through the other stored partials
+func checkPartial(partial *domain.Partial, partials []domain.Partial) error {
+	includes := make(map[string][]string)
+	for _, p := range partials {
+		if p.Name == partial.Name {
+			continue
+		}
+		// stored partials that do not parse cannot be part of a cycle
+		names, _ := IncludedPartials(p.Body)
+		includes[p.Name] = names
+	}
+	names, err := IncludedPartials(partial.Body)
+	if err != nil {
+		return &domain.InvalidPartialError{Name: partial.Name, Reason: err.Error()}
+	}
+	includes[partial.Name] = names
+
+	// stored partials have no cycles, so a new cycle has to go through the partial
+	var path []string
+	visited := make(map[string]bool)
+	var visit func(name string) bool
+	visit = func(name string) bool {
+		if name == partial.Name && len(path) != 0 {
+			path = append(path, name)
+			return true
+		}
+		if visited[name] {
+			return false
+		}
+		visited[name] = true
+		path = append(path, name)
+		for _, included := range includes[name] {
+			if visit(included) {
+				return true
+			}
+		}
+		path = path[:len(path)-1]
+		return false
+	}
+	if visit(partial.Name) {
+		return &domain.InvalidPartialError{
+			Name:   partial.Name,
+			Reason: fmt.Sprintf("circular include %s", strings.Join(path, " -> ")),
+		}
+	}
+	return nil
+}
+
+// addPartials associates the partials with a parsed body and binds include
+// to them. Templates defined in the body take precedence over partials of
+// the same name.
+func addPartials(tmpl *template.Template, partials []domain.Partial) (*template.Template, error) {
+	for _, partial := range partials {
+		if tmpl.Lookup(partial.Name) != nil {
+			continue
+		}
+		if _, err := tmpl.New(partial.Name).Parse(partial.Body); err != nil {
+			return nil, err
+		}
+	}
+	depth := 0
+	include := func(name string, data interface{}) (string, error) {
+		if depth >= maxIncludeDepth {
+			return "", fmt.Errorf("exceeded maximum include depth %d including %s", maxIncludeDepth, name)
+		}
+		depth++
+		defer func() { depth-- }()
+		var out bytes.Buffer
+		if err := tmpl.ExecuteTemplate(&out, name, data); err != nil {
+			return "", err
+		}
+		return out.String(), nil
+	}
+	return tmpl.Funcs(map[string]interface{}{"include": include}), nil
+}
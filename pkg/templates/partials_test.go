@@ -0,0 +1,49 @@
+package templates
+
+import (
+	"github.com/odpf/siren/domain"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestIncludedPartials(t *testing.T) {
+	t.Run("should return the partials included with template and include", func(t *testing.T) {
+		names, err := IncludedPartials(`[[ template "labels" . ]][[ if .team ]][[ include "team" . | quote ]][[ else ]][[ include "team" . ]][[ end ]]`)
+		assert.Nil(t, err)
+		assert.Equal(t, []string{"labels", "team"}, names)
+	})
+
+	t.Run("should leave out templates defined in the body", func(t *testing.T) {
+		names, err := IncludedPartials(`[[ define "severity" ]]WARNING[[ end ]][[ template "severity" . ]][[ range .teams ]][[ include "team" . ]][[ end ]]`)
+		assert.Nil(t, err)
+		assert.Equal(t, []string{"team"}, names)
+	})
+
+	t.Run("should return error if the body does not parse", func(t *testing.T) {
+		names, err := IncludedPartials(`[[ template "labels" `)
+		assert.Error(t, err)
+		assert.Nil(t, names)
+	})
+}
+
+func TestCheckPartial(t *testing.T) {
+	partials := []domain.Partial{
+		{Name: "labels", Body: `[[ include "team" . ]]`},
+		{Name: "team", Body: `team: [[ .team ]]`},
+	}
+
+	t.Run("should accept a partial including other partials", func(t *testing.T) {
+		err := checkPartial(&domain.Partial{Name: "annotations", Body: `[[ template "labels" . ]]`}, partials)
+		assert.Nil(t, err)
+	})
+
+	t.Run("should reject a partial including itself", func(t *testing.T) {
+		err := checkPartial(&domain.Partial{Name: "annotations", Body: `[[ include "annotations" . ]]`}, partials)
+		assert.EqualError(t, err, "invalid partial annotations: circular include annotations -> annotations")
+	})
+
+	t.Run("should reject an update that closes a cycle", func(t *testing.T) {
+		err := checkPartial(&domain.Partial{Name: "team", Body: `[[ template "labels" . ]]`}, partials)
+		assert.EqualError(t, err, "invalid partial team: circular include team -> labels -> team")
+	})
+}
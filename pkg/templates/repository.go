@@ -4,8 +4,11 @@ import (
 	"bytes"
 	"errors"
 	"fmt"
+	"github.com/lib/pq"
 	"github.com/odpf/siren/domain"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"strings"
 	"text/template"
 )
 
@@ -14,6 +17,8 @@ const (
 	rightDelim = "]]"
 )
 
+var likeEscaper = strings.NewReplacer(`\`, `\\`, "%", `\%`, "_", `\_`)
+
 // Repository talks to the store to read or insert data
 type Repository struct {
 	db *gorm.DB
@@ -25,30 +30,75 @@ func NewRepository(db *gorm.DB) *Repository {
 }
 
 func (r Repository) Migrate() error {
-	err := r.db.AutoMigrate(&Template{})
+	err := r.db.AutoMigrate(&Template{}, &TemplateVersion{}, &Partial{})
 	if err != nil {
 		return err
 	}
-	return nil
+	return r.backfillVersions()
 }
 
+// backfillVersions makes templates stored before versioning version 1 and
+// stores a snapshot of every template whose current version has none
+func (r Repository) backfillVersions() error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		result := tx.Model(&Template{}).Where("version IS NULL OR version = 0").Update("version", 1)
+		if result.Error != nil {
+			return result.Error
+		}
+		var templates []Template
+		result = tx.Where("NOT EXISTS (SELECT 1 FROM template_versions WHERE template_versions.template_id = templates.id " +
+			"AND template_versions.version = templates.version)").Find(&templates)
+		if result.Error != nil {
+			return result.Error
+		}
+		for _, template := range templates {
+			version := &TemplateVersion{
+				TemplateID: template.ID,
+				Version:    template.Version,
+				Body:       template.Body,
+				Tags:       template.Tags,
+				Variables:  template.Variables,
+			}
+			if err := tx.Create(version).Error; err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// Upsert stores a template and a snapshot of it as its next version
 func (r Repository) Upsert(template *Template) (*Template, error) {
 	var newTemplate, existingTemplate Template
-	result := r.db.Where(fmt.Sprintf("name = '%s'", template.Name)).Find(&existingTemplate)
-	if result.Error != nil {
-		return nil, result.Error
-	}
-	if result.RowsAffected == 0 {
-		result = r.db.Create(template)
-	} else {
-		result = r.db.Where("id = ?", existingTemplate.ID).Updates(template)
-	}
-	if result.Error != nil {
-		return nil, result.Error
-	}
-	result = r.db.Where(fmt.Sprintf("name = '%s'", template.Name)).Find(&newTemplate)
-	if result.Error != nil {
-		return nil, result.Error
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		result := tx.Where(fmt.Sprintf("name = '%s'", template.Name)).Find(&existingTemplate)
+		if result.Error != nil {
+			return result.Error
+		}
+		template.Version = existingTemplate.Version + 1
+		if result.RowsAffected == 0 {
+			result = tx.Create(template)
+		} else {
+			result = tx.Where("id = ?", existingTemplate.ID).Updates(template)
+		}
+		if result.Error != nil {
+			return result.Error
+		}
+		result = tx.Where(fmt.Sprintf("name = '%s'", template.Name)).Find(&newTemplate)
+		if result.Error != nil {
+			return result.Error
+		}
+		version := &TemplateVersion{
+			TemplateID: newTemplate.ID,
+			Version:    newTemplate.Version,
+			Body:       newTemplate.Body,
+			Tags:       newTemplate.Tags,
+			Variables:  newTemplate.Variables,
+		}
+		return tx.Create(version).Error
+	})
+	if err != nil {
+		return nil, err
 	}
 	return &newTemplate, nil
 }
@@ -67,6 +117,41 @@ func (r Repository) Index(tag string) ([]Template, error) {
 	return templates, nil
 }
 
+// List returns the templates matching a filter from offset on, at most limit
+// of them unless limit is 0. The sort of the filter must have been checked.
+func (r Repository) List(filter *domain.TemplateFilter, offset, limit int) ([]Template, error) {
+	var templates []Template
+	query := r.db
+	if len(filter.Tags) != 0 {
+		if filter.MatchAllTags {
+			query = query.Where("tags @> ?", pq.StringArray(filter.Tags))
+		} else {
+			query = query.Where("tags && ?", pq.StringArray(filter.Tags))
+		}
+	}
+	if filter.NamePrefix != "" {
+		query = query.Where("name LIKE ?", likeEscaper.Replace(filter.NamePrefix)+"%")
+	}
+	if filter.NameContains != "" {
+		query = query.Where("name LIKE ?", "%"+likeEscaper.Replace(filter.NameContains)+"%")
+	}
+	if !filter.UpdatedSince.IsZero() {
+		query = query.Where("updated_at >= ?", filter.UpdatedSince)
+	}
+	query = query.Order(fmt.Sprintf("%s %s, id %s", filter.SortBy, filter.SortOrder, filter.SortOrder))
+	if offset != 0 {
+		query = query.Offset(offset)
+	}
+	if limit != 0 {
+		query = query.Limit(limit)
+	}
+	result := query.Find(&templates)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	return templates, nil
+}
+
 func (r Repository) GetByName(name string) (*Template, error) {
 	var template Template
 	result := r.db.Where(fmt.Sprintf("name = '%s'", name)).Find(&template)
@@ -79,13 +164,134 @@ func (r Repository) GetByName(name string) (*Template, error) {
 	return &template, nil
 }
 
-func (r Repository) Delete(name string) error {
-	var template Template
-	result := r.db.Where("name = ?", name).Delete(&template)
-	return result.Error
+// Delete deletes a template with its versions. A template used by rules is
+// only deleted if forced, along with its rules. The template row is locked
+// while its rules are counted and deleted, all in one transaction.
+func (r Repository) Delete(name string, force bool, rulesDeleter TemplateRulesDeleter,
+	templatesService domain.TemplatesService) ([]domain.Rule, error) {
+	deletedRules := make([]domain.Rule, 0)
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		var template Template
+		result := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Where("name = ?", name).Find(&template)
+		if result.Error != nil {
+			return result.Error
+		}
+		var ruleCount int64
+		result = tx.Table("rules").Where("template = ?", name).Count(&ruleCount)
+		if result.Error != nil {
+			return result.Error
+		}
+		if ruleCount != 0 {
+			if !force {
+				return &domain.TemplateInUseError{Name: name, Rules: ruleCount}
+			}
+			rules, err := rulesDeleter.DeleteTemplateRules(tx, name, templatesService)
+			if err != nil {
+				return err
+			}
+			deletedRules = rules
+		}
+
+		result = tx.Where("template_id IN (?)", tx.Model(&Template{}).Select("id").Where("name = ?", name)).
+			Delete(&TemplateVersion{})
+		if result.Error != nil {
+			return result.Error
+		}
+		result = tx.Where("name = ?", name).Delete(&Template{})
+		return result.Error
+	})
+	if err != nil {
+		return nil, err
+	}
+	return deletedRules, nil
 }
 
-func enrichWithDefaults(variables []domain.Variable, requestVariables map[string]string) map[string]string {
+// ListVersions returns the versions of a template, latest first
+func (r Repository) ListVersions(name string) ([]TemplateVersion, error) {
+	template, err := r.GetByName(name)
+	if err != nil {
+		return nil, err
+	}
+	if template == nil {
+		return nil, errors.New("template not found")
+	}
+	var versions []TemplateVersion
+	result := r.db.Where("template_id = ?", template.ID).Order("version desc").Find(&versions)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	return versions, nil
+}
+
+// GetVersion returns a version of a template, or nil if there is none
+func (r Repository) GetVersion(name string, version uint) (*TemplateVersion, error) {
+	template, err := r.GetByName(name)
+	if err != nil || template == nil {
+		return nil, err
+	}
+	var templateVersion TemplateVersion
+	result := r.db.Where("template_id = ? AND version = ?", template.ID, version).Find(&templateVersion)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	if result.RowsAffected == 0 {
+		return nil, nil
+	}
+	return &templateVersion, nil
+}
+
+// UpsertPartial stores a partial, refusing partials that do not parse or
+// that include themselves directly or through other partials
+func (r Repository) UpsertPartial(partial *Partial) (*Partial, error) {
+	var newPartial Partial
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		var partials []Partial
+		result := tx.Find(&partials)
+		if result.Error != nil {
+			return result.Error
+		}
+		domainPartials := make([]domain.Partial, 0, len(partials))
+		var existingPartial *Partial
+		for i := 0; i < len(partials); i++ {
+			domainPartials = append(domainPartials, *partials[i].toDomain())
+			if partials[i].Name == partial.Name {
+				existingPartial = &partials[i]
+			}
+		}
+		if err := checkPartial(partial.toDomain(), domainPartials); err != nil {
+			return err
+		}
+		if existingPartial == nil {
+			result = tx.Create(partial)
+		} else {
+			result = tx.Where("id = ?", existingPartial.ID).Updates(partial)
+		}
+		if result.Error != nil {
+			return result.Error
+		}
+		return tx.Where("name = ?", partial.Name).Find(&newPartial).Error
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &newPartial, nil
+}
+
+// ListPartials returns the partials ordered by name
+func (r Repository) ListPartials() ([]Partial, error) {
+	var partials []Partial
+	result := r.db.Order("name").Find(&partials)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	return partials, nil
+}
+
+func (r Repository) DeletePartial(name string) error {
+	return r.db.Where("name = ?", name).Delete(&Partial{}).Error
+}
+
+func enrichWithDefaults(variables []domain.Variable, requestVariables map[string]string) (map[string]string, error) {
 	result := make(map[string]string)
 	for i := 0; i < len(variables); i++ {
 		name := variables[i].Name
@@ -96,11 +302,16 @@ func enrichWithDefaults(variables []domain.Variable, requestVariables map[string
 		} else {
 			result[name] = defaultValue
 		}
+		if err := ValidateVariable(variables[i], result[name]); err != nil {
+			return nil, err
+		}
 	}
-	return result
+	return result, nil
 }
 
-var templateParser = template.New("parser").Delims(leftDelim, rightDelim).Parse
+var templateParser = func(body string) (*template.Template, error) {
+	return newParser().Parse(body)
+}
 
 func (r Repository) Render(name string, requestVariables map[string]string) (string, error) {
 	templateFromDB, err := r.GetByName(name)
@@ -111,13 +322,60 @@ func (r Repository) Render(name string, requestVariables map[string]string) (str
 		return "", errors.New("template not found")
 	}
 	convertedTemplate, err := templateFromDB.toDomain()
-	enrichedVariables := enrichWithDefaults(convertedTemplate.Variables, requestVariables)
+	if err != nil {
+		return "", err
+	}
+	if includesPartials(convertedTemplate.Body) {
+		partials, err := r.ListPartials()
+		if err != nil {
+			return "", err
+		}
+		for i := 0; i < len(partials); i++ {
+			convertedTemplate.Partials = append(convertedTemplate.Partials, *partials[i].toDomain())
+		}
+	}
+	return RenderBody(convertedTemplate, requestVariables)
+}
+
+// RenderBody renders the body of a template with the given variables,
+// falling back to the template defaults for variables not provided. Every
+// value is checked against the type and constraints of its variable. The
+// body can include the partials of the template and use the functions of
+// the helper library.
+func RenderBody(t *domain.Template, requestVariables map[string]string) (string, error) {
+	enrichedVariables, err := enrichWithDefaults(t.Variables, requestVariables)
+	if err != nil {
+		return "", err
+	}
+	return renderBody(t.Body, t.Partials, enrichedVariables)
+}
+
+// RenderBodyUnchecked renders the body of a template like RenderBody without
+// checking the values, for bodies rendered with placeholders in place of the
+// variables
+func RenderBodyUnchecked(t *domain.Template, requestVariables map[string]string) (string, error) {
+	enrichedVariables := make(map[string]string)
+	for _, variable := range t.Variables {
+		value, ok := requestVariables[variable.Name]
+		if !ok {
+			value = variable.Default
+		}
+		enrichedVariables[variable.Name] = value
+	}
+	return renderBody(t.Body, t.Partials, enrichedVariables)
+}
+
+func renderBody(body string, partials []domain.Partial, variables map[string]string) (string, error) {
 	var tpl bytes.Buffer
-	tmpl, err := templateParser(convertedTemplate.Body)
+	tmpl, err := templateParser(body)
+	if err != nil {
+		return "", err
+	}
+	tmpl, err = addPartials(tmpl, partials)
 	if err != nil {
 		return "", err
 	}
-	err = tmpl.Execute(&tpl, enrichedVariables)
+	err = tmpl.Execute(&tpl, variables)
 	if err != nil {
 		return "", err
 	}
@@ -1,7 +1,8 @@
 package templates
 
 import (
-	"github.com/stretchr/testify/mock"
+	domain "github.com/odpf/siren/domain"
+	mock "github.com/stretchr/testify/mock"
 )
 
 // TemplatesRepositoryMock is an autogenerated mock type for the TemplatesRepositoryMock type
@@ -9,8 +10,31 @@ type TemplatesRepositoryMock struct {
 	mock.Mock
 }
 
-// Delete provides a mock function with given fields: _a0
-func (_m *TemplatesRepositoryMock) Delete(_a0 string) error {
+// Delete provides a mock function with given fields: _a0, _a1, _a2, _a3
+func (_m *TemplatesRepositoryMock) Delete(_a0 string, _a1 bool, _a2 TemplateRulesDeleter, _a3 domain.TemplatesService) ([]domain.Rule, error) {
+	ret := _m.Called(_a0, _a1, _a2, _a3)
+
+	var r0 []domain.Rule
+	if rf, ok := ret.Get(0).(func(string, bool, TemplateRulesDeleter, domain.TemplatesService) []domain.Rule); ok {
+		r0 = rf(_a0, _a1, _a2, _a3)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.Rule)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string, bool, TemplateRulesDeleter, domain.TemplatesService) error); ok {
+		r1 = rf(_a0, _a1, _a2, _a3)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DeletePartial provides a mock function with given fields: _a0
+func (_m *TemplatesRepositoryMock) DeletePartial(_a0 string) error {
 	ret := _m.Called(_a0)
 
 	var r0 error
@@ -46,6 +70,29 @@ func (_m *TemplatesRepositoryMock) GetByName(_a0 string) (*Template, error) {
 	return r0, r1
 }
 
+// GetVersion provides a mock function with given fields: _a0, _a1
+func (_m *TemplatesRepositoryMock) GetVersion(_a0 string, _a1 uint) (*TemplateVersion, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *TemplateVersion
+	if rf, ok := ret.Get(0).(func(string, uint) *TemplateVersion); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*TemplateVersion)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string, uint) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // Index provides a mock function with given fields: _a0
 func (_m *TemplatesRepositoryMock) Index(_a0 string) ([]Template, error) {
 	ret := _m.Called(_a0)
@@ -69,6 +116,75 @@ func (_m *TemplatesRepositoryMock) Index(_a0 string) ([]Template, error) {
 	return r0, r1
 }
 
+// List provides a mock function with given fields: _a0, _a1, _a2
+func (_m *TemplatesRepositoryMock) List(_a0 *domain.TemplateFilter, _a1 int, _a2 int) ([]Template, error) {
+	ret := _m.Called(_a0, _a1, _a2)
+
+	var r0 []Template
+	if rf, ok := ret.Get(0).(func(*domain.TemplateFilter, int, int) []Template); ok {
+		r0 = rf(_a0, _a1, _a2)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]Template)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*domain.TemplateFilter, int, int) error); ok {
+		r1 = rf(_a0, _a1, _a2)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListPartials provides a mock function with given fields:
+func (_m *TemplatesRepositoryMock) ListPartials() ([]Partial, error) {
+	ret := _m.Called()
+
+	var r0 []Partial
+	if rf, ok := ret.Get(0).(func() []Partial); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]Partial)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListVersions provides a mock function with given fields: _a0
+func (_m *TemplatesRepositoryMock) ListVersions(_a0 string) ([]TemplateVersion, error) {
+	ret := _m.Called(_a0)
+
+	var r0 []TemplateVersion
+	if rf, ok := ret.Get(0).(func(string) []TemplateVersion); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]TemplateVersion)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // Migrate provides a mock function with given fields:
 func (_m *TemplatesRepositoryMock) Migrate() error {
 	ret := _m.Called()
@@ -126,3 +242,26 @@ func (_m *TemplatesRepositoryMock) Upsert(_a0 *Template) (*Template, error) {
 
 	return r0, r1
 }
+
+// UpsertPartial provides a mock function with given fields: _a0
+func (_m *TemplatesRepositoryMock) UpsertPartial(_a0 *Partial) (*Partial, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *Partial
+	if rf, ok := ret.Get(0).(func(*Partial) *Partial); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*Partial)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*Partial) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
@@ -5,8 +5,10 @@ import (
 	"database/sql/driver"
 	"errors"
 	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/odpf/siren/domain"
 	"github.com/odpf/siren/mocks"
 	"github.com/stretchr/testify/suite"
+	"gorm.io/gorm"
 	"regexp"
 	"testing"
 	"text/template"
@@ -98,6 +100,73 @@ func (s *RepositoryTestSuite) TestIndex() {
 	})
 }
 
+func (s *RepositoryTestSuite) TestList() {
+	template := Template{
+		ID:        1,
+		CreatedAt: time.Now(),
+		UpdatedAt: time.Now(),
+		Name:      "cpu_high",
+		Body:      "bar",
+		Tags:      []string{"foo", "bar"},
+		Variables: `{"name":"foo"}`,
+	}
+	newRows := func() *sqlmock.Rows {
+		return sqlmock.NewRows([]string{"id", "created_at", "updated_at", "name", "body", "tags", "variables"}).
+			AddRow(template.ID, template.CreatedAt, template.UpdatedAt, template.Name, template.Body, template.Tags, template.Variables)
+	}
+
+	s.Run("should get all templates sorted", func() {
+		expectedQuery := regexp.QuoteMeta(`SELECT * FROM "templates" ORDER BY name asc, id asc`)
+		s.dbmock.ExpectQuery(expectedQuery).WillReturnRows(newRows())
+
+		actualTemplates, err := s.repository.List(&domain.TemplateFilter{SortBy: "name", SortOrder: "asc"}, 0, 0)
+		s.Equal([]Template{template}, actualTemplates)
+		s.Nil(err)
+	})
+
+	s.Run("should get templates with any of the tags matching the name from an offset", func() {
+		updatedSince := time.Now()
+		expectedQuery := regexp.QuoteMeta(`SELECT * FROM "templates" WHERE tags && $1 AND name LIKE $2 AND name LIKE $3 AND updated_at >= $4 ORDER BY updated_at desc, id desc LIMIT 11 OFFSET 20`)
+		s.dbmock.ExpectQuery(expectedQuery).
+			WithArgs(sqlmock.AnyArg(), "cpu%", `%\_high%`, updatedSince).
+			WillReturnRows(newRows())
+
+		actualTemplates, err := s.repository.List(&domain.TemplateFilter{
+			Tags:         []string{"foo", "bar"},
+			NamePrefix:   "cpu",
+			NameContains: "_high",
+			UpdatedSince: updatedSince,
+			SortBy:       "updated_at",
+			SortOrder:    "desc",
+		}, 20, 11)
+		s.Equal([]Template{template}, actualTemplates)
+		s.Nil(err)
+	})
+
+	s.Run("should get templates with all of the tags", func() {
+		expectedQuery := regexp.QuoteMeta(`SELECT * FROM "templates" WHERE tags @> $1 ORDER BY name asc, id asc LIMIT 11`)
+		s.dbmock.ExpectQuery(expectedQuery).WillReturnRows(newRows())
+
+		actualTemplates, err := s.repository.List(&domain.TemplateFilter{
+			Tags:         []string{"foo", "bar"},
+			MatchAllTags: true,
+			SortBy:       "name",
+			SortOrder:    "asc",
+		}, 0, 11)
+		s.Equal([]Template{template}, actualTemplates)
+		s.Nil(err)
+	})
+
+	s.Run("should return error if any", func() {
+		expectedQuery := regexp.QuoteMeta(`SELECT * FROM "templates"`)
+		s.dbmock.ExpectQuery(expectedQuery).WillReturnError(errors.New("random error"))
+
+		actualTemplates, err := s.repository.List(&domain.TemplateFilter{SortBy: "name", SortOrder: "asc"}, 0, 0)
+		s.EqualError(err, "random error")
+		s.Empty(actualTemplates)
+	})
+}
+
 func (s *RepositoryTestSuite) TestGetByName() {
 
 	s.Run("should get template by name", func() {
@@ -144,28 +213,123 @@ func (s *RepositoryTestSuite) TestGetByName() {
 }
 
 func (s *RepositoryTestSuite) TestDelete() {
+	lockQuery := regexp.QuoteMeta(`SELECT * FROM "templates" WHERE name = $1 FOR UPDATE`)
+	countRulesQuery := regexp.QuoteMeta(`SELECT count(1) FROM "rules" WHERE template = $1`)
+	deleteVersionsQuery := regexp.QuoteMeta(`DELETE FROM "template_versions" WHERE template_id IN (SELECT "id" FROM "templates" WHERE name = $1)`)
+	deleteQuery := regexp.QuoteMeta(`DELETE FROM "templates" WHERE name = $1`)
+	templateRows := func() *sqlmock.Rows {
+		return sqlmock.NewRows([]string{"id", "name", "body", "version"}).AddRow(10, "foo", "bar", 1)
+	}
+
+	s.Run("should delete template by name with its versions", func() {
+		rulesDeleter := &templateRulesDeleterMock{}
+		s.dbmock.ExpectBegin()
+		s.dbmock.ExpectQuery(lockQuery).WithArgs("foo").WillReturnRows(templateRows())
+		s.dbmock.ExpectQuery(countRulesQuery).WithArgs("foo").WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(0))
+		s.dbmock.ExpectExec(deleteVersionsQuery).WithArgs("foo").WillReturnResult(sqlmock.NewResult(0, 2))
+		s.dbmock.ExpectExec(deleteQuery).WithArgs("foo").WillReturnResult(sqlmock.NewResult(0, 1))
+		s.dbmock.ExpectCommit()
+		rules, err := s.repository.Delete("foo", false, rulesDeleter, &mocks.TemplatesService{})
+		s.Nil(err)
+		s.Empty(rules)
+		s.Empty(rulesDeleter.templates)
+		if err := s.dbmock.ExpectationsWereMet(); err != nil {
+			s.T().Errorf("there were unfulfilled expectations: %s", err)
+		}
+	})
+
+	s.Run("should return error and delete nothing if rules use the template and deletion is not forced", func() {
+		rulesDeleter := &templateRulesDeleterMock{}
+		s.dbmock.ExpectBegin()
+		s.dbmock.ExpectQuery(lockQuery).WithArgs("foo").WillReturnRows(templateRows())
+		s.dbmock.ExpectQuery(countRulesQuery).WithArgs("foo").WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(2))
+		s.dbmock.ExpectRollback()
+		rules, err := s.repository.Delete("foo", false, rulesDeleter, &mocks.TemplatesService{})
+		s.Nil(rules)
+		s.EqualError(err, "template foo is used by 2 rules, delete them or force the deletion")
+		s.Empty(rulesDeleter.templates)
+		if err := s.dbmock.ExpectationsWereMet(); err != nil {
+			s.T().Errorf("there were unfulfilled expectations: %s", err)
+		}
+	})
 
-	s.Run("should delete template by name", func() {
-		deleteQuery := regexp.QuoteMeta(`DELETE FROM "templates" WHERE name = $1`)
+	s.Run("should delete the rules of the template in the same transaction if forced", func() {
+		rulesDeleter := &templateRulesDeleterMock{rules: []domain.Rule{{Id: 1, Template: "foo"}, {Id: 2, Template: "foo"}}}
+		s.dbmock.ExpectBegin()
+		s.dbmock.ExpectQuery(lockQuery).WithArgs("foo").WillReturnRows(templateRows())
+		s.dbmock.ExpectQuery(countRulesQuery).WithArgs("foo").WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(2))
+		s.dbmock.ExpectExec(deleteVersionsQuery).WithArgs("foo").WillReturnResult(sqlmock.NewResult(0, 2))
 		s.dbmock.ExpectExec(deleteQuery).WithArgs("foo").WillReturnResult(sqlmock.NewResult(0, 1))
-		err := s.repository.Delete("foo")
+		s.dbmock.ExpectCommit()
+		rules, err := s.repository.Delete("foo", true, rulesDeleter, &mocks.TemplatesService{})
 		s.Nil(err)
+		s.Equal(2, len(rules))
+		s.Equal([]string{"foo"}, rulesDeleter.templates)
+		if err := s.dbmock.ExpectationsWereMet(); err != nil {
+			s.T().Errorf("there were unfulfilled expectations: %s", err)
+		}
+	})
+
+	s.Run("should keep the template if deleting its rules fails", func() {
+		rulesDeleter := &templateRulesDeleterMock{err: errors.New("random error")}
+		s.dbmock.ExpectBegin()
+		s.dbmock.ExpectQuery(lockQuery).WithArgs("foo").WillReturnRows(templateRows())
+		s.dbmock.ExpectQuery(countRulesQuery).WithArgs("foo").WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(2))
+		s.dbmock.ExpectRollback()
+		rules, err := s.repository.Delete("foo", true, rulesDeleter, &mocks.TemplatesService{})
+		s.Nil(rules)
+		s.EqualError(err, "random error")
+		if err := s.dbmock.ExpectationsWereMet(); err != nil {
+			s.T().Errorf("there were unfulfilled expectations: %s", err)
+		}
 	})
 
 	s.Run("should return error if any", func() {
 		expectedErrorMessage := "random error"
-		deleteQuery := regexp.QuoteMeta(`DELETE FROM "templates" WHERE name = $1`)
+		s.dbmock.ExpectBegin()
+		s.dbmock.ExpectQuery(lockQuery).WithArgs("foo").WillReturnRows(templateRows())
+		s.dbmock.ExpectQuery(countRulesQuery).WithArgs("foo").WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(0))
+		s.dbmock.ExpectExec(deleteVersionsQuery).WithArgs("foo").WillReturnResult(sqlmock.NewResult(0, 0))
 		s.dbmock.ExpectExec(deleteQuery).WithArgs("foo").WillReturnError(errors.New(expectedErrorMessage))
-		err := s.repository.Delete("foo")
+		s.dbmock.ExpectRollback()
+		_, err := s.repository.Delete("foo", false, &templateRulesDeleterMock{}, &mocks.TemplatesService{})
+		s.Equal(err.Error(), expectedErrorMessage)
+	})
+
+	s.Run("should return error if deleting the versions fails", func() {
+		expectedErrorMessage := "random error"
+		s.dbmock.ExpectBegin()
+		s.dbmock.ExpectQuery(lockQuery).WithArgs("foo").WillReturnRows(templateRows())
+		s.dbmock.ExpectQuery(countRulesQuery).WithArgs("foo").WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(0))
+		s.dbmock.ExpectExec(deleteVersionsQuery).WithArgs("foo").WillReturnError(errors.New(expectedErrorMessage))
+		s.dbmock.ExpectRollback()
+		_, err := s.repository.Delete("foo", false, &templateRulesDeleterMock{}, &mocks.TemplatesService{})
 		s.Equal(err.Error(), expectedErrorMessage)
 	})
 }
 
+// templateRulesDeleterMock records the templates whose rules it deleted
+type templateRulesDeleterMock struct {
+	rules     []domain.Rule
+	err       error
+	templates []string
+}
+
+func (m *templateRulesDeleterMock) DeleteTemplateRules(_ *gorm.DB, template string, _ domain.TemplatesService) ([]domain.Rule, error) {
+	m.templates = append(m.templates, template)
+	if m.err != nil {
+		return nil, m.err
+	}
+	return m.rules, nil
+}
+
 func (s *RepositoryTestSuite) TestUpsert() {
+	templateColumns := []string{"id", "created_at", "updated_at", "name", "body", "tags", "variables", "version"}
+	insertVersionQuery := regexp.QuoteMeta(`INSERT INTO "template_versions" ("created_at","template_id","version","body","tags","variables") VALUES ($1,$2,$3,$4,$5,$6) RETURNING "id"`)
 
 	s.Run("should insert template if not exist", func() {
 		firstSelectQuery := regexp.QuoteMeta(`SELECT * FROM "templates" WHERE name = 'foo'`)
-		insertQuery := regexp.QuoteMeta(`INSERT INTO "templates" ("created_at","updated_at","name","body","tags","variables","id") VALUES ($1,$2,$3,$4,$5,$6,$7) RETURNING "id"`)
+		insertQuery := regexp.QuoteMeta(`INSERT INTO "templates" ("created_at","updated_at","name","body","tags","variables","version","id") VALUES ($1,$2,$3,$4,$5,$6,$7,$8) RETURNING "id"`)
 		secondSelectQuery := regexp.QuoteMeta(`SELECT * FROM "templates" WHERE name = 'foo'`)
 		expectedTemplate := &Template{
 			ID:        10,
@@ -175,27 +339,36 @@ func (s *RepositoryTestSuite) TestUpsert() {
 			Body:      "bar",
 			Tags:      []string{"baz"},
 			Variables: `{"name":"foo"}`,
+			Version:   1,
 		}
-		expectedRows := sqlmock.NewRows([]string{"id", "created_at", "updated_at", "name", "body", "tags", "variables"}).
+		expectedRows := sqlmock.NewRows(templateColumns).
 			AddRow(expectedTemplate.ID, expectedTemplate.CreatedAt,
 				expectedTemplate.UpdatedAt, expectedTemplate.Name,
 				expectedTemplate.Body, expectedTemplate.Tags,
-				expectedTemplate.Variables)
+				expectedTemplate.Variables, expectedTemplate.Version)
+		s.dbmock.ExpectBegin()
 		s.dbmock.ExpectQuery(firstSelectQuery).WillReturnRows(sqlmock.NewRows(nil))
 		s.dbmock.ExpectQuery(insertQuery).WithArgs(expectedTemplate.CreatedAt,
 			expectedTemplate.UpdatedAt, expectedTemplate.Name,
 			expectedTemplate.Body, expectedTemplate.Tags,
-			expectedTemplate.Variables, expectedTemplate.ID).
+			expectedTemplate.Variables, expectedTemplate.Version, expectedTemplate.ID).
 			WillReturnRows(sqlmock.NewRows(nil))
 		s.dbmock.ExpectQuery(secondSelectQuery).WillReturnRows(expectedRows)
+		s.dbmock.ExpectQuery(insertVersionQuery).WithArgs(AnyTime{}, expectedTemplate.ID, 1,
+			expectedTemplate.Body, expectedTemplate.Tags, expectedTemplate.Variables).
+			WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1))
+		s.dbmock.ExpectCommit()
 		actualTemplate, err := s.repository.Upsert(expectedTemplate)
 		s.Equal(expectedTemplate, actualTemplate)
 		s.Nil(err)
+		if err := s.dbmock.ExpectationsWereMet(); err != nil {
+			s.T().Errorf("there were unfulfilled expectations: %s", err)
+		}
 	})
 
 	s.Run("should update template if exist", func() {
 		firstSelectQuery := regexp.QuoteMeta(`SELECT * FROM "templates" WHERE name = 'foo'`)
-		updateQuery := regexp.QuoteMeta(`UPDATE "templates" SET "created_at"=$1,"updated_at"=$2,"name"=$3,"body"=$4,"tags"=$5,"variables"=$6 WHERE id = $7`)
+		updateQuery := regexp.QuoteMeta(`UPDATE "templates" SET "created_at"=$1,"updated_at"=$2,"name"=$3,"body"=$4,"tags"=$5,"variables"=$6,"version"=$7 WHERE id = $8`)
 		secondSelectQuery := regexp.QuoteMeta(`SELECT * FROM "templates" WHERE name = 'foo'`)
 		timeNow := time.Now()
 		expectedTemplate := &Template{
@@ -206,6 +379,7 @@ func (s *RepositoryTestSuite) TestUpsert() {
 			Body:      "bar",
 			Tags:      []string{"baz"},
 			Variables: `{"name":"foo"}`,
+			Version:   3,
 		}
 		input := &Template{
 			CreatedAt: timeNow,
@@ -216,34 +390,44 @@ func (s *RepositoryTestSuite) TestUpsert() {
 			Variables: `{"name":"foo"}`,
 		}
 
-		expectedRows1 := sqlmock.NewRows([]string{"id", "created_at", "updated_at", "name", "body", "tags", "variables"}).
+		expectedRows1 := sqlmock.NewRows(templateColumns).
 			AddRow(expectedTemplate.ID, expectedTemplate.CreatedAt,
 				expectedTemplate.UpdatedAt, expectedTemplate.Name,
 				expectedTemplate.Body, expectedTemplate.Tags,
-				expectedTemplate.Variables)
+				expectedTemplate.Variables, 2)
 
-		expectedRows2 := sqlmock.NewRows([]string{"id", "created_at", "updated_at", "name", "body", "tags", "variables"}).
+		expectedRows2 := sqlmock.NewRows(templateColumns).
 			AddRow(expectedTemplate.ID, expectedTemplate.CreatedAt,
 				expectedTemplate.UpdatedAt, expectedTemplate.Name,
 				expectedTemplate.Body, expectedTemplate.Tags,
-				expectedTemplate.Variables)
+				expectedTemplate.Variables, expectedTemplate.Version)
 
+		s.dbmock.ExpectBegin()
 		s.dbmock.ExpectQuery(firstSelectQuery).WillReturnRows(expectedRows1)
 		s.dbmock.ExpectExec(updateQuery).WithArgs(AnyTime{},
 			AnyTime{}, expectedTemplate.Name,
 			expectedTemplate.Body, expectedTemplate.Tags,
-			expectedTemplate.Variables, expectedTemplate.ID).
+			expectedTemplate.Variables, 3, expectedTemplate.ID).
 			WillReturnResult(sqlmock.NewResult(10, 1))
 		s.dbmock.ExpectQuery(secondSelectQuery).WillReturnRows(expectedRows2)
+		s.dbmock.ExpectQuery(insertVersionQuery).WithArgs(AnyTime{}, expectedTemplate.ID, 3,
+			expectedTemplate.Body, expectedTemplate.Tags, expectedTemplate.Variables).
+			WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(5))
+		s.dbmock.ExpectCommit()
 		actualTemplate, err := s.repository.Upsert(input)
 		s.Equal(expectedTemplate, actualTemplate)
 		s.Nil(err)
+		if err := s.dbmock.ExpectationsWereMet(); err != nil {
+			s.T().Errorf("there were unfulfilled expectations: %s", err)
+		}
 	})
 
 	s.Run("should return error if first select query fails", func() {
 		expectedErrorMessage := "random error"
 		firstSelectQuery := regexp.QuoteMeta(`SELECT * FROM "templates" WHERE name = 'foo'`)
+		s.dbmock.ExpectBegin()
 		s.dbmock.ExpectQuery(firstSelectQuery).WillReturnError(errors.New("random error"))
+		s.dbmock.ExpectRollback()
 		timeNow := time.Now()
 		input := &Template{
 			CreatedAt: timeNow,
@@ -261,7 +445,7 @@ func (s *RepositoryTestSuite) TestUpsert() {
 	s.Run("should return error if insert fails", func() {
 		expectedErrorMessage := "random error"
 		firstSelectQuery := regexp.QuoteMeta(`SELECT * FROM "templates" WHERE name = 'foo'`)
-		insertQuery := regexp.QuoteMeta(`INSERT INTO "templates" ("created_at","updated_at","name","body","tags","variables","id") VALUES ($1,$2,$3,$4,$5,$6,$7) RETURNING "id"`)
+		insertQuery := regexp.QuoteMeta(`INSERT INTO "templates" ("created_at","updated_at","name","body","tags","variables","version","id") VALUES ($1,$2,$3,$4,$5,$6,$7,$8) RETURNING "id"`)
 		timeNow := time.Now()
 		expectedTemplate := &Template{
 			ID:        10,
@@ -281,11 +465,13 @@ func (s *RepositoryTestSuite) TestUpsert() {
 			Tags:      []string{"baz"},
 			Variables: `{"name":"foo"}`,
 		}
+		s.dbmock.ExpectBegin()
 		s.dbmock.ExpectQuery(firstSelectQuery).WillReturnRows(sqlmock.NewRows(nil))
 		s.dbmock.ExpectQuery(insertQuery).WithArgs(expectedTemplate.CreatedAt,
 			expectedTemplate.UpdatedAt, expectedTemplate.Name,
 			expectedTemplate.Body, expectedTemplate.Tags,
-			expectedTemplate.Variables, expectedTemplate.ID).WillReturnError(errors.New("random error"))
+			expectedTemplate.Variables, 1, expectedTemplate.ID).WillReturnError(errors.New("random error"))
+		s.dbmock.ExpectRollback()
 
 		actualTemplate, err := s.repository.Upsert(input)
 		s.Equal(err.Error(), expectedErrorMessage)
@@ -295,7 +481,7 @@ func (s *RepositoryTestSuite) TestUpsert() {
 	s.Run("should return error if update fails", func() {
 		expectedErrorMessage := "random error"
 		firstSelectQuery := regexp.QuoteMeta(`SELECT * FROM "templates" WHERE name = 'foo'`)
-		updateQuery := regexp.QuoteMeta(`UPDATE "templates" SET "created_at"=$1,"updated_at"=$2,"name"=$3,"body"=$4,"tags"=$5,"variables"=$6 WHERE id = $7`)
+		updateQuery := regexp.QuoteMeta(`UPDATE "templates" SET "created_at"=$1,"updated_at"=$2,"name"=$3,"body"=$4,"tags"=$5,"variables"=$6,"version"=$7 WHERE id = $8`)
 		timeNow := time.Now()
 		expectedTemplate := &Template{
 			ID:        10,
@@ -315,18 +501,20 @@ func (s *RepositoryTestSuite) TestUpsert() {
 			Variables: `{"name":"foo"}`,
 		}
 
-		expectedRows1 := sqlmock.NewRows([]string{"id", "created_at", "updated_at", "name", "body", "tags", "variables"}).
+		expectedRows1 := sqlmock.NewRows(templateColumns).
 			AddRow(expectedTemplate.ID, expectedTemplate.CreatedAt,
 				expectedTemplate.UpdatedAt, expectedTemplate.Name,
 				expectedTemplate.Body, expectedTemplate.Tags,
-				expectedTemplate.Variables)
+				expectedTemplate.Variables, 1)
 
+		s.dbmock.ExpectBegin()
 		s.dbmock.ExpectQuery(firstSelectQuery).WillReturnRows(expectedRows1)
 		s.dbmock.ExpectExec(updateQuery).WithArgs(AnyTime{},
 			AnyTime{}, expectedTemplate.Name,
 			expectedTemplate.Body, expectedTemplate.Tags,
-			expectedTemplate.Variables, expectedTemplate.ID).
+			expectedTemplate.Variables, 2, expectedTemplate.ID).
 			WillReturnError(errors.New(expectedErrorMessage))
+		s.dbmock.ExpectRollback()
 
 		actualTemplate, err := s.repository.Upsert(input)
 		s.Equal(err.Error(), expectedErrorMessage)
@@ -336,7 +524,7 @@ func (s *RepositoryTestSuite) TestUpsert() {
 	s.Run("should return error if second select query fails", func() {
 		expectedErrorMessage := "random error"
 		firstSelectQuery := regexp.QuoteMeta(`SELECT * FROM "templates" WHERE name = 'foo'`)
-		updateQuery := regexp.QuoteMeta(`UPDATE "templates" SET "created_at"=$1,"updated_at"=$2,"name"=$3,"body"=$4,"tags"=$5,"variables"=$6 WHERE id = $7`)
+		updateQuery := regexp.QuoteMeta(`UPDATE "templates" SET "created_at"=$1,"updated_at"=$2,"name"=$3,"body"=$4,"tags"=$5,"variables"=$6,"version"=$7 WHERE id = $8`)
 		secondSelectQuery := regexp.QuoteMeta(`SELECT * FROM "templates" WHERE name = 'foo'`)
 		timeNow := time.Now()
 		expectedTemplate := &Template{
@@ -357,23 +545,160 @@ func (s *RepositoryTestSuite) TestUpsert() {
 			Variables: `{"name":"foo"}`,
 		}
 
-		expectedRows1 := sqlmock.NewRows([]string{"id", "created_at", "updated_at", "name", "body", "tags", "variables"}).
+		expectedRows1 := sqlmock.NewRows(templateColumns).
 			AddRow(expectedTemplate.ID, expectedTemplate.CreatedAt,
 				expectedTemplate.UpdatedAt, expectedTemplate.Name,
 				expectedTemplate.Body, expectedTemplate.Tags,
-				expectedTemplate.Variables)
+				expectedTemplate.Variables, 1)
 
+		s.dbmock.ExpectBegin()
 		s.dbmock.ExpectQuery(firstSelectQuery).WillReturnRows(expectedRows1)
 		s.dbmock.ExpectExec(updateQuery).WithArgs(AnyTime{},
 			AnyTime{}, expectedTemplate.Name,
 			expectedTemplate.Body, expectedTemplate.Tags,
-			expectedTemplate.Variables, expectedTemplate.ID).
+			expectedTemplate.Variables, 2, expectedTemplate.ID).
 			WillReturnResult(sqlmock.NewResult(10, 1))
 		s.dbmock.ExpectQuery(secondSelectQuery).WillReturnError(errors.New(expectedErrorMessage))
+		s.dbmock.ExpectRollback()
 		actualTemplate, err := s.repository.Upsert(input)
 		s.Equal(err.Error(), expectedErrorMessage)
 		s.Empty(actualTemplate)
 	})
+
+	s.Run("should return error if storing the version fails", func() {
+		expectedErrorMessage := "random error"
+		firstSelectQuery := regexp.QuoteMeta(`SELECT * FROM "templates" WHERE name = 'foo'`)
+		updateQuery := regexp.QuoteMeta(`UPDATE "templates" SET "created_at"=$1,"updated_at"=$2,"name"=$3,"body"=$4,"tags"=$5,"variables"=$6,"version"=$7 WHERE id = $8`)
+		secondSelectQuery := regexp.QuoteMeta(`SELECT * FROM "templates" WHERE name = 'foo'`)
+		timeNow := time.Now()
+		input := &Template{
+			CreatedAt: timeNow,
+			UpdatedAt: timeNow,
+			Name:      "foo",
+			Body:      "bar",
+			Tags:      []string{"baz"},
+			Variables: `{"name":"foo"}`,
+		}
+
+		s.dbmock.ExpectBegin()
+		s.dbmock.ExpectQuery(firstSelectQuery).WillReturnRows(sqlmock.NewRows(templateColumns).
+			AddRow(10, timeNow, timeNow, "foo", "bar", input.Tags, `{"name":"foo"}`, 1))
+		s.dbmock.ExpectExec(updateQuery).WillReturnResult(sqlmock.NewResult(10, 1))
+		s.dbmock.ExpectQuery(secondSelectQuery).WillReturnRows(sqlmock.NewRows(templateColumns).
+			AddRow(10, timeNow, timeNow, "foo", "bar", input.Tags, `{"name":"foo"}`, 2))
+		s.dbmock.ExpectQuery(insertVersionQuery).WillReturnError(errors.New(expectedErrorMessage))
+		s.dbmock.ExpectRollback()
+		actualTemplate, err := s.repository.Upsert(input)
+		s.Equal(err.Error(), expectedErrorMessage)
+		s.Empty(actualTemplate)
+	})
+}
+
+func (s *RepositoryTestSuite) TestBackfillVersions() {
+	updateQuery := regexp.QuoteMeta(`UPDATE "templates" SET "version"=$1,"updated_at"=$2 WHERE version IS NULL OR version = 0`)
+	selectQuery := regexp.QuoteMeta(`SELECT * FROM "templates" WHERE NOT EXISTS (SELECT 1 FROM template_versions WHERE template_versions.template_id = templates.id AND template_versions.version = templates.version)`)
+	insertVersionQuery := regexp.QuoteMeta(`INSERT INTO "template_versions" ("created_at","template_id","version","body","tags","variables") VALUES ($1,$2,$3,$4,$5,$6) RETURNING "id"`)
+	templateColumns := []string{"id", "created_at", "updated_at", "name", "body", "tags", "variables", "version"}
+
+	s.Run("should store version 1 of templates stored before versioning", func() {
+		s.dbmock.ExpectBegin()
+		s.dbmock.ExpectExec(updateQuery).WithArgs(1, AnyTime{}).WillReturnResult(sqlmock.NewResult(0, 1))
+		s.dbmock.ExpectQuery(selectQuery).WillReturnRows(sqlmock.NewRows(templateColumns).
+			AddRow(10, time.Now(), time.Now(), "foo", "bar", "{baz}", `[{"name":"foo"}]`, 1))
+		s.dbmock.ExpectQuery(insertVersionQuery).
+			WithArgs(AnyTime{}, 10, 1, "bar", sqlmock.AnyArg(), `[{"name":"foo"}]`).
+			WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1))
+		s.dbmock.ExpectCommit()
+		err := s.repository.(*Repository).backfillVersions()
+		s.Nil(err)
+		if err := s.dbmock.ExpectationsWereMet(); err != nil {
+			s.T().Errorf("there were unfulfilled expectations: %s", err)
+		}
+	})
+
+	s.Run("should return error and rollback if update fails", func() {
+		s.dbmock.ExpectBegin()
+		s.dbmock.ExpectExec(updateQuery).WillReturnError(errors.New("random error"))
+		s.dbmock.ExpectRollback()
+		err := s.repository.(*Repository).backfillVersions()
+		s.EqualError(err, "random error")
+		if err := s.dbmock.ExpectationsWereMet(); err != nil {
+			s.T().Errorf("there were unfulfilled expectations: %s", err)
+		}
+	})
+}
+
+func (s *RepositoryTestSuite) TestListVersions() {
+	selectTemplateQuery := regexp.QuoteMeta(`SELECT * FROM "templates" WHERE name = 'foo'`)
+	selectVersionsQuery := regexp.QuoteMeta(`SELECT * FROM "template_versions" WHERE template_id = $1 ORDER BY version desc`)
+	templateRows := func() *sqlmock.Rows {
+		return sqlmock.NewRows([]string{"id", "name", "version"}).AddRow(10, "foo", 2)
+	}
+
+	s.Run("should return versions of a template latest first", func() {
+		createdAt := time.Now()
+		expectedVersions := []TemplateVersion{
+			{ID: 2, CreatedAt: createdAt, TemplateID: 10, Version: 2, Body: "baz", Tags: []string{"baz"}, Variables: `[]`},
+			{ID: 1, CreatedAt: createdAt, TemplateID: 10, Version: 1, Body: "bar", Tags: []string{"baz"}, Variables: `[]`},
+		}
+		s.dbmock.ExpectQuery(selectTemplateQuery).WillReturnRows(templateRows())
+		s.dbmock.ExpectQuery(selectVersionsQuery).WithArgs(10).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "created_at", "template_id", "version", "body", "tags", "variables"}).
+				AddRow(2, createdAt, 10, 2, "baz", "{baz}", `[]`).
+				AddRow(1, createdAt, 10, 1, "bar", "{baz}", `[]`))
+		actualVersions, err := s.repository.ListVersions("foo")
+		s.Nil(err)
+		s.Equal(expectedVersions, actualVersions)
+	})
+
+	s.Run("should return error if template not found", func() {
+		s.dbmock.ExpectQuery(selectTemplateQuery).WillReturnRows(sqlmock.NewRows(nil))
+		actualVersions, err := s.repository.ListVersions("foo")
+		s.Nil(actualVersions)
+		s.EqualError(err, "template not found")
+	})
+
+	s.Run("should return error if any", func() {
+		s.dbmock.ExpectQuery(selectTemplateQuery).WillReturnRows(templateRows())
+		s.dbmock.ExpectQuery(selectVersionsQuery).WillReturnError(errors.New("random error"))
+		actualVersions, err := s.repository.ListVersions("foo")
+		s.Nil(actualVersions)
+		s.EqualError(err, "random error")
+	})
+}
+
+func (s *RepositoryTestSuite) TestGetVersion() {
+	selectTemplateQuery := regexp.QuoteMeta(`SELECT * FROM "templates" WHERE name = 'foo'`)
+	selectVersionQuery := regexp.QuoteMeta(`SELECT * FROM "template_versions" WHERE template_id = $1 AND version = $2`)
+	templateRows := func() *sqlmock.Rows {
+		return sqlmock.NewRows([]string{"id", "name", "version"}).AddRow(10, "foo", 2)
+	}
+
+	s.Run("should return a version of a template", func() {
+		createdAt := time.Now()
+		s.dbmock.ExpectQuery(selectTemplateQuery).WillReturnRows(templateRows())
+		s.dbmock.ExpectQuery(selectVersionQuery).WithArgs(10, 1).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "created_at", "template_id", "version", "body", "tags", "variables"}).
+				AddRow(1, createdAt, 10, 1, "bar", "{baz}", `[]`))
+		actualVersion, err := s.repository.GetVersion("foo", 1)
+		s.Nil(err)
+		s.Equal(&TemplateVersion{ID: 1, CreatedAt: createdAt, TemplateID: 10, Version: 1, Body: "bar", Tags: []string{"baz"}, Variables: `[]`}, actualVersion)
+	})
+
+	s.Run("should return nil if version not found", func() {
+		s.dbmock.ExpectQuery(selectTemplateQuery).WillReturnRows(templateRows())
+		s.dbmock.ExpectQuery(selectVersionQuery).WithArgs(10, 3).WillReturnRows(sqlmock.NewRows(nil))
+		actualVersion, err := s.repository.GetVersion("foo", 3)
+		s.Nil(err)
+		s.Nil(actualVersion)
+	})
+
+	s.Run("should return nil if template not found", func() {
+		s.dbmock.ExpectQuery(selectTemplateQuery).WillReturnRows(sqlmock.NewRows(nil))
+		actualVersion, err := s.repository.GetVersion("foo", 1)
+		s.Nil(err)
+		s.Nil(actualVersion)
+	})
 }
 
 func (s *RepositoryTestSuite) TestRender() {
@@ -429,6 +754,58 @@ func (s *RepositoryTestSuite) TestRender() {
 		s.Nil(err)
 	})
 
+	s.Run("should return error if a value does not match the type of its variable", func() {
+		expectedQuery := regexp.QuoteMeta(`SELECT * FROM "templates" WHERE name = 'foo'`)
+		expectedRows := sqlmock.NewRows([]string{"id", "created_at", "updated_at", "name", "body", "tags", "variables"}).
+			AddRow(10, time.Now(), time.Now(), "foo", "cpu_usage > [[.threshold]]", "{baz}",
+				`[{"name":"threshold","default":"80","type":"int","description":"test","max":"100"}]`)
+		s.dbmock.ExpectQuery(expectedQuery).WillReturnRows(expectedRows)
+		renderedBody, err := s.repository.Render("foo", map[string]string{"threshold": "abc"})
+		s.EqualError(err, `invalid variable threshold: "abc" is not an int`)
+		var invalidVariableErr *domain.InvalidVariableError
+		s.True(errors.As(err, &invalidVariableErr))
+		s.Equal("", renderedBody)
+	})
+
+	s.Run("should return error if a value is out of the bounds of its variable", func() {
+		expectedQuery := regexp.QuoteMeta(`SELECT * FROM "templates" WHERE name = 'foo'`)
+		expectedRows := sqlmock.NewRows([]string{"id", "created_at", "updated_at", "name", "body", "tags", "variables"}).
+			AddRow(10, time.Now(), time.Now(), "foo", "cpu_usage > [[.threshold]]", "{baz}",
+				`[{"name":"threshold","default":"80","type":"int","description":"test","max":"100"}]`)
+		s.dbmock.ExpectQuery(expectedQuery).WillReturnRows(expectedRows)
+		renderedBody, err := s.repository.Render("foo", map[string]string{"threshold": "120"})
+		s.EqualError(err, "invalid variable threshold: 120 is greater than max 100")
+		s.Equal("", renderedBody)
+	})
+
+	s.Run("should render template body including partials", func() {
+		expectedQuery := regexp.QuoteMeta(`SELECT * FROM "templates" WHERE name = 'foo'`)
+		partialsQuery := regexp.QuoteMeta(`SELECT * FROM "partials" ORDER BY name`)
+		expectedRows := sqlmock.NewRows([]string{"id", "created_at", "updated_at", "name", "body", "tags", "variables"}).
+			AddRow(10, time.Now(), time.Now(), "foo", `labels: {[[ template "team" . ]], severity: [[ include "severity" . | quote ]]}`, "{baz}",
+				`[{"name":"team","default":"odpf","type":"string","description":"test"}]`)
+		partialRows := sqlmock.NewRows([]string{"id", "created_at", "updated_at", "name", "body"}).
+			AddRow(1, time.Now(), time.Now(), "severity", "WARNING").
+			AddRow(2, time.Now(), time.Now(), "team", "team: [[ .team ]]")
+		s.dbmock.ExpectQuery(expectedQuery).WillReturnRows(expectedRows)
+		s.dbmock.ExpectQuery(partialsQuery).WillReturnRows(partialRows)
+		renderedBody, err := s.repository.Render("foo", map[string]string{})
+		s.Equal(`labels: {team: odpf, severity: "WARNING"}`, renderedBody)
+		s.Nil(err)
+	})
+
+	s.Run("should return error if an included partial does not exist", func() {
+		expectedQuery := regexp.QuoteMeta(`SELECT * FROM "templates" WHERE name = 'foo'`)
+		partialsQuery := regexp.QuoteMeta(`SELECT * FROM "partials" ORDER BY name`)
+		expectedRows := sqlmock.NewRows([]string{"id", "created_at", "updated_at", "name", "body", "tags", "variables"}).
+			AddRow(10, time.Now(), time.Now(), "foo", `[[ include "team" . ]]`, "{baz}", `[]`)
+		s.dbmock.ExpectQuery(expectedQuery).WillReturnRows(expectedRows)
+		s.dbmock.ExpectQuery(partialsQuery).WillReturnRows(sqlmock.NewRows(nil))
+		renderedBody, err := s.repository.Render("foo", map[string]string{})
+		s.Error(err)
+		s.Equal("", renderedBody)
+	})
+
 	s.Run("should return error if template not found", func() {
 		expectedQuery := regexp.QuoteMeta(`SELECT * FROM "templates" WHERE name = 'foo'`)
 		s.dbmock.ExpectQuery(expectedQuery).WillReturnRows(sqlmock.NewRows(nil))
@@ -469,6 +846,131 @@ func (s *RepositoryTestSuite) TestRender() {
 	})
 }
 
+func (s *RepositoryTestSuite) TestUpsertPartial() {
+	partialColumns := []string{"id", "created_at", "updated_at", "name", "body"}
+	selectAllQuery := regexp.QuoteMeta(`SELECT * FROM "partials"`)
+	selectQuery := regexp.QuoteMeta(`SELECT * FROM "partials" WHERE name = $1`)
+
+	s.Run("should insert partial if not exist", func() {
+		insertQuery := regexp.QuoteMeta(`INSERT INTO "partials" ("created_at","updated_at","name","body") VALUES ($1,$2,$3,$4) RETURNING "id"`)
+		timeNow := time.Now()
+		input := &Partial{Name: "team", Body: `team: [[ .team ]]`}
+		expectedPartial := &Partial{ID: 1, CreatedAt: timeNow, UpdatedAt: timeNow, Name: "team", Body: `team: [[ .team ]]`}
+		s.dbmock.ExpectBegin()
+		s.dbmock.ExpectQuery(selectAllQuery).WillReturnRows(sqlmock.NewRows(partialColumns).
+			AddRow(2, timeNow, timeNow, "labels", `[[ template "team" . ]]`))
+		s.dbmock.ExpectQuery(insertQuery).WithArgs(AnyTime{}, AnyTime{}, input.Name, input.Body).
+			WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1))
+		s.dbmock.ExpectQuery(selectQuery).WithArgs("team").WillReturnRows(sqlmock.NewRows(partialColumns).
+			AddRow(1, timeNow, timeNow, "team", `team: [[ .team ]]`))
+		s.dbmock.ExpectCommit()
+		actualPartial, err := s.repository.UpsertPartial(input)
+		s.Equal(expectedPartial, actualPartial)
+		s.Nil(err)
+		if err := s.dbmock.ExpectationsWereMet(); err != nil {
+			s.T().Errorf("there were unfulfilled expectations: %s", err)
+		}
+	})
+
+	s.Run("should update partial if exist", func() {
+		updateQuery := regexp.QuoteMeta(`UPDATE "partials" SET "updated_at"=$1,"name"=$2,"body"=$3 WHERE id = $4`)
+		timeNow := time.Now()
+		input := &Partial{Name: "team", Body: `team: [[ .team | quote ]]`}
+		expectedPartial := &Partial{ID: 1, CreatedAt: timeNow, UpdatedAt: timeNow, Name: "team", Body: `team: [[ .team | quote ]]`}
+		s.dbmock.ExpectBegin()
+		s.dbmock.ExpectQuery(selectAllQuery).WillReturnRows(sqlmock.NewRows(partialColumns).
+			AddRow(1, timeNow, timeNow, "team", `team: [[ .team ]]`))
+		s.dbmock.ExpectExec(updateQuery).WithArgs(AnyTime{}, input.Name, input.Body, 1).
+			WillReturnResult(sqlmock.NewResult(1, 1))
+		s.dbmock.ExpectQuery(selectQuery).WithArgs("team").WillReturnRows(sqlmock.NewRows(partialColumns).
+			AddRow(1, timeNow, timeNow, "team", `team: [[ .team | quote ]]`))
+		s.dbmock.ExpectCommit()
+		actualPartial, err := s.repository.UpsertPartial(input)
+		s.Equal(expectedPartial, actualPartial)
+		s.Nil(err)
+		if err := s.dbmock.ExpectationsWereMet(); err != nil {
+			s.T().Errorf("there were unfulfilled expectations: %s", err)
+		}
+	})
+
+	s.Run("should return error if the partial includes itself through other partials", func() {
+		input := &Partial{Name: "team", Body: `team: [[ include "labels" . ]]`}
+		s.dbmock.ExpectBegin()
+		s.dbmock.ExpectQuery(selectAllQuery).WillReturnRows(sqlmock.NewRows(partialColumns).
+			AddRow(2, time.Now(), time.Now(), "labels", `[[ template "severity" . ]]`).
+			AddRow(3, time.Now(), time.Now(), "severity", `[[ template "team" . ]]`))
+		s.dbmock.ExpectRollback()
+		actualPartial, err := s.repository.UpsertPartial(input)
+		s.EqualError(err, "invalid partial team: circular include team -> labels -> severity -> team")
+		var invalidPartialErr *domain.InvalidPartialError
+		s.True(errors.As(err, &invalidPartialErr))
+		s.Nil(actualPartial)
+		if err := s.dbmock.ExpectationsWereMet(); err != nil {
+			s.T().Errorf("there were unfulfilled expectations: %s", err)
+		}
+	})
+
+	s.Run("should return error if the partial does not parse", func() {
+		input := &Partial{Name: "team", Body: `team: [[ .team `}
+		s.dbmock.ExpectBegin()
+		s.dbmock.ExpectQuery(selectAllQuery).WillReturnRows(sqlmock.NewRows(partialColumns))
+		s.dbmock.ExpectRollback()
+		actualPartial, err := s.repository.UpsertPartial(input)
+		var invalidPartialErr *domain.InvalidPartialError
+		s.True(errors.As(err, &invalidPartialErr))
+		s.Nil(actualPartial)
+	})
+
+	s.Run("should return error if listing the partials failed", func() {
+		s.dbmock.ExpectBegin()
+		s.dbmock.ExpectQuery(selectAllQuery).WillReturnError(errors.New("random error"))
+		s.dbmock.ExpectRollback()
+		actualPartial, err := s.repository.UpsertPartial(&Partial{Name: "team", Body: "team: odpf"})
+		s.EqualError(err, "random error")
+		s.Nil(actualPartial)
+	})
+}
+
+func (s *RepositoryTestSuite) TestListPartials() {
+	expectedQuery := regexp.QuoteMeta(`SELECT * FROM "partials" ORDER BY name`)
+
+	s.Run("should return the partials ordered by name", func() {
+		timeNow := time.Now()
+		s.dbmock.ExpectQuery(expectedQuery).WillReturnRows(sqlmock.NewRows([]string{"id", "created_at", "updated_at", "name", "body"}).
+			AddRow(1, timeNow, timeNow, "labels", "team: odpf"))
+		actualPartials, err := s.repository.ListPartials()
+		s.Equal([]Partial{{ID: 1, CreatedAt: timeNow, UpdatedAt: timeNow, Name: "labels", Body: "team: odpf"}}, actualPartials)
+		s.Nil(err)
+	})
+
+	s.Run("should return error if any", func() {
+		s.dbmock.ExpectQuery(expectedQuery).WillReturnError(errors.New("random error"))
+		actualPartials, err := s.repository.ListPartials()
+		s.EqualError(err, "random error")
+		s.Nil(actualPartials)
+	})
+}
+
+func (s *RepositoryTestSuite) TestDeletePartial() {
+	expectedQuery := regexp.QuoteMeta(`DELETE FROM "partials" WHERE name = $1`)
+
+	s.Run("should delete the partial", func() {
+		s.dbmock.ExpectBegin()
+		s.dbmock.ExpectExec(expectedQuery).WithArgs("labels").WillReturnResult(sqlmock.NewResult(0, 1))
+		s.dbmock.ExpectCommit()
+		err := s.repository.DeletePartial("labels")
+		s.Nil(err)
+	})
+
+	s.Run("should return error if any", func() {
+		s.dbmock.ExpectBegin()
+		s.dbmock.ExpectExec(expectedQuery).WithArgs("labels").WillReturnError(errors.New("random error"))
+		s.dbmock.ExpectRollback()
+		err := s.repository.DeletePartial("labels")
+		s.EqualError(err, "random error")
+	})
+}
+
 func TestRepository(t *testing.T) {
 	suite.Run(t, new(RepositoryTestSuite))
 }
@@ -1,35 +1,77 @@
 package templates
 
 import (
+	"encoding/base64"
+	"fmt"
 	"github.com/odpf/siren/domain"
 	"gorm.io/gorm"
+	"strconv"
+)
+
+var (
+	templateSorts      = map[string]bool{"name": true, "created_at": true, "updated_at": true}
+	templateSortOrders = map[string]bool{"asc": true, "desc": true}
 )
 
 // Service handles business logic
 type Service struct {
 	repository TemplatesRepository
+	rules      TemplateRules
 }
 
-// NewService returns repository struct
-func NewService(db *gorm.DB) domain.TemplatesService {
-	return &Service{NewRepository(db)}
+// NewService returns repository struct. The rules of a template are synced
+// with rules when the template is upserted, and deleted with it when the
+// deletion of the template is forced.
+func NewService(db *gorm.DB, rules TemplateRules) domain.TemplatesService {
+	return &Service{repository: NewRepository(db), rules: rules}
 }
 
 func (service Service) Migrate() error {
 	return service.repository.Migrate()
 }
 
-func (service Service) Upsert(template *domain.Template) (*domain.Template, error) {
+// Upsert stores a template and pushes again every rule group with rules
+// following its latest version, returning the report of each group. A dry run
+// stores nothing and reports the diff of every group instead.
+func (service Service) Upsert(template *domain.Template, dryRun bool) (*domain.Template, []domain.RuleGroupSync, error) {
+	if dryRun {
+		partials, err := service.ListPartials()
+		if err != nil {
+			return nil, nil, err
+		}
+		dryRunTemplate := *template
+		dryRunTemplate.Partials = partials
+		ruleGroupSyncs, err := service.rules.SyncTemplate(&dryRunTemplate, true, service)
+		if err != nil {
+			return nil, nil, err
+		}
+		return &dryRunTemplate, ruleGroupSyncs, nil
+	}
+
+	if err := ValidateVariables(template.Variables); err != nil {
+		return nil, nil, err
+	}
 	t := &Template{}
 	t, err := t.fromDomain(template)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 	upsertedTemplate, err := service.repository.Upsert(t)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
+	}
+	domainTemplate, err := upsertedTemplate.toDomain()
+	if err != nil {
+		return nil, nil, err
+	}
+	if err := service.withPartials(domainTemplate); err != nil {
+		return nil, nil, err
 	}
-	return upsertedTemplate.toDomain()
+	ruleGroupSyncs, err := service.rules.SyncTemplate(domainTemplate, false, service)
+	if err != nil {
+		return nil, nil, err
+	}
+	return domainTemplate, ruleGroupSyncs, nil
 }
 
 func (service Service) Index(tag string) ([]domain.Template, error) {
@@ -42,21 +84,184 @@ func (service Service) Index(tag string) ([]domain.Template, error) {
 		t, _ := templates[i].toDomain()
 		domainTemplates = append(domainTemplates, *t)
 	}
+	pointers := make([]*domain.Template, 0, len(domainTemplates))
+	for i := 0; i < len(domainTemplates); i++ {
+		pointers = append(pointers, &domainTemplates[i])
+	}
+	if err := service.withPartials(pointers...); err != nil {
+		return nil, err
+	}
 	return domainTemplates, nil
 }
 
+// List returns a page of the templates matching a filter, sorted by name by
+// default. Page tokens hold the offset of their page.
+func (service Service) List(filter *domain.TemplateFilter) (*domain.TemplatePage, error) {
+	f := *filter
+	if f.SortBy == "" {
+		f.SortBy = "name"
+	}
+	if f.SortOrder == "" {
+		f.SortOrder = "asc"
+	}
+	if !templateSorts[f.SortBy] {
+		return nil, &domain.InvalidTemplateFilterError{Reason: fmt.Sprintf("unknown sort %q", f.SortBy)}
+	}
+	if !templateSortOrders[f.SortOrder] {
+		return nil, &domain.InvalidTemplateFilterError{Reason: fmt.Sprintf("unknown sort order %q", f.SortOrder)}
+	}
+	if f.PageSize < 0 {
+		return nil, &domain.InvalidTemplateFilterError{Reason: "page size cannot be negative"}
+	}
+	offset, err := decodePageToken(f.PageToken)
+	if err != nil {
+		return nil, err
+	}
+
+	limit := 0
+	if f.PageSize != 0 {
+		// one more template tells whether there is a next page
+		limit = f.PageSize + 1
+	}
+	templates, err := service.repository.List(&f, offset, limit)
+	if err != nil {
+		return nil, err
+	}
+	page := &domain.TemplatePage{}
+	if f.PageSize != 0 && len(templates) > f.PageSize {
+		templates = templates[:f.PageSize]
+		page.NextPageToken = encodePageToken(offset + f.PageSize)
+	}
+	page.Templates = make([]domain.Template, 0, len(templates))
+	for i := 0; i < len(templates); i++ {
+		t, err := templates[i].toDomain()
+		if err != nil {
+			return nil, err
+		}
+		page.Templates = append(page.Templates, *t)
+	}
+	pointers := make([]*domain.Template, 0, len(page.Templates))
+	for i := 0; i < len(page.Templates); i++ {
+		pointers = append(pointers, &page.Templates[i])
+	}
+	if err := service.withPartials(pointers...); err != nil {
+		return nil, err
+	}
+	return page, nil
+}
+
+func encodePageToken(offset int) string {
+	return base64.RawURLEncoding.EncodeToString([]byte(strconv.Itoa(offset)))
+}
+
+func decodePageToken(token string) (int, error) {
+	if token == "" {
+		return 0, nil
+	}
+	decoded, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return 0, &domain.InvalidTemplateFilterError{Reason: "malformed page token"}
+	}
+	offset, err := strconv.Atoi(string(decoded))
+	if err != nil || offset < 0 {
+		return 0, &domain.InvalidTemplateFilterError{Reason: "malformed page token"}
+	}
+	return offset, nil
+}
+
 func (service Service) GetByName(name string) (*domain.Template, error) {
 	template, err := service.repository.GetByName(name)
 	if err != nil || template == nil {
 		return nil, err
 	}
-	return template.toDomain()
+	domainTemplate, err := template.toDomain()
+	if err != nil {
+		return nil, err
+	}
+	return domainTemplate, service.withPartials(domainTemplate)
 }
 
-func (service Service) Delete(name string) error {
-	return service.repository.Delete(name)
+// Delete deletes a template and returns the rules deleted with it. A template
+// used by rules is only deleted if forced.
+func (service Service) Delete(name string, force bool) ([]domain.Rule, error) {
+	return service.repository.Delete(name, force, service.rules, service)
 }
 
 func (service Service) Render(name string, body map[string]string) (string, error) {
 	return service.repository.Render(name, body)
 }
+
+func (service Service) ListVersions(name string) ([]domain.Template, error) {
+	versions, err := service.repository.ListVersions(name)
+	if err != nil {
+		return nil, err
+	}
+	domainTemplates := make([]domain.Template, 0, len(versions))
+	for i := 0; i < len(versions); i++ {
+		t, err := versions[i].toDomain(name)
+		if err != nil {
+			return nil, err
+		}
+		domainTemplates = append(domainTemplates, *t)
+	}
+	return domainTemplates, nil
+}
+
+func (service Service) GetVersion(name string, version uint) (*domain.Template, error) {
+	templateVersion, err := service.repository.GetVersion(name, version)
+	if err != nil || templateVersion == nil {
+		return nil, err
+	}
+	domainTemplate, err := templateVersion.toDomain(name)
+	if err != nil {
+		return nil, err
+	}
+	return domainTemplate, service.withPartials(domainTemplate)
+}
+
+func (service Service) UpsertPartial(partial *domain.Partial) (*domain.Partial, error) {
+	p := &Partial{}
+	upsertedPartial, err := service.repository.UpsertPartial(p.fromDomain(partial))
+	if err != nil {
+		return nil, err
+	}
+	return upsertedPartial.toDomain(), nil
+}
+
+func (service Service) ListPartials() ([]domain.Partial, error) {
+	partials, err := service.repository.ListPartials()
+	if err != nil {
+		return nil, err
+	}
+	domainPartials := make([]domain.Partial, 0, len(partials))
+	for i := 0; i < len(partials); i++ {
+		domainPartials = append(domainPartials, *partials[i].toDomain())
+	}
+	return domainPartials, nil
+}
+
+func (service Service) DeletePartial(name string) error {
+	return service.repository.DeletePartial(name)
+}
+
+// withPartials sets the partials of the templates that include any, loading
+// them once for all templates
+func (service Service) withPartials(templates ...*domain.Template) error {
+	var partials []domain.Partial
+	loaded := false
+	for _, t := range templates {
+		if !includesPartials(t.Body) {
+			continue
+		}
+		if !loaded {
+			var err error
+			partials, err = service.ListPartials()
+			if err != nil {
+				return err
+			}
+			loaded = true
+		}
+		t.Partials = partials
+	}
+	return nil
+}
@@ -0,0 +1,165 @@
+package templates
+
+import (
+	"errors"
+	"github.com/odpf/siren/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"testing"
+)
+
+func TestService_List(t *testing.T) {
+	dummyTemplates := []Template{
+		{ID: 1, Name: "foo", Body: "bar", Tags: []string{"baz"}, Variables: `[]`},
+		{ID: 2, Name: "bar", Body: "baz", Tags: []string{"baz"}, Variables: `[]`},
+		{ID: 3, Name: "baz", Body: "foo", Tags: []string{"baz"}, Variables: `[]`},
+	}
+
+	t.Run("should list all templates sorted by name by default", func(t *testing.T) {
+		repositoryMock := &TemplatesRepositoryMock{}
+		dummyService := Service{repository: repositoryMock}
+		expectedFilter := &domain.TemplateFilter{Tags: []string{"baz"}, SortBy: "name", SortOrder: "asc"}
+		repositoryMock.On("List", expectedFilter, 0, 0).Return(dummyTemplates, nil).Once()
+
+		page, err := dummyService.List(&domain.TemplateFilter{Tags: []string{"baz"}})
+		assert.Nil(t, err)
+		assert.Equal(t, 3, len(page.Templates))
+		assert.Equal(t, "foo", page.Templates[0].Name)
+		assert.Equal(t, "", page.NextPageToken)
+		repositoryMock.AssertExpectations(t)
+	})
+
+	t.Run("should return a page and the token of the next page", func(t *testing.T) {
+		repositoryMock := &TemplatesRepositoryMock{}
+		dummyService := Service{repository: repositoryMock}
+		expectedFilter := &domain.TemplateFilter{SortBy: "updated_at", SortOrder: "desc", PageSize: 2}
+		repositoryMock.On("List", expectedFilter, 0, 3).Return(dummyTemplates, nil).Once()
+
+		page, err := dummyService.List(&domain.TemplateFilter{SortBy: "updated_at", SortOrder: "desc", PageSize: 2})
+		assert.Nil(t, err)
+		assert.Equal(t, 2, len(page.Templates))
+		assert.Equal(t, "bar", page.Templates[1].Name)
+		assert.Equal(t, encodePageToken(2), page.NextPageToken)
+		repositoryMock.AssertExpectations(t)
+	})
+
+	t.Run("should return the last page from the offset of the page token", func(t *testing.T) {
+		repositoryMock := &TemplatesRepositoryMock{}
+		dummyService := Service{repository: repositoryMock}
+		token := encodePageToken(2)
+		expectedFilter := &domain.TemplateFilter{SortBy: "name", SortOrder: "asc", PageSize: 2, PageToken: token}
+		repositoryMock.On("List", expectedFilter, 2, 3).Return(dummyTemplates[2:], nil).Once()
+
+		page, err := dummyService.List(&domain.TemplateFilter{PageSize: 2, PageToken: token})
+		assert.Nil(t, err)
+		assert.Equal(t, 1, len(page.Templates))
+		assert.Equal(t, "baz", page.Templates[0].Name)
+		assert.Equal(t, "", page.NextPageToken)
+		repositoryMock.AssertExpectations(t)
+	})
+
+	t.Run("should return error if the filter is invalid", func(t *testing.T) {
+		tests := []struct {
+			filter *domain.TemplateFilter
+			err    string
+		}{
+			{&domain.TemplateFilter{SortBy: "body"}, `invalid template filter: unknown sort "body"`},
+			{&domain.TemplateFilter{SortOrder: "up"}, `invalid template filter: unknown sort order "up"`},
+			{&domain.TemplateFilter{PageSize: -1}, "invalid template filter: page size cannot be negative"},
+			{&domain.TemplateFilter{PageToken: "!"}, "invalid template filter: malformed page token"},
+			{&domain.TemplateFilter{PageToken: encodePageToken(-1)}, "invalid template filter: malformed page token"},
+		}
+		for _, tc := range tests {
+			repositoryMock := &TemplatesRepositoryMock{}
+			dummyService := Service{repository: repositoryMock}
+			page, err := dummyService.List(tc.filter)
+			assert.Nil(t, page)
+			assert.EqualError(t, err, tc.err)
+			repositoryMock.AssertNotCalled(t, "List")
+		}
+	})
+
+	t.Run("should return error if listing failed", func(t *testing.T) {
+		repositoryMock := &TemplatesRepositoryMock{}
+		dummyService := Service{repository: repositoryMock}
+		repositoryMock.On("List", &domain.TemplateFilter{SortBy: "name", SortOrder: "asc"}, 0, 0).
+			Return(nil, errors.New("random error")).Once()
+
+		page, err := dummyService.List(&domain.TemplateFilter{})
+		assert.Nil(t, page)
+		assert.EqualError(t, err, "random error")
+	})
+}
+
+func TestService_Upsert(t *testing.T) {
+	template := &domain.Template{Name: "foo", Body: "bar", Tags: []string{"baz"}, Variables: []domain.Variable{}}
+	storedTemplate := &Template{ID: 1, Name: "foo", Body: "bar", Tags: []string{"baz"}, Variables: `[]`, Version: 2}
+	reports := []domain.RuleGroupSync{{ProviderNamespace: 1, Namespace: "foo", GroupName: "bar", Success: true}}
+
+	t.Run("should store the template and sync the rule groups with rules of it", func(t *testing.T) {
+		repositoryMock := &TemplatesRepositoryMock{}
+		rulesMock := &TemplateRulesMock{}
+		dummyService := Service{repository: repositoryMock, rules: rulesMock}
+		repositoryMock.On("Upsert", mock.AnythingOfType("*templates.Template")).Return(storedTemplate, nil).Once()
+		rulesMock.On("SyncTemplate", mock.AnythingOfType("*domain.Template"), false, mock.Anything).
+			Run(func(args mock.Arguments) {
+				assert.Equal(t, uint(2), args.Get(0).(*domain.Template).Version)
+			}).Return(reports, nil).Once()
+
+		result, ruleGroupSyncs, err := dummyService.Upsert(template, false)
+		assert.Nil(t, err)
+		assert.Equal(t, uint(1), result.ID)
+		assert.Equal(t, reports, ruleGroupSyncs)
+		repositoryMock.AssertExpectations(t)
+		rulesMock.AssertExpectations(t)
+	})
+
+	t.Run("should store a template whose variables have no type as strings", func(t *testing.T) {
+		repositoryMock := &TemplatesRepositoryMock{}
+		rulesMock := &TemplateRulesMock{}
+		dummyService := Service{repository: repositoryMock, rules: rulesMock}
+		untypedTemplate := &domain.Template{Name: "foo", Body: "bar",
+			Variables: []domain.Variable{{Name: "team", Type: "", Default: "odpf"}}}
+		repositoryMock.On("Upsert", mock.AnythingOfType("*templates.Template")).Return(storedTemplate, nil).Once()
+		rulesMock.On("SyncTemplate", mock.AnythingOfType("*domain.Template"), false, mock.Anything).
+			Return(reports, nil).Once()
+
+		result, _, err := dummyService.Upsert(untypedTemplate, false)
+		assert.Nil(t, err)
+		assert.Equal(t, uint(1), result.ID)
+		repositoryMock.AssertExpectations(t)
+	})
+
+	t.Run("should diff the rule groups without storing the template in dry run", func(t *testing.T) {
+		repositoryMock := &TemplatesRepositoryMock{}
+		rulesMock := &TemplateRulesMock{}
+		dummyService := Service{repository: repositoryMock, rules: rulesMock}
+		repositoryMock.On("ListPartials").Return([]Partial{{ID: 1, Name: "labels", Body: "team: odpf"}}, nil).Once()
+		rulesMock.On("SyncTemplate", mock.AnythingOfType("*domain.Template"), true, mock.Anything).
+			Run(func(args mock.Arguments) {
+				assert.Equal(t, "labels", args.Get(0).(*domain.Template).Partials[0].Name)
+			}).Return(reports, nil).Once()
+
+		result, ruleGroupSyncs, err := dummyService.Upsert(template, true)
+		assert.Nil(t, err)
+		assert.Equal(t, "foo", result.Name)
+		assert.Empty(t, template.Partials)
+		assert.Equal(t, reports, ruleGroupSyncs)
+		repositoryMock.AssertNotCalled(t, "Upsert", mock.Anything)
+		rulesMock.AssertExpectations(t)
+	})
+
+	t.Run("should return error if syncing the rule groups failed", func(t *testing.T) {
+		repositoryMock := &TemplatesRepositoryMock{}
+		rulesMock := &TemplateRulesMock{}
+		dummyService := Service{repository: repositoryMock, rules: rulesMock}
+		repositoryMock.On("Upsert", mock.AnythingOfType("*templates.Template")).Return(storedTemplate, nil).Once()
+		rulesMock.On("SyncTemplate", mock.AnythingOfType("*domain.Template"), false, mock.Anything).
+			Return(nil, errors.New("random error")).Once()
+
+		result, ruleGroupSyncs, err := dummyService.Upsert(template, false)
+		assert.Nil(t, result)
+		assert.Nil(t, ruleGroupSyncs)
+		assert.EqualError(t, err, "random error")
+	})
+}
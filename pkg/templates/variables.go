@@ -0,0 +1,193 @@
+package templates
+
+import (
+	"fmt"
+	"github.com/odpf/siren/domain"
+	"github.com/prometheus/common/model"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	variableTypeInt      = "int"
+	variableTypeFloat    = "float"
+	variableTypeDuration = "duration"
+	variableTypeBool     = "bool"
+	variableTypeString   = "string"
+	variableTypeEnum     = "enum"
+)
+
+func invalidVariable(name, format string, args ...interface{}) error {
+	return &domain.InvalidVariableError{Name: name, Reason: fmt.Sprintf(format, args...)}
+}
+
+// ValidateVariables checks the definitions of the variables of a template,
+// including that the default of every optional variable is a valid value
+func ValidateVariables(variables []domain.Variable) error {
+	for _, variable := range variables {
+		if err := validateVariableDefinition(variable); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func validateVariableDefinition(variable domain.Variable) error {
+	// variables without a type, as in templates written before types were
+	// checked, are strings
+	if variable.Type == "" {
+		variable.Type = variableTypeString
+	}
+	switch variable.Type {
+	case variableTypeInt, variableTypeFloat, variableTypeDuration:
+		for _, bound := range []string{variable.Min, variable.Max} {
+			if bound == "" {
+				continue
+			}
+			if _, err := parseBound(variable.Type, bound); err != nil {
+				return invalidVariable(variable.Name, "bound %q is not a valid %s", bound, variable.Type)
+			}
+		}
+		if variable.Min != "" && variable.Max != "" {
+			min, _ := parseBound(variable.Type, variable.Min)
+			max, _ := parseBound(variable.Type, variable.Max)
+			if min > max {
+				return invalidVariable(variable.Name, "min %s is greater than max %s", variable.Min, variable.Max)
+			}
+		}
+	case variableTypeString:
+		if variable.Pattern != "" {
+			if _, err := compilePattern(variable.Pattern); err != nil {
+				return invalidVariable(variable.Name, "invalid pattern: %v", err)
+			}
+		}
+	case variableTypeEnum:
+		if len(variable.AllowedValues) == 0 {
+			return invalidVariable(variable.Name, "enum variables need allowed values")
+		}
+	case variableTypeBool:
+	default:
+		return invalidVariable(variable.Name, "unknown type %q", variable.Type)
+	}
+
+	if (variable.Min != "" || variable.Max != "") && !isBoundedType(variable.Type) {
+		return invalidVariable(variable.Name, "min and max are only supported by int, float and duration variables")
+	}
+	if variable.Pattern != "" && variable.Type != variableTypeString {
+		return invalidVariable(variable.Name, "pattern is only supported by string variables")
+	}
+	if len(variable.AllowedValues) != 0 && variable.Type != variableTypeEnum {
+		return invalidVariable(variable.Name, "allowed values are only supported by enum variables")
+	}
+
+	if variable.Required {
+		if variable.Default != "" {
+			return invalidVariable(variable.Name, "required variables cannot have a default")
+		}
+		return nil
+	}
+	if err := ValidateVariable(variable, variable.Default); err != nil {
+		return invalidVariable(variable.Name, "default %q is not a valid value, set a valid default or make the variable required", variable.Default)
+	}
+	return nil
+}
+
+// ValidateVariable checks a value of a template variable against its type
+// and constraints. Variables without a type are strings. Variables of unknown
+// types, which can be stored by templates created before types were checked,
+// accept any value.
+func ValidateVariable(variable domain.Variable, value string) error {
+	if variable.Required && value == "" {
+		return invalidVariable(variable.Name, "value is required")
+	}
+	if variable.Type == "" {
+		variable.Type = variableTypeString
+	}
+	switch variable.Type {
+	case variableTypeInt:
+		n, err := strconv.ParseInt(value, 10, 64)
+		if err != nil {
+			return invalidVariable(variable.Name, "%q is not an int", value)
+		}
+		return checkBounds(variable, value, float64(n))
+	case variableTypeFloat:
+		f, err := strconv.ParseFloat(value, 64)
+		if err != nil {
+			return invalidVariable(variable.Name, "%q is not a float", value)
+		}
+		return checkBounds(variable, value, f)
+	case variableTypeDuration:
+		d, err := model.ParseDuration(value)
+		if err != nil {
+			return invalidVariable(variable.Name, "%q is not a duration", value)
+		}
+		return checkBounds(variable, value, float64(time.Duration(d)))
+	case variableTypeBool:
+		if _, err := strconv.ParseBool(value); err != nil {
+			return invalidVariable(variable.Name, "%q is not a bool", value)
+		}
+	case variableTypeString:
+		if variable.Pattern == "" {
+			return nil
+		}
+		pattern, err := compilePattern(variable.Pattern)
+		if err != nil {
+			return invalidVariable(variable.Name, "invalid pattern: %v", err)
+		}
+		if !pattern.MatchString(value) {
+			return invalidVariable(variable.Name, "%q does not match pattern %s", value, variable.Pattern)
+		}
+	case variableTypeEnum:
+		for _, allowedValue := range variable.AllowedValues {
+			if value == allowedValue {
+				return nil
+			}
+		}
+		return invalidVariable(variable.Name, "%q is not one of [%s]", value, strings.Join(variable.AllowedValues, ", "))
+	}
+	return nil
+}
+
+func isBoundedType(variableType string) bool {
+	return variableType == variableTypeInt || variableType == variableTypeFloat || variableType == variableTypeDuration
+}
+
+// compilePattern anchors a pattern so that it has to match the whole value
+func compilePattern(pattern string) (*regexp.Regexp, error) {
+	return regexp.Compile("^(?:" + pattern + ")$")
+}
+
+func parseBound(variableType, bound string) (float64, error) {
+	if variableType == variableTypeDuration {
+		d, err := model.ParseDuration(bound)
+		if err != nil {
+			return 0, err
+		}
+		return float64(time.Duration(d)), nil
+	}
+	return strconv.ParseFloat(bound, 64)
+}
+
+func checkBounds(variable domain.Variable, value string, number float64) error {
+	if variable.Min != "" {
+		min, err := parseBound(variable.Type, variable.Min)
+		if err != nil {
+			return invalidVariable(variable.Name, "bound %q is not a valid %s", variable.Min, variable.Type)
+		}
+		if number < min {
+			return invalidVariable(variable.Name, "%s is less than min %s", value, variable.Min)
+		}
+	}
+	if variable.Max != "" {
+		max, err := parseBound(variable.Type, variable.Max)
+		if err != nil {
+			return invalidVariable(variable.Name, "bound %q is not a valid %s", variable.Max, variable.Type)
+		}
+		if number > max {
+			return invalidVariable(variable.Name, "%s is greater than max %s", value, variable.Max)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,193 @@
+package templates
+
+import (
+	"github.com/odpf/siren/domain"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestValidateVariable(t *testing.T) {
+	tests := []struct {
+		name     string
+		variable domain.Variable
+		value    string
+		err      string
+	}{
+		{
+			name:     "should accept an int",
+			variable: domain.Variable{Name: "threshold", Type: "int"},
+			value:    "80",
+		},
+		{
+			name:     "should reject a value that is not an int",
+			variable: domain.Variable{Name: "threshold", Type: "int"},
+			value:    "abc",
+			err:      `invalid variable threshold: "abc" is not an int`,
+		},
+		{
+			name:     "should reject an int less than min",
+			variable: domain.Variable{Name: "threshold", Type: "int", Min: "10"},
+			value:    "5",
+			err:      "invalid variable threshold: 5 is less than min 10",
+		},
+		{
+			name:     "should accept a float within bounds",
+			variable: domain.Variable{Name: "ratio", Type: "float", Min: "0", Max: "1"},
+			value:    "0.5",
+		},
+		{
+			name:     "should reject a float greater than max",
+			variable: domain.Variable{Name: "ratio", Type: "float", Min: "0", Max: "1"},
+			value:    "1.5",
+			err:      "invalid variable ratio: 1.5 is greater than max 1",
+		},
+		{
+			name:     "should accept a prometheus duration",
+			variable: domain.Variable{Name: "for", Type: "duration"},
+			value:    "1h30m",
+		},
+		{
+			name:     "should reject a value that is not a duration",
+			variable: domain.Variable{Name: "for", Type: "duration"},
+			value:    "10",
+			err:      `invalid variable for: "10" is not a duration`,
+		},
+		{
+			name:     "should compare durations with duration bounds",
+			variable: domain.Variable{Name: "for", Type: "duration", Max: "1h"},
+			value:    "90m",
+			err:      "invalid variable for: 90m is greater than max 1h",
+		},
+		{
+			name:     "should reject a value that is not a bool",
+			variable: domain.Variable{Name: "enabled", Type: "bool"},
+			value:    "yes",
+			err:      `invalid variable enabled: "yes" is not a bool`,
+		},
+		{
+			name:     "should accept any string without a pattern",
+			variable: domain.Variable{Name: "team", Type: "string"},
+			value:    "",
+		},
+		{
+			name:     "should reject a string that does not match the whole pattern",
+			variable: domain.Variable{Name: "team", Type: "string", Pattern: "[a-z]+"},
+			value:    "odpf-1",
+			err:      `invalid variable team: "odpf-1" does not match pattern [a-z]+`,
+		},
+		{
+			name:     "should accept an allowed enum value",
+			variable: domain.Variable{Name: "severity", Type: "enum", AllowedValues: []string{"WARNING", "CRITICAL"}},
+			value:    "CRITICAL",
+		},
+		{
+			name:     "should reject an enum value that is not allowed",
+			variable: domain.Variable{Name: "severity", Type: "enum", AllowedValues: []string{"WARNING", "CRITICAL"}},
+			value:    "INFO",
+			err:      `invalid variable severity: "INFO" is not one of [WARNING, CRITICAL]`,
+		},
+		{
+			name:     "should reject an empty value of a required variable",
+			variable: domain.Variable{Name: "team", Type: "string", Required: true},
+			value:    "",
+			err:      "invalid variable team: value is required",
+		},
+		{
+			name:     "should accept any value of a variable of unknown type",
+			variable: domain.Variable{Name: "foo", Type: "bar"},
+			value:    "baz",
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := ValidateVariable(tc.variable, tc.value)
+			if tc.err == "" {
+				assert.Nil(t, err)
+				return
+			}
+			assert.EqualError(t, err, tc.err)
+		})
+	}
+}
+
+func TestValidateVariables(t *testing.T) {
+	tests := []struct {
+		name     string
+		variable domain.Variable
+		err      string
+	}{
+		{
+			name:     "should accept a variable with a valid default",
+			variable: domain.Variable{Name: "threshold", Type: "int", Default: "80", Min: "0", Max: "100"},
+		},
+		{
+			name:     "should accept a required variable without default",
+			variable: domain.Variable{Name: "team", Type: "string", Required: true},
+		},
+		{
+			name:     "should accept a variable without a type as a string",
+			variable: domain.Variable{Name: "team", Default: "odpf", Pattern: "^[a-z]+$"},
+		},
+		{
+			name:     "should reject an unknown type",
+			variable: domain.Variable{Name: "foo", Type: "bar"},
+			err:      `invalid variable foo: unknown type "bar"`,
+		},
+		{
+			name:     "should reject an invalid default",
+			variable: domain.Variable{Name: "threshold", Type: "int", Default: "abc"},
+			err:      `invalid variable threshold: default "abc" is not a valid value, set a valid default or make the variable required`,
+		},
+		{
+			name:     "should reject an empty default of an int",
+			variable: domain.Variable{Name: "threshold", Type: "int"},
+			err:      `invalid variable threshold: default "" is not a valid value, set a valid default or make the variable required`,
+		},
+		{
+			name:     "should reject a required variable with a default",
+			variable: domain.Variable{Name: "team", Type: "string", Default: "odpf", Required: true},
+			err:      "invalid variable team: required variables cannot have a default",
+		},
+		{
+			name:     "should reject min greater than max",
+			variable: domain.Variable{Name: "for", Type: "duration", Default: "5m", Min: "1h", Max: "1m"},
+			err:      "invalid variable for: min 1h is greater than max 1m",
+		},
+		{
+			name:     "should reject a bound that does not match the type",
+			variable: domain.Variable{Name: "threshold", Type: "int", Default: "80", Max: "1h"},
+			err:      `invalid variable threshold: bound "1h" is not a valid int`,
+		},
+		{
+			name:     "should reject bounds of a string",
+			variable: domain.Variable{Name: "team", Type: "string", Min: "1"},
+			err:      "invalid variable team: min and max are only supported by int, float and duration variables",
+		},
+		{
+			name:     "should reject an enum without allowed values",
+			variable: domain.Variable{Name: "severity", Type: "enum", Default: "WARNING"},
+			err:      "invalid variable severity: enum variables need allowed values",
+		},
+		{
+			name:     "should reject allowed values of a string",
+			variable: domain.Variable{Name: "severity", Type: "string", AllowedValues: []string{"WARNING"}},
+			err:      "invalid variable severity: allowed values are only supported by enum variables",
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := ValidateVariables([]domain.Variable{tc.variable})
+			if tc.err == "" {
+				assert.Nil(t, err)
+				return
+			}
+			assert.EqualError(t, err, tc.err)
+		})
+	}
+}
+
+func TestValidateVariablesWithInvalidPattern(t *testing.T) {
+	err := ValidateVariables([]domain.Variable{{Name: "team", Type: "string", Pattern: "[a-z"}})
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "invalid variable team: invalid pattern: error parsing regexp")
+}
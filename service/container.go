@@ -1,9 +1,14 @@
 package service
 
 import (
+	"github.com/odpf/siren/pkg/adapter"
+	"github.com/odpf/siren/pkg/adapter/cortex"
+	"github.com/odpf/siren/pkg/adapter/loki"
+	"github.com/odpf/siren/pkg/adapter/prometheus"
 	"github.com/odpf/siren/pkg/namespace"
 	"github.com/odpf/siren/pkg/provider"
 	"github.com/odpf/siren/pkg/receiver"
+	"github.com/odpf/siren/pkg/silence"
 	"github.com/odpf/siren/pkg/subscription"
 	"net/http"
 
@@ -20,6 +25,7 @@ import (
 type Container struct {
 	TemplatesService    domain.TemplatesService
 	RulesService        domain.RuleService
+	RuleReconciler      domain.RuleReconciler
 	AlertService        domain.AlertService
 	CodeExchangeService domain.CodeExchangeService
 	NotifierServices    domain.NotifierServices
@@ -27,11 +33,25 @@ type Container struct {
 	NamespaceService    domain.NamespaceService
 	ReceiverService     domain.ReceiverService
 	SubscriptionService domain.SubscriptionService
+	InhibitionService   domain.InhibitionService
+	TimeIntervalService domain.TimeIntervalService
+	SilenceService      domain.SilenceService
 }
 
-func Init(db *gorm.DB, c *domain.Config, httpClient *http.Client) (*Container, error) {
-	templatesService := templates.NewService(db)
-	rulesService := rules.NewService(db)
+// NewProviderAdapters returns the registry of the provider adapters built into siren
+func NewProviderAdapters(c *domain.Config, httpClient *http.Client) *adapter.Registry {
+	adapters := adapter.NewRegistry()
+	adapters.Register(cortex.ProviderType, cortex.NewFactory(httpClient))
+	prometheusFactory := prometheus.NewFactory(c.Prometheus, httpClient)
+	adapters.Register(prometheus.ProviderType, prometheusFactory)
+	adapters.Register(prometheus.VMAlertProviderType, prometheusFactory)
+	adapters.Register(loki.ProviderType, loki.NewFactory(httpClient))
+	return adapters
+}
+
+func Init(db *gorm.DB, c *domain.Config, httpClient *http.Client, adapters *adapter.Registry) (*Container, error) {
+	templatesService := templates.NewService(db, rules.NewRepository(db, adapters))
+	rulesService := rules.NewService(db, adapters)
 	alertHistoryService := alerts.NewService(db)
 	codeExchangeService, err := codeexchange.NewService(db, httpClient, c.SlackApp, c.EncryptionKey)
 	if err != nil {
@@ -40,7 +60,11 @@ func Init(db *gorm.DB, c *domain.Config, httpClient *http.Client) (*Container, e
 
 	slackNotifierService := slacknotifier.NewService()
 	providerService := provider.NewService(db)
-	namespaceService, err := namespace.NewService(db, c.EncryptionKey)
+	namespaceSyncer, err := subscription.NewNamespaceSyncer(db, adapters, c.EncryptionKey)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create namespace syncer")
+	}
+	namespaceService, err := namespace.NewService(db, c.EncryptionKey, namespaceSyncer)
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to create namespace service")
 	}
@@ -48,10 +72,22 @@ func Init(db *gorm.DB, c *domain.Config, httpClient *http.Client) (*Container, e
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to create receiver service")
 	}
-	subscriptionService, err := subscription.NewService(db, c.EncryptionKey)
+	subscriptionService, err := subscription.NewService(db, adapters, c.EncryptionKey)
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to create subscriptions service")
 	}
+	inhibitionService, err := subscription.NewInhibitionService(db, adapters, c.EncryptionKey)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create inhibition service")
+	}
+	timeIntervalService, err := subscription.NewTimeIntervalService(db, adapters, c.EncryptionKey)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create time interval service")
+	}
+	silenceService, err := silence.NewService(db, adapters, c.EncryptionKey)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create silence service")
+	}
 
 	return &Container{
 		TemplatesService:    templatesService,
@@ -65,6 +101,9 @@ func Init(db *gorm.DB, c *domain.Config, httpClient *http.Client) (*Container, e
 		NamespaceService:    namespaceService,
 		ReceiverService:     receiverService,
 		SubscriptionService: subscriptionService,
+		InhibitionService:   inhibitionService,
+		TimeIntervalService: timeIntervalService,
+		SilenceService:      silenceService,
 	}, nil
 }
 
@@ -101,5 +140,13 @@ func (container *Container) MigrateAll(db *gorm.DB) error {
 	if err != nil {
 		return err
 	}
+	err = container.InhibitionService.Migrate()
+	if err != nil {
+		return err
+	}
+	err = container.TimeIntervalService.Migrate()
+	if err != nil {
+		return err
+	}
 	return nil
 }